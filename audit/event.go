@@ -0,0 +1,126 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit implements a tamper-evident log of administrative and consent actions.
+//
+// Every event contains the hash of its predecessor, which makes the events of a network a hash chain. Modifying or
+// removing an event breaks the chain, which is detected by Verify. The hashes are HMACs keyed with the system secret,
+// so that the chain can not be recomputed by someone who can only write to the database.
+package audit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/x/sqlxx"
+)
+
+const (
+	ActionClientCreated             = "client.created"
+	ActionClientUpdated             = "client.updated"
+	ActionClientDeleted             = "client.deleted"
+	ActionInitialAccessTokenCreated = "client.initial_access_token.created" // #nosec G101
+	ActionInitialAccessTokenDeleted = "client.initial_access_token.deleted" // #nosec G101
+	ActionJSONWebKeySetCreated      = "jwk.set.created"
+	ActionJSONWebKeySetUpdated      = "jwk.set.updated"
+	ActionJSONWebKeySetDeleted      = "jwk.set.deleted"
+	ActionJSONWebKeyUpdated         = "jwk.key.updated"
+	ActionJSONWebKeyDeleted         = "jwk.key.deleted"
+	ActionTrustGrantCreated         = "trust.grant.created"
+	ActionTrustGrantDeleted         = "trust.grant.deleted"
+	ActionLoginAccepted             = "login.accepted"
+	ActionLoginRejected             = "login.rejected"
+	ActionLoginSessionRevoked       = "login.session.revoked"
+	ActionConsentAccepted           = "consent.accepted"
+	ActionConsentRejected           = "consent.rejected"
+	ActionConsentRevoked            = "consent.revoked"
+	ActionLogoutAccepted            = "logout.accepted"
+	ActionLogoutRejected            = "logout.rejected"
+	ActionDeviceUserCodeAccepted    = "device.user_code.accepted"
+)
+
+// Audit Event
+//
+// swagger:model auditEvent
+type Event struct {
+	// The ID of the event.
+	ID uuid.UUID `json:"id" db:"id"`
+
+	NID uuid.UUID `json:"-" db:"nid"`
+
+	// The position of the event in the audit log of the network, starting at 1.
+	Sequence int64 `json:"sequence" db:"seq"`
+
+	// The time the event was recorded at, truncated to seconds.
+	Time time.Time `json:"time" db:"created_at"`
+
+	// The caller which performed the action, taken from the configured actor header or the TLS client certificate.
+	Actor string `json:"actor" db:"actor"`
+
+	// The action which was performed, for example "client.updated".
+	Action string `json:"action" db:"action"`
+
+	// The ID of the resource the action was performed on.
+	ResourceID string `json:"resource_id" db:"resource_id"`
+
+	// The ID of the request which performed the action.
+	RequestID string `json:"request_id" db:"request_id"`
+
+	// The changed fields of the resource, with secrets redacted. Every field is an object with the keys "before"
+	// and "after"; a key is missing if the field did not exist before or does not exist after the action.
+	Diff sqlxx.JSONRawMessage `json:"diff" db:"diff"`
+
+	// The hash of the previous event. It is empty for the first event.
+	PreviousHash string `json:"previous_hash" db:"previous_hash"`
+
+	// The hash of this event, which covers all other fields including the hash of the previous event. It is an
+	// HMAC-SHA256 keyed with the system secret.
+	Hash string `json:"hash" db:"hash"`
+}
+
+func (Event) TableName() string {
+	return "hydra_audit_event"
+}
+
+// ComputeHash returns the hash of the event, keyed with the given key. The fields are length-prefixed, so that no
+// two distinct events serialize the same way.
+func (e *Event) ComputeHash(key []byte) string {
+	h := hmac.New(sha256.New, key)
+	for _, v := range []string{
+		e.ID.String(),
+		e.NID.String(),
+		strconv.FormatInt(e.Sequence, 10),
+		strconv.FormatInt(e.Time.Unix(), 10),
+		e.Actor,
+		e.Action,
+		e.ResourceID,
+		e.RequestID,
+		string(e.Diff),
+		e.PreviousHash,
+	} {
+		_, _ = fmt.Fprintf(h, "%d:%s", len(v), v)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// HashKeys returns the keys audit events are hashed with. The first key is derived from the current system secret
+// and hashes new events; the others are derived from rotated system secrets and are only used for verification.
+func HashKeys(ctx context.Context, c *config.DefaultProvider) ([][]byte, error) {
+	current, err := c.GetGlobalSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := c.GetRotatedGlobalSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append([][]byte{current}, rotated...), nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/httprouterx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+const (
+	EventsPath = "/audit/events"
+)
+
+type Handler struct {
+	r InternalRegistry
+}
+
+func NewHandler(r InternalRegistry) *Handler {
+	return &Handler{r: r}
+}
+
+func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
+	admin.GET(EventsPath, h.listAuditEvents)
+}
+
+// Audit Events
+//
+// swagger:model auditEvents
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type auditEvents []Event
+
+// List Audit Events Request
+//
+// swagger:parameters listAuditEvents
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type listAuditEvents struct {
+	// If set, only events with this action, for example "client.updated", are returned.
+	//
+	// in: query
+	// required: false
+	Action string `json:"action"`
+
+	// If set, only events for the resource with this ID are returned.
+	//
+	// in: query
+	// required: false
+	ResourceID string `json:"resource_id"`
+
+	// If set, only events performed by this actor are returned.
+	//
+	// in: query
+	// required: false
+	Actor string `json:"actor"`
+
+	keysetpagination.RequestParameters
+}
+
+// swagger:route GET /admin/audit/events audit listAuditEvents
+//
+// # List Audit Events
+//
+// Lists the events of the audit log in the order they were recorded in. The audit log contains the mutating calls
+// to the admin APIs for clients, JSON Web Keys, trust relationships, and login, consent and logout flows. Secrets
+// are redacted from the recorded changes.
+//
+// Use `hydra audit verify` to check that the audit log has not been tampered with.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: auditEvents
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) listAuditEvents(w http.ResponseWriter, r *http.Request) {
+	pageKeys := h.r.Config().GetPaginationEncryptionKeys(r.Context())
+	pageOpts, err := keysetpagination.ParseQueryParams(pageKeys, r.URL.Query())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse pagination parameters: %v", err)))
+		return
+	}
+
+	q := r.URL.Query()
+	events, nextPage, err := h.r.AuditManager().GetAuditEvents(r.Context(), Filter{
+		Action:     q.Get("action"),
+		ResourceID: q.Get("resource_id"),
+		Actor:      q.Get("actor"),
+	}, pageOpts...)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if events == nil {
+		events = []Event{}
+	}
+
+	keysetpagination.SetLinkHeader(w, pageKeys, r.URL, nextPage)
+	h.r.Writer().Write(w, r, events)
+}
@@ -0,0 +1,105 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/x/httprouterx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	reg := newRegistry(t)
+	router := httprouterx.NewRouterAdminWithPrefix()
+	audit.NewHandler(reg).SetRoutes(router)
+	client.NewHandler(reg).SetAdminRoutes(router)
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path, body string) *http.Response {
+		req, err := http.NewRequestWithContext(t.Context(), method, ts.URL+"/admin"+path, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("X-Forwarded-User", "alice")
+		res, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+	list := func(t *testing.T, query string) []audit.Event {
+		res := do(t, http.MethodGet, audit.EventsPath+query, "")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		var events []audit.Event
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&events))
+		return events
+	}
+
+	res := do(t, http.MethodPost, client.ClientsHandlerPath, `{"client_id": "audited", "redirect_uris": ["https://a.example.com/"]}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	res = do(t, http.MethodPatch, client.ClientsHandlerPath+"/audited", `[{"op": "replace", "path": "/redirect_uris", "value": ["https://b.example.com/"]}]`)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	res = do(t, http.MethodDelete, client.ClientsHandlerPath+"/audited", "")
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	t.Run("case=lists events", func(t *testing.T) {
+		events := list(t, "")
+		require.Len(t, events, 3)
+		for i, action := range []string{audit.ActionClientCreated, audit.ActionClientUpdated, audit.ActionClientDeleted} {
+			assert.Equal(t, action, events[i].Action)
+			assert.Equal(t, "audited", events[i].ResourceID)
+			assert.Equal(t, "alice", events[i].Actor)
+			assert.EqualValues(t, i+1, events[i].Sequence)
+		}
+		assert.JSONEq(t, `{"before": ["https://a.example.com/"], "after": ["https://b.example.com/"]}`, gjson.GetBytes(events[1].Diff, "redirect_uris").Raw)
+	})
+
+	t.Run("case=filters events", func(t *testing.T) {
+		events := list(t, "?action="+audit.ActionClientUpdated)
+		require.Len(t, events, 1)
+		assert.Equal(t, audit.ActionClientUpdated, events[0].Action)
+
+		assert.Len(t, list(t, "?resource_id=audited"), 3)
+		assert.Empty(t, list(t, "?actor=bob"))
+	})
+
+	t.Run("case=paginates events", func(t *testing.T) {
+		res := do(t, http.MethodGet, audit.EventsPath+"?page_size=2", "")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		var events []audit.Event
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&events))
+		require.Len(t, events, 2)
+
+		_, next, isLast := keysetpagination.ParseHeader(res)
+		require.False(t, isLast)
+		res = do(t, http.MethodGet, audit.EventsPath+"?page_size=2&page_token="+url.QueryEscape(next), "")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&events))
+		require.Len(t, events, 1)
+		assert.Equal(t, audit.ActionClientDeleted, events[0].Action)
+	})
+	t.Run("case=is decoded by the SDK", func(t *testing.T) {
+		sdk := hydra.NewAPIClient(hydra.NewConfiguration())
+		sdk.GetConfig().Servers = hydra.ServerConfigurations{{URL: ts.URL}}
+
+		events, _, err := sdk.AuditAPI.ListAuditEvents(t.Context()).Action(audit.ActionClientCreated).Execute()
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "audited", events[0].GetResourceId())
+		assert.EqualValues(t, 1, events[0].GetSequence())
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+type (
+	// Manager stores audit events. Events can only be appended, never changed or removed.
+	Manager interface {
+		// AppendAuditEvent assigns the next sequence number, the previous hash and the hash to the event and
+		// stores it.
+		AppendAuditEvent(ctx context.Context, e *Event) error
+
+		// GetAuditEvents returns the events matching the filter, ordered by their sequence number.
+		GetAuditEvents(ctx context.Context, filter Filter, pageOpts ...keysetpagination.Option) ([]Event, *keysetpagination.Paginator, error)
+	}
+
+	// Filter restricts the events returned by Manager.GetAuditEvents. Empty fields match all events.
+	Filter struct {
+		Action     string
+		ResourceID string
+		Actor      string
+	}
+
+	ManagerProvider interface {
+		AuditManager() Manager
+	}
+)
@@ -0,0 +1,51 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ory/hydra/v2/x"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+// TestHelperConcurrentAppends appends events concurrently, each in its own transaction like the admin and consent
+// handlers do, and checks that they form a single chain.
+func TestHelperConcurrentAppends(m Manager, tx x.Transactor) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx := t.Context()
+		resource := "concurrent-" + t.Name()
+
+		var eg errgroup.Group
+		for i := range 10 {
+			eg.Go(func() error {
+				return tx.Transaction(ctx, func(ctx context.Context) error {
+					return m.AppendAuditEvent(ctx, &Event{Action: ActionClientCreated, ResourceID: resource, Diff: []byte(fmt.Sprintf(`{"n":%d}`, i))})
+				})
+			})
+		}
+		require.NoError(t, eg.Wait())
+
+		events, _, err := m.GetAuditEvents(ctx, Filter{}, keysetpagination.WithSize(keysetpagination.DefaultMaxSize))
+		require.NoError(t, err)
+
+		var appended int
+		for k, e := range events {
+			if k > 0 {
+				assert.Equal(t, events[k-1].Sequence+1, e.Sequence)
+				assert.Equal(t, events[k-1].Hash, e.PreviousHash)
+			}
+			if e.ResourceID == resource {
+				appended++
+			}
+		}
+		assert.Equal(t, 10, appended)
+	}
+}
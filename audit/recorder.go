@@ -0,0 +1,201 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/sqlxx"
+)
+
+// redacted replaces the values of secret fields in the diff.
+const redacted = "[REDACTED]"
+
+var (
+	// secretFields are redacted wherever they appear in a resource.
+	secretFields = []string{"client_secret", "secret", "registration_access_token", "password"}
+
+	// privateKeyFields are the private members of a JSON Web Key, which are redacted in objects having a "kty".
+	privateKeyFields = []string{"d", "p", "q", "dp", "dq", "qi", "k", "oth"}
+)
+
+type (
+	recorderDependencies interface {
+		config.Provider
+		x.Transactor
+		ManagerProvider
+	}
+
+	// Recorder records audit events for requests to the admin API.
+	Recorder struct {
+		r recorderDependencies
+	}
+
+	RecorderProvider interface {
+		AuditRecorder() *Recorder
+	}
+)
+
+func NewRecorder(r recorderDependencies) *Recorder {
+	return &Recorder{r: r}
+}
+
+// Enabled returns whether the audit log is enabled. Handlers use it to skip loading the state of a resource before
+// it is changed if no event is recorded.
+func (r *Recorder) Enabled(ctx context.Context) bool {
+	return r.r.Config().AuditEnabled(ctx)
+}
+
+// Transaction runs f in a database transaction if the audit log is enabled, and directly otherwise. Handlers
+// perform an action and record its event in f, so that the action is rolled back if the event can not be recorded.
+func (r *Recorder) Transaction(ctx context.Context, f func(ctx context.Context) error) error {
+	if !r.Enabled(ctx) {
+		return f(ctx)
+	}
+	return r.r.Transaction(ctx, f)
+}
+
+// Record appends an event for the action performed by the request, if the audit log is enabled. Before and after
+// are the states of the resource and are marshalled to JSON; nil means that the resource did not exist.
+//
+// Record must be called in the Transaction which performs the action.
+func (r *Recorder) Record(ctx context.Context, req *http.Request, action, resourceID string, before, after any) error {
+	if !r.Enabled(ctx) {
+		return nil
+	}
+
+	d, err := diff(before, after)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return r.r.AuditManager().AppendAuditEvent(ctx, &Event{
+		Time:       time.Now().UTC().Truncate(time.Second),
+		Actor:      r.actor(req),
+		Action:     action,
+		ResourceID: resourceID,
+		RequestID:  req.Header.Get(r.r.Config().AuditRequestIDHeader(ctx)),
+		Diff:       d,
+	})
+}
+
+// actor returns the value of the configured actor header or, if the header is not configured or empty, the
+// identity of the TLS client certificate.
+func (r *Recorder) actor(req *http.Request) string {
+	if header := r.r.Config().AuditActorHeader(req.Context()); header != "" {
+		if actor := req.Header.Get(header); actor != "" {
+			return actor
+		}
+	}
+
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		cert := req.TLS.PeerCertificates[0]
+		if len(cert.URIs) > 0 {
+			return cert.URIs[0].String()
+		}
+		return cert.Subject.CommonName
+	}
+
+	return ""
+}
+
+// diff returns the top-level fields which differ between before and after. Fields are compared before secrets
+// are redacted, so that a changed secret shows up in the diff without revealing its value.
+func diff(before, after any) (sqlxx.JSONRawMessage, error) {
+	b, err := toObject(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toObject(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(map[string]bool)
+	for k, v := range b {
+		if av, ok := a[k]; !ok || !reflect.DeepEqual(v, av) {
+			changed[k] = true
+		}
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			changed[k] = true
+		}
+	}
+
+	b, a = redact(b).(map[string]any), redact(a).(map[string]any)
+	out := make(map[string]map[string]any, len(changed))
+	for k := range changed {
+		field := make(map[string]any, 2)
+		if v, ok := b[k]; ok {
+			field["before"] = v
+		}
+		if v, ok := a[k]; ok {
+			field["after"] = v
+		}
+		out[k] = field
+	}
+
+	// json.Marshal sorts map keys, which keeps the diff and therefore the hash deterministic.
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// toObject marshals v to JSON and decodes it into a map. Nil values, including typed nil pointers, are returned
+// as an empty map.
+func toObject(v any) (map[string]any, error) {
+	out := make(map[string]any)
+	if v == nil {
+		return out, nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(raw, []byte("null")) {
+		return out, nil
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		_, isKey := v["kty"]
+		out := make(map[string]any, len(v))
+		for k, vv := range v {
+			if slices.Contains(secretFields, k) || (isKey && slices.Contains(privateKeyFields, k)) {
+				out[k] = redacted
+				continue
+			}
+			out[k] = redact(vv)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, vv := range v {
+			out[i] = redact(vv)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/sqlcon"
+)
+
+func newRegistry(t *testing.T) *driver.RegistrySQL {
+	return testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyAuditEnabled:     true,
+		config.KeyAuditActorHeader: "X-Forwarded-User",
+	})))
+}
+
+func lastEvent(t *testing.T, reg *driver.RegistrySQL) audit.Event {
+	events, _, err := reg.AuditManager().GetAuditEvents(t.Context(), audit.Filter{})
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	return events[len(events)-1]
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("case=records the changed fields", func(t *testing.T) {
+		reg := newRegistry(t)
+
+		r := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/admin/clients/client-1", nil)
+		r.Header.Set("X-Forwarded-User", "alice")
+		r.Header.Set("X-Request-Id", "request-1")
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionClientUpdated, "client-1",
+			&client.Client{ID: "client-1", Name: "old", Secret: "old-secret", RedirectURIs: []string{"https://a.example.com/"}},
+			&client.Client{ID: "client-1", Name: "old", Secret: "new-secret", RedirectURIs: []string{"https://b.example.com/"}},
+		))
+
+		e := lastEvent(t, reg)
+		assert.Equal(t, "alice", e.Actor)
+		assert.Equal(t, "request-1", e.RequestID)
+		assert.Equal(t, audit.ActionClientUpdated, e.Action)
+		assert.Equal(t, "client-1", e.ResourceID)
+
+		diff := gjson.ParseBytes(e.Diff)
+		assert.JSONEq(t, `{"before": ["https://a.example.com/"], "after": ["https://b.example.com/"]}`, diff.Get("redirect_uris").Raw)
+		assert.JSONEq(t, `{"before": "[REDACTED]", "after": "[REDACTED]"}`, diff.Get("client_secret").Raw)
+		assert.False(t, diff.Get("client_name").Exists(), "%s", e.Diff)
+		assert.False(t, diff.Get("client_id").Exists(), "%s", e.Diff)
+		assert.NotContains(t, string(e.Diff), "new-secret")
+	})
+
+	t.Run("case=omits the missing side", func(t *testing.T) {
+		reg := newRegistry(t)
+
+		r := httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/admin/clients/client-1", nil)
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionClientDeleted, "client-1", &client.Client{ID: "client-1"}, (*client.Client)(nil)))
+
+		diff := gjson.ParseBytes(lastEvent(t, reg).Diff)
+		assert.Equal(t, "client-1", diff.Get("client_id.before").String())
+		assert.False(t, diff.Get("client_id.after").Exists())
+	})
+
+	t.Run("case=redacts private keys", func(t *testing.T) {
+		reg := newRegistry(t)
+
+		r := httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/admin/keys/set", nil)
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionJSONWebKeySetUpdated, "set", nil, map[string]any{
+			"keys": []any{map[string]any{"kty": "oct", "kid": "key-1", "k": "c2VjcmV0"}},
+		}))
+
+		diff := gjson.ParseBytes(lastEvent(t, reg).Diff)
+		assert.Equal(t, "key-1", diff.Get("keys.after.0.kid").String())
+		assert.Equal(t, "[REDACTED]", diff.Get("keys.after.0.k").String())
+	})
+
+	t.Run("case=uses the client certificate as actor", func(t *testing.T) {
+		reg := newRegistry(t)
+
+		r := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/clients", nil)
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "deploy-bot"}}}}
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionClientCreated, "client-1", nil, &client.Client{ID: "client-1"}))
+		assert.Equal(t, "deploy-bot", lastEvent(t, reg).Actor)
+
+		r.TLS.PeerCertificates[0].URIs = []*url.URL{{Scheme: "spiffe", Host: "example.org", Path: "/deploy-bot"}}
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionClientCreated, "client-2", nil, &client.Client{ID: "client-2"}))
+		assert.Equal(t, "spiffe://example.org/deploy-bot", lastEvent(t, reg).Actor)
+	})
+
+	t.Run("case=rolls back the action if the event can not be recorded", func(t *testing.T) {
+		reg := newRegistry(t)
+
+		r := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/admin/clients", nil)
+		err := reg.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+			require.NoError(t, reg.ClientManager().CreateClient(ctx, &client.Client{ID: "client-1"}))
+			// A channel can not be marshalled to JSON, which makes computing the diff fail.
+			return reg.AuditRecorder().Record(ctx, r, audit.ActionClientCreated, "client-1", nil, make(chan int))
+		})
+		require.Error(t, err)
+
+		_, err = reg.ClientManager().GetConcreteClient(t.Context(), "client-1")
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+	})
+
+	t.Run("case=does nothing if disabled", func(t *testing.T) {
+		reg := newRegistry(t)
+
+		ctx := contextx.WithConfigValue(t.Context(), config.KeyAuditEnabled, false)
+		r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/clients", nil)
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionClientCreated, "client-1", nil, &client.Client{ID: "client-1"}))
+
+		events, _, err := reg.AuditManager().GetAuditEvents(t.Context(), audit.Filter{})
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ory/hydra/v2/driver/config"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+// VerificationError is returned by Verify if the audit log has been tampered with.
+type VerificationError struct {
+	// Sequence is the sequence number of the first event which failed verification.
+	Sequence int64
+	Reason   string
+}
+
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf("audit event %d: %s", e.Sequence, e.Reason)
+}
+
+// VerificationResult describes a successfully verified audit log.
+type VerificationResult struct {
+	// Events is the number of verified events.
+	Events int64 `json:"events"`
+
+	// Head is the hash of the last event, or empty if there are no events.
+	Head string `json:"head"`
+}
+
+type verifierDependencies interface {
+	config.Provider
+	ManagerProvider
+}
+
+// Verify checks the hash chain of the audit log of the network in the context. It returns a *VerificationError
+// if an event was changed, removed or inserted.
+func Verify(ctx context.Context, r verifierDependencies) (*VerificationResult, error) {
+	keys, err := HashKeys(ctx, r.Config())
+	if err != nil {
+		return nil, err
+	}
+
+	var res VerificationResult
+	opts := []keysetpagination.Option{keysetpagination.WithSize(keysetpagination.DefaultMaxSize)}
+	for {
+		events, next, err := r.AuditManager().GetAuditEvents(ctx, Filter{}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range events {
+			switch {
+			case e.Sequence != res.Events+1:
+				return nil, &VerificationError{Sequence: res.Events + 1, Reason: fmt.Sprintf("expected the next event but got event %d", e.Sequence)}
+			case e.PreviousHash != res.Head:
+				return nil, &VerificationError{Sequence: e.Sequence, Reason: "the previous hash does not match the hash of the previous event"}
+			case !slices.ContainsFunc(keys, func(key []byte) bool { return e.Hash == e.ComputeHash(key) }):
+				return nil, &VerificationError{Sequence: e.Sequence, Reason: "the hash does not match the contents of the event"}
+			}
+			res.Events, res.Head = e.Sequence, e.Hash
+		}
+
+		if next.IsLast() {
+			return &res, nil
+		}
+		opts = next.ToOptions()
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/x/contextx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	reg := newRegistry(t)
+	ctx := t.Context()
+
+	res, err := audit.Verify(ctx, reg)
+	require.NoError(t, err)
+	assert.Zero(t, res.Events)
+	assert.Empty(t, res.Head)
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/clients", nil)
+	for i := range 5 {
+		id := fmt.Sprintf("client-%d", i)
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionClientCreated, id, nil, &client.Client{ID: id}))
+	}
+
+	t.Run("case=verifies the chain", func(t *testing.T) {
+		res, err := audit.Verify(ctx, reg)
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, res.Events)
+		assert.Equal(t, lastEvent(t, reg).Hash, res.Head)
+	})
+
+	t.Run("case=verifies across pages", func(t *testing.T) {
+		events, next, err := reg.AuditManager().GetAuditEvents(ctx, audit.Filter{}, keysetpagination.WithSize(2))
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.False(t, next.IsLast())
+	})
+
+	t.Run("case=verifies events hashed with a rotated secret", func(t *testing.T) {
+		ctx := contextx.WithConfigValue(ctx, config.KeyGetSystemSecret, []string{"111111111111111111111111111111111111111111111111", "000000000000000000000000000000000000000000000000"})
+		res, err := audit.Verify(ctx, reg)
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, res.Events)
+	})
+
+	t.Run("case=detects a recomputed chain", func(t *testing.T) {
+		// Without the system secret, an attacker can not recompute the hash of a changed event.
+		e := lastEvent(t, reg)
+		e.Actor = "mallory"
+		require.NoError(t, reg.Persister().Connection(ctx).RawQuery("UPDATE hydra_audit_event SET actor = ?, hash = ? WHERE seq = ?", e.Actor, e.ComputeHash([]byte("guessed")), e.Sequence).Exec())
+
+		_, err := audit.Verify(ctx, reg)
+		var verr *audit.VerificationError
+		require.ErrorAs(t, err, &verr)
+		assert.EqualValues(t, 5, verr.Sequence)
+	})
+
+	t.Run("case=detects tampering", func(t *testing.T) {
+		require.NoError(t, reg.Persister().Connection(ctx).RawQuery("UPDATE hydra_audit_event SET actor = ? WHERE seq = ?", "mallory", 3).Exec())
+
+		_, err := audit.Verify(ctx, reg)
+		var verr *audit.VerificationError
+		require.ErrorAs(t, err, &verr)
+		assert.EqualValues(t, 3, verr.Sequence)
+	})
+
+	t.Run("case=detects removed events", func(t *testing.T) {
+		require.NoError(t, reg.Persister().Connection(ctx).RawQuery("DELETE FROM hydra_audit_event WHERE seq = ?", 2).Exec())
+
+		_, err := audit.Verify(ctx, reg)
+		var verr *audit.VerificationError
+		require.ErrorAs(t, err, &verr)
+		assert.EqualValues(t, 2, verr.Sequence)
+	})
+}
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +26,31 @@ var (
 	_ fosite.Client              = (*Client)(nil)
 )
 
+const (
+	// RefreshTokenReusePolicyRevokeTokens revokes all tokens issued with the same authorization if a refresh token
+	// is reused.
+	RefreshTokenReusePolicyRevokeTokens = "revoke_tokens"
+	// RefreshTokenReusePolicyRevokeLoginSession additionally revokes the login session.
+	RefreshTokenReusePolicyRevokeLoginSession = "revoke_login_session"
+	// RefreshTokenReusePolicyRevokeConsent additionally revokes the login session and the consent.
+	RefreshTokenReusePolicyRevokeConsent = "revoke_consent"
+)
+
+const (
+	// SecurityProfileFAPI2Security enforces the FAPI 2.0 Security Profile for a client.
+	SecurityProfileFAPI2Security = "fapi2_security"
+	// SecurityProfileFAPI2MessageSigning additionally requires signed request objects.
+	SecurityProfileFAPI2MessageSigning = "fapi2_message_signing"
+)
+
+const (
+	// ApplicationTypeWeb restricts a client to https redirect URIs which are not on the loopback interface.
+	ApplicationTypeWeb = "web"
+	// ApplicationTypeNative restricts a client to the redirect URIs of native apps and requires it to be a public
+	// client using PKCE, see https://www.rfc-editor.org/rfc/rfc8252.
+	ApplicationTypeNative = "native"
+)
+
 // OAuth 2.0 Client
 //
 // OAuth 2.0 Clients are used to perform OAuth 2.0 and OpenID Connect flows. Usually, OAuth 2.0 clients are
@@ -51,6 +77,11 @@ type Client struct {
 	// never again. The secret is kept in hashed format and is not recoverable once lost.
 	Secret string `json:"client_secret,omitempty" db:"client_secret"`
 
+	// EncryptedSecret is the client secret, encrypted with the system secret. It is only stored for clients using the
+	// client_secret_jwt client authentication method, because the HMAC of their client assertions can not be
+	// verified with the hashed secret.
+	EncryptedSecret sqlxx.NullString `json:"-" db:"client_secret_encrypted" faker:"-"`
+
 	// OAuth 2.0 Client Redirect URIs
 	//
 	// RedirectURIs is an array of allowed redirect urls for the client.
@@ -195,6 +226,8 @@ type Client struct {
 	// - `client_secret_basic`: (default) Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` encoded in the HTTP Authorization header.
 	// - `client_secret_post`: Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` in the HTTP body.
 	// - `private_key_jwt`: Use JSON Web Tokens to authenticate the client.
+	// - `tls_client_auth`: Use a mutual TLS client certificate issued by a trusted certificate authority (RFC 8705).
+	// - `self_signed_tls_client_auth`: Use a self-signed mutual TLS client certificate whose key is in the client's JSON Web Key Set (RFC 8705).
 	// - `none`: Used for public clients (native apps, mobile apps) which can not have secrets.
 	//
 	// default: client_secret_basic
@@ -224,8 +257,64 @@ type Client struct {
 	// JWS alg algorithm [JWA] REQUIRED for signing UserInfo Responses. If this is specified, the response will be JWT
 	// [JWT] serialized, and signed using JWS. The default, if omitted, is for the UserInfo Response to return the Claims
 	// as a UTF-8 encoded JSON object using the application/json content-type.
+	//
+	// One of "none", "RS256", "ES256", "ES384", "PS256" and "EdDSA". The response is signed with the active key of the
+	// algorithm in the "hydra.openid.id-token" JSON Web Key Set.
 	UserinfoSignedResponseAlg string `json:"userinfo_signed_response_alg,omitempty" db:"userinfo_signed_response_alg" faker:"len=10"`
 
+	// OpenID Connect ID Token Signed Response Algorithm
+	//
+	// JWS alg algorithm [JWA] REQUIRED for signing the ID Token issued to this Client. One of "RS256", "ES256", "ES384",
+	// "PS256" and "EdDSA". The ID Token is signed with the active key of the algorithm in the "hydra.openid.id-token"
+	// JSON Web Key Set, which is the most recent key of that algorithm. If omitted, the most recent key of the set is
+	// used.
+	IDTokenSignedResponseAlg string `json:"id_token_signed_response_alg,omitempty" db:"id_token_signed_response_alg" faker:"len=10"`
+
+	// OAuth 2.0 Client Security Profile
+	//
+	// The security profile enforced for this client. One of "fapi2_security" and "fapi2_message_signing". If set,
+	// authorization requests must be pushed and use S256 PKCE, the client must authenticate with private_key_jwt,
+	// tls_client_auth or self_signed_tls_client_auth, access tokens are sender-constrained with DPoP, and
+	// authorization codes expire after at most 60 seconds. The "fapi2_message_signing" profile additionally requires
+	// signed request objects.
+	SecurityProfile string `json:"security_profile,omitempty" db:"security_profile" faker:"-"`
+
+	// OAuth 2.0 Token Introspection Signed Response Algorithm
+	//
+	// JWS alg algorithm [JWA] for signing JWT introspection responses [RFC9701] requested by this Client as a
+	// resource server. One of "RS256", "ES256", "ES384", "PS256" and "EdDSA". The response is signed with the active
+	// key of the algorithm in the "hydra.oauth2.introspection" JSON Web Key Set. Defaults to "RS256".
+	IntrospectionSignedResponseAlg string `json:"introspection_signed_response_alg,omitempty" db:"introspection_signed_response_alg" faker:"-"`
+
+	// OAuth 2.0 Token Introspection Encrypted Response Algorithm
+	//
+	// JWE alg algorithm [JWA] for encrypting JWT introspection responses [RFC9701] requested by this Client as a
+	// resource server. One of "RSA-OAEP", "RSA-OAEP-256", "ECDH-ES", "ECDH-ES+A128KW" and "ECDH-ES+A256KW". The
+	// response is encrypted with a key of the client's JSON Web Key Set. If omitted, the response is not encrypted.
+	IntrospectionEncryptedResponseAlg string `json:"introspection_encrypted_response_alg,omitempty" db:"introspection_encrypted_response_alg" faker:"-"`
+
+	// OAuth 2.0 Token Introspection Encrypted Response Encryption Algorithm
+	//
+	// JWE enc algorithm [JWA] for encrypting JWT introspection responses [RFC9701] requested by this Client as a
+	// resource server. One of "A128CBC-HS256", "A256CBC-HS512", "A128GCM" and "A256GCM". Defaults to "A128CBC-HS256"
+	// if introspection_encrypted_response_alg is set.
+	IntrospectionEncryptedResponseEnc string `json:"introspection_encrypted_response_enc,omitempty" db:"introspection_encrypted_response_enc" faker:"-"`
+
+	// OAuth 2.0 Client TLS Client Auth Subject DN
+	//
+	// The expected subject distinguished name of the certificate the client uses for mutual TLS client
+	// authentication [RFC8705], for example "CN=client,O=Example". Required if token_endpoint_auth_method is
+	// "tls_client_auth".
+	TLSClientAuthSubjectDN string `json:"tls_client_auth_subject_dn,omitempty" db:"tls_client_auth_subject_dn" faker:"-"`
+
+	// OpenID Connect Client Application Type
+	//
+	// Kind of the application, either "web" or "native". Web clients must use https redirect URIs which are not on
+	// the loopback interface. Native clients must use private-use URI schemes in reverse domain name notation, https
+	// or loopback redirect URIs, must not have a client secret and must use PKCE. If omitted, neither profile is
+	// enforced.
+	ApplicationType string `json:"application_type,omitempty" db:"application_type" faker:"-"`
+
 	// OAuth 2.0 Client Creation Date
 	//
 	// CreatedAt returns the timestamp of the client's creation.
@@ -290,6 +379,21 @@ type Client struct {
 	// RegistrationClientURI is the URL used to update, get, or delete the OAuth2 Client.
 	RegistrationClientURI string `json:"registration_client_uri,omitempty" db:"-"`
 
+	// OpenID Connect Dynamic Client Registration Software Statement
+	//
+	// SoftwareStatement is a JSON Web Token signed by a trusted issuer which asserts client metadata (RFC 7591). The
+	// claims of the software statement override the client metadata sent in the registration request. It is echoed
+	// in the registration response, but not stored.
+	SoftwareStatement string `json:"software_statement,omitempty" db:"-" faker:"-"`
+
+	// InitialAccessTokenID is the ID of the initial access token the client was registered with. The registration
+	// policy of the token also applies when the client is updated using Dynamic Client Registration.
+	InitialAccessTokenID uuid.NullUUID `json:"-" db:"initial_access_token_id" faker:"-"`
+
+	// FederationExpiresAt is the time the trust chain of a client registered using OpenID Federation expires. Once
+	// it has expired, the client is registered again using its current trust chain.
+	FederationExpiresAt sqlxx.NullTime `json:"-" db:"federation_expires_at" faker:"-"`
+
 	// OAuth 2.0 Access Token Strategy
 	//
 	// AccessTokenStrategy is the strategy used to generate access tokens.
@@ -305,6 +409,14 @@ type Client struct {
 	// be set from the admin API.
 	SkipLogoutConsent sqlxx.NullBool `json:"skip_logout_consent" db:"skip_logout_consent" faker:"-"`
 
+	// OAuth 2.0 Refresh Token Reuse Policy
+	//
+	// RefreshTokenReusePolicy defines what is revoked when a refresh token which was already used is presented
+	// again. All tokens issued with the same authorization are always revoked. Valid options are `revoke_tokens`
+	// (the default), `revoke_login_session` which additionally revokes the login session the tokens were issued in,
+	// and `revoke_consent` which additionally revokes the consent.
+	RefreshTokenReusePolicy string `json:"refresh_token_reuse_policy,omitempty" db:"refresh_token_reuse_policy" faker:"-"`
+
 	Lifespans
 }
 
@@ -384,6 +496,36 @@ type Lifespans struct {
 	//
 	// The lifespan of a Device Authorization issued by the OAuth2 2.0 Device Authorization Grant for this OAuth 2.0 Client.
 	DeviceAuthorizationGrantRefreshTokenLifespan x.NullDuration `json:"device_authorization_grant_refresh_token_lifespan,omitempty" db:"device_authorization_grant_refresh_token_lifespan"`
+
+	// OAuth2 2.0 Refresh Token Rotation Disabled
+	//
+	// If set to true, the OAuth2 2.0 Refresh Token Grant returns the presented refresh token instead of issuing a
+	// new one. Can not be combined with a refresh token idle lifespan or a rotation grace period.
+	RefreshTokenRotationDisabled bool `json:"refresh_token_rotation_disabled,omitempty" db:"refresh_token_rotation_disabled"`
+
+	// OAuth2 2.0 Refresh Token Rotation Grace Period
+	//
+	// The period in which a rotated refresh token of this OAuth 2.0 Client can still be used. Overrides
+	// `oauth2.grant.refresh_token.rotation_grace_period`.
+	RefreshTokenRotationGracePeriod x.NullDuration `json:"refresh_token_rotation_grace_period,omitempty" db:"refresh_token_rotation_grace_period"`
+
+	// OAuth2 2.0 Refresh Token Rotation Grace Reuse Count
+	//
+	// How often a rotated refresh token of this OAuth 2.0 Client can be used within the grace period. Overrides
+	// `oauth2.grant.refresh_token.rotation_grace_reuse_count`.
+	RefreshTokenRotationGraceReuseCount sqlxx.NullInt64 `json:"refresh_token_rotation_grace_reuse_count,omitempty" db:"refresh_token_rotation_grace_reuse_count"`
+
+	// OAuth2 2.0 Refresh Token Idle Lifespan
+	//
+	// The refresh tokens of this OAuth 2.0 Client expire if they are not used within this period. Every refresh
+	// issues a refresh token which expires after this period again.
+	RefreshTokenIdleLifespan x.NullDuration `json:"refresh_token_idle_lifespan,omitempty" db:"refresh_token_idle_lifespan"`
+
+	// OAuth2 2.0 Refresh Token Maximum Lifespan
+	//
+	// The maximum lifetime of the refresh tokens of an authorization, measured from the authentication of the
+	// end-user. Afterwards, the end-user has to log in again.
+	RefreshTokenMaxLifespan x.NullDuration `json:"refresh_token_max_lifespan,omitempty" db:"refresh_token_max_lifespan"`
 }
 
 func (Client) TableName() string {
@@ -503,6 +645,10 @@ func (c *Client) GetRequestObjectSigningAlgorithm() string {
 	return c.RequestObjectSigningAlgorithm
 }
 
+func (c *Client) GetTLSClientAuthSubjectDN() string {
+	return c.TLSClientAuthSubjectDN
+}
+
 func (c *Client) GetTokenEndpointAuthMethod() string {
 	if c.TokenEndpointAuthMethod == "" {
 		return "client_secret_basic"
@@ -564,12 +710,51 @@ func (c *Client) GetEffectiveLifespan(gt fosite.GrantType, tt fosite.TokenType,
 		}
 	}
 
+	if tt == fosite.RefreshToken && c.RefreshTokenIdleLifespan.Valid {
+		// The idle lifespan caps the lifespan of every refresh token, which slides with each refresh.
+		if cl == nil {
+			cl = &fallback
+		}
+		if *cl < 0 || c.RefreshTokenIdleLifespan.Duration < *cl {
+			cl = &c.RefreshTokenIdleLifespan.Duration
+		}
+	}
+
 	if cl == nil {
 		return fallback
 	}
 	return *cl
 }
 
+var _ fosite.ClientWithRefreshTokenPolicy = &Client{}
+
+func (c *Client) IsRefreshTokenRotationDisabled() bool {
+	return c.RefreshTokenRotationDisabled
+}
+
+func (c *Client) GetRefreshTokenMaxLifespan() time.Duration {
+	if !c.RefreshTokenMaxLifespan.Valid {
+		return 0
+	}
+	return c.RefreshTokenMaxLifespan.Duration
+}
+
+// GetEffectiveGracefulRefreshTokenRotation returns the graceful refresh token rotation of the client, using the
+// fallback for the values which the client does not configure.
+func (c *Client) GetEffectiveGracefulRefreshTokenRotation(fallback config.GracefulRefreshTokenRotation) config.GracefulRefreshTokenRotation {
+	if c.RefreshTokenRotationDisabled {
+		return config.GracefulRefreshTokenRotation{}
+	}
+	if c.RefreshTokenRotationGracePeriod.Valid {
+		fallback.Period = c.RefreshTokenRotationGracePeriod.Duration
+	}
+	if c.RefreshTokenRotationGraceReuseCount.Valid {
+		// The validator limits the count to the range of int32.
+		fallback.Count = int32(x.Clamp(c.RefreshTokenRotationGraceReuseCount.Int, 0, math.MaxInt32)) //nolint:gosec
+	}
+	return fallback
+}
+
 func (c *Client) GetAccessTokenStrategy() config.AccessTokenStrategyType {
 	// We ignore the error here, because the empty string will default to
 	// the global access token strategy.
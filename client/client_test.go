@@ -5,10 +5,14 @@ package client
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/sqlxx"
 )
 
 var _ fosite.OpenIDConnectClient = new(Client)
@@ -31,3 +35,30 @@ func TestClient(t *testing.T) {
 	assert.Len(t, c.GetScopes(), 2)
 	assert.EqualValues(t, c.RedirectURIs, c.GetRedirectURIs())
 }
+
+func TestClientRefreshTokenPolicy(t *testing.T) {
+	t.Run("case=idle lifespan caps refresh token lifespans", func(t *testing.T) {
+		c := &Client{Lifespans: Lifespans{
+			AuthorizationCodeGrantRefreshTokenLifespan: x.NullDuration{Duration: time.Hour, Valid: true},
+			RefreshTokenIdleLifespan:                   x.NullDuration{Duration: 30 * time.Minute, Valid: true},
+		}}
+
+		assert.Equal(t, 30*time.Minute, c.GetEffectiveLifespan(fosite.GrantTypeAuthorizationCode, fosite.RefreshToken, 2*time.Hour))
+		assert.Equal(t, 30*time.Minute, c.GetEffectiveLifespan(fosite.GrantTypeRefreshToken, fosite.RefreshToken, -1))
+		assert.Equal(t, 10*time.Minute, c.GetEffectiveLifespan(fosite.GrantTypeRefreshToken, fosite.RefreshToken, 10*time.Minute))
+		assert.Equal(t, 2*time.Hour, c.GetEffectiveLifespan(fosite.GrantTypeRefreshToken, fosite.AccessToken, 2*time.Hour))
+	})
+
+	t.Run("case=graceful rotation falls back to the configuration", func(t *testing.T) {
+		fallback := config.GracefulRefreshTokenRotation{Period: time.Minute, Count: 3}
+
+		assert.Equal(t, fallback, (&Client{}).GetEffectiveGracefulRefreshTokenRotation(fallback))
+		assert.Equal(t, config.GracefulRefreshTokenRotation{Period: time.Hour, Count: 3}, (&Client{Lifespans: Lifespans{
+			RefreshTokenRotationGracePeriod: x.NullDuration{Duration: time.Hour, Valid: true},
+		}}).GetEffectiveGracefulRefreshTokenRotation(fallback))
+		assert.Equal(t, config.GracefulRefreshTokenRotation{Period: time.Minute}, (&Client{Lifespans: Lifespans{
+			RefreshTokenRotationGraceReuseCount: sqlxx.NullInt64{Valid: true},
+		}}).GetEffectiveGracefulRefreshTokenRotation(fallback))
+		assert.Zero(t, (&Client{Lifespans: Lifespans{RefreshTokenRotationDisabled: true}}).GetEffectiveGracefulRefreshTokenRotation(fallback))
+	})
+}
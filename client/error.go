@@ -26,3 +26,21 @@ var ErrInvalidRequest = &fosite.RFC6749Error{
 	ErrorField:       "invalid_request",
 	CodeField:        http.StatusBadRequest,
 }
+
+var ErrInvalidSoftwareStatement = &fosite.RFC6749Error{
+	DescriptionField: "The software statement presented is invalid.",
+	ErrorField:       "invalid_software_statement",
+	CodeField:        http.StatusBadRequest,
+}
+
+var ErrUnapprovedSoftwareStatement = &fosite.RFC6749Error{
+	DescriptionField: "The software statement presented is not approved for use by this authorization server.",
+	ErrorField:       "unapproved_software_statement",
+	CodeField:        http.StatusBadRequest,
+}
+
+var ErrInvalidTrustChain = &fosite.RFC6749Error{
+	DescriptionField: "The trust chain of the entity could not be resolved to a trust anchor of the federation.",
+	ErrorField:       "invalid_trust_chain",
+	CodeField:        http.StatusBadRequest,
+}
@@ -0,0 +1,281 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/federation"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+)
+
+type (
+	federationRegistry interface {
+		Registry
+		federation.ResolverProvider
+	}
+
+	// FederationRegistrar registers the relying parties of an OpenID Federation as OAuth 2.0 Clients, as described
+	// in OpenID Federation 1.0, Section 12. The client ID of a relying party is its entity identifier.
+	FederationRegistrar struct {
+		r federationRegistry
+	}
+
+	FederationRegistrarProvider interface {
+		ClientFederationRegistrar() *FederationRegistrar
+	}
+)
+
+func NewFederationRegistrar(r federationRegistry) *FederationRegistrar {
+	return &FederationRegistrar{r: r}
+}
+
+// IsEntityIdentifier returns true if the client ID is the entity identifier of a relying party of the federation,
+// which is an HTTPS URL without query and fragment. HTTP is allowed in development mode.
+func (f *FederationRegistrar) IsEntityIdentifier(ctx context.Context, clientID string) bool {
+	if !f.r.Config().FederationEnabled(ctx) {
+		return false
+	}
+	u, err := url.Parse(clientID)
+	if err != nil || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return false
+	}
+	return u.Scheme == "https" || (u.Scheme == "http" && f.r.Config().IsDevelopmentMode(ctx))
+}
+
+// RegisterAutomatically registers the relying party at the authorization endpoint (automatic registration). The
+// request object of the authorization request must be signed with a key of the relying party, is verified before
+// the trust chain of the relying party is resolved, and can only be used once. Relying parties registered before
+// are only registered again once their trust chain has expired.
+func (f *FederationRegistrar) RegisterAutomatically(ctx context.Context, entityID, requestObject string) (*Client, error) {
+	existing, err := f.r.ClientManager().GetConcreteClient(ctx, entityID)
+	if err == nil && !existing.federationRegistrationExpired() {
+		return existing, nil
+	} else if err != nil && !errors.Is(err, sqlcon.ErrNoRows()) {
+		return nil, err
+	}
+
+	if requestObject == "" {
+		return nil, errors.WithStack(ErrInvalidRequest.WithHint("Automatic registration using OpenID Federation requires a signed request object."))
+	}
+	token, claims, err := f.parseRequestObject(ctx, entityID, requestObject)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := f.r.FederationResolver()
+	ec, err := resolver.EntityConfiguration(ctx, entityID)
+	if err != nil {
+		return nil, trustChainError(err)
+	}
+	if err := f.verifyRequestObject(ctx, ec, token); err != nil {
+		return nil, err
+	}
+	if err := f.r.ClientManager().SetClientAssertionJWT(ctx, claims.ID, claims.Expiry.Time()); errors.Is(err, fosite.ErrJTIKnown) {
+		return nil, errors.WithStack(ErrInvalidRequest.WithHint("The request object was used before."))
+	} else if err != nil {
+		return nil, err
+	}
+
+	chain, err := resolver.ResolveEntityConfiguration(ctx, ec)
+	if err != nil {
+		return nil, trustChainError(err)
+	}
+
+	c, err := f.clientFromTrustChain(ctx, chain, federation.RegistrationTypeAutomatic)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.persist(ctx, c, existing); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// RegisterExplicitly registers the relying party with its entity configuration (explicit registration). Relying
+// parties registered before are registered again with their current trust chain.
+func (f *FederationRegistrar) RegisterExplicitly(ctx context.Context, entityConfiguration string) (*Client, *federation.TrustChain, error) {
+	ec, err := federation.ParseEntityStatement(entityConfiguration)
+	if err != nil {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHint("The entity configuration is invalid.").WithWrap(err).WithDebug(err.Error()))
+	}
+	issuer := f.r.Config().IssuerURL(ctx).String()
+	if !slices.ContainsFunc(ec.Audience, func(aud string) bool { return federation.SameEntity(aud, issuer) }) {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHintf("The audience of the entity configuration must be '%s'.", issuer))
+	}
+	if !f.IsEntityIdentifier(ctx, ec.Subject) {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHintf("The entity identifier '%s' is not a valid client ID.", ec.Subject))
+	}
+
+	chain, err := f.r.FederationResolver().ResolveEntityConfiguration(ctx, ec)
+	if err != nil {
+		return nil, nil, trustChainError(err)
+	}
+
+	c, err := f.clientFromTrustChain(ctx, chain, federation.RegistrationTypeExplicit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing, err := f.r.ClientManager().GetConcreteClient(ctx, c.ID)
+	if err != nil && !errors.Is(err, sqlcon.ErrNoRows()) {
+		return nil, nil, err
+	}
+	if err := f.persist(ctx, c, existing); err != nil {
+		return nil, nil, err
+	}
+	return c, chain, nil
+}
+
+// clientFromTrustChain returns the client described by the relying party metadata of the trust chain, after the
+// metadata policies of the federation were applied.
+func (f *FederationRegistrar) clientFromTrustChain(ctx context.Context, chain *federation.TrustChain, registrationType string) (*Client, error) {
+	metadata, err := chain.Metadata(federation.EntityTypeOpenIDRelyingParty)
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidClientMetadata.WithHint("The relying party metadata violates the metadata policy of the federation.").WithWrap(err).WithDebug(err.Error()))
+	}
+
+	types, _ := metadata["client_registration_types"].([]any)
+	if !slices.Contains(types, any(registrationType)) {
+		return nil, errors.WithStack(ErrInvalidClientMetadata.WithHintf("The relying party does not support %s registration.", registrationType))
+	}
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var c Client
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, errors.WithStack(ErrInvalidClientMetadata.WithHint("The relying party metadata is not valid client metadata.").WithWrap(err).WithDebug(err.Error()))
+	}
+
+	// Relying parties of the federation authenticate with their keys, so no secret is issued.
+	c.ID = chain.EntityID
+	c.Secret = ""
+	if c.TokenEndpointAuthMethod == "" && c.ApplicationType == ApplicationTypeNative {
+		c.TokenEndpointAuthMethod = "none"
+	} else if c.TokenEndpointAuthMethod == "" {
+		c.TokenEndpointAuthMethod = "private_key_jwt"
+	}
+	if c.TokenEndpointAuthMethod != "private_key_jwt" && c.TokenEndpointAuthMethod != "none" {
+		return nil, errors.WithStack(ErrInvalidClientMetadata.WithHint("Relying parties registered using OpenID Federation must use the token endpoint authentication method 'private_key_jwt' or 'none'."))
+	}
+	c.FederationExpiresAt = sqlxx.NullTime(chain.ExpiresAt.UTC())
+
+	if err := f.r.ClientValidator().ValidateDynamicRegistration(ctx, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// parseRequestObject parses the request object and validates its claims, which must identify the relying party as
+// its issuer and client and the authorization server as its audience. The signature is not verified.
+func (f *FederationRegistrar) parseRequestObject(ctx context.Context, entityID, requestObject string) (*jwt.JSONWebToken, *jwt.Claims, error) {
+	token, err := jwt.ParseSigned(requestObject)
+	if err != nil {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHint("The request object could not be parsed.").WithWrap(err).WithDebug(err.Error()))
+	}
+	if len(token.Headers) != 1 || !isSupportedAuthTokenSigningAlg(token.Headers[0].Algorithm) {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHint("The request object must be signed by the relying party."))
+	}
+
+	var claims struct {
+		jwt.Claims
+		ClientID string `json:"client_id"`
+	}
+	if err := token.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHint("The request object could not be parsed.").WithWrap(err).WithDebug(err.Error()))
+	}
+	if claims.ClientID != entityID || claims.Issuer != entityID {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHint("The request object must be issued by the relying party for its own entity identifier."))
+	}
+	issuer := f.r.Config().IssuerURL(ctx).String()
+	if !slices.ContainsFunc(claims.Audience, func(aud string) bool { return federation.SameEntity(aud, issuer) }) {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHintf("The audience of the request object must be '%s'.", issuer))
+	}
+	if claims.ID == "" || claims.Expiry == nil {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHint("The request object must contain the claims 'jti' and 'exp'."))
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{Time: time.Now()}, time.Minute); err != nil {
+		return nil, nil, errors.WithStack(ErrInvalidRequest.WithHintf("The request object is not valid: %s.", err))
+	}
+	return token, &claims.Claims, nil
+}
+
+// verifyRequestObject verifies that the request object was signed with a key of the relying party metadata in its
+// entity configuration.
+func (f *FederationRegistrar) verifyRequestObject(ctx context.Context, ec *federation.EntityStatement, token *jwt.JSONWebToken) error {
+	metadata := ec.Metadata[federation.EntityTypeOpenIDRelyingParty]
+
+	var keys *jose.JSONWebKeySet
+	if raw, ok := metadata["jwks"]; ok {
+		encoded, err := json.Marshal(raw)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := json.Unmarshal(encoded, &keys); err != nil {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHint("The keys of the relying party metadata are invalid.").WithWrap(err).WithDebug(err.Error()))
+		}
+	} else if uri, _ := metadata["jwks_uri"].(string); uri != "" {
+		var err error
+		if keys, err = f.r.ClientValidator().jwks.Resolve(ctx, uri, false); err != nil {
+			return err
+		}
+	}
+	if keys == nil {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHint("The relying party metadata does not contain any keys to verify the request object."))
+	}
+
+	candidates := keys.Keys
+	if kid := token.Headers[0].KeyID; kid != "" {
+		candidates = keys.Key(kid)
+	}
+	verified := slices.ContainsFunc(candidates, func(key jose.JSONWebKey) bool {
+		return token.Claims(key.Public()) == nil
+	})
+	if !verified {
+		return errors.WithStack(ErrInvalidRequest.WithHint("The signature of the request object could not be verified with the keys of the relying party."))
+	}
+	return nil
+}
+
+// trustChainError returns the error returned to the relying party if its trust chain could not be resolved.
+func trustChainError(err error) error {
+	if errors.Is(err, federation.ErrTooManyResolutions) {
+		return errors.WithStack(fosite.ErrTemporarilyUnavailable.WithHint("Too many trust chains are being resolved, please try again later.").WithWrap(err))
+	}
+	return errors.WithStack(ErrInvalidTrustChain.WithWrap(err).WithDebug(err.Error()))
+}
+
+// persist creates the client or replaces the existing client with the same entity identifier. Clients which were
+// not registered using OpenID Federation are never replaced.
+func (f *FederationRegistrar) persist(ctx context.Context, c *Client, existing *Client) error {
+	if existing == nil {
+		return f.r.ClientManager().CreateClient(ctx, c)
+	}
+	if !existing.IsFederationClient() {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("The client ID '%s' is already in use.", c.ID))
+	}
+	c.CreatedAt = existing.CreatedAt
+	return f.r.ClientManager().UpdateClient(ctx, c)
+}
+
+// IsFederationClient returns true if the client was registered using OpenID Federation.
+func (c *Client) IsFederationClient() bool {
+	return !time.Time(c.FederationExpiresAt).IsZero()
+}
+
+func (c *Client) federationRegistrationExpired() bool {
+	return c.IsFederationClient() && time.Now().After(time.Time(c.FederationExpiresAt))
+}
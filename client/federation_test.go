@@ -0,0 +1,308 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/federation"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/httprouterx"
+	"github.com/ory/x/sqlxx"
+)
+
+const federationIssuer = "https://hydra.example.com/"
+
+func newFederation(t *testing.T) (anchor, rp *testhelpers.FederationEntity) {
+	f := testhelpers.NewFederation(t)
+	anchor = f.Entity(t, "anchor")
+	anchor.MetadataPolicy = map[string]map[string]federation.Operators{
+		federation.EntityTypeOpenIDRelyingParty: {
+			"grant_types": {federation.OperatorSubsetOf: []any{"authorization_code", "refresh_token"}},
+			"scope":       {federation.OperatorValue: "openid"},
+		},
+	}
+
+	rp = f.Entity(t, "rp", anchor)
+	raw, err := json.Marshal(rp.PublicJWKS())
+	require.NoError(t, err)
+	var jwks map[string]any
+	require.NoError(t, json.Unmarshal(raw, &jwks))
+	rp.Metadata[federation.EntityTypeOpenIDRelyingParty] = map[string]any{
+		"client_name":               "Relying Party",
+		"client_registration_types": []any{federation.RegistrationTypeAutomatic, federation.RegistrationTypeExplicit},
+		"grant_types":               []any{"authorization_code", "client_credentials"},
+		"response_types":            []any{"code"},
+		"redirect_uris":             []any{"https://rp.example.com/callback"},
+		"scope":                     "openid offline_access",
+		"jwks":                      jwks,
+	}
+	return anchor, rp
+}
+
+func newFederationRegistry(t *testing.T, values map[string]any) *driver.RegistrySQL {
+	values[config.KeyIssuerURL] = federationIssuer
+	return testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(values)))
+}
+
+func requestObject(t *testing.T, rp *testhelpers.FederationEntity, claims map[string]any) string {
+	base := map[string]any{
+		"iss":       rp.ID,
+		"client_id": rp.ID,
+		"aud":       federationIssuer,
+		"jti":       uuid.Must(uuid.NewV4()).String(),
+		"exp":       time.Now().Add(time.Minute).Unix(),
+	}
+	for k, v := range claims {
+		base[k] = v
+	}
+	return rp.Sign(t, base, "oauth-authz-req+jwt")
+}
+
+func assertFederationClient(t *testing.T, c *client.Client, rp *testhelpers.FederationEntity) {
+	assert.Equal(t, rp.ID, c.GetID())
+	assert.Equal(t, "Relying Party", c.Name)
+	assert.EqualValues(t, []string{"authorization_code"}, c.GrantTypes)
+	assert.Equal(t, "openid", c.Scope)
+	assert.Equal(t, "private_key_jwt", c.TokenEndpointAuthMethod)
+	assert.True(t, c.IsFederationClient())
+}
+
+func TestFederationRegistrar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("case=entity identifiers require federation to be enabled", func(t *testing.T) {
+		reg := newFederationRegistry(t, map[string]any{})
+		assert.False(t, reg.ClientFederationRegistrar().IsEntityIdentifier(t.Context(), "https://rp.example.com"))
+	})
+
+	t.Run("case=entity identifiers", func(t *testing.T) {
+		anchor, _ := newFederation(t)
+		reg := newFederationRegistry(t, testhelpers.FederationConfig(t, anchor))
+		registrar := reg.ClientFederationRegistrar()
+
+		for id, expected := range map[string]bool{
+			"https://rp.example.com":          true,
+			"https://rp.example.com/rp":       true,
+			"https://rp.example.com/?a=b":     false,
+			"https://rp.example.com/#section": false,
+			"my-client":                       false,
+			"urn:example:rp":                  false,
+		} {
+			assert.Equalf(t, expected, registrar.IsEntityIdentifier(t.Context(), id), "%s", id)
+		}
+	})
+
+	t.Run("case=automatic registration", func(t *testing.T) {
+		anchor, rp := newFederation(t)
+		reg := newFederationRegistry(t, testhelpers.FederationConfig(t, anchor))
+		registrar := reg.ClientFederationRegistrar()
+
+		t.Run("requires a request object", func(t *testing.T) {
+			_, err := registrar.RegisterAutomatically(t.Context(), rp.ID, "")
+			assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		})
+
+		t.Run("requires the request object to be signed by the relying party", func(t *testing.T) {
+			_, err := registrar.RegisterAutomatically(t.Context(), rp.ID, anchor.Sign(t, map[string]any{
+				"iss":       rp.ID,
+				"client_id": rp.ID,
+				"aud":       federationIssuer,
+				"jti":       uuid.Must(uuid.NewV4()).String(),
+				"exp":       time.Now().Add(time.Minute).Unix(),
+			}, "oauth-authz-req+jwt"))
+			assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		})
+
+		t.Run("requires the request object to be issued for the authorization server", func(t *testing.T) {
+			_, err := registrar.RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, map[string]any{"aud": "https://other.example.com"}))
+			assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		})
+
+		t.Run("requires the request object to have an identifier", func(t *testing.T) {
+			_, err := registrar.RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, map[string]any{"jti": ""}))
+			assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		})
+
+		t.Run("does not resolve the trust chain of invalid request objects", func(t *testing.T) {
+			requests := rp.Federation().Requests.Load()
+			_, err := registrar.RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, map[string]any{"aud": "https://other.example.com"}))
+			assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+			assert.Equal(t, requests, rp.Federation().Requests.Load())
+		})
+
+		t.Run("requires the request object to be issued for the relying party", func(t *testing.T) {
+			_, err := registrar.RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, map[string]any{"client_id": "other"}))
+			assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		})
+
+		t.Run("rejects expired request objects", func(t *testing.T) {
+			_, err := registrar.RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))
+			assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		})
+
+		t.Run("registers the relying party", func(t *testing.T) {
+			c, err := registrar.RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, nil))
+			require.NoError(t, err)
+			assertFederationClient(t, c, rp)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), time.Time(c.FederationExpiresAt), time.Minute)
+
+			stored, err := reg.ClientManager().GetConcreteClient(t.Context(), rp.ID)
+			require.NoError(t, err)
+			assertFederationClient(t, stored, rp)
+		})
+
+		t.Run("returns registered relying parties", func(t *testing.T) {
+			c, err := registrar.RegisterAutomatically(t.Context(), rp.ID, "")
+			require.NoError(t, err)
+			assert.Equal(t, rp.ID, c.GetID())
+		})
+	})
+
+	t.Run("case=automatic registration requires a trusted anchor", func(t *testing.T) {
+		_, rp := newFederation(t)
+		other := testhelpers.NewFederation(t).Entity(t, "anchor")
+		reg := newFederationRegistry(t, testhelpers.FederationConfig(t, other))
+
+		_, err := reg.ClientFederationRegistrar().RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, nil))
+		assert.ErrorIs(t, err, client.ErrInvalidTrustChain)
+	})
+
+	t.Run("case=automatic registration rejects replayed request objects", func(t *testing.T) {
+		anchor, rp := newFederation(t)
+		reg := newFederationRegistry(t, testhelpers.FederationConfig(t, anchor))
+		ro := requestObject(t, rp, nil)
+
+		_, err := reg.ClientFederationRegistrar().RegisterAutomatically(t.Context(), rp.ID, ro)
+		require.NoError(t, err)
+		require.NoError(t, reg.ClientManager().DeleteClient(t.Context(), rp.ID))
+
+		_, err = reg.ClientFederationRegistrar().RegisterAutomatically(t.Context(), rp.ID, ro)
+		assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		assert.Contains(t, fosite.ErrorToRFC6749Error(err).HintField, "used before")
+	})
+
+	t.Run("case=automatic registration renews expired registrations", func(t *testing.T) {
+		anchor, rp := newFederation(t)
+		reg := newFederationRegistry(t, testhelpers.FederationConfig(t, anchor))
+		require.NoError(t, reg.ClientManager().CreateClient(t.Context(), &client.Client{ID: rp.ID, FederationExpiresAt: sqlxx.NullTime(time.Now().Add(-time.Hour))}))
+
+		c, err := reg.ClientFederationRegistrar().RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, nil))
+		require.NoError(t, err)
+		assertFederationClient(t, c, rp)
+		assert.True(t, time.Time(c.FederationExpiresAt).After(time.Now()))
+	})
+
+	t.Run("case=automatic registration does not replace other clients", func(t *testing.T) {
+		anchor, rp := newFederation(t)
+		reg := newFederationRegistry(t, testhelpers.FederationConfig(t, anchor))
+		require.NoError(t, reg.ClientManager().CreateClient(t.Context(), &client.Client{ID: rp.ID}))
+
+		c, err := reg.ClientFederationRegistrar().RegisterAutomatically(t.Context(), rp.ID, requestObject(t, rp, nil))
+		require.NoError(t, err)
+		assert.False(t, c.IsFederationClient())
+
+		_, _, err = reg.ClientFederationRegistrar().RegisterExplicitly(t.Context(), rp.EntityConfiguration(t, federationIssuer))
+		assert.ErrorIs(t, err, client.ErrInvalidClientMetadata)
+	})
+}
+
+func TestCreateOidcFederationClient(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, reg *driver.RegistrySQL) *httptest.Server {
+		router := httprouterx.NewRouterPublic()
+		client.NewHandler(reg).SetPublicRoutes(router)
+		ts := httptest.NewServer(router)
+		t.Cleanup(ts.Close)
+		return ts
+	}
+	register := func(t *testing.T, ts *httptest.Server, contentType, body string) (*http.Response, string) {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, ts.URL+client.FederationRegistrationPath, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		res, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close() //nolint:errcheck
+		raw, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, string(raw)
+	}
+
+	t.Run("case=federation is disabled", func(t *testing.T) {
+		_, rp := newFederation(t)
+		ts := newServer(t, newFederationRegistry(t, map[string]any{}))
+
+		res, _ := register(t, ts, federation.ContentTypeEntityStatement, rp.EntityConfiguration(t, federationIssuer))
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	anchor, rp := newFederation(t)
+	reg := newFederationRegistry(t, testhelpers.FederationConfig(t, anchor))
+	ts := newServer(t, reg)
+
+	t.Run("case=requires an entity configuration", func(t *testing.T) {
+		res, body := register(t, ts, "application/json", "{}")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+		assert.Contains(t, body, "invalid_request")
+	})
+
+	t.Run("case=requires the audience to be the issuer", func(t *testing.T) {
+		res, body := register(t, ts, federation.ContentTypeEntityStatement, rp.EntityConfiguration(t, "https://other.example.com"))
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+		assert.Contains(t, body, "audience")
+	})
+
+	t.Run("case=rejects untrusted entities", func(t *testing.T) {
+		other := testhelpers.NewFederation(t)
+		untrusted := other.Entity(t, "rp", other.Entity(t, "anchor"))
+
+		res, body := register(t, ts, federation.ContentTypeEntityStatement, untrusted.EntityConfiguration(t, federationIssuer))
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+		assert.Contains(t, body, "invalid_trust_chain")
+	})
+
+	t.Run("case=registers the relying party", func(t *testing.T) {
+		for range 2 {
+			res, body := register(t, ts, federation.ContentTypeEntityStatement, rp.EntityConfiguration(t, federationIssuer))
+			require.Equal(t, http.StatusOK, res.StatusCode, body)
+			assert.Equal(t, federation.ContentTypeExplicitRegistrationResponse, res.Header.Get("Content-Type"))
+
+			token, err := jwt.ParseSigned(body)
+			require.NoError(t, err)
+			assert.Equal(t, federation.TypeExplicitRegistrationResponse, token.Headers[0].ExtraHeaders[jose.HeaderType])
+
+			keys, err := reg.KeyManager().GetKeySet(t.Context(), "hydra.openid.federation")
+			require.NoError(t, err)
+			var statement federation.EntityStatement
+			require.NoError(t, token.Claims(keys.Keys[0].Public(), &statement))
+			assert.Equal(t, federationIssuer, statement.Issuer)
+			assert.Equal(t, rp.ID, statement.Subject)
+			assert.Equal(t, anchor.ID, statement.TrustAnchorID)
+			assert.Equal(t, []string{anchor.ID}, statement.AuthorityHints)
+			assert.Equal(t, "openid", statement.Metadata[federation.EntityTypeOpenIDRelyingParty]["scope"])
+
+			c, err := reg.ClientManager().GetConcreteClient(t.Context(), rp.ID)
+			require.NoError(t, err)
+			assertFederationClient(t, c, rp)
+		}
+	})
+}
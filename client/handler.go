@@ -16,12 +16,14 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/httprouterx"
 	"github.com/ory/x/jsonx"
 	"github.com/ory/x/openapix"
 	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+	"github.com/ory/x/sqlcon"
 	"github.com/ory/x/urlx"
 	"github.com/ory/x/uuidx"
 )
@@ -31,8 +33,10 @@ type Handler struct {
 }
 
 const (
-	ClientsHandlerPath    = "/clients"
-	DynClientsHandlerPath = "/oauth2/register"
+	ClientsHandlerPath             = "/clients"
+	DynClientsHandlerPath          = "/oauth2/register"
+	FederationRegistrationPath     = "/oauth2/federation/register"
+	InitialAccessTokensHandlerPath = ClientsHandlerPath + "/registration/initial-access-tokens" // #nosec G101
 )
 
 func NewHandler(r InternalRegistry) *Handler {
@@ -47,6 +51,10 @@ func (h *Handler) SetAdminRoutes(r *httprouterx.RouterAdmin) {
 	r.PATCH(ClientsHandlerPath+"/{id}", h.patchOAuth2Client)
 	r.DELETE(ClientsHandlerPath+"/{id}", h.deleteOAuth2Client)
 	r.PUT(ClientsHandlerPath+"/{id}/lifespans", h.setOAuth2ClientLifespans)
+	r.POST(InitialAccessTokensHandlerPath, h.createInitialAccessToken)
+	r.GET(InitialAccessTokensHandlerPath, h.listInitialAccessTokens)
+	r.GET(InitialAccessTokensHandlerPath+"/{id}", h.getInitialAccessToken)
+	r.DELETE(InitialAccessTokensHandlerPath+"/{id}", h.deleteInitialAccessToken)
 }
 
 func (h *Handler) SetPublicRoutes(r *httprouterx.RouterPublic) {
@@ -54,6 +62,7 @@ func (h *Handler) SetPublicRoutes(r *httprouterx.RouterPublic) {
 	r.GET(DynClientsHandlerPath+"/{id}", h.getOidcDynamicClient)
 	r.PUT(DynClientsHandlerPath+"/{id}", h.setOidcDynamicClient)
 	r.DELETE(DynClientsHandlerPath+"/{id}", h.deleteOidcDynamicClient)
+	r.POST(FederationRegistrationPath, h.createOidcFederationClient)
 }
 
 // OAuth 2.0 Client Creation Parameters
@@ -150,7 +159,18 @@ func (h *Handler) createOidcDynamicClient(w http.ResponseWriter, r *http.Request
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
-	c, err := h.CreateClient(r, h.r.ClientValidator().ValidateDynamicRegistration, true)
+	token, err := h.initialAccessToken(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	validator := h.r.ClientValidator().ValidateDynamicRegistration
+	if token != nil {
+		validator = h.validateWithRegistrationPolicy(token)
+	}
+
+	c, err := h.CreateClient(r, validator, true)
 	if err != nil {
 		h.r.Writer().WriteError(w, r, errors.WithStack(err))
 		return
@@ -166,6 +186,11 @@ func (h *Handler) CreateClient(r *http.Request, validator func(context.Context,
 	}
 
 	if isDynamic {
+		// The software statement is applied first, so that its claims are subject to the same restrictions as the
+		// registration request.
+		if err := h.r.ClientValidator().ApplySoftwareStatement(r.Context(), &c); err != nil {
+			return nil, err
+		}
 		if c.Secret != "" {
 			return nil, errors.WithStack(herodot.ErrBadRequest().WithReasonf("It is not allowed to choose your own OAuth2 Client secret."))
 		}
@@ -173,7 +198,8 @@ func (h *Handler) CreateClient(r *http.Request, validator func(context.Context,
 		c.ID = uuidx.NewV4().String()
 	}
 
-	if len(c.Secret) == 0 {
+	// Native applications are public clients and do not get a secret.
+	if len(c.Secret) == 0 && c.ApplicationType != ApplicationTypeNative {
 		secretb, err := x.GenerateSecret(26)
 		if err != nil {
 			return nil, err
@@ -198,13 +224,18 @@ func (h *Handler) CreateClient(r *http.Request, validator func(context.Context,
 	c.RegistrationAccessTokenSignature = signature
 	c.RegistrationClientURI = urlx.AppendPaths(h.r.Config().PublicURL(r.Context()), DynClientsHandlerPath, url.PathEscape(c.GetID())).String()
 
-	if err := h.r.ClientManager().CreateClient(r.Context(), &c); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.r.ClientManager().CreateClient(ctx, &c); err != nil {
+			return err
+		}
+		c.Secret = ""
+		if !c.IsPublic() {
+			c.Secret = secret
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientCreated, c.GetID(), nil, &c)
+	}); err != nil {
 		return nil, err
 	}
-	c.Secret = ""
-	if !c.IsPublic() {
-		c.Secret = secret
-	}
 	return &c, nil
 }
 
@@ -263,7 +294,13 @@ func (h *Handler) setOAuth2Client(w http.ResponseWriter, r *http.Request) {
 	}
 
 	c.ID = r.PathValue("id")
-	if err := h.updateClient(r.Context(), &c, h.r.ClientValidator().Validate); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditState(ctx, c.ID)
+		if err := h.updateClient(ctx, &c, h.r.ClientValidator().Validate); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientUpdated, c.ID, before, h.auditState(ctx, c.ID))
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -364,11 +401,30 @@ func (h *Handler) setOidcDynamicClient(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.r.ClientValidator().ApplySoftwareStatement(r.Context(), &c); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
 	if c.Secret != "" {
 		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrForbidden().WithReasonf("It is not allowed to choose your own OAuth2 Client secret.")))
 		return
 	}
 
+	validator := h.r.ClientValidator().ValidateDynamicRegistration
+	if id := client.(*Client).InitialAccessTokenID; id.Valid {
+		token, err := h.r.InitialAccessTokenManager().GetInitialAccessToken(r.Context(), id.UUID)
+		if errors.Is(err, sqlcon.ErrNoRows()) {
+			// The policy can not be enforced if the token was deleted, so the client can no longer be updated.
+			h.r.Writer().WriteError(w, r, errors.WithStack(ErrInvalidClientMetadata.WithHint("The initial access token this client was registered with no longer exists.")))
+			return
+		} else if err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+		validator = h.validateWithRegistrationPolicy(token)
+	}
+
 	// Regenerate the registration access token
 	token, signature, err := h.r.OAuth2HMACStrategy().GenerateAccessToken(r.Context(), nil)
 	if err != nil {
@@ -379,7 +435,13 @@ func (h *Handler) setOidcDynamicClient(w http.ResponseWriter, r *http.Request) {
 	c.RegistrationAccessTokenSignature = signature
 
 	c.ID = client.GetID()
-	if err := h.updateClient(r.Context(), &c, h.r.ClientValidator().ValidateDynamicRegistration); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditState(ctx, c.ID)
+		if err := h.updateClient(ctx, &c, validator); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientUpdated, c.ID, before, h.auditState(ctx, c.ID))
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -447,6 +509,7 @@ func (h *Handler) patchOAuth2Client(w http.ResponseWriter, r *http.Request) {
 	}
 
 	oldSecret := client.Secret
+	before := *client
 
 	client, err = jsonx.ApplyJSONPatch(patchJSON, client, "/id")
 	if err != nil {
@@ -462,7 +525,12 @@ func (h *Handler) patchOAuth2Client(w http.ResponseWriter, r *http.Request) {
 		client.Secret = ""
 	}
 
-	if err := h.updateClient(r.Context(), client, h.r.ClientValidator().Validate); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.updateClient(ctx, client, h.r.ClientValidator().Validate); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientUpdated, id, &before, h.auditState(ctx, id))
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -709,7 +777,13 @@ type deleteOAuth2Client struct {
 //	  x-ory-ratelimit-bucket: hydra-admin-low
 func (h *Handler) deleteOAuth2Client(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
-	if err := h.r.ClientManager().DeleteClient(r.Context(), id); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditState(ctx, id)
+		if err := h.r.ClientManager().DeleteClient(ctx, id); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientDeleted, id, before, nil)
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -764,10 +838,16 @@ func (h *Handler) setOAuth2ClientLifespans(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	before := *c
 	c.Lifespans = ls
 	c.Secret = ""
 
-	if err := h.updateClient(r.Context(), c, h.r.ClientValidator().Validate); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.updateClient(ctx, c, h.r.ClientValidator().Validate); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientUpdated, id, &before, h.auditState(ctx, id))
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -827,7 +907,13 @@ func (h *Handler) deleteOidcDynamicClient(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if err := h.r.ClientManager().DeleteClient(r.Context(), client.GetID()); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditState(ctx, client.GetID())
+		if err := h.r.ClientManager().DeleteClient(ctx, client.GetID()); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientDeleted, client.GetID(), before, nil)
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -835,6 +921,20 @@ func (h *Handler) deleteOidcDynamicClient(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// auditState returns the stored client, or nil if the audit log is disabled or the client can not be loaded. The
+// stored client is recorded rather than the request body, so that the hashed secret only shows up in the diff if
+// the secret was changed.
+func (h *Handler) auditState(ctx context.Context, id string) *Client {
+	if !h.r.AuditRecorder().Enabled(ctx) {
+		return nil
+	}
+	c, err := h.r.ClientManager().GetConcreteClient(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return c
+}
+
 func (h *Handler) ValidDynamicAuth(r *http.Request, id string) (fosite.Client, error) {
 	c, err := h.r.ClientManager().GetConcreteClient(r.Context(), id)
 	if err != nil {
@@ -848,11 +948,30 @@ func (h *Handler) ValidDynamicAuth(r *http.Request, id string) (fosite.Client, e
 			WithReason("The requested OAuth 2.0 client does not exist or you provided incorrect credentials.").WithDebug("The OAuth2 Client does not have a registration access token."))
 	}
 
+	signature, err := h.registrationTokenSignature(r)
+	if err != nil {
+		return nil, herodot.ErrUnauthorized().
+			WithTrace(err).
+			WithReason("The requested OAuth 2.0 client does not exist or you provided incorrect credentials.").WithDebug(err.Error())
+	}
+
+	if subtle.ConstantTimeCompare([]byte(c.RegistrationAccessTokenSignature), []byte(signature)) == 0 {
+		return nil, errors.WithStack(herodot.ErrUnauthorized().
+			WithReason("The requested OAuth 2.0 client does not exist or you provided incorrect credentials.").WithDebug("Registration access tokens do not match."))
+	}
+
+	return c, nil
+}
+
+// registrationTokenSignature validates the registration access token or initial access token presented as bearer
+// token and returns its signature.
+func (h *Handler) registrationTokenSignature(r *http.Request) (string, error) {
 	token := strings.TrimPrefix(fosite.AccessTokenFromRequest(r), "ory_at_")
 	if err := h.r.OAuth2HMACStrategy().ValidateAccessToken(
 		r.Context(),
 		// The strategy checks the expiry time of the token. Registration tokens don't expire (we don't have a way of
-		// rotating them) so we set the expiry time to a time in the future.
+		// rotating them) so we set the expiry time to a time in the future. The expiry of initial access tokens is
+		// checked separately.
 		&fosite.Request{
 			Session: &fosite.DefaultSession{
 				ExpiresAt: map[fosite.TokenType]time.Time{
@@ -863,18 +982,9 @@ func (h *Handler) ValidDynamicAuth(r *http.Request, id string) (fosite.Client, e
 		},
 		token,
 	); err != nil {
-		return nil, herodot.ErrUnauthorized().
-			WithTrace(err).
-			WithReason("The requested OAuth 2.0 client does not exist or you provided incorrect credentials.").WithDebug(err.Error())
+		return "", err
 	}
-
-	signature := h.r.OAuth2EnigmaStrategy().Signature(token)
-	if subtle.ConstantTimeCompare([]byte(c.RegistrationAccessTokenSignature), []byte(signature)) == 0 {
-		return nil, errors.WithStack(herodot.ErrUnauthorized().
-			WithReason("The requested OAuth 2.0 client does not exist or you provided incorrect credentials.").WithDebug("Registration access tokens do not match."))
-	}
-
-	return c, nil
+	return h.r.OAuth2EnigmaStrategy().Signature(token), nil
 }
 
 func (h *Handler) requireDynamicAuth(r *http.Request) *herodot.DefaultError {
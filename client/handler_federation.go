@@ -0,0 +1,156 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/federation"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+)
+
+// maxEntityConfigurationSize is the maximum size of an entity configuration presented for explicit registration.
+const maxEntityConfigurationSize = 1 << 20
+
+// OpenID Federation Explicit Registration Parameters
+//
+// swagger:parameters createOidcFederationClient
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type createOidcFederationClient struct {
+	// The entity configuration of the relying party, signed with its federation keys.
+	//
+	// in: body
+	// required: true
+	Body string
+}
+
+// swagger:route POST /oauth2/federation/register oidc createOidcFederationClient
+//
+// # Register OAuth2 Client using OpenID Federation Explicit Registration
+//
+// This endpoint registers a relying party of an OpenID Federation as OAuth 2.0 Client. The relying party presents
+// its entity configuration, whose trust chain must end at one of the configured trust anchors. The metadata
+// policies of the federation are applied to the relying party metadata, and the client ID of the registered client
+// is the entity identifier of the relying party.
+//
+// Relying parties which were registered before are registered again with their current trust chain. The response
+// is an entity statement about the relying party containing the registered metadata, signed with the federation
+// keys of Ory Hydra. This feature needs to be enabled in the configuration.
+//
+//	Consumes:
+//	- application/entity-statement+jwt
+//
+//	Produces:
+//	- application/explicit-registration-response+jwt
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: oidcFederationEntityStatement
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-public-low
+func (h *Handler) createOidcFederationClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if !h.r.Config().FederationEnabled(ctx) {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrNotFound().WithReason("OpenID Federation is disabled.")))
+		return
+	}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != federation.ContentTypeEntityStatement {
+		h.r.Writer().WriteError(w, r, errors.WithStack(ErrInvalidRequest.WithHintf("The request body must be an entity configuration of content type '%s'.", federation.ContentTypeEntityStatement)))
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxEntityConfigurationSize))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to read the request body: %s", err)))
+		return
+	}
+
+	var (
+		c     *Client
+		chain *federation.TrustChain
+	)
+	if err := h.r.AuditRecorder().Transaction(ctx, func(ctx context.Context) (err error) {
+		var before *Client
+		if h.r.AuditRecorder().Enabled(ctx) {
+			if ec, err := federation.ParseEntityStatement(string(body)); err == nil {
+				before, _ = h.r.ClientManager().GetConcreteClient(ctx, ec.Subject)
+			}
+		}
+
+		if c, chain, err = h.r.ClientFederationRegistrar().RegisterExplicitly(ctx, string(body)); err != nil {
+			return err
+		}
+		if before != nil {
+			return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientUpdated, c.GetID(), before, c)
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionClientCreated, c.GetID(), nil, c)
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	token, err := h.explicitRegistrationResponse(r, c, chain)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", federation.ContentTypeExplicitRegistrationResponse)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(token))
+}
+
+// explicitRegistrationResponse returns the entity statement about the registered relying party, as described in
+// OpenID Federation 1.0, Section 12.2.3.
+func (h *Handler) explicitRegistrationResponse(r *http.Request, c *Client, chain *federation.TrustChain) (string, error) {
+	ctx := r.Context()
+
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	var metadata map[string]any
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	statement := federation.EntityStatement{
+		Issuer:         h.r.Config().IssuerURL(ctx).String(),
+		Subject:        c.GetID(),
+		Audience:       []string{c.GetID()},
+		IssuedAt:       time.Now().Unix(),
+		ExpiresAt:      chain.ExpiresAt.Unix(),
+		AuthorityHints: []string{chain.AuthorityHint},
+		TrustAnchorID:  chain.TrustAnchorID,
+		Metadata:       map[string]map[string]any{federation.EntityTypeOpenIDRelyingParty: metadata},
+	}
+	claims, err := statement.Claims()
+	if err != nil {
+		return "", err
+	}
+
+	keyID, err := h.r.FederationJWTSigner().GetPublicKeyID(ctx)
+	if err != nil {
+		return "", err
+	}
+	token, _, err := h.r.FederationJWTSigner().Generate(ctx, claims, &jwt.Headers{
+		Extra: map[string]any{"kid": keyID, "typ": federation.TypeExplicitRegistrationResponse},
+	})
+	return token, err
+}
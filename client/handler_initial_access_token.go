@@ -0,0 +1,351 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/fosite"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+	"github.com/ory/x/urlx"
+)
+
+// Create Initial Access Token Request Body
+//
+// swagger:model createInitialAccessToken
+type createInitialAccessTokenBody struct {
+	// A human-readable description of the token, for example the name of the partner it is issued to.
+	//
+	// example: Example Partner Inc.
+	Description string `json:"description"`
+
+	// The grant types clients registered with this token may use. If empty, all grant types are allowed.
+	//
+	// example: ["authorization_code", "refresh_token"]
+	AllowedGrantTypes []string `json:"allowed_grant_types"`
+
+	// The scope clients registered with this token may request. If empty, all scope is allowed.
+	//
+	// example: ["openid", "offline_access"]
+	AllowedScope []string `json:"allowed_scope"`
+
+	// The hosts the redirect URIs and post logout redirect URIs of clients registered with this token may use.
+	// If empty, all hosts are allowed.
+	//
+	// example: ["partner.example.com"]
+	AllowedRedirectURIHosts []string `json:"allowed_redirect_uri_hosts"`
+
+	// The time the token expires. If unset, the token does not expire.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// Create Initial Access Token Parameters
+//
+// swagger:parameters createInitialAccessToken
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type createInitialAccessToken struct {
+	// in: body
+	Body createInitialAccessTokenBody
+}
+
+// swagger:route POST /admin/clients/registration/initial-access-tokens oAuth2 createInitialAccessToken
+//
+// # Create an Initial Access Token
+//
+// Use this endpoint to issue an initial access token, which authorizes the registration of OAuth 2.0 Clients
+// using OpenID Connect Dynamic Client Registration. Present the token as bearer token to the registration endpoint.
+//
+// Clients registered with the token may only use the grant types, scope and redirect URI hosts allowed by the
+// token, also when they are updated later on. Registration can be restricted to requests presenting an initial
+// access token in the configuration.
+//
+// The token is returned in the response and you will not be able to retrieve it later on.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  201: initialAccessToken
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) createInitialAccessToken(w http.ResponseWriter, r *http.Request) {
+	var body createInitialAccessTokenBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	t := InitialAccessToken{
+		ID:                      uuid.Must(uuid.NewV4()),
+		Description:             body.Description,
+		AllowedGrantTypes:       body.AllowedGrantTypes,
+		AllowedScope:            body.AllowedScope,
+		AllowedRedirectURIHosts: body.AllowedRedirectURIHosts,
+	}
+	if body.ExpiresAt != nil {
+		if body.ExpiresAt.Before(time.Now()) {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReason("The expiry time of the initial access token must be in the future.")))
+			return
+		}
+		t.ExpiresAt = sqlxx.NullTime(body.ExpiresAt.UTC().Round(time.Second))
+	}
+
+	token, signature, err := h.r.OAuth2HMACStrategy().GenerateAccessToken(r.Context(), nil)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	t.Signature = signature
+
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.r.InitialAccessTokenManager().CreateInitialAccessToken(ctx, &t); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionInitialAccessTokenCreated, t.ID.String(), nil, &t)
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	t.Token = token
+	h.r.Writer().WriteCreated(w, r, urlx.MustJoin("/admin", InitialAccessTokensHandlerPath, url.PathEscape(t.ID.String())), &t)
+}
+
+// Paginated Initial Access Token List Response
+//
+// swagger:response listInitialAccessTokens
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type listInitialAccessTokensResponse struct {
+	keysetpagination.ResponseHeaders
+
+	// List of Initial Access Tokens
+	//
+	// in:body
+	Body []InitialAccessToken
+}
+
+// Paginated Initial Access Token List Parameters
+//
+// swagger:parameters listInitialAccessTokens
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type listInitialAccessTokensParameters struct {
+	keysetpagination.RequestParameters
+}
+
+// swagger:route GET /admin/clients/registration/initial-access-tokens oAuth2 listInitialAccessTokens
+//
+// # List Initial Access Tokens
+//
+// This endpoint lists all initial access tokens, and never returns the tokens themselves.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: listInitialAccessTokens
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) listInitialAccessTokens(w http.ResponseWriter, r *http.Request) {
+	pageKeys := h.r.Config().GetPaginationEncryptionKeys(r.Context())
+	pageOpts, err := keysetpagination.ParseQueryParams(pageKeys, r.URL.Query())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse pagination parameters: %s", err)))
+		return
+	}
+
+	ts, nextPage, err := h.r.InitialAccessTokenManager().GetInitialAccessTokens(r.Context(), pageOpts...)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if ts == nil {
+		ts = []InitialAccessToken{}
+	}
+
+	keysetpagination.SetLinkHeader(w, pageKeys, r.URL, nextPage)
+	h.r.Writer().Write(w, r, ts)
+}
+
+// Get Initial Access Token Parameters
+//
+// swagger:parameters getInitialAccessToken
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type getInitialAccessToken struct {
+	// The id of the initial access token.
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// swagger:route GET /admin/clients/registration/initial-access-tokens/{id} oAuth2 getInitialAccessToken
+//
+// # Get an Initial Access Token
+//
+// Use this endpoint to get an initial access token. The token itself is not returned.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: initialAccessToken
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getInitialAccessToken(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	t, err := h.r.InitialAccessTokenManager().GetInitialAccessToken(r.Context(), id)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, t)
+}
+
+// Delete Initial Access Token Parameters
+//
+// swagger:parameters deleteInitialAccessToken
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type deleteInitialAccessToken struct {
+	// The id of the initial access token.
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// swagger:route DELETE /admin/clients/registration/initial-access-tokens/{id} oAuth2 deleteInitialAccessToken
+//
+// # Delete an Initial Access Token
+//
+// Use this endpoint to delete an initial access token. Once deleted, the token can no longer be used to register
+// OAuth 2.0 Clients. Clients registered with the token can no longer be updated using OpenID Connect Dynamic Client
+// Registration, because the registration policy of the token can no longer be enforced.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  204: emptyResponse
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) deleteInitialAccessToken(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		var before *InitialAccessToken
+		if h.r.AuditRecorder().Enabled(ctx) {
+			before, _ = h.r.InitialAccessTokenManager().GetInitialAccessToken(ctx, id)
+		}
+
+		if err := h.r.InitialAccessTokenManager().DeleteInitialAccessToken(ctx, id); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionInitialAccessTokenDeleted, id.String(), before, nil)
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// initialAccessToken returns the initial access token presented with a dynamic client registration request. It
+// returns nil if no token was presented and initial access tokens are not required.
+func (h *Handler) initialAccessToken(r *http.Request) (*InitialAccessToken, error) {
+	if fosite.AccessTokenFromRequest(r) == "" {
+		if h.r.Config().DynamicRegistrationRequiresInitialAccessToken(r.Context()) {
+			return nil, errors.WithStack(herodot.ErrUnauthorized().WithReason("An initial access token is required to register an OAuth 2.0 client."))
+		}
+		return nil, nil
+	}
+
+	signature, err := h.registrationTokenSignature(r)
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrUnauthorized().
+			WithReason("The initial access token is invalid.").WithDebug(err.Error()))
+	}
+
+	t, err := h.r.InitialAccessTokenManager().GetInitialAccessTokenBySignature(r.Context(), signature)
+	if errors.Is(err, sqlcon.ErrNoRows()) {
+		return nil, errors.WithStack(herodot.ErrUnauthorized().
+			WithReason("The initial access token is invalid.").WithDebug("The initial access token does not exist."))
+	} else if err != nil {
+		return nil, err
+	}
+
+	if t.IsExpired() {
+		return nil, errors.WithStack(herodot.ErrUnauthorized().WithReason("The initial access token has expired."))
+	}
+	return t, nil
+}
+
+// validateWithRegistrationPolicy returns a validator which also enforces the registration policy of the initial
+// access token, and links the client to the token.
+func (h *Handler) validateWithRegistrationPolicy(t *InitialAccessToken) func(context.Context, *Client) error {
+	return func(ctx context.Context, c *Client) error {
+		if err := h.r.ClientValidator().ValidateDynamicRegistration(ctx, c); err != nil {
+			return err
+		}
+		if err := h.r.ClientValidator().ValidateRegistrationPolicy(c, t); err != nil {
+			return err
+		}
+		c.InitialAccessTokenID = uuid.NullUUID{UUID: t.ID, Valid: true}
+		return nil
+	}
+}
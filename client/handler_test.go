@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/urfave/negroni"
 
@@ -612,6 +613,23 @@ func TestHandler(t *testing.T) {
 			snapshotx.SnapshotT(t, newResponseSnapshot(body, res))
 		})
 
+		t.Run("case=creating a native client dynamically registers a public client", func(t *testing.T) {
+			body, res := makeJSON(t, publicTs, "POST", client.DynClientsHandlerPath, &client.Client{
+				ApplicationType: client.ApplicationTypeNative,
+				RedirectURIs:    []string{"com.example.app:/cb", "http://127.0.0.1/cb"},
+			})
+			require.Equal(t, http.StatusCreated, res.StatusCode, body)
+			assert.Equal(t, client.ApplicationTypeNative, gjson.Get(body, "application_type").String(), body)
+			assert.Equal(t, "none", gjson.Get(body, "token_endpoint_auth_method").String(), body)
+			assert.False(t, gjson.Get(body, "client_secret").Exists(), body)
+
+			body, res = makeJSON(t, publicTs, "POST", client.DynClientsHandlerPath, &client.Client{
+				ApplicationType: client.ApplicationTypeNative,
+				RedirectURIs:    []string{"https://127.0.0.1/cb", "myapp:/cb"},
+			})
+			assert.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+		})
+
 		t.Run("case=update the lifespans of an OAuth2 client", func(t *testing.T) {
 			expected := &client.Client{
 				Name:                    "update-existing-client-lifespans",
@@ -653,5 +671,106 @@ func TestHandler(t *testing.T) {
 
 			})
 		})
+
+		t.Run("case=initial access tokens", func(t *testing.T) {
+			createToken := func(t *testing.T, body any) string {
+				res, status := makeJSON(t, adminTs, "POST", client.InitialAccessTokensHandlerPath, body)
+				require.Equal(t, http.StatusCreated, status.StatusCode, res)
+				require.NotEmpty(t, gjson.Get(res, "token").String(), res)
+				return res
+			}
+			register := func(t *testing.T, token string, c *client.Client) (string, *http.Response) {
+				var b bytes.Buffer
+				require.NoError(t, json.NewEncoder(&b).Encode(c))
+				return fetchWithBearerAuth(t, "POST", urlx.MustJoin(publicTs.URL, client.DynClientsHandlerPath), token, &b)
+			}
+
+			t.Run("endpoint=admin", func(t *testing.T) {
+				created := createToken(t, map[string]any{"description": "Example Partner Inc."})
+				id := gjson.Get(created, "id").String()
+
+				body, res := makeJSON(t, adminTs, "GET", urlx.MustJoin(client.InitialAccessTokensHandlerPath, id), nil)
+				require.Equal(t, http.StatusOK, res.StatusCode, body)
+				assert.Equal(t, "Example Partner Inc.", gjson.Get(body, "description").String())
+				assert.False(t, gjson.Get(body, "token").Exists(), "the token must only be returned on creation")
+
+				body, res = makeJSON(t, adminTs, "GET", client.InitialAccessTokensHandlerPath, nil)
+				require.Equal(t, http.StatusOK, res.StatusCode, body)
+				assert.Contains(t, gjson.Get(body, "#.id").Value(), id)
+				assert.Empty(t, gjson.Get(body, "#.token").Array(), "the token must only be returned on creation")
+
+				_, res = makeJSON(t, adminTs, "DELETE", urlx.MustJoin(client.InitialAccessTokensHandlerPath, id), nil)
+				assert.Equal(t, http.StatusNoContent, res.StatusCode)
+				_, res = makeJSON(t, adminTs, "GET", urlx.MustJoin(client.InitialAccessTokensHandlerPath, id), nil)
+				assert.Equal(t, http.StatusNotFound, res.StatusCode)
+				_, res = makeJSON(t, adminTs, "DELETE", urlx.MustJoin(client.InitialAccessTokensHandlerPath, id), nil)
+				assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+				body, res = makeJSON(t, adminTs, "POST", client.InitialAccessTokensHandlerPath, map[string]any{"expires_at": "2000-01-01T00:00:00Z"})
+				assert.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+			})
+
+			t.Run("endpoint=dynamic client registration", func(t *testing.T) {
+				created := createToken(t, map[string]any{
+					"allowed_grant_types":        []string{"authorization_code", "refresh_token"},
+					"allowed_scope":              []string{"openid", "offline_access"},
+					"allowed_redirect_uri_hosts": []string{"partner.example.com"},
+				})
+				token := gjson.Get(created, "token").String()
+
+				t.Run("case=token is required", func(t *testing.T) {
+					require.NoError(t, reg.Config().Set(ctx, config.KeyDynamicRegistrationInitialAccessToken, true))
+					t.Cleanup(func() {
+						require.NoError(t, reg.Config().Set(ctx, config.KeyDynamicRegistrationInitialAccessToken, false))
+					})
+
+					body, res := makeJSON(t, publicTs, "POST", client.DynClientsHandlerPath, &client.Client{RedirectURIs: []string{"https://partner.example.com/cb"}})
+					assert.Equal(t, http.StatusUnauthorized, res.StatusCode, body)
+
+					body, res = register(t, "invalid", &client.Client{RedirectURIs: []string{"https://partner.example.com/cb"}})
+					assert.Equal(t, http.StatusUnauthorized, res.StatusCode, body)
+
+					body, res = register(t, token, &client.Client{RedirectURIs: []string{"https://partner.example.com/cb"}, Scope: "openid"})
+					assert.Equal(t, http.StatusCreated, res.StatusCode, body)
+				})
+
+				t.Run("case=registration policy is enforced", func(t *testing.T) {
+					for _, c := range []*client.Client{
+						{RedirectURIs: []string{"https://partner.example.com/cb"}, Scope: "openid", GrantTypes: []string{"client_credentials"}},
+						{RedirectURIs: []string{"https://partner.example.com/cb"}, Scope: "openid admin"},
+						{RedirectURIs: []string{"https://attacker.example.com/cb"}, Scope: "openid"},
+					} {
+						body, res := register(t, token, c)
+						assert.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+					}
+				})
+
+				t.Run("case=expired token is rejected", func(t *testing.T) {
+					expiring := createToken(t, map[string]any{"expires_at": time.Now().Add(2 * time.Second).Format(time.RFC3339)})
+					time.Sleep(3 * time.Second)
+					body, res := register(t, gjson.Get(expiring, "token").String(), &client.Client{RedirectURIs: []string{"https://partner.example.com/cb"}})
+					assert.Equal(t, http.StatusUnauthorized, res.StatusCode, body)
+				})
+
+				t.Run("case=updates are bound to the registration policy", func(t *testing.T) {
+					policy := createToken(t, map[string]any{"allowed_redirect_uri_hosts": []string{"partner.example.com"}})
+					body, res := register(t, gjson.Get(policy, "token").String(), &client.Client{RedirectURIs: []string{"https://partner.example.com/cb"}})
+					require.Equal(t, http.StatusCreated, res.StatusCode, body)
+					id, rat := getClientID(body), gjson.Get(body, "registration_access_token").String()
+
+					payload, _ := sjson.Set(body, "redirect_uris", []string{"https://attacker.example.com/cb"})
+					payload, _ = sjson.Delete(payload, "client_secret")
+					updated, res := fetchWithBearerAuth(t, "PUT", urlx.MustJoin(publicTs.URL, client.DynClientsHandlerPath, url.PathEscape(id)), rat, bytes.NewBufferString(payload))
+					require.Equal(t, http.StatusBadRequest, res.StatusCode, updated)
+
+					_, res = makeJSON(t, adminTs, "DELETE", urlx.MustJoin(client.InitialAccessTokensHandlerPath, gjson.Get(policy, "id").String()), nil)
+					require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+					payload, _ = sjson.Delete(body, "client_secret")
+					updated, res = fetchWithBearerAuth(t, "PUT", urlx.MustJoin(publicTs.URL, client.DynClientsHandlerPath, url.PathEscape(id)), rat, bytes.NewBufferString(payload))
+					assert.Equal(t, http.StatusBadRequest, res.StatusCode, "updates fail once the initial access token is deleted: %s", updated)
+				})
+			})
+		})
 	})
 }
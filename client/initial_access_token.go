@@ -0,0 +1,82 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/sqlxx"
+)
+
+// OpenID Connect Dynamic Client Registration Initial Access Token
+//
+// Initial access tokens are issued by an administrator and authorize the registration of OAuth 2.0 Clients using
+// OpenID Connect Dynamic Client Registration. Clients registered with an initial access token are restricted to
+// the grant types, scope and redirect URI hosts allowed by the token, also when they are updated later on.
+//
+// swagger:model initialAccessToken
+type InitialAccessToken struct {
+	// The ID of the initial access token.
+	ID uuid.UUID `json:"id" db:"id"`
+
+	NID uuid.UUID `json:"-" db:"nid"`
+
+	// Signature is the signature of the token which is used to look up the token.
+	Signature string `json:"-" db:"signature"`
+
+	// The initial access token. It is only returned when the token is created. Present it as a bearer token
+	// to the dynamic client registration endpoint.
+	Token string `json:"token,omitempty" db:"-"`
+
+	// A human-readable description of the token, for example the name of the partner it was issued to.
+	Description string `json:"description" db:"description"`
+
+	// The grant types clients registered with this token may use. If empty, all grant types are allowed.
+	//
+	// example: ["authorization_code", "refresh_token"]
+	AllowedGrantTypes sqlxx.StringSliceJSONFormat `json:"allowed_grant_types" db:"allowed_grant_types"`
+
+	// The scope clients registered with this token may request. If empty, all scope is allowed.
+	//
+	// example: ["openid", "offline_access"]
+	AllowedScope sqlxx.StringSliceJSONFormat `json:"allowed_scope" db:"allowed_scope"`
+
+	// The hosts the redirect URIs and post logout redirect URIs of clients registered with this token may use.
+	// If empty, all hosts are allowed.
+	//
+	// example: ["partner.example.com"]
+	AllowedRedirectURIHosts sqlxx.StringSliceJSONFormat `json:"allowed_redirect_uri_hosts" db:"allowed_redirect_uri_hosts"`
+
+	// The time the token was created.
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+	// The time the token expires. Once expired, the token can no longer be used to register clients, but the
+	// policy of the token still applies to the clients registered with it. If unset, the token does not expire.
+	ExpiresAt sqlxx.NullTime `json:"expires_at" db:"expires_at"`
+}
+
+func (InitialAccessToken) TableName() string {
+	return "hydra_oauth2_initial_access_token"
+}
+
+func (t *InitialAccessToken) BeforeSave(_ *pop.Connection) error {
+	if t.AllowedGrantTypes == nil {
+		t.AllowedGrantTypes = sqlxx.StringSliceJSONFormat{}
+	}
+	if t.AllowedScope == nil {
+		t.AllowedScope = sqlxx.StringSliceJSONFormat{}
+	}
+	if t.AllowedRedirectURIHosts == nil {
+		t.AllowedRedirectURIHosts = sqlxx.StringSliceJSONFormat{}
+	}
+	return nil
+}
+
+// IsExpired returns true if the token can no longer be used to register clients.
+func (t *InitialAccessToken) IsExpired() bool {
+	return !time.Time(t.ExpiresAt).IsZero() && time.Time(t.ExpiresAt).Before(time.Now())
+}
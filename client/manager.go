@@ -6,6 +6,8 @@ package client
 import (
 	"context"
 
+	"github.com/gofrs/uuid"
+
 	"github.com/ory/hydra/v2/fosite"
 	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
 )
@@ -26,6 +28,7 @@ type Manager interface {
 
 type Storage interface {
 	fosite.ClientManager
+	fosite.ClientSecretStorage
 
 	CreateClient(ctx context.Context, c *Client) error
 
@@ -41,3 +44,20 @@ type Storage interface {
 type ManagerProvider interface {
 	ClientManager() Manager
 }
+
+type InitialAccessTokenManager interface {
+	CreateInitialAccessToken(ctx context.Context, t *InitialAccessToken) error
+
+	GetInitialAccessToken(ctx context.Context, id uuid.UUID) (*InitialAccessToken, error)
+
+	// GetInitialAccessTokenBySignature returns the token with the given signature, including expired tokens.
+	GetInitialAccessTokenBySignature(ctx context.Context, signature string) (*InitialAccessToken, error)
+
+	GetInitialAccessTokens(ctx context.Context, pageOpts ...keysetpagination.Option) ([]InitialAccessToken, *keysetpagination.Paginator, error)
+
+	DeleteInitialAccessToken(ctx context.Context, id uuid.UUID) error
+}
+
+type InitialAccessTokenManagerProvider interface {
+	InitialAccessTokenManager() InitialAccessTokenManager
+}
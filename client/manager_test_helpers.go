@@ -25,6 +25,7 @@ import (
 	"github.com/ory/x/contextx"
 	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
 	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
 )
 
 func TestHelperClientAutoGenerateKey(m Storage) func(t *testing.T) {
@@ -64,6 +65,49 @@ func TestHelperClientAuthenticate(m Manager) func(t *testing.T) {
 	}
 }
 
+func TestHelperClientSecretJWT(m Manager) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx := context.Background()
+
+		c := &Client{Secret: "secret", TokenEndpointAuthMethod: "client_secret_jwt"}
+		require.NoError(t, m.CreateClient(ctx, c))
+
+		actual, err := m.GetConcreteClient(ctx, c.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, actual.EncryptedSecret)
+		assert.NotContains(t, string(actual.EncryptedSecret), "secret")
+		secret, err := m.GetClientSecret(ctx, actual)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", string(secret))
+
+		// The client secret is kept if the client is updated without it.
+		require.NoError(t, m.UpdateClient(ctx, &Client{ID: c.ID, Name: "updated", TokenEndpointAuthMethod: "client_secret_jwt"}))
+		actual, err = m.GetConcreteClient(ctx, c.ID)
+		require.NoError(t, err)
+		secret, err = m.GetClientSecret(ctx, actual)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", string(secret))
+
+		require.NoError(t, m.UpdateClient(ctx, &Client{ID: c.ID, Secret: "rotated", TokenEndpointAuthMethod: "client_secret_jwt"}))
+		actual, err = m.GetConcreteClient(ctx, c.ID)
+		require.NoError(t, err)
+		secret, err = m.GetClientSecret(ctx, actual)
+		require.NoError(t, err)
+		assert.Equal(t, "rotated", string(secret))
+
+		// The client secret is only stored encrypted for clients using client_secret_jwt.
+		require.NoError(t, m.UpdateClient(ctx, &Client{ID: c.ID, TokenEndpointAuthMethod: "client_secret_basic"}))
+		actual, err = m.GetConcreteClient(ctx, c.ID)
+		require.NoError(t, err)
+		assert.Empty(t, actual.EncryptedSecret)
+
+		err = m.UpdateClient(ctx, &Client{ID: c.ID, TokenEndpointAuthMethod: "client_secret_jwt"})
+		require.ErrorIs(t, err, ErrInvalidClientMetadata)
+
+		require.NoError(t, m.DeleteClient(ctx, c.ID))
+	}
+}
+
 func TestHelperUpdateTwoClients(m Manager) func(t *testing.T) {
 	return func(t *testing.T) {
 		c1, c2 := &Client{Name: "test client 1"}, &Client{Name: "test client 2"}
@@ -400,3 +444,81 @@ func compare(t *testing.T, expected *Client, actual fosite.Client) {
 		assert.EqualValues(t, expected.RequestObjectSigningAlgorithm, actual.GetRequestObjectSigningAlgorithm())
 	}
 }
+
+func TestHelperInitialAccessTokens(t1, t2 InitialAccessTokenManager) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx := context.Background()
+
+		token := &InitialAccessToken{
+			Signature:               uuidx.NewV4().String(),
+			Description:             "partner",
+			AllowedGrantTypes:       []string{"authorization_code"},
+			AllowedScope:            []string{"openid"},
+			AllowedRedirectURIHosts: []string{"partner.example.com"},
+			ExpiresAt:               sqlxx.NullTime(time.Now().UTC().Add(time.Hour).Truncate(time.Second)),
+		}
+		require.NoError(t, t1.CreateInitialAccessToken(ctx, token))
+		require.NotEqual(t, uuid.Nil, token.ID)
+
+		actual, err := t1.GetInitialAccessToken(ctx, token.ID)
+		require.NoError(t, err)
+		assert.Equal(t, token.Description, actual.Description)
+		assert.Equal(t, token.AllowedGrantTypes, actual.AllowedGrantTypes)
+		assert.Equal(t, token.AllowedScope, actual.AllowedScope)
+		assert.Equal(t, token.AllowedRedirectURIHosts, actual.AllowedRedirectURIHosts)
+		assert.Equal(t, time.Time(token.ExpiresAt), time.Time(actual.ExpiresAt))
+		assert.False(t, actual.IsExpired())
+
+		actual, err = t1.GetInitialAccessTokenBySignature(ctx, token.Signature)
+		require.NoError(t, err)
+		assert.Equal(t, token.ID, actual.ID)
+
+		unrestricted := &InitialAccessToken{Signature: uuidx.NewV4().String()}
+		require.NoError(t, t1.CreateInitialAccessToken(ctx, unrestricted))
+		actual, err = t1.GetInitialAccessToken(ctx, unrestricted.ID)
+		require.NoError(t, err)
+		assert.Empty(t, actual.AllowedGrantTypes)
+		assert.False(t, actual.IsExpired())
+
+		tokens, _, err := t1.GetInitialAccessTokens(ctx)
+		require.NoError(t, err)
+		var ids []uuid.UUID
+		for _, tok := range tokens {
+			ids = append(ids, tok.ID)
+		}
+		assert.Contains(t, ids, token.ID)
+		assert.Contains(t, ids, unrestricted.ID)
+
+		if t1 != t2 {
+			_, err = t2.GetInitialAccessToken(ctx, token.ID)
+			assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+			_, err = t2.GetInitialAccessTokenBySignature(ctx, token.Signature)
+			assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+			assert.ErrorIs(t, t2.DeleteInitialAccessToken(ctx, token.ID), sqlcon.ErrNoRows())
+		}
+
+		require.NoError(t, t1.DeleteInitialAccessToken(ctx, token.ID))
+		require.NoError(t, t1.DeleteInitialAccessToken(ctx, unrestricted.ID))
+		_, err = t1.GetInitialAccessToken(ctx, token.ID)
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+		assert.ErrorIs(t, t1.DeleteInitialAccessToken(ctx, token.ID), sqlcon.ErrNoRows())
+	}
+}
+
+func TestHelperUpdateClientKeepsInitialAccessToken(m Manager) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx := context.Background()
+		tokenID := uuidx.NewV4()
+
+		c := &Client{Name: "registered", InitialAccessTokenID: uuid.NullUUID{UUID: tokenID, Valid: true}}
+		require.NoError(t, m.CreateClient(ctx, c))
+
+		require.NoError(t, m.UpdateClient(ctx, &Client{ID: c.ID, Name: "updated"}))
+
+		actual, err := m.GetConcreteClient(ctx, c.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "updated", actual.Name)
+		assert.Equal(t, uuid.NullUUID{UUID: tokenID, Valid: true}, actual.InitialAccessTokenID)
+		require.NoError(t, m.DeleteClient(ctx, c.ID))
+	}
+}
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 	foauth2 "github.com/ory/hydra/v2/fosite/handler/oauth2"
@@ -15,14 +16,18 @@ import (
 
 type InternalRegistry interface {
 	httpx.WriterProvider
+	audit.RecorderProvider
 	Registry
 }
 
 type Registry interface {
 	ClientValidator() *Validator
 	ClientManager() Manager
+	InitialAccessTokenManager() InitialAccessTokenManager
 	ClientHasher() fosite.Hasher
 	OpenIDJWTSigner() jwk.JWTSigner
+	jwk.FederationSignerProvider
+	FederationRegistrarProvider
 	OAuth2HMACStrategy() foauth2.CoreStrategy
 	OAuth2EnigmaStrategy() *enigma.HMACStrategy
 	rfc8628.DeviceRateLimitStrategyProvider
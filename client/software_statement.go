@@ -0,0 +1,122 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+)
+
+// softwareStatementLeeway is the clock skew tolerated when validating the time claims of software statements.
+const softwareStatementLeeway = time.Minute
+
+// ApplySoftwareStatement validates the software statement of the client against the keys of the trusted issuers
+// and overrides the client metadata with the claims of the software statement (RFC 7591, Section 2.3).
+func (v *Validator) ApplySoftwareStatement(ctx context.Context, c *Client) error {
+	if c.SoftwareStatement == "" {
+		if v.r.Config().DynamicRegistrationRequiresSoftwareStatement(ctx) {
+			return errors.WithStack(ErrInvalidSoftwareStatement.WithHint("A software statement is required to register a client."))
+		}
+		return nil
+	}
+
+	token, err := jwt.ParseSigned(c.SoftwareStatement)
+	if err != nil {
+		return errors.WithStack(ErrInvalidSoftwareStatement.WithHint("Unable to parse the software statement.").WithDebug(err.Error()))
+	}
+	if len(token.Headers) != 1 || !isSupportedAuthTokenSigningAlg(token.Headers[0].Algorithm) {
+		return errors.WithStack(ErrInvalidSoftwareStatement.WithHintf("The software statement must be signed using one of the algorithms %s.", strings.Join(supportedAuthTokenSigningAlgs, ", ")))
+	}
+
+	var unverified jwt.Claims
+	if err := token.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return errors.WithStack(ErrInvalidSoftwareStatement.WithHint("Unable to decode the claims of the software statement.").WithDebug(err.Error()))
+	}
+	issuer, ok := v.softwareStatementIssuer(ctx, unverified.Issuer)
+	if !ok {
+		return errors.WithStack(ErrUnapprovedSoftwareStatement.WithHintf("The issuer '%s' of the software statement is not trusted.", unverified.Issuer))
+	}
+
+	keys, err := v.softwareStatementKeys(ctx, issuer.JWKSURI, token.Headers[0].KeyID)
+	if err != nil {
+		return errors.WithStack(ErrInvalidSoftwareStatement.WithHint("Unable to fetch the JSON Web Key Set of the software statement issuer.").WithDebug(fosite.ErrorToRFC6749Error(err).WithExposeDebug(true).GetDescription()))
+	}
+
+	var (
+		registered jwt.Claims
+		claims     map[string]any
+		verified   bool
+	)
+	for _, key := range keys {
+		if err := token.Claims(key.Public(), &registered, &claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return errors.WithStack(ErrUnapprovedSoftwareStatement.WithHint("The signature of the software statement could not be verified with the keys of its issuer."))
+	}
+	if err := registered.ValidateWithLeeway(jwt.Expected{Issuer: issuer.Issuer, Time: time.Now()}, softwareStatementLeeway); err != nil {
+		return errors.WithStack(ErrInvalidSoftwareStatement.WithHintf("The software statement is not valid: %s.", err))
+	}
+
+	// The registered claims describe the statement itself, not the client.
+	for _, claim := range []string{"iss", "sub", "aud", "exp", "nbf", "iat", "jti", "software_statement"} {
+		delete(claims, claim)
+	}
+
+	statement := c.SoftwareStatement
+	metadata, err := json.Marshal(claims)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := json.Unmarshal(metadata, c); err != nil {
+		return errors.WithStack(ErrInvalidSoftwareStatement.WithHint("The claims of the software statement are not valid client metadata.").WithDebug(err.Error()))
+	}
+	c.SoftwareStatement = statement
+
+	return nil
+}
+
+func (v *Validator) softwareStatementIssuer(ctx context.Context, issuer string) (config.SoftwareStatementIssuer, bool) {
+	if issuer == "" {
+		return config.SoftwareStatementIssuer{}, false
+	}
+	for _, i := range v.r.Config().SoftwareStatementTrustedIssuers(ctx) {
+		if i.Issuer == issuer {
+			return i, true
+		}
+	}
+	return config.SoftwareStatementIssuer{}, false
+}
+
+// softwareStatementKeys returns the keys of the issuer which may have signed the software statement. The JSON Web
+// Key Set is fetched again if it does not contain the key, because the issuer may have rotated its keys.
+func (v *Validator) softwareStatementKeys(ctx context.Context, location, keyID string) ([]jose.JSONWebKey, error) {
+	set, err := v.jwks.Resolve(ctx, location, false)
+	if err != nil {
+		return nil, err
+	}
+	if keyID == "" {
+		return set.Keys, nil
+	}
+	if keys := set.Key(keyID); len(keys) > 0 {
+		return keys, nil
+	}
+
+	set, err = v.jwks.Resolve(ctx, location, true)
+	if err != nil {
+		return nil, err
+	}
+	return set.Key(keyID), nil
+}
@@ -8,9 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/pkg/errors"
@@ -19,6 +22,9 @@ import (
 
 	"github.com/ory/herodot"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/ipx"
 )
 
@@ -38,21 +44,43 @@ func isSupportedAuthTokenSigningAlg(alg string) bool {
 	return slices.Contains(supportedAuthTokenSigningAlgs, alg)
 }
 
+var supportedClientSecretJWTSigningAlgs = []string{
+	"HS256",
+	"HS384",
+	"HS512",
+}
+
+// securityProfileSigningAlgs are the signing algorithms allowed by the FAPI 2.0 security profiles.
+var securityProfileSigningAlgs = []string{"PS256", "ES256", "EdDSA"}
+
+// securityProfileAuthMethods are the client authentication methods allowed by the FAPI 2.0 security profiles.
+var securityProfileAuthMethods = []string{"private_key_jwt", "tls_client_auth", "self_signed_tls_client_auth"}
+
 type validatorRegistry interface {
 	httpx.ClientProvider
 	config.Provider
+	jwk.OpenIDSignerProvider
 }
 
 type Validator struct {
-	r validatorRegistry
+	r    validatorRegistry
+	jwks fosite.JWKSFetcherStrategy
 }
 
 func NewValidator(r validatorRegistry) *Validator {
-	return &Validator{r: r}
+	return &Validator{
+		r: r,
+		jwks: fosite.NewDefaultJWKSFetcherStrategy(fosite.JWKSFetcherWithHTTPClientSource(func(ctx context.Context) *retryablehttp.Client {
+			return r.HTTPClient(ctx)
+		})),
+	}
 }
 
 func (v *Validator) Validate(ctx context.Context, c *Client) error {
-	if c.TokenEndpointAuthMethod == "" {
+	if c.TokenEndpointAuthMethod == "" && c.ApplicationType == ApplicationTypeNative {
+		// Native apps can not keep secrets, see https://www.rfc-editor.org/rfc/rfc8252#section-8.4.
+		c.TokenEndpointAuthMethod = "none"
+	} else if c.TokenEndpointAuthMethod == "" {
 		c.TokenEndpointAuthMethod = "client_secret_basic"
 	} else if c.TokenEndpointAuthMethod == "private_key_jwt" {
 		if len(c.JSONWebKeysURI) == 0 && c.GetJSONWebKeys() == nil {
@@ -61,6 +89,20 @@ func (v *Validator) Validate(ctx context.Context, c *Client) error {
 		if c.TokenEndpointAuthSigningAlgorithm != "" && !isSupportedAuthTokenSigningAlg(c.TokenEndpointAuthSigningAlgorithm) {
 			return errors.WithStack(ErrInvalidClientMetadata.WithHint("Only RS256, RS384, RS512, PS256, PS384, PS512, ES256, ES384 and ES512 are supported as algorithms for private key authentication."))
 		}
+	} else if c.TokenEndpointAuthMethod == "client_secret_jwt" {
+		if c.TokenEndpointAuthSigningAlgorithm == "" {
+			c.TokenEndpointAuthSigningAlgorithm = "HS256"
+		} else if !slices.Contains(supportedClientSecretJWTSigningAlgs, c.TokenEndpointAuthSigningAlgorithm) {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHint("Only HS256, HS384 and HS512 are supported as algorithms for client secret authentication."))
+		}
+	} else if c.TokenEndpointAuthMethod == "tls_client_auth" {
+		if c.TLSClientAuthSubjectDN == "" {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHint("When token_endpoint_auth_method is 'tls_client_auth', tls_client_auth_subject_dn must be set."))
+		}
+	} else if c.TokenEndpointAuthMethod == "self_signed_tls_client_auth" {
+		if len(c.JSONWebKeysURI) == 0 && c.GetJSONWebKeys() == nil {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHint("When token_endpoint_auth_method is 'self_signed_tls_client_auth', either jwks or jwks_uri must be set."))
+		}
 	}
 
 	if len(c.JSONWebKeysURI) > 0 && c.GetJSONWebKeys() != nil {
@@ -164,8 +206,12 @@ func (v *Validator) Validate(ctx context.Context, c *Client) error {
 		c.UserinfoSignedResponseAlg = "none"
 	}
 
-	if c.UserinfoSignedResponseAlg != "none" && c.UserinfoSignedResponseAlg != "RS256" {
-		return errors.WithStack(ErrInvalidClientMetadata.WithHint("Field userinfo_signed_response_alg can either be 'none' or 'RS256'."))
+	if err := v.validateOpenIDSigningAlgs(ctx, c); err != nil {
+		return err
+	}
+
+	if err := v.validateIntrospectionResponseAlgs(c); err != nil {
+		return err
 	}
 
 	redirs := make([]*url.URL, len(c.RedirectURIs))
@@ -208,6 +254,25 @@ func (v *Validator) Validate(ctx context.Context, c *Client) error {
 		}
 	}
 
+	switch c.RefreshTokenReusePolicy {
+	case "", RefreshTokenReusePolicyRevokeTokens, RefreshTokenReusePolicyRevokeLoginSession, RefreshTokenReusePolicyRevokeConsent:
+	default:
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field refresh_token_reuse_policy must be one of %q, %q, or %q.",
+			RefreshTokenReusePolicyRevokeTokens, RefreshTokenReusePolicyRevokeLoginSession, RefreshTokenReusePolicyRevokeConsent))
+	}
+
+	if err := v.validateRefreshTokenPolicy(c); err != nil {
+		return err
+	}
+
+	if err := v.validateSecurityProfile(c, redirs); err != nil {
+		return err
+	}
+
+	if err := v.validateApplicationType(c, redirs); err != nil {
+		return err
+	}
+
 	if c.AccessTokenStrategy != "" {
 		s, err := config.ToAccessTokenStrategyType(c.AccessTokenStrategy)
 		if err != nil {
@@ -221,6 +286,207 @@ func (v *Validator) Validate(ctx context.Context, c *Client) error {
 	return nil
 }
 
+func (v *Validator) validateRefreshTokenPolicy(c *Client) error {
+	for _, f := range []struct {
+		name string
+		d    x.NullDuration
+	}{
+		{"refresh_token_rotation_grace_period", c.RefreshTokenRotationGracePeriod},
+		{"refresh_token_idle_lifespan", c.RefreshTokenIdleLifespan},
+		{"refresh_token_max_lifespan", c.RefreshTokenMaxLifespan},
+	} {
+		if f.d.Valid && f.d.Duration < 0 {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field %s must not be negative.", f.name))
+		}
+	}
+	if c.RefreshTokenRotationGraceReuseCount.Valid && c.RefreshTokenRotationGraceReuseCount.Int < 0 {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHint("Field refresh_token_rotation_grace_reuse_count must not be negative."))
+	} else if c.RefreshTokenRotationGraceReuseCount.Int > math.MaxInt32 {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field refresh_token_rotation_grace_reuse_count must not exceed %d.", math.MaxInt32))
+	}
+
+	if c.RefreshTokenRotationDisabled &&
+		(c.RefreshTokenRotationGracePeriod.Valid || c.RefreshTokenRotationGraceReuseCount.Valid || c.RefreshTokenIdleLifespan.Valid) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHint("Fields refresh_token_rotation_grace_period, refresh_token_rotation_grace_reuse_count, and refresh_token_idle_lifespan require refresh token rotation, but refresh_token_rotation_disabled is set."))
+	}
+
+	// The same limits apply as to the global configuration of graceful refresh token rotation.
+	if c.RefreshTokenRotationGracePeriod.Valid {
+		maxPeriod := 5 * time.Minute
+		if c.RefreshTokenRotationGraceReuseCount.Int > 0 {
+			maxPeriod = 180 * 24 * time.Hour
+		}
+		if c.RefreshTokenRotationGracePeriod.Duration > maxPeriod {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field refresh_token_rotation_grace_period must not exceed %s.", maxPeriod))
+		}
+	}
+
+	return nil
+}
+
+// validateOpenIDSigningAlgs checks that the OpenID Connect key set has a key for the algorithms the client wants its
+// ID tokens and userinfo responses to be signed with.
+func (v *Validator) validateOpenIDSigningAlgs(ctx context.Context, c *Client) error {
+	if c.UserinfoSignedResponseAlg == "none" && c.IDTokenSignedResponseAlg == "" {
+		return nil
+	}
+
+	algs, err := v.r.OpenIDJWTSigner().GetSigningAlgorithms(ctx)
+	if err != nil {
+		return err
+	}
+	if c.UserinfoSignedResponseAlg != "none" && !slices.Contains(algs, c.UserinfoSignedResponseAlg) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field userinfo_signed_response_alg must be 'none' or one of '%s', which are the algorithms of the OpenID Connect signing keys.", strings.Join(algs, "', '")))
+	}
+	if c.IDTokenSignedResponseAlg != "" && !slices.Contains(algs, c.IDTokenSignedResponseAlg) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field id_token_signed_response_alg must be one of '%s', which are the algorithms of the OpenID Connect signing keys.", strings.Join(algs, "', '")))
+	}
+	return nil
+}
+
+// validateIntrospectionResponseAlgs validates the algorithms of JWT introspection responses and defaults the
+// content encryption algorithm if the response is encrypted.
+func (v *Validator) validateIntrospectionResponseAlgs(c *Client) error {
+	if c.IntrospectionSignedResponseAlg != "" && !slices.Contains(jwk.SigningAlgorithms, c.IntrospectionSignedResponseAlg) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field introspection_signed_response_alg must be one of '%s'.", strings.Join(jwk.SigningAlgorithms, "', '")))
+	}
+
+	if c.IntrospectionEncryptedResponseAlg == "" {
+		if c.IntrospectionEncryptedResponseEnc != "" {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHint("Field introspection_encrypted_response_enc requires introspection_encrypted_response_alg to be set."))
+		}
+		return nil
+	}
+	if !slices.Contains(jwk.EncryptionAlgorithms, c.IntrospectionEncryptedResponseAlg) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field introspection_encrypted_response_alg must be one of '%s'.", strings.Join(jwk.EncryptionAlgorithms, "', '")))
+	}
+	if c.IntrospectionEncryptedResponseEnc == "" {
+		c.IntrospectionEncryptedResponseEnc = jwk.ContentEncryptionAlgorithms[0]
+	}
+	if !slices.Contains(jwk.ContentEncryptionAlgorithms, c.IntrospectionEncryptedResponseEnc) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field introspection_encrypted_response_enc must be one of '%s'.", strings.Join(jwk.ContentEncryptionAlgorithms, "', '")))
+	}
+	if len(c.JSONWebKeysURI) == 0 && c.GetJSONWebKeys() == nil {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHint("Field introspection_encrypted_response_alg requires the jwks or jwks_uri field to be set."))
+	}
+	return nil
+}
+
+// validateSecurityProfile validates that a client with a security profile only uses the features allowed by the
+// FAPI 2.0 Security Profile, and, for the message signing profile, that it signs its request objects.
+func (v *Validator) validateSecurityProfile(c *Client, redirs []*url.URL) error {
+	switch c.SecurityProfile {
+	case "":
+		return nil
+	case SecurityProfileFAPI2Security, SecurityProfileFAPI2MessageSigning:
+	default:
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field security_profile must be one of %q or %q.",
+			SecurityProfileFAPI2Security, SecurityProfileFAPI2MessageSigning))
+	}
+
+	if !slices.Contains(securityProfileAuthMethods, c.TokenEndpointAuthMethod) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Security profile %q requires token_endpoint_auth_method to be one of '%s'.",
+			c.SecurityProfile, strings.Join(securityProfileAuthMethods, "', '")))
+	}
+	if c.TokenEndpointAuthMethod == "private_key_jwt" && !slices.Contains(securityProfileSigningAlgs, c.TokenEndpointAuthSigningAlgorithm) {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Security profile %q requires token_endpoint_auth_signing_alg to be one of '%s'.",
+			c.SecurityProfile, strings.Join(securityProfileSigningAlgs, "', '")))
+	}
+
+	for field, alg := range map[string]string{
+		"id_token_signed_response_alg": c.IDTokenSignedResponseAlg,
+		"userinfo_signed_response_alg": c.UserinfoSignedResponseAlg,
+		"request_object_signing_alg":   c.RequestObjectSigningAlgorithm,
+	} {
+		if alg == "" || (field == "userinfo_signed_response_alg" && alg == "none") {
+			continue
+		}
+		if !slices.Contains(securityProfileSigningAlgs, alg) {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Security profile %q requires field %s to be one of '%s'.",
+				c.SecurityProfile, field, strings.Join(securityProfileSigningAlgs, "', '")))
+		}
+	}
+	if c.SecurityProfile == SecurityProfileFAPI2MessageSigning && c.RequestObjectSigningAlgorithm == "" {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Security profile %q requires field request_object_signing_alg to be set.", c.SecurityProfile))
+	}
+
+	if responseTypes := c.GetResponseTypes(); len(responseTypes) != 1 || responseTypes[0] != "code" {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Security profile %q only allows response type 'code'.", c.SecurityProfile))
+	}
+	for _, grantType := range c.GetGrantTypes() {
+		if grantType == "implicit" || grantType == "password" {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Security profile %q does not allow grant type '%s'.", c.SecurityProfile, grantType))
+		}
+	}
+
+	for _, r := range redirs {
+		if r.Scheme != "https" {
+			return errors.WithStack(ErrInvalidRedirectURI.WithHintf("Security profile %q requires redirect URIs to use https:// but %s does not.", c.SecurityProfile, r))
+		}
+	}
+
+	return nil
+}
+
+// validateApplicationType validates the client against the profile of its application type. Web clients must use https
+// redirect URIs which are not on the loopback interface. Native clients must be public clients and use the redirect
+// URIs of https://www.rfc-editor.org/rfc/rfc8252#section-7.
+func (v *Validator) validateApplicationType(c *Client, redirs []*url.URL) error {
+	switch c.ApplicationType {
+	case "":
+		return nil
+	case ApplicationTypeWeb:
+		for _, r := range redirs {
+			if r.Scheme != "https" || fosite.IsLocalhost(r) {
+				return errors.WithStack(ErrInvalidRedirectURI.WithHintf("Web applications must use https:// redirect URIs which are not on the loopback interface, but %s is not.", r))
+			}
+		}
+		return nil
+	case ApplicationTypeNative:
+	default:
+		return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Field application_type must be one of %q or %q.", ApplicationTypeWeb, ApplicationTypeNative))
+	}
+
+	if c.TokenEndpointAuthMethod != "none" {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHint("Native applications must use token_endpoint_auth_method 'none'."))
+	}
+	if c.Secret != "" {
+		return errors.WithStack(ErrInvalidClientMetadata.WithHint("Native applications must not have a client secret."))
+	}
+
+	for _, r := range redirs {
+		switch r.Scheme {
+		case "https":
+			// https://www.rfc-editor.org/rfc/rfc8252#section-7.2
+		case "http":
+			// https://www.rfc-editor.org/rfc/rfc8252#section-7.3
+			if ip := net.ParseIP(r.Hostname()); ip == nil || !ip.IsLoopback() {
+				return errors.WithStack(ErrInvalidRedirectURI.WithHintf("Native applications may only use http:// redirect URIs with a loopback IP address, but %s does not.", r))
+			}
+		default:
+			// https://www.rfc-editor.org/rfc/rfc8252#section-7.1
+			if !isReverseDomainName(r.Scheme) {
+				return errors.WithStack(ErrInvalidRedirectURI.WithHintf("Native applications must use private-use URI schemes in reverse domain name notation such as 'com.example.app', but %s does not.", r))
+			}
+		}
+	}
+	return nil
+}
+
+// isReverseDomainName returns whether the URI scheme is a domain name in reverse order, such as "com.example.app".
+func isReverseDomainName(scheme string) bool {
+	labels := strings.Split(scheme, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" {
+			return false
+		}
+	}
+	return true
+}
+
 func (v *Validator) ValidateDynamicRegistration(ctx context.Context, c *Client) error {
 	if c.Metadata != nil {
 		return errors.WithStack(ErrInvalidClientMetadata.WithHint(`"metadata" cannot be set for dynamic client registration`))
@@ -238,6 +504,41 @@ func (v *Validator) ValidateDynamicRegistration(ctx context.Context, c *Client)
 	return v.Validate(ctx, c)
 }
 
+// ValidateRegistrationPolicy validates that the client only uses the grant types, scope and redirect URI hosts allowed
+// by the initial access token it is registered with. It must be called after the client was validated, so that
+// defaults are applied.
+func (v *Validator) ValidateRegistrationPolicy(c *Client, t *InitialAccessToken) error {
+	if len(t.AllowedGrantTypes) > 0 {
+		for _, grantType := range c.GetGrantTypes() {
+			if !slices.Contains(t.AllowedGrantTypes, grantType) {
+				return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Grant type '%s' is not allowed by the initial access token.", grantType))
+			}
+		}
+	}
+
+	if len(t.AllowedScope) > 0 {
+		for _, scope := range c.GetScopes() {
+			if !slices.Contains(t.AllowedScope, scope) {
+				return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Scope '%s' is not allowed by the initial access token.", scope))
+			}
+		}
+	}
+
+	if len(t.AllowedRedirectURIHosts) > 0 {
+		for _, uri := range slices.Concat(c.RedirectURIs, c.PostLogoutRedirectURIs) {
+			u, err := url.Parse(uri)
+			if err != nil {
+				return errors.WithStack(ErrInvalidRedirectURI.WithHintf("Redirect URI '%s' could not be parsed.", uri))
+			}
+			if !slices.Contains(t.AllowedRedirectURIHosts, u.Hostname()) {
+				return errors.WithStack(ErrInvalidRedirectURI.WithHintf("The host of redirect URI '%s' is not allowed by the initial access token.", uri))
+			}
+		}
+	}
+
+	return nil
+}
+
 func (v *Validator) ValidateSectorIdentifierURL(ctx context.Context, location string, redirectURIs []string) error {
 	l, err := url.Parse(location)
 	if err != nil {
@@ -5,14 +5,19 @@ package client_test
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3"
+	josejwt "github.com/go-jose/go-jose/v3/jwt"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +30,7 @@ import (
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/httpx"
+	"github.com/ory/x/sqlxx"
 )
 
 func TestValidate(t *testing.T) {
@@ -35,6 +41,14 @@ func TestValidate(t *testing.T) {
 	})))
 	v := NewValidator(reg)
 
+	// The OpenID Connect key set has keys for RS256, ES256 and EdDSA.
+	_, err := reg.OpenIDJWTSigner().GetSigningAlgorithms(ctx)
+	require.NoError(t, err)
+	for _, alg := range []string{"ES256", "EdDSA"} {
+		_, err := reg.KeyManager().GenerateAndPersistKeySet(ctx, x.OpenIDConnectKeyName, "", alg, "sig")
+		require.NoError(t, err)
+	}
+
 	dec := json.NewDecoder(strings.NewReader(validJWKS))
 	dec.DisallowUnknownFields()
 	var goodJWKS jose.JSONWebKeySet
@@ -69,6 +83,88 @@ func TestValidate(t *testing.T) {
 			in:        &Client{ID: "foo", UserinfoSignedResponseAlg: "foo"},
 			assertErr: assert.Error,
 		},
+		{
+			in: &Client{ID: "foo", UserinfoSignedResponseAlg: "ES256", IDTokenSignedResponseAlg: "EdDSA"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "ES256", c.UserinfoSignedResponseAlg)
+				assert.Equal(t, "EdDSA", c.IDTokenSignedResponseAlg)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", IDTokenSignedResponseAlg: "none"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", IDTokenSignedResponseAlg: "ES384"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", UserinfoSignedResponseAlg: "PS256"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", IntrospectionSignedResponseAlg: "HS256"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", IntrospectionEncryptedResponseEnc: "A128GCM"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", IntrospectionEncryptedResponseAlg: "RSA-OAEP"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", JSONWebKeys: &x.JoseJSONWebKeySet{JSONWebKeySet: new(jose.JSONWebKeySet)}, IntrospectionEncryptedResponseAlg: "RSA1_5"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", JSONWebKeys: &x.JoseJSONWebKeySet{JSONWebKeySet: new(jose.JSONWebKeySet)}, IntrospectionEncryptedResponseAlg: "RSA-OAEP", IntrospectionEncryptedResponseEnc: "A192GCM"},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", JSONWebKeys: &x.JoseJSONWebKeySet{JSONWebKeySet: new(jose.JSONWebKeySet)}, IntrospectionSignedResponseAlg: "PS256", IntrospectionEncryptedResponseAlg: "RSA-OAEP"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "PS256", c.IntrospectionSignedResponseAlg)
+				assert.Equal(t, "A128CBC-HS256", c.IntrospectionEncryptedResponseEnc)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", RefreshTokenReusePolicy: "foo"},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", RefreshTokenReusePolicy: RefreshTokenReusePolicyRevokeConsent},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, RefreshTokenReusePolicyRevokeConsent, c.RefreshTokenReusePolicy)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", Lifespans: Lifespans{RefreshTokenMaxLifespan: x.NullDuration{Duration: -time.Hour, Valid: true}}},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", Lifespans: Lifespans{RefreshTokenRotationDisabled: true, RefreshTokenIdleLifespan: x.NullDuration{Duration: time.Hour, Valid: true}}},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", Lifespans: Lifespans{RefreshTokenRotationGraceReuseCount: sqlxx.NullInt64{Int: math.MaxInt32 + 1, Valid: true}}},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", Lifespans: Lifespans{RefreshTokenRotationGracePeriod: x.NullDuration{Duration: time.Hour, Valid: true}}},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", Lifespans: Lifespans{
+				RefreshTokenRotationGracePeriod:     x.NullDuration{Duration: time.Hour, Valid: true},
+				RefreshTokenRotationGraceReuseCount: sqlxx.NullInt64{Int: 1, Valid: true},
+				RefreshTokenMaxLifespan:             x.NullDuration{Duration: 24 * time.Hour, Valid: true},
+			}},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, time.Hour, c.RefreshTokenRotationGracePeriod.Duration)
+			},
+		},
 		{
 			in:        &Client{ID: "foo", TokenEndpointAuthMethod: "private_key_jwt"},
 			assertErr: assert.Error,
@@ -81,6 +177,80 @@ func TestValidate(t *testing.T) {
 			in:        &Client{ID: "foo", JSONWebKeys: &x.JoseJSONWebKeySet{JSONWebKeySet: new(jose.JSONWebKeySet)}, TokenEndpointAuthMethod: "private_key_jwt", TokenEndpointAuthSigningAlgorithm: "HS256"},
 			assertErr: assert.Error,
 		},
+		{
+			in: &Client{ID: "foo", TokenEndpointAuthMethod: "client_secret_jwt"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "HS256", c.TokenEndpointAuthSigningAlgorithm)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", TokenEndpointAuthMethod: "client_secret_jwt", TokenEndpointAuthSigningAlgorithm: "RS256"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", TokenEndpointAuthMethod: "tls_client_auth"},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", TokenEndpointAuthMethod: "tls_client_auth", TLSClientAuthSubjectDN: "CN=foo"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "CN=foo", c.GetTLSClientAuthSubjectDN())
+			},
+		},
+		{
+			in:        &Client{ID: "foo", TokenEndpointAuthMethod: "self_signed_tls_client_auth"},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", JSONWebKeys: &x.JoseJSONWebKeySet{JSONWebKeySet: new(jose.JSONWebKeySet)}, TokenEndpointAuthMethod: "self_signed_tls_client_auth"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "self_signed_tls_client_auth", c.GetTokenEndpointAuthMethod())
+			},
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: "mobile"},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", ApplicationType: ApplicationTypeWeb, RedirectURIs: []string{"https://example.com/cb"}},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "client_secret_basic", c.TokenEndpointAuthMethod)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: ApplicationTypeWeb, RedirectURIs: []string{"http://example.com/cb"}},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: ApplicationTypeWeb, RedirectURIs: []string{"https://localhost/cb"}},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", ApplicationType: ApplicationTypeNative, RedirectURIs: []string{"com.example.app:/cb", "http://127.0.0.1/cb", "http://[::1]:8080/cb", "https://app.example.com/cb"}},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "none", c.TokenEndpointAuthMethod)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: ApplicationTypeNative, RedirectURIs: []string{"myapp:/cb"}},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: ApplicationTypeNative, RedirectURIs: []string{"http://example.com/cb"}},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: ApplicationTypeNative, RedirectURIs: []string{"http://localhost/cb"}},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: ApplicationTypeNative, TokenEndpointAuthMethod: "client_secret_basic"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", ApplicationType: ApplicationTypeNative, Secret: "secret"},
+			assertErr: assert.Error,
+		},
 		{
 			in:        &Client{ID: "foo", TermsOfServiceURI: "file://i-am-a-file"},
 			assertErr: assert.Error,
@@ -227,6 +397,80 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateSecurityProfile(t *testing.T) {
+	ctx := context.Background()
+	v := NewValidator(testhelpers.NewRegistryMemory(t))
+
+	var jwks jose.JSONWebKeySet
+	require.NoError(t, json.Unmarshal([]byte(validJWKS), &jwks))
+
+	valid := func() *Client {
+		return &Client{
+			ID:                                "foo",
+			SecurityProfile:                   SecurityProfileFAPI2Security,
+			TokenEndpointAuthMethod:           "private_key_jwt",
+			TokenEndpointAuthSigningAlgorithm: "PS256",
+			JSONWebKeys:                       &x.JoseJSONWebKeySet{JSONWebKeySet: &jwks},
+			RedirectURIs:                      []string{"https://client.example.org/callback"},
+			GrantTypes:                        []string{"authorization_code", "refresh_token"},
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(c *Client)
+		err    string
+	}{
+		{name: "valid", modify: func(c *Client) {}},
+		{name: "message signing", modify: func(c *Client) {
+			c.SecurityProfile = SecurityProfileFAPI2MessageSigning
+			c.RequestObjectSigningAlgorithm = "ES256"
+		}},
+		{name: "unknown profile", modify: func(c *Client) { c.SecurityProfile = "fapi1" }, err: "security_profile must be one of"},
+		{name: "client secret", modify: func(c *Client) {
+			c.TokenEndpointAuthMethod = "client_secret_basic"
+			c.JSONWebKeys = nil
+		}, err: "requires token_endpoint_auth_method to be one of 'private_key_jwt', 'tls_client_auth', 'self_signed_tls_client_auth'"},
+		{name: "tls client auth", modify: func(c *Client) {
+			c.TokenEndpointAuthMethod = "tls_client_auth"
+			c.TokenEndpointAuthSigningAlgorithm = ""
+			c.TLSClientAuthSubjectDN = "CN=client.example.org"
+		}},
+		{name: "self-signed tls client auth", modify: func(c *Client) {
+			c.TokenEndpointAuthMethod = "self_signed_tls_client_auth"
+			c.TokenEndpointAuthSigningAlgorithm = ""
+		}},
+		{name: "default auth signing alg", modify: func(c *Client) { c.TokenEndpointAuthSigningAlgorithm = "" }, err: "requires token_endpoint_auth_signing_alg to be one of 'PS256', 'ES256', 'EdDSA'"},
+		{name: "ES384 auth signing alg", modify: func(c *Client) { c.TokenEndpointAuthSigningAlgorithm = "ES384" }, err: "requires token_endpoint_auth_signing_alg"},
+		{name: "RS256 id token", modify: func(c *Client) { c.IDTokenSignedResponseAlg = "RS256" }, err: "id_token_signed_response_alg"},
+		{name: "unsigned userinfo", modify: func(c *Client) { c.UserinfoSignedResponseAlg = "none" }},
+		{name: "message signing without request object", modify: func(c *Client) {
+			c.SecurityProfile = SecurityProfileFAPI2MessageSigning
+		}, err: "requires field request_object_signing_alg"},
+		{name: "implicit", modify: func(c *Client) {
+			c.ResponseTypes = []string{"code", "id_token"}
+		}, err: "only allows response type 'code'"},
+		{name: "password grant", modify: func(c *Client) {
+			c.GrantTypes = append(c.GrantTypes, "password")
+		}, err: "does not allow grant type 'password'"},
+		{name: "http redirect", modify: func(c *Client) {
+			c.RedirectURIs = []string{"http://client.example.org/callback"}
+		}, err: "requires redirect URIs to use https://"},
+	} {
+		t.Run("case="+tc.name, func(t *testing.T) {
+			c := valid()
+			tc.modify(c)
+			err := v.Validate(ctx, c)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, fosite.ErrorToRFC6749Error(err).HintField, tc.err)
+		})
+	}
+}
+
 type fakeHTTP struct {
 	*driver.RegistrySQL
 	c *http.Client
@@ -404,3 +648,187 @@ func TestValidateDynamicRegistration(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRegistrationPolicy(t *testing.T) {
+	v := NewValidator(testhelpers.NewRegistryMemory(t))
+	policy := &InitialAccessToken{
+		AllowedGrantTypes:       []string{"authorization_code", "refresh_token"},
+		AllowedScope:            []string{"openid", "offline_access"},
+		AllowedRedirectURIHosts: []string{"partner.example.com"},
+	}
+
+	for k, tc := range []struct {
+		in        *Client
+		policy    *InitialAccessToken
+		expectErr string
+	}{
+		{
+			in: &Client{
+				GrantTypes:             []string{"authorization_code", "refresh_token"},
+				Scope:                  "openid offline_access",
+				RedirectURIs:           []string{"https://partner.example.com/callback"},
+				PostLogoutRedirectURIs: []string{"https://partner.example.com:8443/logout"},
+			},
+			policy: policy,
+		},
+		{
+			// The default grant type is authorization_code.
+			in:     &Client{Scope: "openid"},
+			policy: policy,
+		},
+		{
+			in:        &Client{GrantTypes: []string{"client_credentials"}, Scope: "openid"},
+			policy:    policy,
+			expectErr: "Grant type 'client_credentials' is not allowed",
+		},
+		{
+			in:        &Client{Scope: "openid admin"},
+			policy:    policy,
+			expectErr: "Scope 'admin' is not allowed",
+		},
+		{
+			in:        &Client{Scope: "openid", RedirectURIs: []string{"https://attacker.example.com/callback"}},
+			policy:    policy,
+			expectErr: "The host of redirect URI 'https://attacker.example.com/callback' is not allowed",
+		},
+		{
+			in:        &Client{Scope: "openid", PostLogoutRedirectURIs: []string{"https://partner.example.com.attacker.example.com/"}},
+			policy:    policy,
+			expectErr: "is not allowed",
+		},
+		{
+			in: &Client{
+				GrantTypes:   []string{"client_credentials"},
+				Scope:        "admin",
+				RedirectURIs: []string{"https://attacker.example.com/callback"},
+			},
+			policy: &InitialAccessToken{},
+		},
+	} {
+		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
+			err := v.ValidateRegistrationPolicy(tc.in, tc.policy)
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, fosite.ErrorToRFC6749Error(err).GetDescription(), tc.expectErr)
+		})
+	}
+}
+
+func TestApplySoftwareStatement(t *testing.T) {
+	newKey := func(t *testing.T, kid string) jose.JSONWebKey {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		return jose.JSONWebKey{Key: key, KeyID: kid, Algorithm: "RS256", Use: "sig"}
+	}
+	trusted, untrusted := newKey(t, "trusted"), newKey(t, "trusted")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{trusted.Public()}})
+	}))
+	t.Cleanup(server.Close)
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeySoftwareStatementTrustedIssuers: []map[string]any{{"issuer": "https://partners.example.com", "jwks_uri": server.URL}},
+	})))
+	v := NewValidator(reg)
+
+	sign := func(t *testing.T, key jose.JSONWebKey, alg jose.SignatureAlgorithm, claims map[string]any) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+		require.NoError(t, err)
+		token, err := josejwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		return token
+	}
+	claims := func(extra map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":           "https://partners.example.com",
+			"iat":           time.Now().Unix(),
+			"exp":           time.Now().Add(time.Hour).Unix(),
+			"software_id":   "partner-app",
+			"client_name":   "Partner App",
+			"redirect_uris": []string{"https://partner.example.com/callback"},
+		}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+
+	t.Run("case=statement claims override the client metadata", func(t *testing.T) {
+		statement := sign(t, trusted, jose.RS256, claims(nil))
+		c := &Client{
+			Name:              "Submitted Name",
+			RedirectURIs:      []string{"https://attacker.example.com/callback"},
+			Scope:             "openid",
+			SoftwareStatement: statement,
+		}
+		require.NoError(t, v.ApplySoftwareStatement(t.Context(), c))
+		assert.Equal(t, "Partner App", c.Name)
+		assert.EqualValues(t, []string{"https://partner.example.com/callback"}, c.RedirectURIs)
+		assert.Equal(t, "openid", c.Scope, "metadata not asserted by the statement is kept")
+		assert.Equal(t, statement, c.SoftwareStatement)
+	})
+
+	t.Run("case=no statement is accepted unless required", func(t *testing.T) {
+		require.NoError(t, v.ApplySoftwareStatement(t.Context(), &Client{}))
+
+		reg.Config().MustSet(t.Context(), config.KeyDynamicRegistrationSoftwareStatement, true)
+		t.Cleanup(func() {
+			reg.Config().MustSet(context.Background(), config.KeyDynamicRegistrationSoftwareStatement, false)
+		})
+		assert.ErrorIs(t, v.ApplySoftwareStatement(t.Context(), &Client{}), ErrInvalidSoftwareStatement)
+	})
+
+	for _, tc := range []struct {
+		name      string
+		statement func(t *testing.T) string
+		expectErr error
+	}{
+		{
+			name:      "malformed",
+			statement: func(t *testing.T) string { return "not-a-jwt" },
+			expectErr: ErrInvalidSoftwareStatement,
+		},
+		{
+			name: "symmetric algorithm",
+			statement: func(t *testing.T) string {
+				return sign(t, jose.JSONWebKey{Key: []byte("01234567890123456789012345678901")}, jose.HS256, claims(nil))
+			},
+			expectErr: ErrInvalidSoftwareStatement,
+		},
+		{
+			name: "untrusted issuer",
+			statement: func(t *testing.T) string {
+				return sign(t, trusted, jose.RS256, claims(map[string]any{"iss": "https://attacker.example.com"}))
+			},
+			expectErr: ErrUnapprovedSoftwareStatement,
+		},
+		{
+			name:      "invalid signature",
+			statement: func(t *testing.T) string { return sign(t, untrusted, jose.RS256, claims(nil)) },
+			expectErr: ErrUnapprovedSoftwareStatement,
+		},
+		{
+			name: "expired",
+			statement: func(t *testing.T) string {
+				return sign(t, trusted, jose.RS256, claims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))
+			},
+			expectErr: ErrInvalidSoftwareStatement,
+		},
+		{
+			name: "issued in the future",
+			statement: func(t *testing.T) string {
+				return sign(t, trusted, jose.RS256, claims(map[string]any{"iat": time.Now().Add(time.Hour).Unix()}))
+			},
+			expectErr: ErrInvalidSoftwareStatement,
+		},
+	} {
+		t.Run("case="+tc.name, func(t *testing.T) {
+			c := &Client{SoftwareStatement: tc.statement(t)}
+			assert.ErrorIs(t, v.ApplySoftwareStatement(t.Context(), c), tc.expectErr)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cli"
+	"github.com/ory/hydra/v2/driver"
+)
+
+func NewAuditVerifyCmd(dOpts []driver.OptionsModifier) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "verify [database_url]",
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Verify the hash chain of the audit log",
+		Example: `hydra audit verify --network acme [database_url]`,
+		Long: `Verify that the audit log of a network has not been tampered with.
+
+Every audit event contains the hash of the previous event. This command recomputes the hash of every event and
+fails if an event was changed, removed or inserted. The hashes are keyed with the system secret, so the secrets
+of the server, including rotated ones, must be configured. On success, it prints the hash of the last event, which can
+be stored outside of the database to detect if events are removed from the end of the audit log later.
+
+The database connection string is read from the first argument, the environment variable DSN, or the
+configuration file.`,
+		RunE: cli.NewHandler(dOpts).Audit.Verify,
+	}
+	cmd.Flags().String(cli.Network, "", "The name or ID of the network to verify. Defaults to the default network.")
+	return cmd
+}
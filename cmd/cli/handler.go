@@ -10,11 +10,15 @@ import (
 type Handler struct {
 	Migration *MigrateHandler
 	Janitor   *JanitorHandler
+	Network   *NetworkHandler
+	Audit     *AuditHandler
 }
 
 func NewHandler(dOpts []driver.OptionsModifier) *Handler {
 	return &Handler{
 		Migration: newMigrateHandler(dOpts),
 		Janitor:   newJanitorHandler(dOpts),
+		Network:   newNetworkHandler(dOpts),
+		Audit:     newAuditHandler(dOpts),
 	}
 }
@@ -0,0 +1,61 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+const (
+	Network = "network"
+)
+
+type AuditHandler struct {
+	dOpts []driver.OptionsModifier
+}
+
+func newAuditHandler(dOpts []driver.OptionsModifier) *AuditHandler {
+	return &AuditHandler{
+		dOpts: dOpts,
+	}
+}
+
+func (h *AuditHandler) Verify(cmd *cobra.Command, args []string) error {
+	d, err := makeDriver(cmd, args, h.dOpts)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if name := flagx.MustGetString(cmd, Network); name != "" {
+		ctx, err = d.NetworkResolver().Resolve(ctx, name)
+		if err != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Unable to find network %s: %s\n", name, err)
+			return cmdx.FailSilently(cmd)
+		}
+	}
+	nid := d.Persister().NetworkID(ctx)
+
+	res, err := audit.Verify(ctx, d)
+	if verr := new(audit.VerificationError); errors.As(err, &verr) {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "The audit log of network %s has been tampered with: %s\n", nid, verr)
+		return cmdx.FailSilently(cmd)
+	} else if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Verified %d audit events of network %s.\n", res.Events, nid)
+	if res.Head != "" {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "The hash of the last event is %s.\n", res.Head)
+	}
+	return nil
+}
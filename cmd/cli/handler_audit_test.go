@@ -0,0 +1,60 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/contextx"
+)
+
+func TestAuditHandler(t *testing.T) {
+	reg := newMigratedRegistry(t)
+	ctx := contextx.WithConfigValue(t.Context(), config.KeyAuditEnabled, true)
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/clients", nil)
+	for _, id := range []string{"client-1", "client-2"} {
+		require.NoError(t, reg.AuditRecorder().Record(r.Context(), r, audit.ActionClientCreated, id, nil, &client.Client{ID: id}))
+	}
+	events, _, err := reg.AuditManager().GetAuditEvents(ctx, audit.Filter{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	// The system secret is required to compute the hashes of the events.
+	conf := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(conf, []byte("secrets:\n  system: [\"000000000000000000000000000000000000000000000000\"]\n"), 0600))
+
+	t.Run("case=verifies the audit log", func(t *testing.T) {
+		stdout, stderr, err := cmdx.Exec(t, cmd.NewRootCmd(), nil, "audit", "verify", "--config", conf, reg.Config().DSN())
+		require.NoError(t, err, stderr)
+		assert.Contains(t, stdout, "Verified 2 audit events")
+		assert.Contains(t, stdout, events[1].Hash)
+	})
+
+	t.Run("case=rejects unknown networks", func(t *testing.T) {
+		_, stderr, err := cmdx.Exec(t, cmd.NewRootCmd(), nil, "audit", "verify", "--network", "unknown", "--config", conf, reg.Config().DSN())
+		require.Error(t, err)
+		assert.Contains(t, stderr, "Unable to find network unknown")
+	})
+
+	t.Run("case=detects tampering", func(t *testing.T) {
+		require.NoError(t, reg.Persister().Connection(ctx).RawQuery("UPDATE hydra_audit_event SET resource_id = ? WHERE seq = ?", "client-3", 1).Exec())
+
+		_, stderr, err := cmdx.Exec(t, cmd.NewRootCmd(), nil, "audit", "verify", "--config", conf, reg.Config().DSN())
+		require.Error(t, err)
+		assert.Contains(t, stderr, "has been tampered with: audit event 1")
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ory/hydra/v2/driver"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/persistence"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/flagx"
@@ -159,8 +160,24 @@ func addRoutine(out io.Writer, p persistence.Persister, names ...string) []clean
 		case OnlyTokens:
 			routines = append(routines, cleanup(out, p.FlushInactiveAccessTokens, "access tokens"))
 			routines = append(routines, cleanup(out, p.FlushInactiveRefreshTokens, "refresh tokens"))
+			routines = append(routines, cleanup(out, func(ctx context.Context, _ time.Time, _, _ int) error {
+				_, err := p.FlushExpiredPartitions(ctx)
+				return err
+			}, "token partitions"))
+			routines = append(routines, cleanup(out, func(ctx context.Context, notAfter time.Time, limit, batchSize int) error {
+				_, err := p.FlushExpired(ctx, janitor.TableRefreshTokenFamilies, notAfter, limit, batchSize)
+				return err
+			}, "refresh token families"))
+			routines = append(routines, cleanup(out, func(ctx context.Context, notAfter time.Time, limit, batchSize int) error {
+				_, err := p.FlushExpired(ctx, janitor.TableSecurityEvents, notAfter, limit, batchSize)
+				return err
+			}, "security events"))
 		case OnlyRequests:
 			routines = append(routines, cleanup(out, p.FlushInactiveLoginConsentRequests, "login-consent requests"))
+			routines = append(routines, cleanup(out, func(ctx context.Context, notAfter time.Time, limit, batchSize int) error {
+				_, err := p.FlushExpired(ctx, janitor.TablePushedAuthRequests, notAfter, limit, batchSize)
+				return err
+			}, "pushed authorization requests"))
 		case OnlyGrants:
 			routines = append(routines, cleanup(out, p.FlushInactiveGrants, "grants"))
 		}
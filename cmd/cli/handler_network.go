@@ -0,0 +1,204 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/persistence"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/flagx"
+	"github.com/ory/x/popx"
+)
+
+const (
+	TransportKey         = "transport-key"
+	IncludeRefreshTokens = "include-refresh-tokens"
+	Output               = "output"
+	Input                = "input"
+)
+
+type NetworkHandler struct {
+	dOpts []driver.OptionsModifier
+}
+
+func newNetworkHandler(dOpts []driver.OptionsModifier) *NetworkHandler {
+	return &NetworkHandler{
+		dOpts: dOpts,
+	}
+}
+
+// makeDriver creates a driver for commands which work on the database directly. The DSN is read from the first
+// argument, the environment, or the configuration file.
+func makeDriver(cmd *cobra.Command, args []string, dOpts []driver.OptionsModifier) (*driver.RegistrySQL, error) {
+	co := []configx.OptionModifier{
+		configx.WithFlags(cmd.Flags()),
+		configx.SkipValidation(),
+	}
+	if len(args) > 0 {
+		co = append(co, configx.WithValue(config.KeyDSN, args[0]))
+	}
+
+	d, err := driver.New(cmd.Context(), append([]driver.OptionsModifier{
+		driver.DisableValidation(),
+		driver.DisablePreloading(),
+		driver.WithConfigOptions(co...),
+	}, dOpts...)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not create driver")
+	}
+	if len(d.Config().DSN()) == 0 {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "No DSN provided. Please provide a DSN as the first argument or set the DSN environment variable.")
+		return nil, cmdx.FailSilently(cmd)
+	}
+
+	return d, nil
+}
+
+func (h *NetworkHandler) Export(cmd *cobra.Command, args []string) error {
+	d, err := makeDriver(cmd, args, h.dOpts)
+	if err != nil {
+		return err
+	}
+
+	transport, err := persistence.NewTransportCipher(flagx.MustGetString(cmd, TransportKey))
+	if err != nil {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), err)
+		return cmdx.FailSilently(cmd)
+	}
+
+	status, err := d.Migrator().MigrationStatus(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if status.HasPending() {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "The database has pending migrations. Please apply them using `hydra migrate sql up` before exporting.")
+		return cmdx.FailSilently(cmd)
+	}
+
+	archive, err := d.Persister().ExportNetwork(cmd.Context(), transport, persistence.NetworkExportOptions{
+		IncludeRefreshTokens: flagx.MustGetBool(cmd, IncludeRefreshTokens),
+	})
+	if err != nil {
+		return err
+	}
+	archive.Migration = latestAppliedMigration(status)
+
+	out := cmd.OutOrStdout()
+	if name := flagx.MustGetString(cmd, Output); name != "" {
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer f.Close() //nolint:errcheck
+		out = f
+	}
+
+	gz := gzip.NewWriter(out)
+	if err := json.NewEncoder(gz).Encode(archive); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := gz.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	rows := 0
+	for _, t := range archive.Tables {
+		rows += len(t.Rows)
+	}
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d rows of network %s.\n", rows, archive.NID)
+	return nil
+}
+
+func (h *NetworkHandler) Import(cmd *cobra.Command, args []string) error {
+	d, err := makeDriver(cmd, args, h.dOpts)
+	if err != nil {
+		return err
+	}
+
+	transport, err := persistence.NewTransportCipher(flagx.MustGetString(cmd, TransportKey))
+	if err != nil {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), err)
+		return cmdx.FailSilently(cmd)
+	}
+
+	in := cmd.InOrStdin()
+	if name := flagx.MustGetString(cmd, Input); name != "" {
+		f, err := os.Open(name) // #nosec G304 -- the file is explicitly chosen by the operator
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer f.Close() //nolint:errcheck
+		in = f
+	}
+
+	archive, err := readNetworkArchive(in)
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Unable to read the network archive: %s\n", err)
+		return cmdx.FailSilently(cmd)
+	}
+
+	status, err := d.Migrator().MigrationStatus(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if status.HasPending() {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "The database has pending migrations. Please apply them using `hydra migrate sql up` before importing.")
+		return cmdx.FailSilently(cmd)
+	}
+	if !isMigrationApplied(status, archive.Migration) {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "The archive was exported from a database with migration %s which is not known to this version. Please upgrade before importing.\n", archive.Migration)
+		return cmdx.FailSilently(cmd)
+	}
+
+	if err := d.Persister().ImportNetwork(cmd.Context(), archive, transport); err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Unable to import network %s: %s\n", archive.NID, err)
+		return cmdx.FailSilently(cmd)
+	}
+
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Imported network %s into network %s.\n", archive.NID, d.Persister().NetworkID(cmd.Context()))
+	return nil
+}
+
+func readNetworkArchive(in io.Reader) (*persistence.NetworkArchive, error) {
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gz.Close() //nolint:errcheck
+
+	var archive persistence.NetworkArchive
+	if err := json.NewDecoder(gz).Decode(&archive); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &archive, nil
+}
+
+func latestAppliedMigration(status popx.MigrationStatuses) (latest string) {
+	for _, s := range status {
+		if s.State == popx.Applied && s.Version > latest {
+			latest = s.Version
+		}
+	}
+	return latest
+}
+
+func isMigrationApplied(status popx.MigrationStatuses, version string) bool {
+	for _, s := range status {
+		if s.State == popx.Applied && s.Version == version {
+			return true
+		}
+	}
+	return false
+}
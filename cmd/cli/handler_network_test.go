@@ -0,0 +1,68 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/dbal"
+)
+
+// newMigratedRegistry returns a registry whose database was migrated instead of restored from the schema dump,
+// because network archives are checked against the applied migrations.
+func newMigratedRegistry(t *testing.T) *driver.RegistrySQL {
+	reg := testhelpers.NewRegistrySQLFromURL(t, dbal.NewSQLiteTestDatabase(t), false, false)
+	require.NoError(t, reg.Migrator().MigrateUp(t.Context()))
+	require.NoError(t, reg.InitNetwork(t.Context()))
+	return reg
+}
+
+func TestNetworkHandler(t *testing.T) {
+	source, target := newMigratedRegistry(t), newMigratedRegistry(t)
+	require.NoError(t, source.ClientManager().CreateClient(t.Context(), &client.Client{ID: "exported-client"}))
+	_, err := source.KeyManager().GenerateAndPersistKeySet(t.Context(), "exported-set", "exported-key", "ES256", "sig")
+	require.NoError(t, err)
+
+	archive := filepath.Join(t.TempDir(), "network.json.gz")
+
+	// The system secret is required to decrypt and encrypt the keys in the databases.
+	conf := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(conf, []byte("secrets:\n  system: [\"000000000000000000000000000000000000000000000000\"]\n"), 0600))
+
+	t.Run("case=requires a transport key", func(t *testing.T) {
+		_, _, err := cmdx.Exec(t, cmd.NewRootCmd(), nil, "export", "--output", archive, source.Config().DSN())
+		require.Error(t, err)
+	})
+
+	t.Run("case=exports and imports a network", func(t *testing.T) {
+		_, stderr, err := cmdx.Exec(t, cmd.NewRootCmd(), nil, "export", "--transport-key", "some-transport-key", "--output", archive, "--config", conf, source.Config().DSN())
+		require.NoError(t, err, stderr)
+		assert.Contains(t, stderr, "Exported 2 rows")
+
+		_, stderr, err = cmdx.Exec(t, cmd.NewRootCmd(), nil, "import", "network", "--transport-key", "some-transport-key", "--input", archive, "--config", conf, target.Config().DSN())
+		require.NoError(t, err, stderr)
+		assert.Contains(t, stderr, "Imported network "+source.Persister().NetworkID(t.Context()).String())
+
+		_, err = target.ClientManager().GetConcreteClient(t.Context(), "exported-client")
+		require.NoError(t, err)
+		_, err = target.KeyManager().GetKey(t.Context(), "exported-set", "exported-key")
+		require.NoError(t, err)
+	})
+
+	t.Run("case=rejects the wrong transport key", func(t *testing.T) {
+		_, stderr, err := cmdx.Exec(t, cmd.NewRootCmd(), nil, "import", "network", "--transport-key", "another-transport-key", "--input", archive, "--config", conf, newMigratedRegistry(t).Config().DSN())
+		require.Error(t, err)
+		assert.Contains(t, stderr, "Unable to import network")
+	})
+}
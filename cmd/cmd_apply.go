@@ -0,0 +1,593 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+const (
+	flagApplyFile   = "file"
+	flagApplyPrune  = "prune"
+	flagApplyDryRun = "dry-run"
+
+	manifestKindOAuth2Client          = "OAuth2Client"
+	manifestKindTrustedJwtGrantIssuer = "TrustedOAuth2JwtGrantIssuer"
+	manifestKindJSONWebKeySet         = "JsonWebKeySet"
+
+	applyActionCreate  = "create"
+	applyActionUpdate  = "update"
+	applyActionReplace = "replace"
+	applyActionDelete  = "delete"
+)
+
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+type (
+	// applyManifest is a single resource declaration read from a manifest file.
+	applyManifest struct {
+		Kind string          `json:"kind"`
+		Spec json.RawMessage `json:"spec"`
+	}
+
+	// applyJSONWebKeySet is the spec of a JsonWebKeySet manifest.
+	applyJSONWebKeySet struct {
+		Set  string             `json:"set"`
+		Keys []hydra.JsonWebKey `json:"keys"`
+	}
+
+	// applyState is the desired state declared by all manifests.
+	applyState struct {
+		clients map[string]hydra.OAuth2Client
+		grants  map[string]hydra.TrustOAuth2JwtGrantIssuer
+		keys    map[string]map[string]hydra.JsonWebKey
+	}
+
+	applyStep struct {
+		Action string `json:"action"`
+		Kind   string `json:"kind"`
+		ID     string `json:"id"`
+
+		run func(ctx context.Context) error
+	}
+)
+
+func NewApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f <file-or-directory> [-f <file-or-directory> ...]",
+		Args:  cobra.NoArgs,
+		Short: "Converge OAuth 2.0 Clients, trust relationships and JSON Web Key Sets to the state declared in manifests",
+		Example: `Declare an OAuth 2.0 Client and a JSON Web Key Set:
+
+	cat > ./manifests/clients.yaml <<EOF
+	kind: OAuth2Client
+	spec:
+	  client_id: my-client
+	  grant_types: [client_credentials]
+	  scope: offline
+	---
+	kind: JsonWebKeySet
+	spec:
+	  set: my-set
+	  keys:
+	    - kid: my-key
+	      kty: oct
+	      alg: HS256
+	      use: sig
+	      k: c2VjcmV0
+	EOF
+
+Show what would change without changing anything:
+
+	{{ .CommandPath }} -f ./manifests --dry-run
+
+Converge and delete all OAuth 2.0 Clients, trust relationships and keys in declared sets that are not declared:
+
+	{{ .CommandPath }} -f ./manifests --prune`,
+		Long: `This command reads YAML or JSON manifests from files and directories and converges the server to the declared state using the admin API.
+
+Each manifest has a "kind" and a "spec". Supported kinds are:
+
+- ` + manifestKindOAuth2Client + `: the spec is an OAuth 2.0 Client. The "client_id" field is required.
+- ` + manifestKindTrustedJwtGrantIssuer + `: the spec is a trusted JWT Bearer Grant Type Issuer request body.
+- ` + manifestKindJSONWebKeySet + `: the spec contains the set name in "set" and the keys in "keys".
+
+A file may contain a single manifest, a list of manifests, or several YAML documents separated by "---".
+
+Resources which are not declared are left untouched unless --prune is set. With --prune, OAuth 2.0 Clients and
+trust relationships which are not declared are deleted, as well as keys which are not declared in a declared JSON Web Key Set.
+JSON Web Key Sets which are not declared are never deleted.
+
+Client secrets are never returned by the server and are therefore not compared. If a manifest declares a client secret,
+it is set whenever the client is created or updated.
+
+Trust relationships can not be updated. If a declared trust relationship differs from the existing one, it is replaced.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			paths := flagx.MustGetStringSlice(cmd, flagApplyFile)
+			if len(paths) == 0 {
+				_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "At least one file or directory must be given using --file.")
+				return cmdx.FailSilently(cmd)
+			}
+
+			manifests, err := readApplyManifests(paths)
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read manifests: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			desired, err := newApplyState(manifests)
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Invalid manifests: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			plan, err := planApply(cmd, m, desired, flagx.MustGetBool(cmd, flagApplyPrune))
+			if err != nil {
+				return err
+			}
+
+			if flagx.MustGetBool(cmd, flagApplyDryRun) {
+				cmdx.PrintTable(cmd, &outputApplyStepCollection{steps: plan})
+				return nil
+			}
+
+			applied := make([]applyStep, 0, len(plan))
+			failed := make(map[string]error)
+			for _, step := range plan {
+				if err := step.run(cmd.Context()); err != nil {
+					failed[step.Kind+" "+step.ID] = cmdx.PrintOpenAPIError(cmd, err)
+					continue
+				}
+				applied = append(applied, step)
+			}
+
+			cmdx.PrintTable(cmd, &outputApplyStepCollection{steps: applied})
+			if len(failed) != 0 {
+				cmdx.PrintErrors(cmd, failed)
+				return cmdx.FailSilently(cmd)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceP(flagApplyFile, "f", nil, "Manifest file or directory containing manifest files. Directories are read recursively.")
+	cmd.Flags().Bool(flagApplyPrune, false, "Delete OAuth 2.0 Clients, trust relationships and keys of declared JSON Web Key Sets which are not declared in any manifest.")
+	cmd.Flags().Bool(flagApplyDryRun, false, "Only print the planned changes without applying them.")
+	cmdx.RegisterHTTPClientFlags(cmd.PersistentFlags())
+	cmdx.RegisterFormatFlags(cmd.PersistentFlags())
+	return cmd
+}
+
+func readApplyManifests(paths []string) ([]applyManifest, error) {
+	var manifests []applyManifest
+	for _, root := range paths {
+		if err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// Files given explicitly are always read, files found in directories only if they look like manifests.
+			if path != root {
+				switch strings.ToLower(filepath.Ext(path)) {
+				case ".json", ".yaml", ".yml":
+				default:
+					return nil
+				}
+			}
+
+			contents, err := os.ReadFile(path) // #nosec G304
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			current, err := decodeApplyManifests(contents)
+			if err != nil {
+				return errors.Wrapf(err, "unable to decode %s", path)
+			}
+			manifests = append(manifests, current...)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return manifests, nil
+}
+
+func decodeApplyManifests(contents []byte) ([]applyManifest, error) {
+	var manifests []applyManifest
+	for _, doc := range yamlDocumentSeparator.Split(string(contents), -1) {
+		if len(strings.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		// JSON is valid YAML, so every document is converted to JSON first.
+		raw, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		raw = bytes.TrimSpace(raw)
+		if bytes.Equal(raw, []byte("null")) {
+			continue
+		}
+
+		if bytes.HasPrefix(raw, []byte("[")) {
+			var current []applyManifest
+			if err := json.Unmarshal(raw, &current); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			manifests = append(manifests, current...)
+			continue
+		}
+
+		var current applyManifest
+		if err := json.Unmarshal(raw, &current); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		manifests = append(manifests, current)
+	}
+	return manifests, nil
+}
+
+func newApplyState(manifests []applyManifest) (*applyState, error) {
+	s := &applyState{
+		clients: map[string]hydra.OAuth2Client{},
+		grants:  map[string]hydra.TrustOAuth2JwtGrantIssuer{},
+		keys:    map[string]map[string]hydra.JsonWebKey{},
+	}
+
+	for _, m := range manifests {
+		switch m.Kind {
+		case manifestKindOAuth2Client:
+			var c hydra.OAuth2Client
+			if err := json.Unmarshal(m.Spec, &c); err != nil {
+				return nil, errors.Wrapf(err, "unable to decode %s", m.Kind)
+			}
+			id := c.GetClientId()
+			if id == "" {
+				return nil, errors.Errorf("%s manifests must declare a client_id", m.Kind)
+			}
+			if _, ok := s.clients[id]; ok {
+				return nil, errors.Errorf("%s %q is declared more than once", m.Kind, id)
+			}
+			s.clients[id] = c
+		case manifestKindTrustedJwtGrantIssuer:
+			var g hydra.TrustOAuth2JwtGrantIssuer
+			if err := json.Unmarshal(m.Spec, &g); err != nil {
+				return nil, errors.Wrapf(err, "unable to decode %s", m.Kind)
+			}
+			id := applyGrantID(g.Issuer, g.GetSubject(), g.GetAllowAnySubject(), g.GetJwk().Kid)
+			if _, ok := s.grants[id]; ok {
+				return nil, errors.Errorf("%s %q is declared more than once", m.Kind, id)
+			}
+			s.grants[id] = g
+		case manifestKindJSONWebKeySet:
+			var set applyJSONWebKeySet
+			if err := json.Unmarshal(m.Spec, &set); err != nil {
+				return nil, errors.Wrapf(err, "unable to decode %s", m.Kind)
+			}
+			if set.Set == "" {
+				return nil, errors.Errorf("%s manifests must declare a set", m.Kind)
+			}
+			if _, ok := s.keys[set.Set]; !ok {
+				s.keys[set.Set] = map[string]hydra.JsonWebKey{}
+			}
+			for _, k := range set.Keys {
+				if _, ok := s.keys[set.Set][k.Kid]; ok {
+					return nil, errors.Errorf("key %q of %s %q is declared more than once", k.Kid, m.Kind, set.Set)
+				}
+				s.keys[set.Set][k.Kid] = k
+			}
+		default:
+			return nil, errors.Errorf("unknown manifest kind %q, expected one of %s, %s, %s", m.Kind,
+				manifestKindOAuth2Client, manifestKindTrustedJwtGrantIssuer, manifestKindJSONWebKeySet)
+		}
+	}
+
+	return s, nil
+}
+
+// planApply compares the desired state with the server state and returns the steps required to converge.
+func planApply(cmd *cobra.Command, m *hydra.APIClient, desired *applyState, prune bool) ([]applyStep, error) {
+	var plan []applyStep
+	for _, f := range []func(*cobra.Command, *hydra.APIClient, *applyState, bool) ([]applyStep, error){
+		planApplyClients,
+		planApplyGrants,
+		planApplyKeys,
+	} {
+		steps, err := f(cmd, m, desired, prune)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, steps...)
+	}
+	return plan, nil
+}
+
+func planApplyClients(cmd *cobra.Command, m *hydra.APIClient, desired *applyState, prune bool) ([]applyStep, error) {
+	var plan []applyStep
+	for _, id := range slices.Sorted(maps.Keys(desired.clients)) {
+		c := desired.clients[id]
+		actual, resp, err := m.OAuth2API.GetOAuth2Client(cmd.Context(), id).Execute() //nolint:bodyclose
+		switch {
+		case resp != nil && resp.StatusCode == http.StatusNotFound:
+			plan = append(plan, applyStep{Action: applyActionCreate, Kind: manifestKindOAuth2Client, ID: id, run: func(ctx context.Context) error {
+				_, _, err := m.OAuth2API.CreateOAuth2Client(ctx).OAuth2Client(c).Execute() //nolint:bodyclose
+				return err
+			}})
+			continue
+		case err != nil:
+			return nil, cmdx.PrintOpenAPIError(cmd, err)
+		}
+
+		equal, err := isDeclaredSubset(c, actual, "client_secret", "created_at", "updated_at", "registration_access_token", "registration_client_uri")
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			plan = append(plan, applyStep{Action: applyActionUpdate, Kind: manifestKindOAuth2Client, ID: id, run: func(ctx context.Context) error {
+				_, _, err := m.OAuth2API.SetOAuth2Client(ctx, id).OAuth2Client(c).Execute() //nolint:bodyclose
+				return err
+			}})
+		}
+	}
+
+	if !prune {
+		return plan, nil
+	}
+
+	var pageToken string
+	for {
+		req := m.OAuth2API.ListOAuth2Clients(cmd.Context()).PageSize(500)
+		if pageToken != "" {
+			req = req.PageToken(pageToken)
+		}
+		list, resp, err := req.Execute()
+		if err != nil {
+			return nil, cmdx.PrintOpenAPIError(cmd, err)
+		}
+		_ = resp.Body.Close()
+
+		for _, c := range list {
+			id := c.GetClientId()
+			if _, ok := desired.clients[id]; ok {
+				continue
+			}
+			plan = append(plan, applyStep{Action: applyActionDelete, Kind: manifestKindOAuth2Client, ID: id, run: func(ctx context.Context) error {
+				_, err := m.OAuth2API.DeleteOAuth2Client(ctx, id).Execute() //nolint:bodyclose
+				return err
+			}})
+		}
+
+		if pageToken = getPageToken(resp); pageToken == "" {
+			break
+		}
+	}
+
+	return plan, nil
+}
+
+func planApplyGrants(cmd *cobra.Command, m *hydra.APIClient, desired *applyState, prune bool) ([]applyStep, error) {
+	var existing []hydra.TrustedOAuth2JwtGrantIssuer
+	var pageToken string
+	for {
+		req := m.OAuth2API.ListTrustedOAuth2JwtGrantIssuers(cmd.Context()).PageSize(500)
+		if pageToken != "" {
+			req = req.PageToken(pageToken)
+		}
+		list, resp, err := req.Execute()
+		if err != nil {
+			return nil, cmdx.PrintOpenAPIError(cmd, err)
+		}
+		_ = resp.Body.Close()
+
+		existing = append(existing, list...)
+		if pageToken = getPageToken(resp); pageToken == "" {
+			break
+		}
+	}
+
+	var plan []applyStep
+	found := map[string]bool{}
+	for _, actual := range existing {
+		key := actual.GetPublicKey()
+		id := applyGrantID(actual.GetIssuer(), actual.GetSubject(), actual.GetAllowAnySubject(), key.GetKid())
+		grantID := actual.GetId()
+
+		g, ok := desired.grants[id]
+		if !ok {
+			if prune {
+				plan = append(plan, applyStep{Action: applyActionDelete, Kind: manifestKindTrustedJwtGrantIssuer, ID: id, run: func(ctx context.Context) error {
+					_, err := m.OAuth2API.DeleteTrustedOAuth2JwtGrantIssuer(ctx, grantID).Execute() //nolint:bodyclose
+					return err
+				}})
+			}
+			continue
+		}
+		found[id] = true
+
+		equal := actual.GetExpiresAt().Equal(g.ExpiresAt) &&
+			slices.Equal(slices.Sorted(slices.Values(actual.Scope)), slices.Sorted(slices.Values(g.Scope)))
+		if equal && !g.HasJwk() {
+			// Grants without a key trust the JSON Web Key Set published by the issuer.
+			var err error
+			if equal, err = isDeclaredSubset(applyFederatedGrant(g), applyFederatedGrant(hydra.TrustOAuth2JwtGrantIssuer{
+				JwksUri:         actual.JwksUri,
+				OidcDiscovery:   actual.OidcDiscovery,
+				ClaimConditions: actual.ClaimConditions,
+				ClaimMappings:   actual.ClaimMappings,
+			})); err != nil {
+				return nil, err
+			}
+		} else if equal {
+			actualKey, _, err := m.JwkAPI.GetJsonWebKey(cmd.Context(), key.GetSet(), key.GetKid()).Execute() //nolint:bodyclose
+			if err != nil {
+				return nil, cmdx.PrintOpenAPIError(cmd, err)
+			}
+			if len(actualKey.Keys) != 1 {
+				equal = false
+			} else if equal, err = isDeclaredSubset(g.Jwk, actualKey.Keys[0]); err != nil {
+				return nil, err
+			}
+		}
+
+		if !equal {
+			plan = append(plan, applyStep{Action: applyActionReplace, Kind: manifestKindTrustedJwtGrantIssuer, ID: id, run: func(ctx context.Context) error {
+				//nolint:bodyclose
+				if _, err := m.OAuth2API.DeleteTrustedOAuth2JwtGrantIssuer(ctx, grantID).Execute(); err != nil {
+					return err
+				}
+				_, _, err := m.OAuth2API.TrustOAuth2JwtGrantIssuer(ctx).TrustOAuth2JwtGrantIssuer(g).Execute() //nolint:bodyclose
+				return err
+			}})
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(desired.grants)) {
+		if found[id] {
+			continue
+		}
+		g := desired.grants[id]
+		plan = append(plan, applyStep{Action: applyActionCreate, Kind: manifestKindTrustedJwtGrantIssuer, ID: id, run: func(ctx context.Context) error {
+			_, _, err := m.OAuth2API.TrustOAuth2JwtGrantIssuer(ctx).TrustOAuth2JwtGrantIssuer(g).Execute() //nolint:bodyclose
+			return err
+		}})
+	}
+
+	return plan, nil
+}
+
+func planApplyKeys(cmd *cobra.Command, m *hydra.APIClient, desired *applyState, prune bool) ([]applyStep, error) {
+	var plan []applyStep
+	for _, set := range slices.Sorted(maps.Keys(desired.keys)) {
+		actual := map[string]hydra.JsonWebKey{}
+		keys, resp, err := m.JwkAPI.GetJsonWebKeySet(cmd.Context(), set).Execute() //nolint:bodyclose
+		switch {
+		case resp != nil && resp.StatusCode == http.StatusNotFound:
+		case err != nil:
+			return nil, cmdx.PrintOpenAPIError(cmd, err)
+		default:
+			for _, k := range keys.Keys {
+				actual[k.Kid] = k
+			}
+		}
+
+		for _, kid := range slices.Sorted(maps.Keys(desired.keys[set])) {
+			k := desired.keys[set][kid]
+			action := applyActionCreate
+			if current, ok := actual[kid]; ok {
+				equal, err := isDeclaredSubset(k, current)
+				if err != nil {
+					return nil, err
+				}
+				if equal {
+					continue
+				}
+				action = applyActionUpdate
+			}
+
+			plan = append(plan, applyStep{Action: action, Kind: manifestKindJSONWebKeySet, ID: set + "/" + kid, run: func(ctx context.Context) error {
+				_, _, err := m.JwkAPI.SetJsonWebKey(ctx, set, kid).JsonWebKey(k).Execute() //nolint:bodyclose
+				return err
+			}})
+		}
+
+		if !prune {
+			continue
+		}
+
+		for _, kid := range slices.Sorted(maps.Keys(actual)) {
+			if _, ok := desired.keys[set][kid]; ok {
+				continue
+			}
+			plan = append(plan, applyStep{Action: applyActionDelete, Kind: manifestKindJSONWebKeySet, ID: set + "/" + kid, run: func(ctx context.Context) error {
+				_, err := m.JwkAPI.DeleteJsonWebKey(ctx, set, kid).Execute() //nolint:bodyclose
+				return err
+			}})
+		}
+	}
+	return plan, nil
+}
+
+// applyGrantID identifies a trust relationship. The server assigns random IDs, so trust relationships are matched
+// by what makes them unique instead.
+// applyFederatedGrant returns the fields of a grant which trusts the JSON Web Key Set published by the issuer.
+func applyFederatedGrant(g hydra.TrustOAuth2JwtGrantIssuer) map[string]any {
+	return map[string]any{
+		"jwks_uri":         g.GetJwksUri(),
+		"oidc_discovery":   g.GetOidcDiscovery(),
+		"claim_conditions": g.GetClaimConditions(),
+		"claim_mappings":   g.GetClaimMappings(),
+	}
+}
+
+func applyGrantID(issuer, subject string, allowAnySubject bool, kid string) string {
+	if allowAnySubject {
+		subject = "*"
+	}
+	return issuer + "|" + subject + "|" + kid
+}
+
+// isDeclaredSubset returns true if every field set in desired has the same value in actual. Fields not set in desired,
+// and the ignored fields, are not compared because the server fills in defaults and generated values for them.
+func isDeclaredSubset(desired, actual any, ignore ...string) (bool, error) {
+	toMap := func(v any) (map[string]any, error) {
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(v); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		var m map[string]any
+		if err := json.NewDecoder(&buf).Decode(&m); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return m, nil
+	}
+
+	d, err := toMap(desired)
+	if err != nil {
+		return false, err
+	}
+	a, err := toMap(actual)
+	if err != nil {
+		return false, err
+	}
+
+	for k, v := range d {
+		if slices.Contains(ignore, k) {
+			continue
+		}
+		if !reflect.DeepEqual(v, a[k]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,192 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/x/cmdx"
+)
+
+func TestApply(t *testing.T) {
+	reg := testhelpers.NewRegistryMemory(t)
+	_, admin := testhelpers.NewOAuth2Server(t.Context(), t, reg)
+
+	// A new command is used for every run because flag values would otherwise carry over.
+	exec := func(t *testing.T, args ...string) (string, string, error) {
+		return cmdx.Exec(t, cmd.NewApplyCmd(), nil, append(args, "--"+cmdx.FlagEndpoint, admin.URL, "--"+cmdx.FlagFormat, string(cmdx.FormatJSON))...)
+	}
+	apply := func(t *testing.T, args ...string) map[string]string {
+		stdout, stderr, err := exec(t, args...)
+		require.NoError(t, err, "std_out: %s\nstd_err: %s", stdout, stderr)
+
+		actions := map[string]string{}
+		for _, step := range gjson.Parse(stdout).Array() {
+			actions[step.Get("kind").String()+" "+step.Get("id").String()] = step.Get("action").String()
+		}
+		return actions
+	}
+
+	keys, err := jwk.GenerateJWK(jose.ES256, "grant-key", "sig")
+	require.NoError(t, err)
+	grantKey, err := json.Marshal(keys.Keys[0].Public())
+	require.NoError(t, err)
+	expiresAt := time.Now().Add(time.Hour).UTC().Round(time.Second).Format(time.RFC3339)
+
+	dir := t.TempDir()
+	writeManifest := func(t *testing.T, name, contents string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0600))
+	}
+
+	writeManifest(t, "clients.yaml", `
+kind: OAuth2Client
+spec:
+  client_id: apply-client-1
+  client_secret: some-secret
+  grant_types: [client_credentials]
+  scope: foo
+---
+kind: OAuth2Client
+spec:
+  client_id: apply-client-2
+  scope: bar
+`)
+	writeManifest(t, "keys.json", `[{"kind": "JsonWebKeySet", "spec": {"set": "apply-set", "keys": [{"kid": "apply-key", "kty": "oct", "alg": "HS256", "use": "sig", "k": "c2VjcmV0"}]}}]`)
+	writeManifest(t, "grants.yml", `
+kind: TrustedOAuth2JwtGrantIssuer
+spec:
+  issuer: https://apply.example.com
+  subject: apply-subject
+  scope: [foo]
+  expires_at: `+expiresAt+`
+  jwk: `+string(grantKey)+`
+`)
+	writeManifest(t, "README.md", "This file is not a manifest and must be ignored.")
+
+	t.Run("case=dry run does not change anything", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			"OAuth2Client apply-client-1":       "create",
+			"OAuth2Client apply-client-2":       "create",
+			"JsonWebKeySet apply-set/apply-key": "create",
+			"TrustedOAuth2JwtGrantIssuer https://apply.example.com|apply-subject|grant-key": "create",
+		}, apply(t, "--file", dir, "--dry-run"))
+
+		_, err := reg.ClientManager().GetClient(t.Context(), "apply-client-1")
+		require.Error(t, err)
+	})
+
+	t.Run("case=creates declared resources", func(t *testing.T) {
+		assert.Len(t, apply(t, "--file", dir), 4)
+
+		actual, err := reg.ClientManager().GetConcreteClient(t.Context(), "apply-client-1")
+		require.NoError(t, err)
+		assert.Equal(t, "foo", actual.Scope)
+		require.NoError(t, reg.ClientHasher().Compare(t.Context(), actual.GetHashedSecret(), []byte("some-secret")))
+
+		set, err := reg.KeyManager().GetKeySet(t.Context(), "apply-set")
+		require.NoError(t, err)
+		require.Len(t, set.Keys, 1)
+		assert.Equal(t, "apply-key", set.Keys[0].KeyID)
+
+		grants, _, err := reg.GrantManager().GetGrants(t.Context(), "https://apply.example.com")
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, []string{"foo"}, grants[0].Scope)
+	})
+
+	t.Run("case=is idempotent", func(t *testing.T) {
+		assert.Empty(t, apply(t, "--file", dir))
+	})
+
+	t.Run("case=updates changed resources", func(t *testing.T) {
+		writeManifest(t, "clients.yaml", `
+- kind: OAuth2Client
+  spec:
+    client_id: apply-client-1
+    grant_types: [client_credentials]
+    scope: foo baz
+- kind: OAuth2Client
+  spec:
+    client_id: apply-client-2
+    scope: bar
+`)
+		assert.Equal(t, map[string]string{
+			"OAuth2Client apply-client-1": "update",
+		}, apply(t, "--file", dir))
+
+		actual, err := reg.ClientManager().GetConcreteClient(t.Context(), "apply-client-1")
+		require.NoError(t, err)
+		assert.Equal(t, "foo baz", actual.Scope)
+		require.NoError(t, reg.ClientHasher().Compare(t.Context(), actual.GetHashedSecret(), []byte("some-secret")), "the existing secret is kept when none is declared")
+	})
+
+	t.Run("case=replaces changed trust relationships", func(t *testing.T) {
+		writeManifest(t, "grants.yml", `
+kind: TrustedOAuth2JwtGrantIssuer
+spec:
+  issuer: https://apply.example.com
+  subject: apply-subject
+  scope: [foo, bar]
+  expires_at: `+expiresAt+`
+  jwk: `+string(grantKey)+`
+`)
+		assert.Equal(t, map[string]string{
+			"TrustedOAuth2JwtGrantIssuer https://apply.example.com|apply-subject|grant-key": "replace",
+		}, apply(t, "--file", dir))
+
+		grants, _, err := reg.GrantManager().GetGrants(t.Context(), "https://apply.example.com")
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.ElementsMatch(t, []string{"foo", "bar"}, grants[0].Scope)
+	})
+
+	t.Run("case=prunes undeclared resources", func(t *testing.T) {
+		undeclared := createClient(t, reg, nil)
+		key := createJWK(t, reg, "apply-set", "RS256")
+
+		assert.NotContains(t, apply(t, "--file", dir), "OAuth2Client "+undeclared.GetID(), "undeclared resources are only deleted with --prune")
+		_, err := reg.ClientManager().GetClient(t.Context(), undeclared.GetID())
+		require.NoError(t, err)
+
+		actions := apply(t, "--file", dir, "--prune")
+		assert.Equal(t, "delete", actions["OAuth2Client "+undeclared.GetID()])
+		assert.Equal(t, "delete", actions["JsonWebKeySet apply-set/"+key.KeyID])
+
+		_, err = reg.ClientManager().GetClient(t.Context(), undeclared.GetID())
+		require.Error(t, err)
+
+		set, err := reg.KeyManager().GetKeySet(t.Context(), "apply-set")
+		require.NoError(t, err)
+		require.Len(t, set.Keys, 1)
+		assert.Equal(t, "apply-key", set.Keys[0].KeyID)
+
+		_, err = reg.ClientManager().GetClient(t.Context(), "apply-client-2")
+		require.NoError(t, err)
+	})
+
+	t.Run("case=rejects invalid manifests", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "invalid.yaml")
+		require.NoError(t, os.WriteFile(file, []byte("kind: Unknown\nspec: {}\n"), 0600))
+		_, stderr, err := exec(t, "--file", file)
+		require.Error(t, err)
+		assert.Contains(t, stderr, `unknown manifest kind "Unknown"`)
+
+		require.NoError(t, os.WriteFile(file, []byte("kind: OAuth2Client\nspec: {scope: foo}\n"), 0600))
+		_, stderr, err = exec(t, "--file", file)
+		require.Error(t, err)
+		assert.Contains(t, stderr, "must declare a client_id")
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cli"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/x/configx"
+)
+
+func NewImportNetworkCmd(dOpts []driver.OptionsModifier) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "network [database_url]",
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Import a network archive",
+		Example: `hydra import network --transport-key "$TRANSPORT_KEY" --input network.json.gz [database_url]`,
+		Long: `Import an archive created by "hydra export" into the network of the target database. The IDs of all
+imported resources are preserved.
+
+The target database must be fully migrated and must know the latest migration of the database the archive
+was exported from. All rows are imported in a single transaction.
+
+The database connection string is read from the first argument, the environment variable DSN, or the
+configuration file.`,
+		RunE: cli.NewHandler(dOpts).Network.Import,
+	}
+	cmd.Flags().String(cli.TransportKey, "", "The key the archive was exported with.")
+	cmd.Flags().StringP(cli.Input, "i", "", "Read the archive from this file instead of STDIN.")
+	_ = cmd.MarkFlagRequired(cli.TransportKey)
+	configx.RegisterFlags(cmd.PersistentFlags())
+	return cmd
+}
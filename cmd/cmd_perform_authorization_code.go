@@ -176,6 +176,18 @@ and success, unless if the --no-shutdown flag is provided.`,
 			}
 
 			clientSecret := flagx.MustGetString(cmd, "client-secret")
+
+			discovery, err := discoverAuthorizationServer(cmd.Context(), client.GetConfig().HTTPClient, endpoint)
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Unable to discover the OpenID Connect configuration of %s: %s\n", endpoint, err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			issuer := flagx.MustGetString(cmd, "issuer")
+			if issuer == "" {
+				issuer = discovery.Issuer
+			}
+
 			proto := "http"
 			if isSSL {
 				proto = "https"
@@ -271,11 +283,14 @@ and success, unless if the --no-shutdown flag is provided.`,
 			}))
 
 			rt := router{
-				cl:    client,
-				skip:  skip,
-				cmd:   cmd,
-				state: &state,
-				conf:  &conf,
+				cl:     client,
+				skip:   skip,
+				cmd:    cmd,
+				state:  &state,
+				issuer: issuer,
+				conf:   &conf,
+
+				requireIssuer: discovery.AuthorizationResponseIssParameterSupported,
 				onDone: func() {
 					if !noShutdown {
 						go shutdown()
@@ -338,6 +353,7 @@ and success, unless if the --no-shutdown flag is provided.`,
 	cmd.Flags().Bool("https", false, "Sets up HTTPS for the endpoint using a self-signed certificate which is re-generated every time you start this command")
 	cmd.Flags().Bool("skip", false, "Skip login and/or consent steps if possible. Only effective if you have configured the Login and Consent UI URLs to point to this server.")
 	cmd.Flags().String("response-mode", "", "Set the response mode. Can be query (default) or form_post.")
+	cmd.Flags().String("issuer", "", "Expect this issuer in the authorization response (RFC 9207), defaults to the issuer from OpenID Connect Discovery")
 
 	return cmd
 }
@@ -347,10 +363,44 @@ type router struct {
 	skip           bool
 	cmd            *cobra.Command
 	state          *string
+	issuer         string
 	conf           *oauth2.Config
 	onDone         func()
 	serverLocation string
 	noShutdown     bool
+
+	// requireIssuer is true if the authorization server includes the issuer in authorization responses.
+	requireIssuer bool
+}
+
+type authorizationServerMetadata struct {
+	Issuer                                     string `json:"issuer"`
+	AuthorizationResponseIssParameterSupported bool   `json:"authorization_response_iss_parameter_supported"`
+}
+
+// discoverAuthorizationServer fetches the OpenID Connect Discovery document of the authorization server.
+func discoverAuthorizationServer(ctx context.Context, hc *http.Client, endpoint *url.URL) (*authorizationServerMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlx.AppendPaths(endpoint, "/.well-known/openid-configuration").String(), nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	res, err := hc.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("expected status code 200 but got %d", res.StatusCode)
+	}
+	var metadata authorizationServerMetadata
+	if err := json.NewDecoder(res.Body).Decode(&metadata); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if metadata.Issuer == "" {
+		return nil, errors.New("the discovery document does not contain an issuer")
+	}
+	return &metadata, nil
 }
 
 func (rt *router) loginGET(w http.ResponseWriter, r *http.Request) {
@@ -570,6 +620,21 @@ func (rt *router) consentPOST(w http.ResponseWriter, r *http.Request) {
 func (rt *router) callback(w http.ResponseWriter, r *http.Request) {
 	defer rt.onDone()
 
+	// Responses without an issuer are only rejected if the authorization server includes it in its responses, see
+	// https://www.rfc-editor.org/rfc/rfc9207#section-2.4.
+	iss := r.URL.Query().Get("iss")
+	if (iss != "" || rt.requireIssuer) && strings.TrimSuffix(iss, "/") != strings.TrimSuffix(rt.issuer, "/") {
+		descr := fmt.Sprintf("Issuers do not match. Expected %q, got %q.", rt.issuer, iss)
+		_, _ = fmt.Fprintln(rt.cmd.ErrOrStderr(), descr)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = tokenUserError.Execute(w, &ed{
+			Name:        "Issuers do not match",
+			Description: descr,
+		})
+		return
+	}
+
 	if len(r.URL.Query().Get("error")) > 0 {
 		_, _ = fmt.Fprintf(rt.cmd.ErrOrStderr(), "Got error: %s\n", r.URL.Query().Get("error_description"))
 
@@ -0,0 +1,124 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/x/cmdx"
+)
+
+func TestPerformAuthorizationCode(t *testing.T) {
+	t.Parallel()
+
+	const issuer = "https://hydra.example.com/"
+
+	newAuthorizationServer := func(t *testing.T, issParameterSupported bool) *httptest.Server {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/.well-known/openid-configuration" {
+				http.NotFound(w, r)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"issuer": issuer,
+				"authorization_response_iss_parameter_supported": issParameterSupported,
+			})
+		}))
+		t.Cleanup(ts.Close)
+		return ts
+	}
+
+	freePort := func(t *testing.T) string {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close() //nolint:errcheck
+		return strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+	}
+
+	// callback performs the authorization code flow against the authorization server and returns the output of the
+	// command after it received the authorization response.
+	callback := func(t *testing.T, endpoint string, query string, args ...string) (string, string) {
+		c := cmd.NewPerformAuthorizationCodeCmd()
+		cmdx.RegisterHTTPClientFlags(c.Flags())
+		cmdx.RegisterFormatFlags(c.Flags())
+
+		port := freePort(t)
+		var stdout, stderr bytes.Buffer
+		eg := cmdx.ExecBackgroundCtx(t.Context(), c, nil, &stdout, &stderr, append([]string{
+			"--" + cmdx.FlagEndpoint, endpoint, "--client-id", "client", "--no-open", "--port", port, "--state", "state",
+		}, args...)...)
+
+		var body string
+		require.EventuallyWithT(t, func(t *assert.CollectT) {
+			res, err := http.Get("http://127.0.0.1:" + port + "/callback?" + query)
+			require.NoError(t, err)
+			defer res.Body.Close() //nolint:errcheck
+			raw, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			body = string(raw)
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.NoError(t, eg.Wait())
+		return body, stderr.String()
+	}
+
+	t.Run("case=requires the issuer if the authorization server supports it", func(t *testing.T) {
+		ts := newAuthorizationServer(t, true)
+
+		body, stderr := callback(t, ts.URL, "code=code&state=state")
+		assert.Contains(t, body, "Issuers do not match")
+		assert.Contains(t, stderr, `Expected "`+issuer+`", got "".`)
+	})
+
+	t.Run("case=accepts the issuer from discovery", func(t *testing.T) {
+		ts := newAuthorizationServer(t, true)
+
+		body, _ := callback(t, ts.URL, "code=code&state=other&iss="+issuer)
+		assert.Contains(t, body, "States do not match")
+	})
+
+	t.Run("case=prefers the issuer from the flag", func(t *testing.T) {
+		ts := newAuthorizationServer(t, true)
+
+		body, stderr := callback(t, ts.URL, "code=code&state=state&iss="+issuer, "--issuer", "https://other.example.com/")
+		assert.Contains(t, body, "Issuers do not match")
+		assert.Contains(t, stderr, `Expected "https://other.example.com/", got "`+issuer+`".`)
+	})
+
+	t.Run("case=does not require the issuer if the authorization server does not support it", func(t *testing.T) {
+		ts := newAuthorizationServer(t, false)
+
+		body, _ := callback(t, ts.URL, "code=code&state=other")
+		assert.Contains(t, body, "States do not match")
+	})
+
+	t.Run("case=validates the issuer if the authorization server includes it", func(t *testing.T) {
+		ts := newAuthorizationServer(t, false)
+
+		body, _ := callback(t, ts.URL, "code=code&state=state&iss=https://other.example.com/")
+		assert.Contains(t, body, "Issuers do not match")
+	})
+
+	t.Run("case=fails if the authorization server can not be discovered", func(t *testing.T) {
+		ts := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(ts.Close)
+
+		c := cmd.NewPerformAuthorizationCodeCmd()
+		cmdx.RegisterHTTPClientFlags(c.Flags())
+		stderr := cmdx.ExecExpectedErr(t, c, "--"+cmdx.FlagEndpoint, ts.URL, "--client-id", "client", "--no-open")
+		assert.Contains(t, stderr, "Unable to discover the OpenID Connect configuration")
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cli"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/x/configx"
+)
+
+func NewExportCmd(dOpts []driver.OptionsModifier) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export [database_url]",
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Export a network into an archive",
+		Example: `hydra export --transport-key "$TRANSPORT_KEY" --output network.json.gz [database_url]`,
+		Long: `Export all OAuth 2.0 Clients, JSON Web Keys, trust relationships as well as remembered login and consent
+sessions of a network into a versioned, gzip compressed archive. Use "hydra import network" to restore the archive
+into another database.
+
+JSON Web Keys and token session data are encrypted with the system secret in the database. They are decrypted and
+re-encrypted using the transport key, which has to be passed to "hydra import network" as well.
+
+Active refresh tokens are only exported if --include-refresh-tokens is set. They can only be used after the
+import if the target uses the same system secret as the source.
+
+The database connection string is read from the first argument, the environment variable DSN, or the
+configuration file.`,
+		RunE: cli.NewHandler(dOpts).Network.Export,
+	}
+	cmd.Flags().String(cli.TransportKey, "", "The key used to encrypt secrets in the archive. Must be at least 16 characters long.")
+	cmd.Flags().Bool(cli.IncludeRefreshTokens, false, "Also export active refresh tokens.")
+	cmd.Flags().StringP(cli.Output, "o", "", "Write the archive to this file instead of STDOUT.")
+	_ = cmd.MarkFlagRequired(cli.TransportKey)
+	configx.RegisterFlags(cmd.PersistentFlags())
+	return cmd
+}
@@ -59,6 +59,9 @@ Janitor can be used in several ways.
    or any combination of them
 
 		hydra janitor --tokens --requests --grants {database-url}
+
+Instead of running this command as a separate job, you can let "hydra serve" clean up
+stale database rows periodically by setting "janitor.enabled" to true.
 `,
 		RunE: cli.NewHandler(dOpts).Janitor.RunE,
 		Args: cli.NewHandler(dOpts).Janitor.Args,
@@ -0,0 +1,51 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+type outputApplyStepCollection struct {
+	steps []applyStep
+}
+
+func (applyStep) Header() []string {
+	return []string{"ACTION", "KIND", "ID"}
+}
+
+func (s applyStep) Columns() []string {
+	return []string{s.Action, s.Kind, s.ID}
+}
+
+func (s applyStep) Interface() interface{} {
+	return s
+}
+
+func (outputApplyStepCollection) Header() []string {
+	return applyStep{}.Header()
+}
+
+func (c outputApplyStepCollection) Table() [][]string {
+	rows := make([][]string, len(c.steps))
+	for i, step := range c.steps {
+		rows[i] = step.Columns()
+	}
+	return rows
+}
+
+func (c outputApplyStepCollection) Interface() interface{} {
+	if c.steps == nil {
+		return []applyStep{}
+	}
+	return c.steps
+}
+
+func (c outputApplyStepCollection) Len() int {
+	return len(c.steps)
+}
+
+func (c outputApplyStepCollection) IDs() []string {
+	ids := make([]string, len(c.steps))
+	for i, step := range c.steps {
+		ids[i] = step.ID
+	}
+	return ids
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/x/cmdx"
+	"github.com/ory/x/jsonnetsecure"
 
 	"github.com/spf13/cobra"
 
@@ -56,6 +57,7 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 	importCmd.AddCommand(
 		NewImportClientCmd(),
 		NewKeysImportCmd(),
+		NewImportNetworkCmd(opts),
 	)
 
 	performCmd := NewPerformCmd()
@@ -75,6 +77,9 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 	migrateCmd.AddCommand(NewMigrateSQLCmd(opts))
 	migrateCmd.AddCommand(NewMigrateStatusCmd(opts))
 
+	auditCmd := NewAuditCmd()
+	auditCmd.AddCommand(NewAuditVerifyCmd(opts))
+
 	serveCmd := NewServeCmd()
 	serveCmd.AddCommand(NewServeAdminCmd(opts))
 	serveCmd.AddCommand(NewServePublicCmd(opts))
@@ -91,9 +96,13 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 		introspectCmd,
 		revokeCmd,
 		migrateCmd,
+		auditCmd,
 		serveCmd,
+		NewApplyCmd(),
+		NewExportCmd(opts),
 		NewJanitorCmd(opts),
 		NewVersionCmd(),
+		jsonnetsecure.NewJsonnetCmd(),
 	)
 }
 
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/rs/cors"
 	"github.com/spf13/cobra"
 	"github.com/urfave/negroni"
@@ -38,8 +39,10 @@ import (
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/driver"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x/events"
 )
 
 func ensureNoMemoryDSN(r *driver.RegistrySQL) {
@@ -64,6 +67,8 @@ func RunServeAdmin(dOpts []driver.OptionsModifier) func(cmd *cobra.Command, args
 		if err != nil {
 			return err
 		}
+
+		defer startJanitor(ctx, d)()
 		return srv()
 	}
 }
@@ -84,6 +89,8 @@ func RunServePublic(dOpts []driver.OptionsModifier) func(cmd *cobra.Command, arg
 		if err != nil {
 			return err
 		}
+
+		defer startJanitor(ctx, d)()
 		return srv()
 	}
 }
@@ -115,14 +122,45 @@ func RunServeAll(dOpts []driver.OptionsModifier) func(cmd *cobra.Command, args [
 			return err
 		}
 
+		defer startJanitor(ctx, d)()
 		eg.Go(srvAdmin)
 		eg.Go(srvPublic)
 		return eg.Wait()
 	}
 }
 
+// startJanitor starts the janitor in the background if it is enabled. The returned function stops the janitor
+// and waits until it released its lease.
+func startJanitor(ctx context.Context, d *driver.RegistrySQL) func() {
+	if !d.Config().JanitorEnabled() {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		janitor.NewWorker(d).Start(ctx)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
 var httpMetrics = prometheusx.NewHTTPMetrics("hydra", prometheusx.HTTPPrefix, config.Version, config.Commit, config.Date)
 
+// metricsLabelMiddleware applies the configured client ID label policy to the OAuth2 metrics derived from events.
+func metricsLabelMiddleware(d *driver.RegistrySQL) negroni.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		ctx := r.Context()
+		next(w, r.WithContext(events.WithClientIDLabelPolicy(ctx, events.ClientIDLabelPolicy{
+			Enabled:   d.Config().MetricsClientIDLabelEnabled(ctx),
+			AllowList: d.Config().MetricsClientIDLabelAllowList(ctx),
+		})))
+	}
+}
+
 func adminServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metricsx.Service) (func() error, error) {
 	cfg := d.Config().ServeAdmin(contextx.RootContext)
 
@@ -141,8 +179,10 @@ func adminServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metrics
 		recovery,
 		negroni.HandlerFunc(httprouterx.TrimTrailingSlashNegroni),
 		negroni.HandlerFunc(httprouterx.NoCacheNegroni),
+		negroni.HandlerFunc(d.NetworkResolver().Middleware),
 		negroni.HandlerFunc(httprouterx.AddAdminPrefixIfNotPresentNegroni),
 		negroni.HandlerFunc(semconv.Middleware),
+		negroni.HandlerFunc(metricsLabelMiddleware(d)),
 		httpMetrics,
 		logger,
 	)
@@ -197,7 +237,9 @@ func publicServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metric
 		recovery,
 		negroni.HandlerFunc(httprouterx.TrimTrailingSlashNegroni),
 		negroni.HandlerFunc(httprouterx.NoCacheNegroni),
+		negroni.HandlerFunc(d.NetworkResolver().Middleware),
 		negroni.HandlerFunc(semconv.Middleware),
+		negroni.HandlerFunc(metricsLabelMiddleware(d)),
 		httpMetrics,
 		logger,
 	)
@@ -228,7 +270,16 @@ func publicServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metric
 
 	n.UseHandler(router)
 	return func() error {
-		return serve(ctx, d, cfg, n, "public")
+		if !d.Config().MTLSEnabled(ctx) {
+			return serve(ctx, d, cfg, n, "public")
+		}
+
+		// The public endpoints are also served on the mtls interface, so that only clients which authenticate using
+		// mutual TLS are asked for a certificate, see https://www.rfc-editor.org/rfc/rfc8705#section-5.
+		eg := new(errgroup.Group)
+		eg.Go(func() error { return serve(ctx, d, cfg, n, "public") })
+		eg.Go(func() error { return serve(ctx, d, d.Config().ServeMTLS(contextx.RootContext), n, "mtls") })
+		return eg.Wait()
 	}, nil
 }
 
@@ -339,6 +390,13 @@ func serve(
 	if cfg.TLS.Enabled {
 		// #nosec G402 - This is a false positive because we use graceful.WithDefaults which sets the correct TLS settings.
 		tlsConfig = &tls.Config{GetCertificate: GetOrCreateTLSCertificate(ctx, d, cfg.TLS, ifaceName)}
+		if ifaceName == "mtls" {
+			// Client certificates are verified during client authentication, because self-signed certificates are
+			// allowed, see https://www.rfc-editor.org/rfc/rfc8705#section-2.2.
+			tlsConfig.ClientAuth = tls.RequestClientCert
+		}
+	} else if ifaceName == "mtls" {
+		return errors.New("the mtls interface requests client certificates, so TLS must be enabled on it, see serve.mtls.tls or serve.tls")
 	}
 
 	srv := graceful.WithDefaults(&http.Server{
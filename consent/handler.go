@@ -5,6 +5,7 @@ package consent
 
 import (
 	"cmp"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -12,9 +13,11 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/hydra/v2/x/events"
 	"github.com/ory/x/httprouterx"
@@ -53,6 +56,7 @@ func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
 	admin.DELETE(SessionsPath+"/login", h.revokeOAuth2LoginSessions)
 	admin.GET(SessionsPath+"/consent", h.listOAuth2ConsentSessions)
 	admin.DELETE(SessionsPath+"/consent", h.revokeOAuth2ConsentSessions)
+	admin.GET(SessionsPath+"/consent/history", h.listOAuth2ConsentHistory)
 
 	admin.GET(LogoutPath, h.getOAuth2LogoutRequest)
 	admin.PUT(LogoutPath+"/accept", h.acceptOAuth2LogoutRequest)
@@ -92,6 +96,22 @@ type _ struct {
 	//
 	// in: query
 	All bool `json:"all"`
+
+	// Revoke Scopes
+	//
+	// If set, only these scopes are revoked from the consent sessions the Subject granted to the Client. Requires
+	// the `subject` and `client` parameters.
+	//
+	// in: query
+	Scope []string `json:"scope"`
+
+	// Revoke Audiences
+	//
+	// If set, only these audiences are revoked from the consent sessions the Subject granted to the Client.
+	// Requires the `subject` and `client` parameters.
+	//
+	// in: query
+	Audience []string `json:"audience"`
 }
 
 // swagger:route DELETE /admin/oauth2/auth/sessions/consent oAuth2 revokeOAuth2ConsentSessions
@@ -101,6 +121,10 @@ type _ struct {
 // This endpoint revokes a subject's granted consent sessions and invalidates all
 // associated OAuth 2.0 Access Tokens. You may also only revoke sessions for a specific OAuth 2.0 Client ID.
 //
+// If `scope` or `audience` are set, only those scopes and audiences are revoked from the consent sessions of the
+// client. The associated access tokens are revoked, while the refresh tokens are downscoped or revoked depending
+// on the `oauth2.grant.refresh_token.partial_revocation` configuration.
+//
 //	Consumes:
 //	- application/json
 //
@@ -121,29 +145,66 @@ func (h *Handler) revokeOAuth2ConsentSessions(w http.ResponseWriter, r *http.Req
 		clientID         = r.URL.Query().Get("client")
 		consentRequestID = r.URL.Query().Get("consent_request_id")
 		allClients       = r.URL.Query().Get("all") == "true"
+		scope            = r.URL.Query()["scope"]
+		audience         = r.URL.Query()["audience"]
 	)
 
 	switch {
+	case len(scope) > 0 || len(audience) > 0:
+		if consentRequestID != "" || subject == "" || clientID == "" || allClients {
+			h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint("Query parameters 'scope' and 'audience' can only be combined with 'subject' and 'client'.")))
+			return
+		}
+		if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+			if err := h.r.ConsentManager().RevokeSubjectClientConsentScopes(ctx, subject, clientID, scope, audience); err != nil {
+				return err
+			}
+			return h.r.AuditRecorder().Record(ctx, r, audit.ActionConsentRevoked, subject, map[string]any{"subject": subject, "client_id": clientID, "scope": scope, "audience": audience}, nil)
+		}); err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+		events.Trace(r.Context(), events.ConsentRevoked, events.WithSubject(subject), events.WithClientID(clientID))
+		h.r.SSFTransmitter().Transmit(r.Context(), ssf.ConsentRevoked(h.r.Config().IssuerURL(r.Context()).String(), subject, clientID, scope, audience))
+
 	case consentRequestID != "" && subject == "" && clientID == "":
-		if err := h.r.ConsentManager().RevokeConsentSessionByID(r.Context(), consentRequestID); err != nil && !errors.Is(err, x.ErrNotFound) {
+		if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+			if err := h.r.ConsentManager().RevokeConsentSessionByID(ctx, consentRequestID); err != nil && !errors.Is(err, x.ErrNotFound) {
+				return err
+			}
+			return h.r.AuditRecorder().Record(ctx, r, audit.ActionConsentRevoked, consentRequestID, map[string]any{"consent_request_id": consentRequestID}, nil)
+		}); err != nil {
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
 		events.Trace(r.Context(), events.ConsentRevoked, events.WithConsentRequestID(consentRequestID))
+		h.r.SSFTransmitter().Transmit(r.Context(), ssf.ConsentRequestRevoked(consentRequestID))
 
 	case consentRequestID == "" && subject != "" && clientID != "" && !allClients:
-		if err := h.r.ConsentManager().RevokeSubjectClientConsentSession(r.Context(), subject, clientID); err != nil && !errors.Is(err, x.ErrNotFound) {
+		if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+			if err := h.r.ConsentManager().RevokeSubjectClientConsentSession(ctx, subject, clientID); err != nil && !errors.Is(err, x.ErrNotFound) {
+				return err
+			}
+			return h.r.AuditRecorder().Record(ctx, r, audit.ActionConsentRevoked, subject, map[string]any{"subject": subject, "client_id": clientID}, nil)
+		}); err != nil {
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
 		events.Trace(r.Context(), events.ConsentRevoked, events.WithSubject(subject), events.WithClientID(clientID))
+		h.r.SSFTransmitter().Transmit(r.Context(), ssf.ConsentRevoked(h.r.Config().IssuerURL(r.Context()).String(), subject, clientID, nil, nil))
 
 	case consentRequestID == "" && subject != "" && clientID == "" && allClients:
-		if err := h.r.ConsentManager().RevokeSubjectConsentSession(r.Context(), subject); err != nil && !errors.Is(err, x.ErrNotFound) {
+		if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+			if err := h.r.ConsentManager().RevokeSubjectConsentSession(ctx, subject); err != nil && !errors.Is(err, x.ErrNotFound) {
+				return err
+			}
+			return h.r.AuditRecorder().Record(ctx, r, audit.ActionConsentRevoked, subject, map[string]any{"subject": subject}, nil)
+		}); err != nil {
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
 		events.Trace(r.Context(), events.ConsentRevoked, events.WithSubject(subject))
+		h.r.SSFTransmitter().Transmit(r.Context(), ssf.ConsentRevoked(h.r.Config().IssuerURL(r.Context()).String(), subject, "", nil, nil))
 
 	default:
 		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint("Invalid combination of query parameters.")))
@@ -233,6 +294,69 @@ func (h *Handler) listOAuth2ConsentSessions(w http.ResponseWriter, r *http.Reque
 	h.r.Writer().Write(w, r, sessions)
 }
 
+// List OAuth 2.0 Consent History Parameters
+//
+// swagger:parameters listOAuth2ConsentHistory
+type _ struct {
+	keysetpagination.RequestParameters
+
+	// The subject to list the consent history for.
+	//
+	// in: query
+	// required: true
+	Subject string `json:"subject"`
+
+	// If set, only the consent history of this OAuth 2.0 Client ID is listed.
+	//
+	// in: query
+	Client string `json:"client"`
+}
+
+// swagger:route GET /admin/oauth2/auth/sessions/consent/history oAuth2 listOAuth2ConsentHistory
+//
+// # List OAuth 2.0 Consent History of a Subject
+//
+// This endpoint lists when a subject granted consent to clients and when consent, or some of its scopes and
+// audiences, were revoked, oldest first. If the subject is unknown, the endpoint returns an empty JSON array.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: oAuth2ConsentHistory
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-high
+func (h *Handler) listOAuth2ConsentHistory(w http.ResponseWriter, r *http.Request) {
+	subject := r.URL.Query().Get("subject")
+	if subject == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'subject' is not defined but should have been.`)))
+		return
+	}
+
+	pageKeys := h.r.Config().GetPaginationEncryptionKeys(r.Context())
+	pageOpts, err := keysetpagination.ParseQueryParams(pageKeys, r.URL.Query())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithHintf("Unable to parse pagination parameters: %s", err)))
+		return
+	}
+
+	history, nextPage, err := h.r.ConsentManager().FindSubjectsConsentHistory(r.Context(), subject, r.URL.Query().Get("client"), pageOpts...)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if history == nil {
+		history = []HistoryEvent{}
+	}
+
+	keysetpagination.SetLinkHeader(w, pageKeys, r.URL, nextPage)
+	h.r.Writer().Write(w, r, history)
+}
+
 // Revoke OAuth 2.0 Consent Login Sessions Parameters
 //
 // swagger:parameters revokeOAuth2LoginSessions
@@ -291,19 +415,31 @@ func (h *Handler) revokeOAuth2LoginSessions(w http.ResponseWriter, r *http.Reque
 	}
 
 	if sid != "" {
-		if err := h.r.ConsentStrategy().HandleHeadlessLogout(r.Context(), w, r, sid); err != nil {
+		if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+			if err := h.r.ConsentStrategy().HandleHeadlessLogout(ctx, w, r, sid); err != nil {
+				return err
+			}
+			return h.r.AuditRecorder().Record(ctx, r, audit.ActionLoginSessionRevoked, sid, map[string]any{"sid": sid}, nil)
+		}); err != nil {
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
+		h.r.SSFTransmitter().Transmit(r.Context(), ssf.SessionRevoked(h.r.Config().IssuerURL(r.Context()).String(), "", sid))
 
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	if err := h.r.LoginManager().RevokeSubjectLoginSession(r.Context(), subject); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.r.LoginManager().RevokeSubjectLoginSession(ctx, subject); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionLoginSessionRevoked, subject, map[string]any{"subject": subject}, nil)
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
+	h.r.SSFTransmitter().Transmit(r.Context(), ssf.SessionRevoked(h.r.Config().IssuerURL(r.Context()).String(), subject, ""))
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -497,6 +633,17 @@ func (h *Handler) acceptOAuth2LoginRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if err := h.r.AuditRecorder().Record(ctx, r, audit.ActionLoginAccepted, f.ID, nil, map[string]any{
+		"subject":      payload.Subject,
+		"client_id":    f.Client.GetID(),
+		"remember":     payload.Remember,
+		"remember_for": payload.RememberFor,
+		"acr":          payload.ACR,
+		"amr":          payload.AMR,
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
 	events.Trace(ctx, events.LoginAccepted, events.WithClientID(f.Client.GetID()), events.WithSubject(payload.Subject))
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
 		RedirectTo: urlx.SetQuery(ru, url.Values{"login_verifier": {verifier}}).String(),
@@ -592,6 +739,15 @@ func (h *Handler) rejectOAuth2LoginRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if err := h.r.AuditRecorder().Record(ctx, r, audit.ActionLoginRejected, f.ID, nil, map[string]any{
+		"subject":           f.Subject,
+		"client_id":         f.Client.GetID(),
+		"error":             payload.Name,
+		"error_description": payload.Description,
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
 	events.Trace(ctx, events.LoginRejected, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
 
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
@@ -765,6 +921,17 @@ func (h *Handler) acceptOAuth2ConsentRequest(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := h.r.AuditRecorder().Record(ctx, r, audit.ActionConsentAccepted, f.ConsentRequestID.String(), nil, map[string]any{
+		"subject":                     f.Subject,
+		"client_id":                   f.Client.GetID(),
+		"grant_scope":                 payload.GrantedScope,
+		"grant_access_token_audience": payload.GrantedAudience,
+		"remember":                    payload.Remember,
+		"remember_for":                payload.RememberFor,
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
 	events.Trace(ctx, events.ConsentAccepted, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
 		RedirectTo: urlx.SetQuery(ru, url.Values{"consent_verifier": {verifier}}).String(),
@@ -862,6 +1029,15 @@ func (h *Handler) rejectOAuth2ConsentRequest(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := h.r.AuditRecorder().Record(ctx, r, audit.ActionConsentRejected, f.ConsentRequestID.String(), nil, map[string]any{
+		"subject":           f.Subject,
+		"client_id":         f.Client.GetID(),
+		"error":             payload.Name,
+		"error_description": payload.Description,
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
 	events.Trace(ctx, events.ConsentRejected, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
 
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
@@ -905,8 +1081,13 @@ func (h *Handler) acceptOAuth2LogoutRequest(w http.ResponseWriter, r *http.Reque
 		r.URL.Query().Get("challenge"),
 	)
 
-	c, err := h.r.LogoutManager().AcceptLogoutRequest(r.Context(), challenge)
-	if err != nil {
+	var c *flow.LogoutRequest
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) (err error) {
+		if c, err = h.r.LogoutManager().AcceptLogoutRequest(ctx, challenge); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionLogoutAccepted, c.ID, nil, map[string]any{"subject": c.Subject, "sid": c.SessionID})
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -951,7 +1132,12 @@ func (h *Handler) rejectOAuth2LogoutRequest(w http.ResponseWriter, r *http.Reque
 		r.URL.Query().Get("challenge"),
 	)
 
-	if err := h.r.LogoutManager().RejectLogoutRequest(r.Context(), challenge); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.r.LogoutManager().RejectLogoutRequest(ctx, challenge); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionLogoutRejected, challenge, nil, nil)
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -1126,6 +1312,14 @@ func (h *Handler) acceptUserCodeRequest(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if err := h.r.AuditRecorder().Record(ctx, r, audit.ActionDeviceUserCodeAccepted, userCodeRequest.GetID(), nil, map[string]any{
+		"client_id":          userCodeRequest.GetClient().GetID(),
+		"requested_scope":    p.RequestedScope,
+		"requested_audience": p.RequestedAudience,
+	}); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
 	events.Trace(ctx, events.DeviceUserCodeAccepted, events.WithClientID(userCodeRequest.GetClient().GetID()))
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
 		RedirectTo: urlx.SetQuery(ru, url.Values{"device_verifier": {verifier}, "client_id": {userCodeRequest.GetClient().GetID()}}).String(),
@@ -462,3 +462,100 @@ func TestAcceptCodeDeviceRequest(t *testing.T) {
 		assert.EqualValues(t, http.StatusOK, resp.StatusCode)
 	})
 }
+
+func TestRevokeConsentScopesAndListHistory(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t)
+
+	h := NewHandler(reg)
+	r := httprouterx.NewRouterAdminWithPrefix()
+	h.SetRoutes(r)
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	cl := &client.Client{ID: uuidx.NewV4().String()}
+	require.NoError(t, reg.ClientManager().CreateClient(t.Context(), cl))
+
+	subject := uuidx.NewV4().String()
+	require.NoError(t, reg.ConsentManager().CreateConsentSession(t.Context(), &flow.Flow{
+		ID:               uuidx.NewV4().String(),
+		NID:              reg.Persister().NetworkID(t.Context()),
+		Client:           cl,
+		Subject:          subject,
+		State:            flow.FlowStateConsentUsed,
+		RequestedAt:      time.Now(),
+		ConsentRequestID: sqlxx.NullString(uuidx.NewV4().String()),
+		ConsentRemember:  true,
+		GrantedScope:     []string{"openid", "photos"},
+		GrantedAudience:  []string{"https://api.example.com"},
+	}))
+
+	revoke := func(t *testing.T, query string) *http.Response {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodDelete, ts.URL+"/admin"+SessionsPath+"/consent?"+query, nil)
+		require.NoError(t, err)
+		res, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+	history := func(t *testing.T, query string) *http.Response {
+		res, err := ts.Client().Get(ts.URL + "/admin" + SessionsPath + "/consent/history?" + query)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+
+	t.Run("case=rejects scopes without subject and client", func(t *testing.T) {
+		for _, query := range []string{
+			"subject=" + subject + "&scope=photos",
+			"subject=" + subject + "&all=true&scope=photos",
+			"consent_request_id=foo&audience=https://api.example.com",
+		} {
+			assert.Equal(t, http.StatusBadRequest, revoke(t, query).StatusCode, query)
+		}
+	})
+
+	t.Run("case=revokes scopes", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, revoke(t, "subject="+subject+"&client="+cl.ID+"&scope=photos").StatusCode)
+
+		remembered, err := reg.ConsentManager().FindGrantedAndRememberedConsentRequest(t.Context(), cl.ID, subject)
+		require.NoError(t, err)
+		assert.EqualValues(t, []string{"openid"}, remembered.GrantedScope)
+		assert.EqualValues(t, []string{"https://api.example.com"}, remembered.GrantedAudience)
+	})
+
+	t.Run("case=lists history", func(t *testing.T) {
+		res := history(t, "subject="+subject+"&client="+cl.ID)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		var events []HistoryEvent
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&events))
+		require.Len(t, events, 2)
+		assert.Equal(t, HistoryEventGranted, events[0].Type)
+		assert.EqualValues(t, []string{"openid", "photos"}, events[0].Scope)
+		assert.Equal(t, HistoryEventRevoked, events[1].Type)
+		assert.EqualValues(t, []string{"photos"}, events[1].Scope)
+		assert.Empty(t, events[1].Audience)
+
+		res = history(t, "subject=unknown")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "[]", string(bytes.TrimSpace(ioutilx.MustReadAll(res.Body))))
+
+		assert.Equal(t, http.StatusBadRequest, history(t, "").StatusCode)
+	})
+
+	t.Run("case=revokes audiences and lists history with the SDK", func(t *testing.T) {
+		sdk := hydra.NewAPIClient(hydra.NewConfiguration())
+		sdk.GetConfig().Servers = hydra.ServerConfigurations{{URL: ts.URL}}
+
+		_, err := sdk.OAuth2API.RevokeOAuth2ConsentSessions(t.Context()).Subject(subject).Client(cl.ID).Audience([]string{"https://api.example.com"}).Execute()
+		require.NoError(t, err)
+
+		events, _, err := sdk.OAuth2API.ListOAuth2ConsentHistory(t.Context()).Subject(subject).Client(cl.ID).Execute()
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, HistoryEventRevoked, events[2].GetType())
+		assert.Equal(t, []string{"https://api.example.com"}, events[2].Audience)
+	})
+}
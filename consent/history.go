@@ -0,0 +1,62 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/x/sqlxx"
+)
+
+const (
+	// HistoryEventGranted is recorded when a subject grants consent to a client.
+	HistoryEventGranted = "granted"
+	// HistoryEventRevoked is recorded when consent, or some of its scopes or audiences, are revoked.
+	HistoryEventRevoked = "revoked"
+)
+
+// OAuth 2.0 Consent History Event
+//
+// A consent history event records that a subject granted consent to a client, or that consent, or some of its
+// scopes or audiences, were revoked.
+//
+// swagger:model oAuth2ConsentHistoryEvent
+type HistoryEvent struct {
+	// The ID of the event. IDs are ordered by the time the event was recorded at.
+	ID uuid.UUID `json:"id" db:"id"`
+
+	NID uuid.UUID `json:"-" db:"nid"`
+
+	// The subject which granted or whose consent was revoked.
+	Subject string `json:"subject" db:"subject"`
+
+	// The ID of the OAuth 2.0 client.
+	ClientID string `json:"client_id" db:"client_id"`
+
+	// The ID of the consent request the event belongs to.
+	ConsentRequestID string `json:"consent_request_id" db:"consent_request_id"`
+
+	// The type of the event, either "granted" or "revoked".
+	Type string `json:"type" db:"type"`
+
+	// The scopes which were granted or revoked.
+	Scope sqlxx.StringSliceJSONFormat `json:"scope" db:"scope"`
+
+	// The audiences which were granted or revoked.
+	Audience sqlxx.StringSliceJSONFormat `json:"audience" db:"audience"`
+
+	// The time the event was recorded at.
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+func (HistoryEvent) TableName() string {
+	return "hydra_oauth2_consent_history"
+}
+
+// OAuth 2.0 Consent History
+//
+// swagger:model oAuth2ConsentHistory
+type _ []HistoryEvent
@@ -29,11 +29,18 @@ type (
 		RevokeSubjectConsentSession(ctx context.Context, subject string) error
 		RevokeSubjectClientConsentSession(ctx context.Context, subject, client string) error
 		RevokeConsentSessionByID(ctx context.Context, consentRequestID string) error
+		// RevokeSubjectClientConsentScopes revokes the given scopes and audiences from the consent sessions the
+		// subject granted to the client. Access tokens of the affected sessions are revoked, refresh tokens are
+		// downscoped or revoked depending on the configuration.
+		RevokeSubjectClientConsentScopes(ctx context.Context, subject, client string, scope, audience []string) error
 
 		CreateConsentSession(ctx context.Context, f *flow.Flow) error
 		FindGrantedAndRememberedConsentRequest(ctx context.Context, client, subject string) (*flow.Flow, error)
 		FindSubjectsGrantedConsentRequests(ctx context.Context, subject string, pageOpts ...keysetpagination.Option) ([]flow.Flow, *keysetpagination.Paginator, error)
 		FindSubjectsSessionGrantedConsentRequests(ctx context.Context, subject, sid string, pageOpts ...keysetpagination.Option) ([]flow.Flow, *keysetpagination.Paginator, error)
+		// FindSubjectsConsentHistory lists the consent history of the subject, oldest first. If client is not empty,
+		// only the history of that client is listed.
+		FindSubjectsConsentHistory(ctx context.Context, subject, client string, pageOpts ...keysetpagination.Option) ([]HistoryEvent, *keysetpagination.Paginator, error)
 
 		ListUserAuthenticatedClientsWithFrontChannelLogout(ctx context.Context, subject, sid string) ([]client.Client, error)
 		ListUserAuthenticatedClientsWithBackChannelLogout(ctx context.Context, subject, sid string) ([]client.Client, error)
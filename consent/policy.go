@@ -0,0 +1,98 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/x/fetcher"
+)
+
+// consentPolicyCacheTTL is how long a fetched consent policy is reused before it is fetched again.
+const consentPolicyCacheTTL = time.Minute
+
+// consentPolicyResult is the object returned by the Jsonnet consent policy.
+type consentPolicyResult struct {
+	Decision    string   `json:"decision"`
+	RemoveScope []string `json:"remove_scope"`
+	Reason      string   `json:"reason"`
+}
+
+// evaluateConsentPolicy evaluates the consent policy on the consent request of the flow and removes the scopes the
+// policy strips from the requested scopes. It returns nil if no consent policy is configured.
+func (s *defaultStrategy) evaluateConsentPolicy(ctx context.Context, f *flow.Flow) (*flow.ConsentPolicyDecision, error) {
+	location := s.r.Config().ConsentPolicyURL(ctx)
+	if location == "" {
+		return nil, nil
+	}
+
+	policy, err := fetcher.NewFetcher(
+		fetcher.WithClient(s.r.HTTPClient(ctx)),
+		fetcher.WithCache(s.policies, consentPolicyCacheTTL),
+	).FetchBytes(ctx, location)
+	if err != nil {
+		return nil, errors.WithStack(fosite.ErrServerError.WithWrap(err).WithHint("Unable to load the consent policy.").WithDebug(err.Error()))
+	}
+
+	// The policy sees the consent request as the consent endpoint would, without the client secret.
+	request := f.GetConsentRequest("")
+	if request.Client != nil {
+		c := *request.Client
+		c.Secret = ""
+		request.Client = &c
+	}
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vm, err := s.r.JsonnetVM(ctx)
+	if err != nil {
+		return nil, errors.WithStack(fosite.ErrServerError.WithWrap(err).WithHint("Unable to evaluate the consent policy.").WithDebug(err.Error()))
+	}
+	vm.ExtCode("consent_request", string(input))
+	out, err := vm.EvaluateAnonymousSnippet(location, string(policy))
+	if err != nil {
+		return nil, errors.WithStack(fosite.ErrServerError.WithWrap(err).WithHint("Unable to evaluate the consent policy.").WithDebug(err.Error()))
+	}
+
+	result := consentPolicyResult{Decision: flow.ConsentPolicyPrompt}
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		return nil, errors.WithStack(fosite.ErrServerError.WithWrap(err).WithHint("The consent policy returned an invalid result.").WithDebug(err.Error()))
+	}
+	switch result.Decision {
+	case flow.ConsentPolicyGrant, flow.ConsentPolicyDeny, flow.ConsentPolicyPrompt:
+	default:
+		return nil, errors.WithStack(fosite.ErrServerError.WithHintf("The consent policy returned the unknown decision '%s'.", result.Decision))
+	}
+
+	decision := &flow.ConsentPolicyDecision{Decision: result.Decision, Reason: result.Reason}
+	f.RequestedScope = slices.DeleteFunc(f.RequestedScope, func(scope string) bool {
+		if slices.Contains(result.RemoveScope, scope) {
+			decision.RemovedScope = append(decision.RemovedScope, scope)
+			return true
+		}
+		return false
+	})
+	f.ConsentPolicy = decision
+
+	s.r.Logger().
+		WithFields(logrus.Fields{
+			"client_id":     f.Client.GetID(),
+			"subject":       f.Subject,
+			"decision":      decision.Decision,
+			"removed_scope": decision.RemovedScope,
+			"reason":        decision.Reason,
+		}).Debug("Consent policy was evaluated.")
+
+	return decision, nil
+}
@@ -5,11 +5,14 @@ package consent
 
 import (
 	"github.com/ory/hydra/v2/aead"
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/httpx"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/logrusx"
 	"github.com/ory/x/otelx"
 )
@@ -22,6 +25,9 @@ type InternalRegistry interface {
 	otelx.Provider
 	x.NetworkProvider
 	kratos.Provider
+	audit.RecorderProvider
+	ssf.TransmitterProvider
+	jsonnetsecure.VMProvider
 	Registry
 	client.Registry
 
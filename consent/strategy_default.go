@@ -15,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dgraph-io/ristretto/v2"
 	"github.com/gorilla/sessions"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/pborman/uuid"
@@ -27,7 +28,9 @@ import (
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/x"
+	"github.com/ory/hydra/v2/x/events"
 	"github.com/ory/x/mapx"
 	"github.com/ory/x/otelx"
 	"github.com/ory/x/sqlcon"
@@ -41,10 +44,22 @@ const (
 	CookieAuthenticationSIDName = "sid"
 )
 
-type defaultStrategy struct{ r InternalRegistry }
+type defaultStrategy struct {
+	r InternalRegistry
+	// policies caches the consent policies by their location.
+	policies *ristretto.Cache[[]byte, []byte]
+}
 
 func NewStrategy(r InternalRegistry) Strategy {
-	return &defaultStrategy{r: r}
+	policies, err := ristretto.NewCache(&ristretto.Config[[]byte, []byte]{
+		NumCounters: 1000,
+		MaxCost:     8 << 20,
+		BufferItems: 64,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &defaultStrategy{r: r, policies: policies}
 }
 
 var (
@@ -582,9 +597,6 @@ func (s *defaultStrategy) forwardConsentRequest(
 	canSkipConsent bool,
 ) error {
 	prompt := stringsx.Splitx(ar.GetRequestForm().Get("prompt"), " ")
-	if slices.Contains(prompt, "none") && !canSkipConsent {
-		return errors.WithStack(fosite.ErrConsentRequired.WithHint(`Prompt 'none' was requested, but no previous consent was found.`))
-	}
 
 	f.ToStateConsentUnused(
 		flow.WithConsentRequestID(strings.ReplaceAll(uuid.New(), "-", "")),
@@ -592,10 +604,23 @@ func (s *defaultStrategy) forwardConsentRequest(
 		flow.WithConsentCSRF(strings.ReplaceAll(uuid.New(), "-", "")),
 	)
 
-	consentChallenge, err := f.ToConsentChallenge(ctx, s.r)
+	policy, err := s.evaluateConsentPolicy(ctx, f)
 	if err != nil {
 		return err
 	}
+	if policy != nil && policy.Decision == flow.ConsentPolicyDeny {
+		events.Trace(ctx, events.ConsentRejected, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
+		return errors.WithStack(fosite.ErrAccessDenied.WithHint("The consent request was denied by the consent policy.").WithDebug(policy.Reason))
+	}
+
+	// The consent policy can not grant requests which explicitly ask for consent, nor device flows, which must always
+	// be authorized by the end-user.
+	grant := policy != nil && policy.Decision == flow.ConsentPolicyGrant &&
+		!slices.Contains(prompt, "consent") && f.DeviceChallengeID == ""
+
+	if slices.Contains(prompt, "none") && !canSkipConsent && !grant {
+		return errors.WithStack(fosite.ErrConsentRequired.WithHint(`Prompt 'none' was requested, but no previous consent was found.`))
+	}
 
 	store, err := s.r.CookieStore(ctx)
 	if err != nil {
@@ -611,6 +636,15 @@ func (s *defaultStrategy) forwardConsentRequest(
 		return errors.WithStack(err)
 	}
 
+	if grant {
+		return s.grantConsentByPolicy(ctx, w, r, f)
+	}
+
+	consentChallenge, err := f.ToConsentChallenge(ctx, s.r)
+	if err != nil {
+		return err
+	}
+
 	http.Redirect(
 		w, r,
 		urlx.SetQuery(s.r.Config().ConsentURL(ctx), url.Values{"consent_challenge": {consentChallenge}}).String(),
@@ -621,6 +655,31 @@ func (s *defaultStrategy) forwardConsentRequest(
 	return errors.WithStack(ErrUserRedirected)
 }
 
+// grantConsentByPolicy accepts the consent request on behalf of the end-user as decided by the consent policy, and
+// redirects the user-agent back to the authorization endpoint.
+func (s *defaultStrategy) grantConsentByPolicy(ctx context.Context, w http.ResponseWriter, r *http.Request, f *flow.Flow) error {
+	if err := f.HandleConsentRequest(&flow.AcceptOAuth2ConsentRequest{
+		GrantedScope:    f.RequestedScope,
+		GrantedAudience: f.RequestedAudience,
+	}); err != nil {
+		return err
+	}
+
+	ru, err := url.Parse(f.RequestURL)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	verifier, err := f.ToConsentVerifier(ctx, s.r)
+	if err != nil {
+		return err
+	}
+
+	events.Trace(ctx, events.ConsentAccepted, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
+	http.Redirect(w, r, urlx.SetQuery(ru, url.Values{"consent_verifier": {verifier}}).String(), http.StatusFound)
+	return errors.WithStack(ErrUserRedirected)
+}
+
 func (s *defaultStrategy) verifyConsent(ctx context.Context, _ http.ResponseWriter, r *http.Request, verifier string) (_ *flow.Flow, err error) {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("").Start(ctx, "DefaultStrategy.verifyConsent")
 	defer otelx.End(span, &err)
@@ -696,11 +755,6 @@ func (s *defaultStrategy) executeBackChannelLogout(ctx context.Context, r *http.
 		return err
 	}
 
-	openIDKeyID, err := s.r.OpenIDJWTSigner().GetPublicKeyID(ctx)
-	if err != nil {
-		return err
-	}
-
 	type task struct {
 		url      string
 		token    string
@@ -716,6 +770,13 @@ func (s *defaultStrategy) executeBackChannelLogout(ctx context.Context, r *http.
 		// s.r.ConsentManager().GetForcedObfuscatedLoginSession(context.Background(), subject, <missing>)
 		// sub := s.obfuscateSubjectIdentifier(c, subject, )
 
+		// Logout tokens are signed like the ID tokens of the client.
+		ctx := jwk.WithSigningAlgorithm(ctx, c.IDTokenSignedResponseAlg)
+		openIDKeyID, err := s.r.OpenIDJWTSigner().GetPublicKeyID(ctx)
+		if err != nil {
+			return err
+		}
+
 		t, _, err := s.r.OpenIDJWTSigner().Generate(ctx, jwt.MapClaims{
 			"iss":    s.r.Config().IssuerURL(ctx).String(),
 			"aud":    []string{c.ID},
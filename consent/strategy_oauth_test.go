@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,6 +31,7 @@ import (
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/internal/testhelpers"
 	"github.com/ory/x/configx"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/pointerx"
 	"github.com/ory/x/urlx"
 	"github.com/ory/x/uuidx"
@@ -1346,6 +1348,112 @@ func TestStrategyDeviceLoginConsent(t *testing.T) {
 	})
 }
 
+func TestStrategyConsentPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := `
+local request = std.extVar('consent_request');
+if request.client.client_name == 'first-party' then
+  { decision: 'grant', remove_scope: ['offline'], reason: 'first-party client' }
+else if request.subject == 'blocked-subject' then
+  { decision: 'deny', reason: 'subject is blocked' }
+else
+  { decision: 'prompt', remove_scope: ['offline'] }
+`
+
+	ctx := context.Background()
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyAccessTokenStrategy:  "opaque",
+		config.KeyConsentRequestMaxAge: time.Hour,
+		config.KeyScopeStrategy:        "exact",
+		config.KeyConsentPolicyURL:     "base64://" + base64.StdEncoding.EncodeToString([]byte(policy)),
+	})), driver.WithJsonnetVMProvider(jsonnetsecure.NewTestProvider(t)))
+
+	publicTS, adminTS := testhelpers.NewOAuth2Server(ctx, t, reg)
+	adminClient := hydra.NewAPIClient(hydra.NewConfiguration())
+	adminClient.GetConfig().Servers = hydra.ServerConfigurations{{URL: adminTS.URL}}
+
+	createClientWithName := func(t *testing.T, name string) *client.Client {
+		return createClient(t, reg, &client.Client{
+			Name:         name,
+			RedirectURIs: []string{testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler)},
+		})
+	}
+
+	exchange := func(t *testing.T, c *client.Client, code string) *oauth2.Token {
+		conf := &oauth2.Config{
+			ClientID:     c.GetID(),
+			ClientSecret: c.Secret,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   publicTS.URL + "/oauth2/auth",
+				TokenURL:  publicTS.URL + "/oauth2/token",
+				AuthStyle: oauth2.AuthStyleInHeader,
+			},
+			RedirectURL: c.RedirectURIs[0],
+		}
+		token, err := conf.Exchange(ctx, code)
+		require.NoError(t, err)
+		return token
+	}
+
+	t.Run("case=grants the request without asking the end-user", func(t *testing.T) {
+		c := createClientWithName(t, "first-party")
+		testhelpers.NewLoginConsentUI(t, reg.Config(),
+			checkAndAcceptLoginHandler(t, adminClient, "aeneas-rekkas", func(*testing.T, *hydra.OAuth2LoginRequest, error) hydra.AcceptOAuth2LoginRequest {
+				return hydra.AcceptOAuth2LoginRequest{}
+			}),
+			testhelpers.HTTPServerNoExpectedCallHandler(t))
+
+		_, res := makeOAuth2Request(t, reg, nil, c, url.Values{"scope": {"openid offline"}})
+		assert.EqualValues(t, http.StatusNotImplemented, res.StatusCode)
+		code := res.Request.URL.Query().Get("code")
+		require.NotEmpty(t, code, "%v", res.Request.URL.Query())
+
+		token := exchange(t, c, code)
+		assert.Equal(t, "openid", token.Extra("scope"))
+		assert.Empty(t, token.RefreshToken)
+	})
+
+	t.Run("case=denies the request without asking the end-user", func(t *testing.T) {
+		c := createClientWithName(t, "third-party")
+		testhelpers.NewLoginConsentUI(t, reg.Config(),
+			checkAndAcceptLoginHandler(t, adminClient, "blocked-subject", func(*testing.T, *hydra.OAuth2LoginRequest, error) hydra.AcceptOAuth2LoginRequest {
+				return hydra.AcceptOAuth2LoginRequest{}
+			}),
+			testhelpers.HTTPServerNoExpectedCallHandler(t))
+
+		_, res := makeOAuth2Request(t, reg, nil, c, url.Values{"scope": {"openid"}})
+		assert.EqualValues(t, http.StatusNotImplemented, res.StatusCode)
+		assert.Empty(t, res.Request.URL.Query().Get("code"))
+		assert.Equal(t, "access_denied", res.Request.URL.Query().Get("error"))
+		assert.Contains(t, res.Request.URL.Query().Get("error_description"), "The consent request was denied by the consent policy.")
+	})
+
+	t.Run("case=removes scopes and asks the end-user", func(t *testing.T) {
+		c := createClientWithName(t, "third-party")
+		testhelpers.NewLoginConsentUI(t, reg.Config(),
+			checkAndAcceptLoginHandler(t, adminClient, "aeneas-rekkas", func(*testing.T, *hydra.OAuth2LoginRequest, error) hydra.AcceptOAuth2LoginRequest {
+				return hydra.AcceptOAuth2LoginRequest{}
+			}),
+			checkAndAcceptConsentHandler(t, adminClient, func(t *testing.T, req *hydra.OAuth2ConsentRequest, err error) hydra.AcceptOAuth2ConsentRequest {
+				require.NoError(t, err)
+				assert.Equal(t, []string{"openid"}, req.RequestedScope)
+				require.NotNil(t, req.Policy)
+				assert.Equal(t, "prompt", req.Policy.GetDecision())
+				assert.Equal(t, []string{"offline"}, req.Policy.RemovedScope)
+				return hydra.AcceptOAuth2ConsentRequest{GrantScope: req.RequestedScope}
+			}))
+
+		_, res := makeOAuth2Request(t, reg, nil, c, url.Values{"scope": {"openid offline"}})
+		assert.EqualValues(t, http.StatusNotImplemented, res.StatusCode)
+		code := res.Request.URL.Query().Get("code")
+		require.NotEmpty(t, code, "%v", res.Request.URL.Query())
+
+		token := exchange(t, c, code)
+		assert.Equal(t, "openid", token.Extra("scope"))
+	})
+}
+
 func DropCookieJar(drop *regexp.Regexp) http.CookieJar {
 	jar, _ := cookiejar.New(nil)
 	return &dropCSRFCookieJar{
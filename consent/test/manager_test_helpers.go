@@ -323,6 +323,84 @@ func ConsentManagerTests(t *testing.T, deps Deps, m consent.Manager, loginManage
 		})
 	})
 
+	t.Run("case=revoke consent scopes", func(t *testing.T) {
+		for _, policy := range []string{"downscope", "invalidate"} {
+			t.Run("policy="+policy, func(t *testing.T) {
+				ctx := t.Context()
+				deps.Config().MustSet(ctx, config.KeyRefreshTokenPartialRevocation, policy)
+				t.Cleanup(func() { deps.Config().MustSet(ctx, config.KeyRefreshTokenPartialRevocation, "downscope") })
+
+				f := MockConsentFlow(true, 0, false)
+				f.NID = deps.Networker().NetworkID(ctx)
+				require.NoError(t, clientManager.CreateClient(ctx, f.Client))
+				require.NoError(t, m.CreateConsentSession(ctx, f))
+
+				at, rt := uuidx.NewV4().String(), uuidx.NewV4().String()
+				sess := &oauth2.Session{DefaultSession: openid.NewDefaultSession()}
+				sess.Subject = f.Subject
+				sess.ConsentChallenge = f.ConsentRequestID.String()
+				req := &fosite.Request{
+					Client:          f.Client,
+					ID:              f.ConsentRequestID.String(),
+					RequestedAt:     time.Now(),
+					Session:         sess,
+					GrantedScope:    fosite.Arguments{"scope_a", "scope_b"},
+					GrantedAudience: fosite.Arguments{"aud_a", "aud_b"},
+				}
+				require.NoError(t, fositeManager.CreateAccessTokenSession(ctx, at, req))
+				require.NoError(t, fositeManager.CreateRefreshTokenSession(ctx, rt, at, req))
+
+				require.NoError(t, m.RevokeSubjectClientConsentScopes(ctx, f.Subject, f.Client.ID, []string{"scope_b"}, []string{"aud_a", "aud_c"}))
+
+				_, err := fositeManager.GetAccessTokenSession(ctx, at, nil)
+				assert.ErrorIs(t, err, fosite.ErrNotFound)
+
+				refreshed, err := fositeManager.GetRefreshTokenSession(ctx, rt, &oauth2.Session{DefaultSession: openid.NewDefaultSession()})
+				if policy == "invalidate" {
+					assert.ErrorIs(t, err, fosite.ErrNotFound)
+				} else {
+					require.NoError(t, err)
+					assert.Equal(t, fosite.Arguments{"scope_a"}, refreshed.GetGrantedScopes())
+					assert.Equal(t, fosite.Arguments{"aud_b"}, refreshed.GetGrantedAudience())
+				}
+
+				remembered, err := m.FindGrantedAndRememberedConsentRequest(ctx, f.Client.ID, f.Subject)
+				require.NoError(t, err)
+				assert.EqualValues(t, []string{"scope_a"}, remembered.GrantedScope)
+				assert.EqualValues(t, []string{"aud_b"}, remembered.GrantedAudience)
+
+				require.NoError(t, m.RevokeSubjectClientConsentSession(ctx, f.Subject, f.Client.ID))
+
+				history, nextPage, err := m.FindSubjectsConsentHistory(ctx, f.Subject, "")
+				require.NoError(t, err)
+				assert.True(t, nextPage.IsLast())
+				require.Len(t, history, 3)
+				for i, expected := range []struct {
+					typ             string
+					scope, audience []string
+				}{
+					{consent.HistoryEventGranted, []string{"scope_a", "scope_b"}, []string{"aud_a", "aud_b"}},
+					{consent.HistoryEventRevoked, []string{"scope_b"}, []string{"aud_a"}},
+					{consent.HistoryEventRevoked, []string{"scope_a"}, []string{"aud_b"}},
+				} {
+					assert.Equal(t, expected.typ, history[i].Type)
+					assert.EqualValues(t, expected.scope, history[i].Scope)
+					assert.EqualValues(t, expected.audience, history[i].Audience)
+					assert.Equal(t, f.Client.ID, history[i].ClientID)
+					assert.Equal(t, f.ConsentRequestID.String(), history[i].ConsentRequestID)
+				}
+
+				history, _, err = m.FindSubjectsConsentHistory(ctx, f.Subject, uuidx.NewV4().String())
+				require.NoError(t, err)
+				assert.Empty(t, history)
+			})
+		}
+
+		t.Run("unknown subject/client return no error", func(t *testing.T) {
+			require.NoError(t, m.RevokeSubjectClientConsentScopes(t.Context(), "i-do-not-exist", "i-do-not-exist", []string{"scope_a"}, nil))
+		})
+	})
+
 	t.Run("case=list consents", func(t *testing.T) {
 		flows := make([]*flow.Flow, 2)
 		for i := range flows {
@@ -6,6 +6,8 @@ package config
 import (
 	"context"
 	"crypto/sha512"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/go-jose/go-jose/v3"
 	"github.com/pkg/errors"
 
 	"github.com/ory/hydra/v2/spec"
@@ -75,6 +78,7 @@ const (
 	KeyIDTokenLifespan                           = "ttl.id_token"      // #nosec G101
 	KeyAuthCodeLifespan                          = "ttl.auth_code"
 	KeyDeviceAndUserCodeLifespan                 = "ttl.device_user_code"
+	KeyPushedAuthorizeRequestLifespan            = "ttl.pushed_authorization_request"
 	KeyAuthenticationSessionLifespan             = "ttl.authentication_session"
 	KeyScopeStrategy                             = "strategies.scope"
 	KeyGetCookieSecrets                          = "secrets.cookie"
@@ -90,13 +94,18 @@ const (
 	KeyDeviceDoneURL                             = "urls.device.success"
 	KeyPublicURL                                 = "urls.self.public"
 	KeyAdminURL                                  = "urls.self.admin"
+	KeyMTLSURL                                   = "urls.self.mtls"
 	KeyIssuerURL                                 = "urls.self.issuer"
 	KeyIdentityProviderAdminURL                  = "urls.identity_provider.url"
 	KeyIdentityProviderPublicURL                 = "urls.identity_provider.publicUrl"
 	KeyIdentityProviderHeaders                   = "urls.identity_provider.headers"
 	KeyAccessTokenStrategy                       = "strategies.access_token"
+	KeyRefreshTokenStrategy                      = "strategies.refresh_token"
 	KeyJWTScopeClaimStrategy                     = "strategies.jwt.scope_claim"
 	KeyDBIgnoreUnknownTableColumns               = "db.ignore_unknown_table_columns"
+	KeyDBReadReplicas                            = "db.read_replicas"
+	KeyDBTokenExpiry                             = "db.token_expiry"
+	KeyDBShortLivedDSN                           = "db.short_lived_dsn"
 	KeySubjectIdentifierAlgorithmSalt            = "oidc.subject_identifiers.pairwise.salt"
 	KeyPublicAllowDynamicRegistration            = "oidc.dynamic_client_registration.enabled"
 	KeyDeviceAuthTokenPollingInterval            = "oauth2.device_authorization.token_polling_interval" // #nosec G101
@@ -105,6 +114,12 @@ const (
 	KeyDeviceAuthUserCodeCharacterSet            = "oauth2.device_authorization.user_code.character_set"
 	KeyPKCEEnforced                              = "oauth2.pkce.enforced"
 	KeyPKCEEnforcedForPublicClients              = "oauth2.pkce.enforced_for_public_clients"
+	KeyPushedAuthorizeRequestsEnforced           = "oauth2.pushed_authorization_requests.enforced"
+	KeySignedMetadataEnabled                     = "oauth2.signed_metadata.enabled"
+	KeyIntrospectionJWTResponseEnabled           = "oauth2.introspection.jwt_response.enabled"
+	KeyIntrospectionPublicEnabled                = "oauth2.introspection.public.enabled"
+	KeyMTLSEnabled                               = "oauth2.mtls.enabled"
+	KeyMTLSCACertificates                        = "oauth2.mtls.ca_certificates"
 	KeyLogLevel                                  = "log.level"
 	KeyCGroupsV1AutoMaxProcsEnabled              = "cgroups.v1.auto_max_procs_enabled"
 	KeyGrantAllClientCredentialsScopesPerDefault = "oauth2.client_credentials.default_grant_allowed_scope" // #nosec G101
@@ -115,12 +130,38 @@ const (
 	KeyPreserveExtClaims                         = "oauth2.preserve_ext_claims"
 	KeyRefreshTokenRotationGracePeriod           = "oauth2.grant.refresh_token.rotation_grace_period"      // #nosec G101
 	KeyRefreshTokenRotationGraceReuseCount       = "oauth2.grant.refresh_token.rotation_grace_reuse_count" // #nosec G101
+	KeyRefreshTokenPartialRevocation             = "oauth2.grant.refresh_token.partial_revocation"         // #nosec G101
 	KeyOAuth2GrantJWTIDOptional                  = "oauth2.grant.jwt.jti_optional"
 	KeyOAuth2GrantJWTIssuedDateOptional          = "oauth2.grant.jwt.iat_optional"
 	KeyOAuth2GrantJWTMaxDuration                 = "oauth2.grant.jwt.max_ttl"
+	KeyOAuth2GrantJWTJWKSCacheTTL                = "oauth2.grant.jwt.jwks_cache_ttl"
 	KeyRefreshTokenHook                          = "oauth2.refresh_token_hook" // #nosec G101
 	KeyTokenHook                                 = "oauth2.token_hook"         // #nosec G101
+	KeyConsentPolicyURL                          = "oauth2.consent_policy.url"
 	KeyDevelopmentMode                           = "dev"
+	KeyMultitenancyEnabled                       = "multitenancy.enabled"
+	KeyMultitenancyHeader                        = "multitenancy.header"
+	KeyMultitenancyPathPrefix                    = "multitenancy.path_prefix"
+	KeyMultitenancyCacheTTL                      = "multitenancy.cache_ttl"
+	KeyAuditEnabled                              = "audit.enabled"
+	KeyAuditActorHeader                          = "audit.actor_header"
+	KeyAuditRequestIDHeader                      = "audit.request_id_header"
+	KeyJanitorEnabled                            = "janitor.enabled"
+	KeyJanitorInterval                           = "janitor.interval"
+	KeyJanitorLimit                              = "janitor.limit"
+	KeyJanitorBatchSize                          = "janitor.batch_size"
+	KeyMetricsClientIDLabelEnabled               = "metrics.client_id_label.enabled"
+	KeyMetricsClientIDLabelAllowList             = "metrics.client_id_label.allow_list"
+	KeySSFEnabled                                = "ssf.enabled"
+	KeySSFEventLifespan                          = "ssf.event_lifespan"
+	KeyDynamicRegistrationInitialAccessToken     = "oidc.dynamic_client_registration.initial_access_token.required" // #nosec G101
+	KeyDynamicRegistrationSoftwareStatement      = "oidc.dynamic_client_registration.software_statement.required"
+	KeySoftwareStatementTrustedIssuers           = "oidc.dynamic_client_registration.software_statement.trusted_issuers"
+	KeyFederationEnabled                         = "oidc.federation.enabled"
+	KeyFederationTrustAnchors                    = "oidc.federation.trust_anchors"
+	KeyFederationAuthorityHints                  = "oidc.federation.authority_hints"
+	KeyFederationEntityConfigurationLifespan     = "oidc.federation.entity_configuration_lifespan"
+	KeyFederationMaxPathLength                   = "oidc.federation.max_path_length"
 )
 
 const DSNMemory = "memory"
@@ -234,8 +275,74 @@ func (p *DefaultProvider) IsDevelopmentMode(ctx context.Context) bool {
 	return p.getProvider(ctx).Bool(KeyDevelopmentMode)
 }
 
+func (p *DefaultProvider) MultitenancyEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyMultitenancyEnabled)
+}
+
+func (p *DefaultProvider) MultitenancyHeader(ctx context.Context) string {
+	return p.getProvider(ctx).String(KeyMultitenancyHeader)
+}
+
+func (p *DefaultProvider) MultitenancyPathPrefix(ctx context.Context) string {
+	return strings.TrimSuffix(p.getProvider(ctx).String(KeyMultitenancyPathPrefix), "/")
+}
+
+func (p *DefaultProvider) MultitenancyCacheTTL(ctx context.Context) time.Duration {
+	return p.getProvider(ctx).DurationF(KeyMultitenancyCacheTTL, 30*time.Second)
+}
+
+func (p *DefaultProvider) AuditEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyAuditEnabled)
+}
+
+func (p *DefaultProvider) AuditActorHeader(ctx context.Context) string {
+	return p.getProvider(ctx).String(KeyAuditActorHeader)
+}
+
+func (p *DefaultProvider) AuditRequestIDHeader(ctx context.Context) string {
+	return p.getProvider(ctx).StringF(KeyAuditRequestIDHeader, "X-Request-Id")
+}
+
+func (p *DefaultProvider) JanitorEnabled() bool {
+	return p.getProvider(contextx.RootContext).Bool(KeyJanitorEnabled)
+}
+
+func (p *DefaultProvider) JanitorInterval() time.Duration {
+	return p.getProvider(contextx.RootContext).DurationF(KeyJanitorInterval, time.Hour)
+}
+
+func (p *DefaultProvider) JanitorLimit() int {
+	return p.getProvider(contextx.RootContext).IntF(KeyJanitorLimit, 10000)
+}
+
+func (p *DefaultProvider) JanitorBatchSize() int {
+	return p.getProvider(contextx.RootContext).IntF(KeyJanitorBatchSize, 100)
+}
+
+func (p *DefaultProvider) MetricsClientIDLabelEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).BoolF(KeyMetricsClientIDLabelEnabled, true)
+}
+
+func (p *DefaultProvider) MetricsClientIDLabelAllowList(ctx context.Context) []string {
+	return p.getProvider(ctx).Strings(KeyMetricsClientIDLabelAllowList)
+}
+
+func (p *DefaultProvider) SSFEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeySSFEnabled)
+}
+
+func (p *DefaultProvider) SSFEventLifespan(ctx context.Context) time.Duration {
+	return p.getProvider(ctx).DurationF(KeySSFEventLifespan, 24*time.Hour)
+}
+
 func (p *DefaultProvider) WellKnownKeys(ctx context.Context, include ...string) []string {
 	include = append(include, x.OAuth2JWTKeyName, x.OpenIDConnectKeyName)
+	if p.SignedMetadataEnabled(ctx) {
+		include = append(include, x.MetadataKeyName)
+	}
+	if p.IntrospectionJWTResponseEnabled(ctx) {
+		include = append(include, x.IntrospectionKeyName)
+	}
 	return stringslice.Unique(append(p.getProvider(ctx).Strings(KeyWellKnownKeys), include...))
 }
 
@@ -365,6 +472,88 @@ func (p *DefaultProvider) PublicAllowDynamicRegistration(ctx context.Context) bo
 	return p.getProvider(ctx).Bool(KeyPublicAllowDynamicRegistration)
 }
 
+// DynamicRegistrationRequiresInitialAccessToken returns true if dynamic client registration requires an initial
+// access token issued by an administrator.
+func (p *DefaultProvider) DynamicRegistrationRequiresInitialAccessToken(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyDynamicRegistrationInitialAccessToken)
+}
+
+// DynamicRegistrationRequiresSoftwareStatement returns true if dynamic client registration requires a software
+// statement signed by one of the trusted issuers.
+func (p *DefaultProvider) DynamicRegistrationRequiresSoftwareStatement(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyDynamicRegistrationSoftwareStatement)
+}
+
+// SoftwareStatementIssuer is an issuer of software statements and the location of its public keys.
+type SoftwareStatementIssuer struct {
+	Issuer  string `json:"issuer" koanf:"issuer"`
+	JWKSURI string `json:"jwks_uri" koanf:"jwks_uri"`
+}
+
+func (p *DefaultProvider) SoftwareStatementTrustedIssuers(ctx context.Context) []SoftwareStatementIssuer {
+	var issuers []SoftwareStatementIssuer
+	if err := p.getProvider(ctx).Unmarshal(KeySoftwareStatementTrustedIssuers, &issuers); err != nil {
+		p.l.WithError(errors.WithStack(err)).
+			Errorf("Configuration value from key %s could not be decoded.", KeySoftwareStatementTrustedIssuers)
+		return nil
+	}
+	return issuers
+}
+
+// FederationEnabled returns true if OpenID Federation is enabled, which publishes the entity configuration and
+// registers relying parties of the federation automatically and explicitly.
+func (p *DefaultProvider) FederationEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyFederationEnabled)
+}
+
+// FederationTrustAnchor is a trust anchor of an OpenID Federation and its public keys.
+type FederationTrustAnchor struct {
+	EntityID string             `json:"entity_id"`
+	JWKS     jose.JSONWebKeySet `json:"jwks"`
+}
+
+func (p *DefaultProvider) FederationTrustAnchors(ctx context.Context) []FederationTrustAnchor {
+	var raw []struct {
+		EntityID string         `koanf:"entity_id"`
+		JWKS     map[string]any `koanf:"jwks"`
+	}
+	if err := p.getProvider(ctx).Unmarshal(KeyFederationTrustAnchors, &raw); err != nil {
+		p.l.WithError(errors.WithStack(err)).
+			Errorf("Configuration value from key %s could not be decoded.", KeyFederationTrustAnchors)
+		return nil
+	}
+
+	anchors := make([]FederationTrustAnchor, 0, len(raw))
+	for _, a := range raw {
+		anchor := FederationTrustAnchor{EntityID: a.EntityID}
+		keys, err := json.Marshal(a.JWKS)
+		if err == nil {
+			err = json.Unmarshal(keys, &anchor.JWKS)
+		}
+		if err != nil {
+			p.l.WithError(errors.WithStack(err)).
+				Errorf("The JSON Web Key Set of trust anchor %s from key %s could not be decoded.", a.EntityID, KeyFederationTrustAnchors)
+			continue
+		}
+		anchors = append(anchors, anchor)
+	}
+	return anchors
+}
+
+func (p *DefaultProvider) FederationAuthorityHints(ctx context.Context) []string {
+	return p.getProvider(ctx).Strings(KeyFederationAuthorityHints)
+}
+
+func (p *DefaultProvider) FederationEntityConfigurationLifespan(ctx context.Context) time.Duration {
+	return p.getProvider(ctx).DurationF(KeyFederationEntityConfigurationLifespan, 24*time.Hour)
+}
+
+// FederationMaxPathLength returns the maximum number of intermediate entities between a relying party and a trust
+// anchor.
+func (p *DefaultProvider) FederationMaxPathLength(ctx context.Context) int {
+	return p.getProvider(ctx).IntF(KeyFederationMaxPathLength, 3)
+}
+
 func (p *DefaultProvider) CookieSameSiteLegacyWorkaround(ctx context.Context) bool {
 	return p.getProvider(ctx).Bool(KeyCookieSameSiteLegacyWorkaround)
 }
@@ -598,6 +787,17 @@ func (p *DefaultProvider) AccessTokenStrategy(ctx context.Context, additionalSou
 	return s
 }
 
+const (
+	RefreshTokenOpaqueStrategy    = "opaque"
+	RefreshTokenStatelessStrategy = "stateless"
+)
+
+// RefreshTokenStrategy returns the strategy used to generate refresh tokens, which is either "opaque" or
+// "stateless".
+func (p *DefaultProvider) RefreshTokenStrategy(ctx context.Context) string {
+	return p.getProvider(ctx).StringF(KeyRefreshTokenStrategy, RefreshTokenOpaqueStrategy)
+}
+
 type (
 	Auth struct {
 		Type   string     `json:"type"`
@@ -655,10 +855,33 @@ func (p *DefaultProvider) TokenRefreshHookConfig(ctx context.Context) *HookConfi
 	return p.getHookConfig(ctx, KeyRefreshTokenHook)
 }
 
+// ConsentPolicyURL returns the location of the Jsonnet consent policy, or an empty string if no consent policy is
+// configured.
+func (p *DefaultProvider) ConsentPolicyURL(ctx context.Context) string {
+	return p.getProvider(ctx).String(KeyConsentPolicyURL)
+}
+
 func (p *DefaultProvider) DbIgnoreUnknownTableColumns() bool {
 	return p.p.Bool(KeyDBIgnoreUnknownTableColumns)
 }
 
+// DBReadReplicas returns the data source names of the read replicas of the database.
+func (p *DefaultProvider) DBReadReplicas() []string {
+	return p.getProvider(contextx.RootContext).Strings(KeyDBReadReplicas)
+}
+
+// DBNativeTokenExpiry returns whether the database removes expired access and refresh tokens instead of the
+// janitor.
+func (p *DefaultProvider) DBNativeTokenExpiry() bool {
+	return p.getProvider(contextx.RootContext).StringF(KeyDBTokenExpiry, "janitor") == "native"
+}
+
+// DBShortLivedDSN returns the data source name of the key-value store for short-lived artifacts. If it is empty,
+// short-lived artifacts are stored in the database.
+func (p *DefaultProvider) DBShortLivedDSN() string {
+	return p.getProvider(contextx.RootContext).String(KeyDBShortLivedDSN)
+}
+
 func (p *DefaultProvider) SubjectIdentifierAlgorithmSalt(ctx context.Context) string {
 	return p.getProvider(ctx).String(KeySubjectIdentifierAlgorithmSalt)
 }
@@ -699,6 +922,61 @@ func (p *DefaultProvider) GetEnforcePKCEForPublicClients(ctx context.Context) bo
 	return p.getProvider(ctx).Bool(KeyPKCEEnforcedForPublicClients)
 }
 
+// GetPushedAuthorizeContextLifespan returns how long a request_uri issued by the pushed authorization request
+// endpoint may be used.
+func (p *DefaultProvider) GetPushedAuthorizeContextLifespan(ctx context.Context) time.Duration {
+	return p.getProvider(ctx).DurationF(KeyPushedAuthorizeRequestLifespan, time.Second*90)
+}
+
+// EnforcePushedAuthorize returns whether all clients must use the pushed authorization request endpoint.
+func (p *DefaultProvider) EnforcePushedAuthorize(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyPushedAuthorizeRequestsEnforced)
+}
+
+// SignedMetadataEnabled returns whether the OAuth 2.0 authorization server metadata contains signed metadata.
+func (p *DefaultProvider) SignedMetadataEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeySignedMetadataEnabled)
+}
+
+// IntrospectionJWTResponseEnabled returns whether resource servers may request JWT introspection responses.
+func (p *DefaultProvider) IntrospectionJWTResponseEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyIntrospectionJWTResponseEnabled)
+}
+
+// PublicIntrospectionEnabled returns whether resource servers authenticated as OAuth 2.0 Clients may introspect
+// tokens at the public introspection endpoint.
+func (p *DefaultProvider) PublicIntrospectionEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyIntrospectionPublicEnabled)
+}
+
+// MTLSEnabled returns whether the public endpoints are also served on the mtls interface, which requests client
+// certificates, and OAuth 2.0 Clients may authenticate using mutual TLS.
+func (p *DefaultProvider) MTLSEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyMTLSEnabled)
+}
+
+// MTLSURL returns the base location of the public endpoints served on the mtls interface.
+func (p *DefaultProvider) MTLSURL(ctx context.Context) *url.URL {
+	return urlRoot(p.getProvider(ctx).RequestURIF(KeyMTLSURL, p.fallbackURL(ctx, "/", p.ServeMTLS(ctx))))
+}
+
+// MTLSCACertificates returns the certificate authorities which issue the client certificates of the tls_client_auth
+// client authentication method, or nil if mutual TLS is disabled or no certificate authorities are configured.
+func (p *DefaultProvider) MTLSCACertificates(ctx context.Context) *x509.CertPool {
+	certs := p.getProvider(ctx).Strings(KeyMTLSCACertificates)
+	if !p.MTLSEnabled(ctx) || len(certs) == 0 {
+		return nil
+	}
+
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		if !pool.AppendCertsFromPEM([]byte(cert)) {
+			p.l.Errorf("Configuration value from key %s contains a value which is not a PEM encoded certificate.", KeyMTLSCACertificates)
+		}
+	}
+	return pool
+}
+
 func (p *DefaultProvider) CGroupsV1AutoMaxProcsEnabled() bool {
 	return p.getProvider(contextx.RootContext).Bool(KeyCGroupsV1AutoMaxProcsEnabled)
 }
@@ -744,6 +1022,11 @@ func (p *DefaultProvider) GetJWTMaxDuration(ctx context.Context) time.Duration {
 	return p.getProvider(ctx).DurationF(KeyOAuth2GrantJWTMaxDuration, time.Hour*24*30)
 }
 
+// GetJWTBearerJWKSCacheTTL returns how long the JSON Web Key Sets of trusted JWT bearer grant issuers are cached.
+func (p *DefaultProvider) GetJWTBearerJWKSCacheTTL(ctx context.Context) time.Duration {
+	return p.getProvider(ctx).DurationF(KeyOAuth2GrantJWTJWKSCacheTTL, time.Hour)
+}
+
 func (p *DefaultProvider) CookieDomain(ctx context.Context) string {
 	return p.getProvider(ctx).String(KeyCookieDomain)
 }
@@ -798,6 +1081,12 @@ func (p *DefaultProvider) GracefulRefreshTokenRotation(ctx context.Context) (cfg
 	return
 }
 
+// InvalidateRefreshTokensOnPartialRevocation returns whether the refresh tokens of a consent session are revoked
+// instead of downscoped if some of its scopes or audiences are revoked.
+func (p *DefaultProvider) InvalidateRefreshTokensOnPartialRevocation(ctx context.Context) bool {
+	return p.getProvider(ctx).StringF(KeyRefreshTokenPartialRevocation, "downscope") == "invalidate"
+}
+
 func (p *DefaultProvider) GetPaginationEncryptionKeys(ctx context.Context) [][32]byte {
 	secrets := p.getProvider(ctx).Strings(KeyPaginationSecrets)
 	if len(secrets) == 0 {
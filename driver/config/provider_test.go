@@ -120,6 +120,20 @@ func TestProviderPublicDisableHealthAccessLog(t *testing.T) {
 	assert.True(t, serve.RequestLog.DisableHealth)
 }
 
+func TestProviderServeMTLS(t *testing.T) {
+	p := newProvider(t)
+	serve := p.ServeMTLS(t.Context())
+	assert.Equal(t, 4443, serve.Port)
+	assert.Equal(t, "4443", p.MTLSURL(t.Context()).Port())
+
+	p = newProvider(t, configx.WithValues(map[string]any{
+		"serve.mtls.port": 8443,
+		KeyMTLSURL:        "https://mtls.example.com/",
+	}))
+	assert.Equal(t, 8443, p.ServeMTLS(t.Context()).Port)
+	assert.Equal(t, "https://mtls.example.com/", p.MTLSURL(t.Context()).String())
+}
+
 func TestPublicAllowDynamicRegistration(t *testing.T) {
 	p := newProvider(t)
 	value := p.PublicAllowDynamicRegistration(t.Context())
@@ -21,6 +21,17 @@ func (p *DefaultProvider) ServePublic(ctx context.Context) *configx.Serve {
 	return c
 }
 
+// ServeMTLS returns the configuration of the interface which serves the public endpoints and requests client
+// certificates for mutual TLS client authentication.
+func (p *DefaultProvider) ServeMTLS(ctx context.Context) *configx.Serve {
+	sharedTLS := p.getProvider(ctx).TLS("serve.tls", configx.TLS{})
+	return p.getProvider(ctx).Serve("serve.mtls", p.IsDevelopmentMode(ctx), configx.Serve{
+		Host: "localhost",
+		Port: 4443,
+		TLS:  sharedTLS,
+	})
+}
+
 func (p *DefaultProvider) ServeAdmin(ctx context.Context) *configx.Serve {
 	sharedTLS := p.getProvider(ctx).TLS("serve.tls", configx.TLS{})
 	return p.getProvider(ctx).Serve("serve.admin", p.IsDevelopmentMode(ctx), configx.Serve{
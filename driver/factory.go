@@ -16,7 +16,9 @@ import (
 	"github.com/ory/hydra/v2/fositex"
 	"github.com/ory/hydra/v2/hsm"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/network"
 	"github.com/ory/x/configx"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/logrusx"
 	"github.com/ory/x/otelx"
 	"github.com/ory/x/popx"
@@ -40,6 +42,7 @@ type (
 		hsmContext         hsm.Context
 		kratos             kratos.Client
 		fop                fosite.OAuth2Provider
+		jsonnetVM          jsonnetsecure.VMProvider
 		dbOptsModifier     []func(details *pop.ConnectionDetails)
 	}
 	OptionsModifier func(*options)
@@ -152,6 +155,13 @@ func WithOAuth2Provider(p fosite.OAuth2Provider) OptionsModifier {
 	}
 }
 
+// WithJsonnetVMProvider sets how Jsonnet VMs are created. By default, they run in a pool of "hydra jsonnet" processes.
+func WithJsonnetVMProvider(p jsonnetsecure.VMProvider) OptionsModifier {
+	return func(o *options) {
+		o.jsonnetVM = p
+	}
+}
+
 func New(ctx context.Context, opts ...OptionsModifier) (*RegistrySQL, error) {
 	o := newOptions(opts)
 	sl := servicelocatorx.NewOptions(o.serviceLocatorOpts...)
@@ -161,7 +171,10 @@ func New(ctx context.Context, opts ...OptionsModifier) (*RegistrySQL, error) {
 		l = logrusx.New("Ory Hydra", config.Version)
 	}
 
-	c, err := config.New(ctx, l, sl.Contextualizer(), o.configOpts...)
+	// Requests which are scoped to a network use the network's ID and configuration.
+	ctxer := network.NewContextualizer(sl.Contextualizer())
+
+	c, err := config.New(ctx, l, ctxer, o.configOpts...)
 	if err != nil {
 		l.WithError(err).Error("Unable to instantiate configuration.")
 		return nil, err
@@ -183,9 +196,10 @@ func New(ctx context.Context, opts ...OptionsModifier) (*RegistrySQL, error) {
 	r.fositeFactories = o.fositexFactories
 	r.hsm = o.hsmContext
 	r.middlewares = sl.HTTPMiddlewares()
-	r.ctxer = sl.Contextualizer()
+	r.ctxer = ctxer
 	r.kratos = o.kratos
 	r.fop = o.fop
+	r.jsonnetVM = o.jsonnetVM
 	r.dbOptsModifier = o.dbOptsModifier
 
 	if err = r.Init(ctx, o.skipNetworkInit, o.autoMigrate, o.extraMigrations, o.goMigrations); err != nil {
@@ -12,15 +12,20 @@ import (
 	"github.com/ory/x/httpx"
 	"github.com/ory/x/otelx"
 
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/federation"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/network"
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/hydra/v2/persistence"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/pop/v6"
 	"github.com/ory/x/contextx"
@@ -41,6 +46,11 @@ type registry interface {
 	consent.Registry
 	jwk.Registry
 	trust.Registry
+	network.Registry
+	audit.Registry
+	ssf.Registry
+	federation.Registry
+	janitor.Registry
 	oauth2.Registry
 	otelx.Provider
 	x.NetworkProvider
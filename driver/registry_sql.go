@@ -9,12 +9,15 @@ import (
 	"fmt"
 	"io/fs"
 	"net/http"
+	"runtime"
+	"sync"
 	"time"
 
 	"github.com/gorilla/sessions"
 	"github.com/hashicorp/go-retryablehttp"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/urfave/negroni"
@@ -22,9 +25,11 @@ import (
 
 	"github.com/ory/herodot"
 	"github.com/ory/hydra/v2/aead"
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/federation"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/compose"
 	foauth2 "github.com/ory/hydra/v2/fosite/handler/oauth2"
@@ -37,12 +42,17 @@ import (
 	"github.com/ory/hydra/v2/fositex"
 	"github.com/ory/hydra/v2/hsm"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/network"
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/hydra/v2/persistence"
+	"github.com/ory/hydra/v2/persistence/kv"
 	"github.com/ory/hydra/v2/persistence/sql"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/hydra/v2/x"
+	"github.com/ory/hydra/v2/x/events"
 	"github.com/ory/hydra/v2/x/oauth2cors"
 	"github.com/ory/pop/v6"
 	"github.com/ory/x/contextx"
@@ -50,6 +60,7 @@ import (
 	"github.com/ory/x/healthx"
 	"github.com/ory/x/httprouterx"
 	"github.com/ory/x/httpx"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/logrusx"
 	"github.com/ory/x/otelx"
 	"github.com/ory/x/popx"
@@ -76,6 +87,7 @@ type RegistrySQL struct {
 	tracerWrapper               func(*otelx.Tracer) *otelx.Tracer
 	arhs                        []oauth2.AccessRequestHook
 	basePersister               *sql.BasePersister
+	shortLived                  kv.Client
 	accessTokenStorage          foauth2.AccessTokenStorage
 	authorizeCodeStorage        foauth2.AuthorizeCodeStorage
 	authorizeCodeStrategy       foauth2.AuthorizeCodeStrategy
@@ -83,28 +95,41 @@ type RegistrySQL struct {
 	openIDConnectRequestStorage openid.OpenIDConnectRequestStorage
 	oc                          fosite.Configurator
 	oidcs                       jwk.JWTSigner
+	feds                        jwk.JWTSigner
+	mds                         jwk.JWTSigner
+	its                         jwk.JWTSigner
 	ats                         jwk.JWTSigner
 	hmacs                       foauth2.CoreStrategy
 	jwtStrategy                 foauth2.AccessTokenStrategy
+	statelessRefreshStrategy    foauth2.RefreshTokenStrategy
 	enigmaHMAC                  *hmac.HMACStrategy
 	deviceHmac                  *rfc8628.DefaultDeviceStrategy
 	fc                          *fositex.Config
 	publicCORS                  *cors.Cors
 	kratos                      kratos.Client
+	jsonnetVM                   jsonnetsecure.VMProvider
+	jsonnetVMOnce               sync.Once
 	fositeFactories             []fositex.Factory
 	migrator                    *sql.MigrationManager
 	dbOptsModifier              []func(details *pop.ConnectionDetails)
 
-	keyManager     jwk.Manager
-	consentManager consent.Manager
+	keyManager      jwk.Manager
+	consentManager  consent.Manager
+	networkResolver *network.Resolver
+	auditRecorder   *audit.Recorder
+	ssfTransmitter  *ssf.Transmitter
+	fedResolver     *federation.Resolver
+	fedRegistrar    *client.FederationRegistrar
+	trustKeyStorage *trust.KeyStorage
 
 	initialPing func(ctx context.Context, l *logrusx.Logger, p *sql.BasePersister) error
 	middlewares []negroni.Handler
 }
 
 var (
-	_ contextx.Provider = (*RegistrySQL)(nil)
-	_ registry          = (*RegistrySQL)(nil)
+	_ contextx.Provider                        = (*RegistrySQL)(nil)
+	_ registry                                 = (*RegistrySQL)(nil)
+	_ foauth2.RefreshTokenReuseHandlerProvider = (*RegistrySQL)(nil)
 )
 
 func (m *RegistrySQL) FositeClientManager() fosite.ClientManager {
@@ -132,6 +157,11 @@ func (m *RegistrySQL) RefreshTokenStorage() foauth2.RefreshTokenStorage {
 	return m.OAuth2Storage()
 }
 
+// RefreshTokenReuseHandler implements foauth2.RefreshTokenReuseHandlerProvider
+func (m *RegistrySQL) RefreshTokenReuseHandler() foauth2.RefreshTokenReuseHandler {
+	return oauth2.NewRefreshTokenReuseHandler(m)
+}
+
 // TokenRevocationStorage implements foauth2.TokenRevocationStorageProvider
 func (m *RegistrySQL) TokenRevocationStorage() foauth2.TokenRevocationStorage {
 	return m.OAuth2Storage()
@@ -158,6 +188,11 @@ func (m *RegistrySQL) PKCERequestStorage() pkce.PKCERequestStorage {
 	return m.OAuth2Storage()
 }
 
+// PARStorage implements fosite.PARStorageProvider
+func (m *RegistrySQL) PARStorage() fosite.PARStorage {
+	return m.OAuth2Storage()
+}
+
 // DeviceAuthStorage implements rfc8628.DeviceAuthStorageProvider
 func (m *RegistrySQL) DeviceAuthStorage() rfc8628.DeviceAuthStorage {
 	return m.OAuth2Storage()
@@ -165,7 +200,10 @@ func (m *RegistrySQL) DeviceAuthStorage() rfc8628.DeviceAuthStorage {
 
 // RFC7523KeyStorage implements rfc7523.RFC7523KeyStorageProvider
 func (m *RegistrySQL) RFC7523KeyStorage() rfc7523.RFC7523KeyStorage {
-	return m.OAuth2Storage()
+	if m.trustKeyStorage == nil {
+		m.trustKeyStorage = trust.NewKeyStorage(m.OAuth2Storage(), m)
+	}
+	return m.trustKeyStorage
 }
 
 // NonceManager implements verifiable.NonceManager
@@ -197,39 +235,45 @@ func (m *RegistrySQL) Init(
 			}
 		}
 
-		// new db connection
-		pool, idlePool, connMaxLifetime, connMaxIdleTime, cleanedDSN := sqlcon.ParseConnectionOptions(
-			m.l, m.Config().DSN(),
-		)
-
-		opts := &pop.ConnectionDetails{
-			URL:             sqlcon.FinalizeDSN(m.l, cleanedDSN),
-			IdlePool:        idlePool,
-			ConnMaxLifetime: connMaxLifetime,
-			ConnMaxIdleTime: connMaxIdleTime,
-			Pool:            pool,
-			TracerProvider:  m.Tracer(ctx).Provider(),
-			Unsafe:          m.Config().DbIgnoreUnknownTableColumns(),
+		c, err := m.openConnection(ctx, m.Config().DSN())
+		if err != nil {
+			return err
 		}
 
-		for _, f := range m.dbOptsModifier {
-			f(opts)
+		if m.Config().DBNativeTokenExpiry() {
+			if d := c.Dialect.Name(); d != "postgres" && d != "cockroach" {
+				return errors.Errorf(`db.token_expiry "native" is only supported on PostgreSQL and CockroachDB, but the database is %s`, d)
+			}
+			extraMigrations = append(extraMigrations, sql.TokenExpiryMigrations)
 		}
 
-		c, err := pop.NewConnection(opts)
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		if err := resilience.Retry(m.l, 5*time.Second, 5*time.Minute, c.Open); err != nil {
-			return errors.WithStack(err)
+		var replicas []*pop.Connection
+		for _, dsn := range m.Config().DBReadReplicas() {
+			rc, err := m.openConnection(ctx, dsn)
+			if err != nil {
+				return err
+			}
+			replicas = append(replicas, rc)
 		}
 
-		m.basePersister = sql.NewBasePersister(c, m)
+		m.basePersister = sql.NewBasePersister(c, m).WithReadReplicas(replicas...)
 		if err := m.initialPing(ctx, m.Logger(), m.basePersister); err != nil {
 			m.Logger().Print("Could not ping database: ", err)
 			return err
 		}
 
+		if dsn := m.Config().DBShortLivedDSN(); dsn != "" {
+			if m.shortLived, err = kv.NewClient(dsn); err != nil {
+				return err
+			}
+			if err := resilience.Retry(m.l, 5*time.Second, 5*time.Minute, func() error {
+				return m.shortLived.Ping(ctx)
+			}); err != nil {
+				m.Logger().Print("Could not ping short-lived storage: ", err)
+				return errors.WithStack(err)
+			}
+		}
+
 		m.migrator = sql.NewMigrationManager(c, m, extraMigrations, goMigrations)
 
 		// if dsn is memory we have to run the migrations on every start
@@ -259,6 +303,34 @@ func (m *RegistrySQL) Init(
 	return nil
 }
 
+// openConnection opens a database connection to the given DSN, retrying until the database is reachable.
+func (m *RegistrySQL) openConnection(ctx context.Context, dsn string) (*pop.Connection, error) {
+	pool, idlePool, connMaxLifetime, connMaxIdleTime, cleanedDSN := sqlcon.ParseConnectionOptions(m.l, dsn)
+
+	opts := &pop.ConnectionDetails{
+		URL:             sqlcon.FinalizeDSN(m.l, cleanedDSN),
+		IdlePool:        idlePool,
+		ConnMaxLifetime: connMaxLifetime,
+		ConnMaxIdleTime: connMaxIdleTime,
+		Pool:            pool,
+		TracerProvider:  m.Tracer(ctx).Provider(),
+		Unsafe:          m.Config().DbIgnoreUnknownTableColumns(),
+	}
+
+	for _, f := range m.dbOptsModifier {
+		f(opts)
+	}
+
+	c, err := pop.NewConnection(opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := resilience.Retry(m.l, 5*time.Second, 5*time.Minute, c.Open); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return c, nil
+}
+
 func (m *RegistrySQL) InitNetwork(ctx context.Context) error {
 	net, err := m.basePersister.DetermineNetwork(ctx)
 	if err != nil {
@@ -270,10 +342,20 @@ func (m *RegistrySQL) InitNetwork(ctx context.Context) error {
 	return nil
 }
 
-func (m *RegistrySQL) PingContext(ctx context.Context) error { return m.basePersister.Ping(ctx) }
+func (m *RegistrySQL) PingContext(ctx context.Context) error {
+	if m.shortLived != nil {
+		if err := m.shortLived.Ping(ctx); err != nil {
+			return err
+		}
+	}
+	return m.basePersister.Ping(ctx)
+}
 
 func (m *RegistrySQL) BasePersister() *sql.BasePersister { return m.basePersister }
 func (m *RegistrySQL) ClientManager() client.Manager     { return m.Persister() }
+func (m *RegistrySQL) InitialAccessTokenManager() client.InitialAccessTokenManager {
+	return m.Persister()
+}
 func (m *RegistrySQL) ConsentManager() consent.Manager {
 	if m.consentManager != nil {
 		return m.consentManager
@@ -303,6 +385,49 @@ func (m *RegistrySQL) KeyManager() jwk.Manager {
 
 func (m *RegistrySQL) GrantManager() trust.GrantManager { return m.Persister() }
 
+func (m *RegistrySQL) NetworkManager() network.Manager { return m.Persister() }
+
+func (m *RegistrySQL) NetworkResolver() *network.Resolver {
+	if m.networkResolver == nil {
+		m.networkResolver = network.NewResolver(m)
+	}
+	return m.networkResolver
+}
+
+func (m *RegistrySQL) AuditManager() audit.Manager { return m.Persister() }
+
+func (m *RegistrySQL) AuditRecorder() *audit.Recorder {
+	if m.auditRecorder == nil {
+		m.auditRecorder = audit.NewRecorder(m)
+	}
+	return m.auditRecorder
+}
+
+func (m *RegistrySQL) SSFManager() ssf.Manager { return m.Persister() }
+
+func (m *RegistrySQL) SSFTransmitter() *ssf.Transmitter {
+	if m.ssfTransmitter == nil {
+		m.ssfTransmitter = ssf.NewTransmitter(m)
+	}
+	return m.ssfTransmitter
+}
+
+func (m *RegistrySQL) FederationResolver() *federation.Resolver {
+	if m.fedResolver == nil {
+		m.fedResolver = federation.NewResolver(m)
+	}
+	return m.fedResolver
+}
+
+func (m *RegistrySQL) ClientFederationRegistrar() *client.FederationRegistrar {
+	if m.fedRegistrar == nil {
+		m.fedRegistrar = client.NewFederationRegistrar(m)
+	}
+	return m.fedRegistrar
+}
+
+func (m *RegistrySQL) JanitorManager() janitor.Manager { return m.Persister() }
+
 func (m *RegistrySQL) Contextualizer() contextx.Contextualizer {
 	if m.ctxer == nil {
 		panic("registry Contextualizer not set")
@@ -332,11 +457,28 @@ func (m *RegistrySQL) RegisterPublicRoutes(ctx context.Context, public *httprout
 	jwk.NewHandler(m).SetPublicRoutes(public, corsMW)
 	client.NewHandler(m).SetPublicRoutes(public)
 	oauth2.NewHandler(m).SetPublicRoutes(public, corsMW)
+	ssf.NewHandler(m).SetPublicRoutes(public)
 }
 
 func (m *RegistrySQL) RegisterAdminRoutes(admin *httprouterx.RouterAdmin) {
 	m.HealthHandler().SetHealthRoutes(admin, true)
 	m.HealthHandler().SetVersionRoutes(admin)
+	if err := events.RegisterMetrics(); err != nil {
+		m.Logger().WithError(err).Warn("Unable to register the OAuth2 metrics.")
+	}
+	// Device codes kept in the short-lived storage expire on their own and are not counted.
+	if counter, ok := m.Persister().(events.PendingDeviceCodeCounter); ok {
+		ctx := context.Background()
+		collector := events.NewPendingDeviceCodesCollector(counter, events.ClientIDLabelPolicy{
+			Enabled:   m.Config().MetricsClientIDLabelEnabled(ctx),
+			AllowList: m.Config().MetricsClientIDLabelAllowList(ctx),
+		}, func(err error) {
+			m.Logger().WithError(err).Warn("Unable to count the pending device codes.")
+		})
+		if err := prometheus.Register(collector); err != nil && !errors.As(err, new(prometheus.AlreadyRegisteredError)) {
+			m.Logger().WithError(err).Warn("Unable to register the pending device codes metric.")
+		}
+	}
 	admin.GET(prometheusx.MetricsPrometheusPath, promhttp.Handler().ServeHTTP)
 
 	consent.NewHandler(m).SetRoutes(admin)
@@ -344,6 +486,9 @@ func (m *RegistrySQL) RegisterAdminRoutes(admin *httprouterx.RouterAdmin) {
 	client.NewHandler(m).SetAdminRoutes(admin)
 	oauth2.NewHandler(m).SetAdminRoutes(admin)
 	trust.NewHandler(m).SetRoutes(admin)
+	network.NewHandler(m).SetRoutes(admin)
+	audit.NewHandler(m).SetRoutes(admin)
+	ssf.NewHandler(m).SetAdminRoutes(admin)
 }
 
 func (m *RegistrySQL) Writer() herodot.Writer {
@@ -462,6 +607,20 @@ func (m *RegistrySQL) CookieStore(ctx context.Context) (sessions.Store, error) {
 	return cs, nil
 }
 
+// JsonnetVM returns a Jsonnet VM. Unless configured otherwise, it runs in a pool of "hydra jsonnet" processes which
+// is started on first use.
+func (m *RegistrySQL) JsonnetVM(ctx context.Context) (jsonnetsecure.VM, error) {
+	m.jsonnetVMOnce.Do(func() {
+		if m.jsonnetVM == nil {
+			m.jsonnetVM = &jsonnetsecure.DefaultProvider{
+				Subcommand: "jsonnet",
+				Pool:       jsonnetsecure.NewProcessPool(runtime.GOMAXPROCS(0)),
+			}
+		}
+	})
+	return m.jsonnetVM.JsonnetVM(ctx)
+}
+
 func (m *RegistrySQL) HTTPClient(_ context.Context, opts ...httpx.ResilientOptions) *retryablehttp.Client {
 	opts = append(opts,
 		httpx.ResilientClientWithLogger(m.Logger()),
@@ -492,6 +651,27 @@ func (m *RegistrySQL) OpenIDJWTSigner() jwk.JWTSigner {
 	return m.oidcs
 }
 
+func (m *RegistrySQL) FederationJWTSigner() jwk.JWTSigner {
+	if m.feds == nil {
+		m.feds = jwk.NewDefaultJWTSigner(m, x.FederationKeyName)
+	}
+	return m.feds
+}
+
+func (m *RegistrySQL) MetadataJWTSigner() jwk.JWTSigner {
+	if m.mds == nil {
+		m.mds = jwk.NewDefaultJWTSigner(m, x.MetadataKeyName)
+	}
+	return m.mds
+}
+
+func (m *RegistrySQL) IntrospectionJWTSigner() jwk.JWTSigner {
+	if m.its == nil {
+		m.its = jwk.NewDefaultJWTSigner(m, x.IntrospectionKeyName)
+	}
+	return m.its
+}
+
 func (m *RegistrySQL) AccessTokenJWTSigner() jwk.JWTSigner {
 	if m.ats == nil {
 		m.ats = jwk.NewDefaultJWTSigner(m, x.OAuth2JWTKeyName)
@@ -523,6 +703,13 @@ func (m *RegistrySQL) OAuth2JWTStrategy() foauth2.AccessTokenStrategy {
 	return m.jwtStrategy
 }
 
+func (m *RegistrySQL) OAuth2StatelessRefreshTokenStrategy() foauth2.RefreshTokenStrategy {
+	if m.statelessRefreshStrategy == nil {
+		m.statelessRefreshStrategy = oauth2.NewStatelessRefreshTokenStrategy(m)
+	}
+	return m.statelessRefreshStrategy
+}
+
 func (m *RegistrySQL) OAuth2AuthorizeCodeStrategy() foauth2.AuthorizeCodeStrategy {
 	if m.authorizeCodeStrategy != nil {
 		return m.authorizeCodeStrategy
@@ -576,10 +763,10 @@ func (m *RegistrySQL) OAuth2ProviderConfig() fosite.Configurator {
 	conf.LoadDefaultHandlers(m, &compose.CommonStrategyProvider{
 		CoreStrategy:   fositex.NewTokenStrategy(m),
 		DeviceStrategy: deviceHmacAtStrategy,
-		OIDCTokenStrategy: &openid.DefaultStrategy{
+		OIDCTokenStrategy: fositex.NewIDTokenStrategy(&openid.DefaultStrategy{
 			Config: conf,
 			Signer: oidcSigner,
-		},
+		}),
 		Signer: oidcSigner,
 	})
 
@@ -620,8 +807,14 @@ func (m *RegistrySQL) Tracer(_ context.Context) *otelx.Tracer {
 	return m.trc
 }
 
-func (m *RegistrySQL) Persister() persistence.Persister { return sql.NewPersister(m.basePersister, m) }
-func (m *RegistrySQL) Config() *config.DefaultProvider  { return m.conf }
+func (m *RegistrySQL) Persister() persistence.Persister {
+	p := sql.NewPersister(m.basePersister, m)
+	if m.shortLived != nil {
+		return kv.NewPersister(p, m.shortLived, m)
+	}
+	return p
+}
+func (m *RegistrySQL) Config() *config.DefaultProvider { return m.conf }
 
 // WithConsentStrategy forces a consent strategy which is only used for testing.
 func (m *RegistrySQL) WithConsentStrategy(c consent.Strategy) { m.cos = c }
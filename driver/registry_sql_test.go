@@ -202,6 +202,16 @@ func TestDbUnknownTableColumns(t *testing.T) {
 	})
 }
 
+func TestDbNativeTokenExpiry_RequiresPostgresOrCockroach(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(t.Context(), WithConfigOptions(
+		configx.WithValue("dsn", dbal.NewSQLiteTestDatabase(t)),
+		configx.WithValue(config.KeyDBTokenExpiry, "native"),
+	))
+	assert.ErrorContains(t, err, `db.token_expiry "native" is only supported on PostgreSQL and CockroachDB`)
+}
+
 func failedPing(err error) func(context.Context, *logrusx.Logger, *sql.BasePersister) error {
 	return func(context.Context, *logrusx.Logger, *sql.BasePersister) error {
 		return pkgerr.WithStack(err)
@@ -0,0 +1,181 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package federation
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/pkg/errors"
+)
+
+const (
+	EntityConfigurationPath = "/.well-known/openid-federation"
+
+	EntityTypeOpenIDProvider     = "openid_provider"
+	EntityTypeOpenIDRelyingParty = "openid_relying_party"
+	EntityTypeFederationEntity   = "federation_entity"
+
+	ContentTypeEntityStatement              = "application/entity-statement+jwt"
+	ContentTypeExplicitRegistrationResponse = "application/explicit-registration-response+jwt"
+
+	TypeEntityStatement              = "entity-statement+jwt"
+	TypeExplicitRegistrationResponse = "explicit-registration-response+jwt"
+
+	RegistrationTypeAutomatic = "automatic"
+	RegistrationTypeExplicit  = "explicit"
+)
+
+// signingAlgorithms are the algorithms entity statements may be signed with.
+var signingAlgorithms = []string{
+	string(jose.RS256), string(jose.RS384), string(jose.RS512),
+	string(jose.PS256), string(jose.PS384), string(jose.PS512),
+	string(jose.ES256), string(jose.ES384), string(jose.ES512),
+	string(jose.EdDSA),
+}
+
+// EntityStatement is a signed statement about an entity of the federation. Entity configurations are entity
+// statements which the entity issues about itself, subordinate statements are issued by the superiors of the
+// entity.
+type EntityStatement struct {
+	Issuer         string                          `json:"iss"`
+	Subject        string                          `json:"sub"`
+	Audience       jwt.Audience                    `json:"aud,omitempty"`
+	IssuedAt       int64                           `json:"iat"`
+	ExpiresAt      int64                           `json:"exp"`
+	JWKS           *jose.JSONWebKeySet             `json:"jwks,omitempty"`
+	AuthorityHints []string                        `json:"authority_hints,omitempty"`
+	Metadata       map[string]map[string]any       `json:"metadata,omitempty"`
+	MetadataPolicy map[string]map[string]Operators `json:"metadata_policy,omitempty"`
+	TrustAnchorID  string                          `json:"trust_anchor_id,omitempty"`
+	raw            string
+	token          *jwt.JSONWebToken
+}
+
+// IsEntityConfiguration returns true if the statement was issued by the entity about itself.
+func (s *EntityStatement) IsEntityConfiguration() bool {
+	return s.Issuer == s.Subject
+}
+
+// FederationEntity returns the federation entity metadata parameter of the statement as a string.
+func (s *EntityStatement) FederationEntity(parameter string) string {
+	v, _ := s.Metadata[EntityTypeFederationEntity][parameter].(string)
+	return v
+}
+
+// Raw returns the signed statement.
+func (s *EntityStatement) Raw() string {
+	return s.raw
+}
+
+// ParseEntityStatement decodes the signed entity statement without verifying its signature.
+func ParseEntityStatement(raw string) (*EntityStatement, error) {
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse the entity statement")
+	}
+	if len(token.Headers) != 1 {
+		return nil, errors.New("the entity statement must have exactly one signature")
+	}
+	if !slices.Contains(signingAlgorithms, token.Headers[0].Algorithm) {
+		return nil, errors.Errorf("the entity statement is signed with the unsupported algorithm %q", token.Headers[0].Algorithm)
+	}
+	if typ, _ := token.Headers[0].ExtraHeaders[jose.HeaderType].(string); typ != TypeEntityStatement {
+		return nil, errors.Errorf("the entity statement has the type %q but expected %q", typ, TypeEntityStatement)
+	}
+
+	s := EntityStatement{raw: raw, token: token}
+	if err := token.UnsafeClaimsWithoutVerification(&s); err != nil {
+		return nil, errors.Wrap(err, "unable to decode the entity statement")
+	}
+	if s.Issuer == "" || s.Subject == "" {
+		return nil, errors.New("the entity statement must contain the iss and sub claims")
+	}
+	return &s, nil
+}
+
+// Verify verifies the signature of the statement with the keys and checks that the statement is valid at the time.
+func (s *EntityStatement) Verify(keys *jose.JSONWebKeySet, now time.Time) error {
+	if keys == nil || len(keys.Keys) == 0 {
+		return errors.Errorf("no keys are available to verify the entity statement of %s issued by %s", s.Subject, s.Issuer)
+	}
+
+	candidates := keys.Keys
+	if kid := s.token.Headers[0].KeyID; kid != "" {
+		candidates = keys.Key(kid)
+	}
+	verified := false
+	for _, key := range candidates {
+		if err := s.token.Claims(key.Public()); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return errors.Errorf("the signature of the entity statement of %s issued by %s is invalid", s.Subject, s.Issuer)
+	}
+
+	if s.ExpiresAt == 0 || s.IssuedAt == 0 {
+		return errors.Errorf("the entity statement of %s issued by %s must contain the iat and exp claims", s.Subject, s.Issuer)
+	}
+	if now.After(time.Unix(s.ExpiresAt, 0).Add(leeway)) {
+		return errors.Errorf("the entity statement of %s issued by %s has expired", s.Subject, s.Issuer)
+	}
+	if now.Add(leeway).Before(time.Unix(s.IssuedAt, 0)) {
+		return errors.Errorf("the entity statement of %s issued by %s is issued in the future", s.Subject, s.Issuer)
+	}
+	return nil
+}
+
+// Claims returns the statement as JWT claims.
+func (s *EntityStatement) Claims() (map[string]any, error) {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var claims map[string]any
+	if err := dec.Decode(&claims); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return integers(claims).(map[string]any), nil
+}
+
+// integers replaces the decoded numbers with integers where possible, because the JWT signer serializes floating
+// point numbers such as the time claims in exponent notation.
+func integers(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for k, e := range v {
+			v[k] = integers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = integers(e)
+		}
+	}
+	return v
+}
+
+// SameEntity returns true if both entity identifiers identify the same entity, ignoring a trailing slash.
+func SameEntity(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+// EntityConfigurationURL returns the location of the entity configuration of the entity.
+func EntityConfigurationURL(entityID string) string {
+	return strings.TrimSuffix(entityID, "/") + EntityConfigurationPath
+}
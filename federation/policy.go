@@ -0,0 +1,222 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package federation
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// The metadata policy operators of OpenID Federation 1.0, Section 6.1.3.
+const (
+	OperatorValue      = "value"
+	OperatorAdd        = "add"
+	OperatorDefault    = "default"
+	OperatorOneOf      = "one_of"
+	OperatorSubsetOf   = "subset_of"
+	OperatorSupersetOf = "superset_of"
+	OperatorEssential  = "essential"
+)
+
+// Operators are the metadata policy operators of one metadata parameter.
+type Operators map[string]any
+
+var ErrPolicyViolation = errors.New("the metadata violates the metadata policy of the federation")
+
+// CombinePolicies combines the metadata policy of a superior with the metadata policy of its subordinate. The
+// subordinate may only restrict the policy of its superior.
+func CombinePolicies(superior, subordinate map[string]Operators) (map[string]Operators, error) {
+	combined := make(map[string]Operators, len(superior)+len(subordinate))
+	for parameter, operators := range superior {
+		if err := validateOperators(parameter, operators); err != nil {
+			return nil, err
+		}
+		combined[parameter] = operators
+	}
+
+	for parameter, operators := range subordinate {
+		if err := validateOperators(parameter, operators); err != nil {
+			return nil, err
+		}
+		existing, ok := combined[parameter]
+		if !ok {
+			combined[parameter] = operators
+			continue
+		}
+		merged, err := combineOperators(parameter, existing, operators)
+		if err != nil {
+			return nil, err
+		}
+		combined[parameter] = merged
+	}
+	return combined, nil
+}
+
+func validateOperators(parameter string, operators Operators) error {
+	for operator, value := range operators {
+		switch operator {
+		case OperatorValue, OperatorDefault:
+		case OperatorAdd, OperatorOneOf, OperatorSubsetOf, OperatorSupersetOf:
+			if _, ok := value.([]any); !ok {
+				return errors.Errorf("the metadata policy operator %q of parameter %q must be an array", operator, parameter)
+			}
+		case OperatorEssential:
+			if _, ok := value.(bool); !ok {
+				return errors.Errorf("the metadata policy operator %q of parameter %q must be a boolean", operator, parameter)
+			}
+		default:
+			return errors.Errorf("the metadata policy operator %q of parameter %q is not supported", operator, parameter)
+		}
+	}
+	return nil
+}
+
+func combineOperators(parameter string, superior, subordinate Operators) (Operators, error) {
+	combined := make(Operators, len(superior)+len(subordinate))
+	for operator, value := range superior {
+		combined[operator] = value
+	}
+
+	for operator, value := range subordinate {
+		existing, ok := combined[operator]
+		if !ok {
+			combined[operator] = value
+			continue
+		}
+
+		switch operator {
+		case OperatorValue, OperatorDefault:
+			if !reflect.DeepEqual(existing, value) {
+				return nil, errors.Errorf("the metadata policies define conflicting %q operators for parameter %q", operator, parameter)
+			}
+		case OperatorAdd, OperatorSupersetOf:
+			combined[operator] = union(existing.([]any), value.([]any))
+		case OperatorOneOf, OperatorSubsetOf:
+			values := intersection(existing.([]any), value.([]any))
+			if operator == OperatorOneOf && len(values) == 0 {
+				return nil, errors.Errorf("the metadata policies define disjoint %q operators for parameter %q", operator, parameter)
+			}
+			combined[operator] = values
+		case OperatorEssential:
+			combined[operator] = existing.(bool) || value.(bool)
+		}
+	}
+	return combined, nil
+}
+
+// ApplyPolicy applies the metadata policy to the metadata in the order of OpenID Federation 1.0, Section 6.1.4.1, and
+// returns the resulting metadata. Unlike the specification, a subset_of operator which leaves no value is a policy
+// violation, because an empty parameter would fall back to the defaults of Ory Hydra.
+func ApplyPolicy(policy map[string]Operators, metadata map[string]any) (map[string]any, error) {
+	result := make(map[string]any, len(metadata))
+	for k, v := range metadata {
+		result[k] = v
+	}
+
+	parameters := make([]string, 0, len(policy))
+	for parameter := range policy {
+		parameters = append(parameters, parameter)
+	}
+	sort.Strings(parameters)
+
+	for _, parameter := range parameters {
+		operators := policy[parameter]
+		if err := validateOperators(parameter, operators); err != nil {
+			return nil, err
+		}
+
+		if value, ok := operators[OperatorValue]; ok {
+			if value == nil {
+				delete(result, parameter)
+			} else {
+				result[parameter] = value
+			}
+		}
+
+		if add, ok := operators[OperatorAdd]; ok {
+			current, present := result[parameter]
+			if !present {
+				result[parameter] = add
+			} else if values, ok := current.([]any); ok {
+				result[parameter] = union(values, add.([]any))
+			} else {
+				return nil, violation(parameter, "must be an array")
+			}
+		}
+
+		if value, ok := operators[OperatorDefault]; ok {
+			if _, present := result[parameter]; !present {
+				result[parameter] = value
+			}
+		}
+
+		if current, present := result[parameter]; present {
+			if oneOf, ok := operators[OperatorOneOf]; ok && !slices.ContainsFunc(oneOf.([]any), equals(current)) {
+				return nil, violation(parameter, "is not one of the allowed values")
+			}
+
+			if subsetOf, ok := operators[OperatorSubsetOf]; ok {
+				values, ok := current.([]any)
+				if !ok {
+					return nil, violation(parameter, "must be an array")
+				}
+				values = intersection(values, subsetOf.([]any))
+				if len(values) == 0 {
+					return nil, violation(parameter, "contains none of the allowed values")
+				}
+				result[parameter] = values
+			}
+
+			if supersetOf, ok := operators[OperatorSupersetOf]; ok {
+				values, ok := current.([]any)
+				if !ok {
+					return nil, violation(parameter, "must be an array")
+				}
+				for _, required := range supersetOf.([]any) {
+					if !slices.ContainsFunc(values, equals(required)) {
+						return nil, violation(parameter, "does not contain all required values")
+					}
+				}
+			}
+		}
+
+		if essential, _ := operators[OperatorEssential].(bool); essential {
+			if _, present := result[parameter]; !present {
+				return nil, violation(parameter, "is essential but missing")
+			}
+		}
+	}
+	return result, nil
+}
+
+func violation(parameter, reason string) error {
+	return errors.Wrapf(ErrPolicyViolation, "parameter %q %s", parameter, reason)
+}
+
+func equals(a any) func(any) bool {
+	return func(b any) bool { return reflect.DeepEqual(a, b) }
+}
+
+func union(a, b []any) []any {
+	result := slices.Clone(a)
+	for _, v := range b {
+		if !slices.ContainsFunc(result, equals(v)) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func intersection(a, b []any) []any {
+	result := make([]any, 0, len(a))
+	for _, v := range a {
+		if slices.ContainsFunc(b, equals(v)) && !slices.ContainsFunc(result, equals(v)) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,175 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package federation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/federation"
+)
+
+func TestCombinePolicies(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		d           string
+		superior    map[string]federation.Operators
+		subordinate map[string]federation.Operators
+		expected    map[string]federation.Operators
+		err         string
+	}{
+		{
+			d:           "subordinate adds parameters",
+			superior:    map[string]federation.Operators{"scope": {federation.OperatorDefault: "openid"}},
+			subordinate: map[string]federation.Operators{"grant_types": {federation.OperatorEssential: true}},
+			expected: map[string]federation.Operators{
+				"scope":       {federation.OperatorDefault: "openid"},
+				"grant_types": {federation.OperatorEssential: true},
+			},
+		},
+		{
+			d: "operators are combined",
+			superior: map[string]federation.Operators{"grant_types": {
+				federation.OperatorSubsetOf:   []any{"authorization_code", "refresh_token"},
+				federation.OperatorSupersetOf: []any{"authorization_code"},
+				federation.OperatorEssential:  false,
+			}},
+			subordinate: map[string]federation.Operators{"grant_types": {
+				federation.OperatorSubsetOf:   []any{"authorization_code", "client_credentials"},
+				federation.OperatorSupersetOf: []any{"refresh_token"},
+				federation.OperatorEssential:  true,
+			}},
+			expected: map[string]federation.Operators{"grant_types": {
+				federation.OperatorSubsetOf:   []any{"authorization_code"},
+				federation.OperatorSupersetOf: []any{"authorization_code", "refresh_token"},
+				federation.OperatorEssential:  true,
+			}},
+		},
+		{
+			d:           "equal values are allowed",
+			superior:    map[string]federation.Operators{"subject_type": {federation.OperatorValue: "pairwise"}},
+			subordinate: map[string]federation.Operators{"subject_type": {federation.OperatorValue: "pairwise"}},
+			expected:    map[string]federation.Operators{"subject_type": {federation.OperatorValue: "pairwise"}},
+		},
+		{
+			d:           "conflicting values are rejected",
+			superior:    map[string]federation.Operators{"subject_type": {federation.OperatorValue: "pairwise"}},
+			subordinate: map[string]federation.Operators{"subject_type": {federation.OperatorValue: "public"}},
+			err:         "conflicting",
+		},
+		{
+			d:           "disjoint one_of operators are rejected",
+			superior:    map[string]federation.Operators{"subject_type": {federation.OperatorOneOf: []any{"pairwise"}}},
+			subordinate: map[string]federation.Operators{"subject_type": {federation.OperatorOneOf: []any{"public"}}},
+			err:         "disjoint",
+		},
+		{
+			d:           "unsupported operators are rejected",
+			subordinate: map[string]federation.Operators{"scope": {"regexp": ".*"}},
+			err:         "not supported",
+		},
+		{
+			d:        "malformed operators are rejected",
+			superior: map[string]federation.Operators{"scope": {federation.OperatorSubsetOf: "openid"}},
+			err:      "must be an array",
+		},
+	} {
+		t.Run("case="+tc.d, func(t *testing.T) {
+			combined, err := federation.CombinePolicies(tc.superior, tc.subordinate)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, combined)
+		})
+	}
+}
+
+func TestApplyPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		d        string
+		policy   map[string]federation.Operators
+		metadata map[string]any
+		expected map[string]any
+		err      string
+	}{
+		{
+			d:        "value replaces the parameter",
+			policy:   map[string]federation.Operators{"subject_type": {federation.OperatorValue: "pairwise"}},
+			metadata: map[string]any{"subject_type": "public"},
+			expected: map[string]any{"subject_type": "pairwise"},
+		},
+		{
+			d:        "null value removes the parameter",
+			policy:   map[string]federation.Operators{"logo_uri": {federation.OperatorValue: nil}},
+			metadata: map[string]any{"logo_uri": "https://rp.example.com/logo.png", "client_name": "RP"},
+			expected: map[string]any{"client_name": "RP"},
+		},
+		{
+			d:        "add extends the parameter",
+			policy:   map[string]federation.Operators{"contacts": {federation.OperatorAdd: []any{"ops@federation.example.com"}}},
+			metadata: map[string]any{"contacts": []any{"rp@example.com"}},
+			expected: map[string]any{"contacts": []any{"rp@example.com", "ops@federation.example.com"}},
+		},
+		{
+			d:        "default sets missing parameters",
+			policy:   map[string]federation.Operators{"scope": {federation.OperatorDefault: "openid"}, "client_name": {federation.OperatorDefault: "Default"}},
+			metadata: map[string]any{"client_name": "RP"},
+			expected: map[string]any{"client_name": "RP", "scope": "openid"},
+		},
+		{
+			d:        "subset_of restricts the parameter",
+			policy:   map[string]federation.Operators{"grant_types": {federation.OperatorSubsetOf: []any{"authorization_code", "refresh_token"}}},
+			metadata: map[string]any{"grant_types": []any{"authorization_code", "client_credentials"}},
+			expected: map[string]any{"grant_types": []any{"authorization_code"}},
+		},
+		{
+			d:        "subset_of without remaining values is a violation",
+			policy:   map[string]federation.Operators{"grant_types": {federation.OperatorSubsetOf: []any{"authorization_code"}}},
+			metadata: map[string]any{"grant_types": []any{"client_credentials"}},
+			err:      "contains none of the allowed values",
+		},
+		{
+			d:        "one_of rejects other values",
+			policy:   map[string]federation.Operators{"subject_type": {federation.OperatorOneOf: []any{"pairwise"}}},
+			metadata: map[string]any{"subject_type": "public"},
+			err:      "is not one of the allowed values",
+		},
+		{
+			d:        "superset_of requires the values",
+			policy:   map[string]federation.Operators{"grant_types": {federation.OperatorSupersetOf: []any{"authorization_code"}}},
+			metadata: map[string]any{"grant_types": []any{"refresh_token"}},
+			err:      "does not contain all required values",
+		},
+		{
+			d:        "essential requires the parameter",
+			policy:   map[string]federation.Operators{"jwks_uri": {federation.OperatorEssential: true}},
+			metadata: map[string]any{},
+			err:      "is essential but missing",
+		},
+		{
+			d:        "operators ignore missing parameters",
+			policy:   map[string]federation.Operators{"grant_types": {federation.OperatorSubsetOf: []any{"authorization_code"}, federation.OperatorOneOf: []any{"x"}}},
+			metadata: map[string]any{"client_name": "RP"},
+			expected: map[string]any{"client_name": "RP"},
+		},
+	} {
+		t.Run("case="+tc.d, func(t *testing.T) {
+			result, err := federation.ApplyPolicy(tc.policy, tc.metadata)
+			if tc.err != "" {
+				assert.ErrorIs(t, err, federation.ErrPolicyViolation)
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package federation
+
+import (
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/x/httpx"
+)
+
+type InternalRegistry interface {
+	httpx.ClientProvider
+	config.Provider
+	Registry
+}
+
+type Registry interface {
+	ResolverProvider
+}
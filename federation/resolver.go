@@ -0,0 +1,388 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package federation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/ory/hydra/v2/driver/config"
+)
+
+const (
+	// leeway is the clock skew tolerated when validating the time claims of entity statements.
+	leeway = time.Minute
+
+	// maxStatementSize is the maximum size of a fetched entity statement.
+	maxStatementSize = 1 << 20
+
+	// maxCacheTTL is the maximum time resolved entity configurations and trust chains are cached for. They are
+	// never cached beyond their expiry.
+	maxCacheTTL = 10 * time.Minute
+
+	// errorCacheTTL is the time failed resolutions are cached for, which limits how often the statements of an
+	// entity are fetched.
+	errorCacheTTL = time.Minute
+
+	// maxConcurrentResolutions is the maximum number of entity configurations and trust chains resolved at the same
+	// time. Further resolutions fail with ErrTooManyResolutions until one completes.
+	maxConcurrentResolutions = 32
+)
+
+// ErrTooManyResolutions is returned if too many entity configurations and trust chains are resolved at the same time.
+var ErrTooManyResolutions = errors.New("too many trust chains are being resolved at the same time")
+
+type (
+	// Resolver resolves and validates the trust chains of entities up to the configured trust anchors, as described
+	// in OpenID Federation 1.0, Section 10. Trust marks and trust chain constraints are not evaluated.
+	//
+	// Resolved entity configurations and trust chains are cached, including failed resolutions, and the number of
+	// concurrent resolutions is limited, because resolutions are triggered by unauthenticated requests.
+	Resolver struct {
+		r       InternalRegistry
+		cache   *ristretto.Cache[string, *resolved]
+		group   singleflight.Group
+		limiter *semaphore.Weighted
+	}
+
+	ResolverProvider interface {
+		FederationResolver() *Resolver
+	}
+
+	// TrustChain is a validated chain of entity statements from an entity to a trust anchor.
+	TrustChain struct {
+		// EntityID is the entity identifier of the entity at the start of the chain.
+		EntityID string
+
+		// TrustAnchorID is the entity identifier of the trust anchor at the end of the chain.
+		TrustAnchorID string
+
+		// AuthorityHint is the entity identifier of the immediate superior of the entity in the chain.
+		AuthorityHint string
+
+		// ExpiresAt is the time the first statement of the chain expires.
+		ExpiresAt time.Time
+
+		// Statements are the signed statements of the chain, starting with the entity configuration of the entity
+		// and ending with the entity configuration of the trust anchor.
+		Statements []string
+
+		statements []*EntityStatement
+	}
+
+	resolved struct {
+		ec    *EntityStatement
+		chain *TrustChain
+		err   error
+	}
+)
+
+func NewResolver(r InternalRegistry) *Resolver {
+	cache, err := ristretto.NewCache(&ristretto.Config[string, *resolved]{
+		NumCounters: 10000 * 10,
+		MaxCost:     10000,
+		BufferItems: 64,
+		Cost: func(*resolved) int64 {
+			return 1
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &Resolver{r: r, cache: cache, limiter: semaphore.NewWeighted(maxConcurrentResolutions)}
+}
+
+// Resolve fetches the entity configuration of the entity and resolves its trust chain.
+func (r *Resolver) Resolve(ctx context.Context, entityID string) (*TrustChain, error) {
+	ec, err := r.EntityConfiguration(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	return r.ResolveEntityConfiguration(ctx, ec)
+}
+
+// EntityConfiguration fetches the entity configuration of the entity and verifies that it was signed with the keys
+// it contains. The entity configuration is not trusted before its trust chain was resolved.
+func (r *Resolver) EntityConfiguration(ctx context.Context, entityID string) (*EntityStatement, error) {
+	res := r.lookup(ctx, "ec:"+entityID, func() (*resolved, error) {
+		ec, err := r.fetch(ctx, EntityConfigurationURL(entityID))
+		if err != nil {
+			return nil, err
+		}
+		if !ec.IsEntityConfiguration() || !SameEntity(ec.Subject, entityID) {
+			return nil, errors.Errorf("the entity configuration of %s was issued by %s for %s", entityID, ec.Issuer, ec.Subject)
+		}
+		if err := ec.Verify(ec.JWKS, time.Now()); err != nil {
+			return nil, err
+		}
+		return &resolved{ec: ec}, nil
+	}, func(res *resolved) time.Time {
+		return time.Unix(res.ec.ExpiresAt, 0)
+	})
+	return res.ec, res.err
+}
+
+// ResolveEntityConfiguration resolves the trust chain of the entity configuration. The first chain which ends at
+// a configured trust anchor is returned.
+func (r *Resolver) ResolveEntityConfiguration(ctx context.Context, ec *EntityStatement) (*TrustChain, error) {
+	key, err := r.chainKey(ctx, ec)
+	if err != nil {
+		return nil, err
+	}
+	res := r.lookup(ctx, key, func() (*resolved, error) {
+		chain, err := r.resolveEntityConfiguration(ctx, ec)
+		if err != nil {
+			return nil, err
+		}
+		return &resolved{chain: chain}, nil
+	}, func(res *resolved) time.Time {
+		return res.chain.ExpiresAt
+	})
+	return res.chain, res.err
+}
+
+// chainKey returns the cache key of the trust chain of the entity configuration, which depends on the trust anchors
+// and the maximum path length configured for the network of the request.
+func (r *Resolver) chainKey(ctx context.Context, ec *EntityStatement) (string, error) {
+	raw, err := json.Marshal([]any{
+		ec.Raw(),
+		r.r.Config().FederationTrustAnchors(ctx),
+		r.r.Config().FederationMaxPathLength(ctx),
+	})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	h := sha256.Sum256(raw)
+	return "chain:" + hex.EncodeToString(h[:]), nil
+}
+
+// lookup returns the cached result for the key or resolves and caches it. Concurrent lookups of the same key share
+// one resolution. Failed resolutions are cached for errorCacheTTL, except if they failed because too many
+// resolutions were in progress.
+func (r *Resolver) lookup(ctx context.Context, key string, resolve func() (*resolved, error), expiresAt func(*resolved) time.Time) *resolved {
+	if res, ok := r.cache.Get(key); ok {
+		return res
+	}
+
+	v, _, _ := r.group.Do(key, func() (any, error) {
+		if !r.limiter.TryAcquire(1) {
+			return &resolved{err: errors.WithStack(ErrTooManyResolutions)}, nil
+		}
+		defer r.limiter.Release(1)
+
+		res, err := resolve()
+		if err != nil {
+			// Resolutions which failed because the request was canceled may succeed when retried.
+			res = &resolved{err: err}
+			if ctx.Err() == nil {
+				r.cache.SetWithTTL(key, res, 1, errorCacheTTL)
+			}
+		} else if ttl := min(time.Until(expiresAt(res)), maxCacheTTL); ttl > 0 {
+			r.cache.SetWithTTL(key, res, 1, ttl)
+		}
+		r.cache.Wait()
+		return res, nil
+	})
+	return v.(*resolved)
+}
+
+func (r *Resolver) resolveEntityConfiguration(ctx context.Context, ec *EntityStatement) (*TrustChain, error) {
+	if !ec.IsEntityConfiguration() {
+		return nil, errors.Errorf("the statement about %s issued by %s is not an entity configuration", ec.Subject, ec.Issuer)
+	}
+	if err := ec.Verify(ec.JWKS, time.Now()); err != nil {
+		return nil, err
+	}
+	if r.trustAnchor(ctx, ec.Subject) != nil {
+		return nil, errors.Errorf("the entity %s is a trust anchor", ec.Subject)
+	}
+
+	superiors, err := r.resolveSuperiors(ctx, ec, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &TrustChain{
+		EntityID:      ec.Subject,
+		TrustAnchorID: superiors[len(superiors)-1].Subject,
+		AuthorityHint: superiors[0].Issuer,
+		statements:    append([]*EntityStatement{ec}, superiors...),
+	}
+	for _, s := range chain.statements {
+		chain.Statements = append(chain.Statements, s.Raw())
+		if exp := time.Unix(s.ExpiresAt, 0); chain.ExpiresAt.IsZero() || exp.Before(chain.ExpiresAt) {
+			chain.ExpiresAt = exp
+		}
+	}
+	return chain, nil
+}
+
+// resolveSuperiors returns the subordinate statements about the entity and its superiors, followed by the entity
+// configuration of the trust anchor.
+func (r *Resolver) resolveSuperiors(ctx context.Context, ec *EntityStatement, intermediates int) ([]*EntityStatement, error) {
+	if len(ec.AuthorityHints) == 0 {
+		return nil, errors.Errorf("the entity %s has no authority hints and is not a trust anchor", ec.Subject)
+	}
+
+	var errs []error
+	for _, hint := range ec.AuthorityHints {
+		superiors, err := r.resolveSuperior(ctx, ec, hint, intermediates)
+		if err == nil {
+			return superiors, nil
+		}
+		errs = append(errs, errors.Wrapf(err, "unable to resolve the trust chain of %s through %s", ec.Subject, hint))
+	}
+	return nil, errors.WithStack(stderrors.Join(errs...))
+}
+
+func (r *Resolver) resolveSuperior(ctx context.Context, ec *EntityStatement, hint string, intermediates int) ([]*EntityStatement, error) {
+	now := time.Now()
+	anchor := r.trustAnchor(ctx, hint)
+	if anchor == nil && intermediates >= r.r.Config().FederationMaxPathLength(ctx) {
+		return nil, errors.New("the trust chain exceeds the maximum path length")
+	}
+
+	superior, err := r.fetch(ctx, EntityConfigurationURL(hint))
+	if err != nil {
+		return nil, err
+	}
+	if !superior.IsEntityConfiguration() || !SameEntity(superior.Subject, hint) {
+		return nil, errors.Errorf("the entity configuration of %s was issued by %s for %s", hint, superior.Issuer, superior.Subject)
+	}
+
+	// The keys of trust anchors are obtained out of band and take precedence over the keys they publish.
+	keys := superior.JWKS
+	if anchor != nil {
+		keys = &anchor.JWKS
+	}
+	if err := superior.Verify(keys, now); err != nil {
+		return nil, err
+	}
+
+	endpoint := superior.FederationEntity("federation_fetch_endpoint")
+	if endpoint == "" {
+		return nil, errors.Errorf("the entity %s does not publish a federation fetch endpoint", hint)
+	}
+	location, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "the federation fetch endpoint of %s is invalid", hint)
+	}
+	query := location.Query()
+	query.Set("sub", ec.Subject)
+	location.RawQuery = query.Encode()
+
+	statement, err := r.fetch(ctx, location.String())
+	if err != nil {
+		return nil, err
+	}
+	if !SameEntity(statement.Issuer, superior.Subject) || statement.Subject != ec.Subject {
+		return nil, errors.Errorf("the subordinate statement fetched from %s was issued by %s for %s", hint, statement.Issuer, statement.Subject)
+	}
+	if err := statement.Verify(keys, now); err != nil {
+		return nil, err
+	}
+
+	// The subordinate statement binds the keys of the entity, which must have signed its entity configuration.
+	if err := ec.Verify(statement.JWKS, now); err != nil {
+		return nil, err
+	}
+
+	if anchor != nil {
+		return []*EntityStatement{statement, superior}, nil
+	}
+
+	superiors, err := r.resolveSuperiors(ctx, superior, intermediates+1)
+	if err != nil {
+		return nil, err
+	}
+	return append([]*EntityStatement{statement}, superiors...), nil
+}
+
+func (r *Resolver) trustAnchor(ctx context.Context, entityID string) *config.FederationTrustAnchor {
+	anchors := r.r.Config().FederationTrustAnchors(ctx)
+	if i := slices.IndexFunc(anchors, func(a config.FederationTrustAnchor) bool {
+		return SameEntity(a.EntityID, entityID)
+	}); i >= 0 {
+		return &anchors[i]
+	}
+	return nil
+}
+
+// fetch fetches and decodes an entity statement. The signature of the statement is not verified.
+func (r *Resolver) fetch(ctx context.Context, location string) (*EntityStatement, error) {
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Accept", ContentTypeEntityStatement)
+
+	res, err := r.r.HTTPClient(ctx).Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to fetch the entity statement from %s", location)
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("expected status code 200 when fetching the entity statement from %s but got %d", location, res.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxStatementSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the entity statement from %s", location)
+	}
+	return ParseEntityStatement(strings.TrimSpace(string(body)))
+}
+
+// Metadata returns the metadata of the entity for the entity type, after applying the metadata of its immediate
+// superior and the combined metadata policies of the chain.
+func (c *TrustChain) Metadata(entityType string) (map[string]any, error) {
+	leaf := c.statements[0]
+	metadata, ok := leaf.Metadata[entityType]
+	if !ok {
+		return nil, errors.Errorf("the entity %s has no %s metadata", c.EntityID, entityType)
+	}
+
+	// The immediate superior may override metadata parameters of the entity.
+	result := make(map[string]any, len(metadata))
+	for k, v := range metadata {
+		result[k] = v
+	}
+	for k, v := range c.statements[1].Metadata[entityType] {
+		result[k] = v
+	}
+
+	// The policies are combined from the trust anchor down to the entity. The last statement is the entity
+	// configuration of the trust anchor, which has no policy for the chain.
+	var (
+		policy map[string]Operators
+		err    error
+	)
+	for i := len(c.statements) - 2; i > 0; i-- {
+		policy, err = CombinePolicies(policy, c.statements[i].MetadataPolicy[entityType])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ApplyPolicy(policy, result)
+}
+
+// JSONWebKeys returns the federation keys of the entity at the start of the chain.
+func (c *TrustChain) JSONWebKeys() *jose.JSONWebKeySet {
+	return c.statements[0].JWKS
+}
@@ -0,0 +1,137 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package federation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/federation"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/x/configx"
+)
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	f := testhelpers.NewFederation(t)
+	anchor := f.Entity(t, "anchor")
+	anchor.MetadataPolicy = map[string]map[string]federation.Operators{
+		federation.EntityTypeOpenIDRelyingParty: {
+			"grant_types": {federation.OperatorSubsetOf: []any{"authorization_code", "refresh_token"}},
+			"scope":       {federation.OperatorDefault: "openid"},
+		},
+	}
+	intermediate := f.Entity(t, "intermediate", anchor)
+	intermediate.MetadataPolicy = map[string]map[string]federation.Operators{
+		federation.EntityTypeOpenIDRelyingParty: {
+			"grant_types": {federation.OperatorSubsetOf: []any{"authorization_code"}},
+		},
+	}
+	rp := f.Entity(t, "rp", intermediate)
+	rp.Metadata[federation.EntityTypeOpenIDRelyingParty] = map[string]any{
+		"client_name": "Relying Party",
+		"grant_types": []any{"authorization_code", "refresh_token", "client_credentials"},
+	}
+	untrusted := f.Entity(t, "untrusted")
+	orphan := f.Entity(t, "orphan", untrusted)
+
+	newResolver := func(t *testing.T, values map[string]any) *federation.Resolver {
+		return testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(values))).FederationResolver()
+	}
+
+	t.Run("case=resolves the trust chain through an intermediate", func(t *testing.T) {
+		chain, err := newResolver(t, testhelpers.FederationConfig(t, anchor)).Resolve(t.Context(), rp.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, rp.ID, chain.EntityID)
+		assert.Equal(t, anchor.ID, chain.TrustAnchorID)
+		assert.Equal(t, intermediate.ID, chain.AuthorityHint)
+		assert.Len(t, chain.Statements, 4)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), chain.ExpiresAt, time.Minute)
+		assert.Len(t, chain.JSONWebKeys().Key(rp.Key.KeyID), 1)
+
+		metadata, err := chain.Metadata(federation.EntityTypeOpenIDRelyingParty)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"client_name": "Relying Party",
+			"grant_types": []any{"authorization_code"},
+			"scope":       "openid",
+		}, metadata)
+
+		_, err = chain.Metadata(federation.EntityTypeOpenIDProvider)
+		assert.Error(t, err)
+	})
+
+	t.Run("case=resolves the entity configuration presented by the entity", func(t *testing.T) {
+		ec, err := federation.ParseEntityStatement(rp.EntityConfiguration(t))
+		require.NoError(t, err)
+
+		chain, err := newResolver(t, testhelpers.FederationConfig(t, anchor)).ResolveEntityConfiguration(t.Context(), ec)
+		require.NoError(t, err)
+		assert.Equal(t, anchor.ID, chain.TrustAnchorID)
+	})
+
+	t.Run("case=rejects entities without a trusted anchor", func(t *testing.T) {
+		_, err := newResolver(t, testhelpers.FederationConfig(t, anchor)).Resolve(t.Context(), orphan.ID)
+		assert.ErrorContains(t, err, "has no authority hints and is not a trust anchor")
+	})
+
+	t.Run("case=rejects trust anchors whose keys do not match the configuration", func(t *testing.T) {
+		values := testhelpers.FederationConfig(t, anchor)
+		values[config.KeyFederationTrustAnchors] = []map[string]any{{
+			"entity_id": anchor.ID,
+			"jwks":      testhelpers.TrustAnchorConfig(t, untrusted)[0]["jwks"],
+		}}
+
+		_, err := newResolver(t, values).Resolve(t.Context(), rp.ID)
+		assert.ErrorContains(t, err, "signature of the entity statement")
+	})
+
+	t.Run("case=rejects trust chains exceeding the maximum path length", func(t *testing.T) {
+		values := testhelpers.FederationConfig(t, anchor)
+		values[config.KeyFederationMaxPathLength] = 0
+
+		_, err := newResolver(t, values).Resolve(t.Context(), rp.ID)
+		assert.ErrorContains(t, err, "maximum path length")
+	})
+
+	t.Run("case=rejects the trust anchor itself", func(t *testing.T) {
+		_, err := newResolver(t, testhelpers.FederationConfig(t, anchor)).Resolve(t.Context(), anchor.ID)
+		assert.ErrorContains(t, err, "is a trust anchor")
+	})
+
+	t.Run("case=rejects unknown entities", func(t *testing.T) {
+		_, err := newResolver(t, testhelpers.FederationConfig(t, anchor)).Resolve(t.Context(), f.Server.URL+"/unknown")
+		assert.ErrorContains(t, err, "expected status code 200")
+	})
+
+	t.Run("case=caches trust chains", func(t *testing.T) {
+		resolver := newResolver(t, testhelpers.FederationConfig(t, anchor))
+		first, err := resolver.Resolve(t.Context(), rp.ID)
+		require.NoError(t, err)
+
+		requests := f.Requests.Load()
+		second, err := resolver.Resolve(t.Context(), rp.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first.Statements, second.Statements)
+		assert.Equal(t, requests, f.Requests.Load())
+	})
+
+	t.Run("case=caches failed resolutions", func(t *testing.T) {
+		resolver := newResolver(t, testhelpers.FederationConfig(t, anchor))
+		_, err := resolver.Resolve(t.Context(), orphan.ID)
+		require.Error(t, err)
+
+		requests := f.Requests.Load()
+		_, err = resolver.Resolve(t.Context(), orphan.ID)
+		assert.ErrorContains(t, err, "has no authority hints and is not a trust anchor")
+		assert.Equal(t, requests, f.Requests.Load())
+	})
+}
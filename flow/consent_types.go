@@ -625,6 +625,35 @@ type OAuth2ConsentRequest struct {
 
 	// Context contains arbitrary information set by the login endpoint or is empty if not set.
 	Context sqlxx.JSONRawMessage `json:"context,omitempty"`
+
+	// Policy contains the decision of the consent policy on this consent request, if a consent policy is
+	// configured. It is meant for debugging the consent policy.
+	Policy *ConsentPolicyDecision `json:"policy,omitempty"`
+}
+
+const (
+	// ConsentPolicyGrant grants the requested scopes and audiences without asking the end-user.
+	ConsentPolicyGrant = "grant"
+	// ConsentPolicyDeny denies the consent request without asking the end-user.
+	ConsentPolicyDeny = "deny"
+	// ConsentPolicyPrompt asks the end-user using the consent endpoint.
+	ConsentPolicyPrompt = "prompt"
+)
+
+// OAuth 2.0 Consent Policy Decision
+//
+// The decision of the consent policy on a consent request.
+//
+// swagger:model oAuth2ConsentPolicyDecision
+type ConsentPolicyDecision struct {
+	// Decision is either "grant", "deny", or "prompt".
+	Decision string `json:"decision"`
+
+	// RemovedScope contains the requested scopes which the policy removed from the consent request.
+	RemovedScope sqlxx.StringSliceJSONFormat `json:"removed_scope,omitempty"`
+
+	// Reason is the explanation of the decision given by the policy.
+	Reason string `json:"reason,omitempty"`
 }
 
 func (r *OAuth2ConsentRequest) MarshalJSON() ([]byte, error) {
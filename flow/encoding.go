@@ -30,6 +30,7 @@ const (
 	consentChallenge
 	consentVerifier
 	authorizeCode
+	refreshToken
 )
 
 func (p purpose) RequestType() string {
@@ -42,6 +43,8 @@ func (p purpose) RequestType() string {
 		return "consent"
 	case authorizeCode:
 		return "authorization code"
+	case refreshToken:
+		return "refresh token"
 	default:
 		return "unknown"
 	}
@@ -57,6 +60,7 @@ var (
 	AsConsentChallenge = withPurpose(consentChallenge)
 	AsConsentVerifier  = withPurpose(consentVerifier)
 	AsAuthorizeCode    = withPurpose(authorizeCode)
+	AsRefreshToken     = withPurpose(refreshToken)
 )
 
 func additionalDataFromOpts(opts ...CodecOption) []byte {
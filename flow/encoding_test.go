@@ -136,6 +136,8 @@ func TestEncoding(t *testing.T) {
 			{"consent challenge decoded as login challenge", flow.AsConsentChallenge, flow.AsLoginChallenge},
 			{"authorization code decoded as login challenge", flow.AsAuthorizeCode, flow.AsLoginChallenge},
 			{"login challenge decoded as authorization code", flow.AsLoginChallenge, flow.AsAuthorizeCode},
+			{"refresh token decoded as authorization code", flow.AsRefreshToken, flow.AsAuthorizeCode},
+			{"consent verifier decoded as refresh token", flow.AsConsentVerifier, flow.AsRefreshToken},
 		}
 
 		for _, tc := range testCases {
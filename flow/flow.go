@@ -269,6 +269,7 @@ type Flow struct {
 	ConsentHandledAt sqlxx.NullTime `db:"consent_handled_at" json:"ch,omitempty"`
 
 	ConsentError       *RequestDeniedError      `db:"-" json:"cx"`
+	ConsentPolicy      *ConsentPolicyDecision   `db:"-" json:"cp,omitempty"`
 	SessionIDToken     sqlxx.MapStringInterface `db:"session_id_token" faker:"-" json:"st"`
 	SessionAccessToken sqlxx.MapStringInterface `db:"session_access_token" faker:"-" json:"sa"`
 }
@@ -456,6 +457,7 @@ func (f *Flow) GetConsentRequest(challenge string) *OAuth2ConsentRequest {
 		ACR:                  f.ACR,
 		AMR:                  f.AMR,
 		Context:              f.Context,
+		Policy:               f.ConsentPolicy,
 	}
 	// set some defaults for the API
 	if cs.RequestedAudience == nil {
@@ -41,6 +41,7 @@ func (f *Flow) setConsentRequest(r OAuth2ConsentRequest) {
 	f.ACR = r.ACR
 	f.AMR = r.AMR
 	f.Context = r.Context
+	f.ConsentPolicy = r.Policy
 }
 
 func TestFlow_HandleDeviceUserAuthRequest(t *testing.T) {
@@ -46,6 +46,9 @@ func (f *Fosite) WriteAuthorizeError(ctx context.Context, rw http.ResponseWriter
 
 	errors := rfcerr.ToValues()
 	errors.Set("state", ar.GetState())
+	if iss := f.Config.GetAuthorizationResponseIssuer(ctx); iss != "" {
+		errors.Set("iss", iss)
+	}
 
 	var redirectURIString string
 	if ar.GetResponseMode() == ResponseModeFormPost {
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
 
 	. "github.com/ory/hydra/v2/fosite"
@@ -47,6 +48,7 @@ func TestWriteAuthorizeError(t *testing.T) {
 		err                  *RFC6749Error
 		debug                bool
 		doNotUseLegacyFormat bool
+		issuer               string
 		mock                 func(*MockResponseWriter, *MockAuthorizeRequester)
 		checkHeader          func(*testing.T, int)
 	}{
@@ -421,12 +423,33 @@ func TestWriteAuthorizeError(t *testing.T) {
 				assert.Equal(t, "text/html;charset=UTF-8", header.Get("Content-Type"))
 			},
 		},
+		// 18
+		{
+			err:    ErrInvalidRequest,
+			issuer: "https://issuer.example.com",
+			mock: func(rw *MockResponseWriter, req *MockAuthorizeRequester) {
+				req.EXPECT().IsRedirectURIValid().Return(true)
+				req.EXPECT().GetRedirectURI().Return(copyUrl(purls[1]))
+				req.EXPECT().GetState().Return("foostate")
+				req.EXPECT().GetResponseMode().Return(ResponseModeQuery).Times(3)
+				rw.EXPECT().Header().Times(3).Return(header)
+				rw.EXPECT().WriteHeader(http.StatusSeeOther)
+			},
+			checkHeader: func(t *testing.T, k int) {
+				loc, err := url.Parse(header.Get("Location"))
+				require.NoError(t, err)
+				assert.Equal(t, "https://issuer.example.com", loc.Query().Get("iss"))
+				assert.Equal(t, "foostate", loc.Query().Get("state"))
+				assert.Equal(t, "bar", loc.Query().Get("foo"))
+			},
+		},
 	} {
 		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
 			oauth2 := &Fosite{
 				Config: &Config{
-					SendDebugMessagesToClients: c.debug,
-					UseLegacyErrorFormat:       !c.doNotUseLegacyFormat,
+					SendDebugMessagesToClients:  c.debug,
+					UseLegacyErrorFormat:        !c.doNotUseLegacyFormat,
+					AuthorizationResponseIssuer: c.issuer,
 				},
 			}
 
@@ -19,6 +19,11 @@ func (f *Fosite) WriteAuthorizeResponse(ctx context.Context, rw http.ResponseWri
 	wh.Set("Cache-Control", "no-store")
 	wh.Set("Pragma", "no-cache")
 
+	// https://www.rfc-editor.org/rfc/rfc9207#section-2
+	if iss := f.Config.GetAuthorizationResponseIssuer(ctx); iss != "" {
+		resp.AddParameter("iss", iss)
+	}
+
 	redir := ar.GetRedirectURI()
 	switch rm := ar.GetResponseMode(); rm {
 	case ResponseModeFormPost:
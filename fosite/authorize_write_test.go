@@ -6,6 +6,7 @@ package fosite_test
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -211,3 +212,43 @@ func TestWriteAuthorizeResponse(t *testing.T) {
 		t.Logf("Passed test case %d", k)
 	}
 }
+
+func TestWriteAuthorizeResponseIssuer(t *testing.T) {
+	f := &Fosite{Config: &Config{AuthorizationResponseIssuer: "https://issuer.example.com"}}
+
+	for _, tc := range []struct {
+		mode   ResponseModeType
+		expect func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			mode: ResponseModeQuery,
+			expect: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, "https://foobar.com/?code=foo&foo=bar&iss=https%3A%2F%2Fissuer.example.com", rec.Header().Get("Location"))
+			},
+		},
+		{
+			mode: ResponseModeFragment,
+			expect: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, "https://foobar.com/?foo=bar#code=foo&iss=https%3A%2F%2Fissuer.example.com", rec.Header().Get("Location"))
+			},
+		},
+		{
+			mode: ResponseModeFormPost,
+			expect: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Contains(t, rec.Body.String(), `name="iss" value="https://issuer.example.com"`)
+			},
+		},
+	} {
+		t.Run("mode="+string(tc.mode), func(t *testing.T) {
+			ar := NewAuthorizeRequest()
+			ar.RedirectURI, _ = url.Parse("https://foobar.com/?foo=bar")
+			ar.ResponseMode = tc.mode
+			resp := NewAuthorizeResponse()
+			resp.AddParameter("code", "foo")
+
+			rec := httptest.NewRecorder()
+			f.WriteAuthorizeResponse(context.Background(), rec, ar, resp)
+			tc.expect(t, rec)
+		})
+	}
+}
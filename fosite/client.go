@@ -71,6 +71,14 @@ type OpenIDConnectClient interface {
 	GetTokenEndpointAuthSigningAlgorithm() string
 }
 
+// TLSClientAuthClient represents a client capable of mutual TLS client authentication with a certificate issued by
+// a trusted certificate authority, see https://www.rfc-editor.org/rfc/rfc8705#section-2.1.
+type TLSClientAuthClient interface {
+	// GetTLSClientAuthSubjectDN returns the expected subject distinguished name of the certificate the client
+	// authenticates with using the tls_client_auth method.
+	GetTLSClientAuthSubjectDN() string
+}
+
 // ResponseModeClient represents a client capable of handling response_mode
 type ResponseModeClient interface {
 	// GetResponseMode returns the response modes that client is allowed to send
@@ -98,6 +106,7 @@ type DefaultOpenIDConnectClient struct {
 	RequestURIs                       []string            `json:"request_uris"`
 	RequestObjectSigningAlgorithm     string              `json:"request_object_signing_alg"`
 	TokenEndpointAuthSigningAlgorithm string              `json:"token_endpoint_auth_signing_alg"`
+	TLSClientAuthSubjectDN            string              `json:"tls_client_auth_subject_dn"`
 }
 
 type DefaultResponseModeClient struct {
@@ -185,6 +194,10 @@ func (c *DefaultOpenIDConnectClient) GetRequestURIs() []string {
 	return c.RequestURIs
 }
 
+func (c *DefaultOpenIDConnectClient) GetTLSClientAuthSubjectDN() string {
+	return c.TLSClientAuthSubjectDN
+}
+
 func (c *DefaultResponseModeClient) GetResponseModes() []ResponseModeType {
 	return c.ResponseModes
 }
@@ -124,16 +124,12 @@ func (f *Fosite) DefaultClientAuthenticationStrategy(ctx context.Context, r *htt
 			)
 
 			switch oidcClient.GetTokenEndpointAuthMethod() {
-			case "private_key_jwt":
+			case "private_key_jwt", "client_secret_jwt":
 				break
 			case "none":
 				return nil, errorsx.WithStack(ErrInvalidClient.WithHint("This requested OAuth 2.0 client does not support client authentication, however 'client_assertion' was provided in the request."))
-			case "client_secret_post":
-				fallthrough
-			case "client_secret_basic":
+			case "client_secret_post", "client_secret_basic", ClientAuthMethodTLSClientAuth, ClientAuthMethodSelfSignedTLSClientAuth:
 				return nil, errorsx.WithStack(ErrInvalidClient.WithHintf("This requested OAuth 2.0 client only supports client authentication method '%s', however 'client_assertion' was provided in the request.", oidcClient.GetTokenEndpointAuthMethod()))
-			case "client_secret_jwt":
-				fallthrough
 			default:
 				return nil, errorsx.WithStack(ErrInvalidClient.WithHintf("This requested OAuth 2.0 client only supports client authentication method '%s', however that method is not supported by this server.", oidcClient.GetTokenEndpointAuthMethod()))
 			}
@@ -144,6 +140,12 @@ func (f *Fosite) DefaultClientAuthenticationStrategy(ctx context.Context, r *htt
 
 			span.SetAttributes(attribute.String("token.method", string(t.Method)))
 
+			// The client secret must never be used as a public key and vice versa.
+			isSecretAlg := t.Method == jose.HS256 || t.Method == jose.HS384 || t.Method == jose.HS512
+			if isSecretAlg != (oidcClient.GetTokenEndpointAuthMethod() == "client_secret_jwt") {
+				return nil, errorsx.WithStack(ErrInvalidClient.WithHintf("The 'client_assertion' uses signing algorithm '%s', which can not be used with client authentication method '%s'.", t.Header["alg"], oidcClient.GetTokenEndpointAuthMethod()))
+			}
+
 			switch t.Method {
 			case jose.RS256, jose.RS384, jose.RS512:
 				return f.findClientPublicJWK(ctx, oidcClient, t, true)
@@ -152,7 +154,7 @@ func (f *Fosite) DefaultClientAuthenticationStrategy(ctx context.Context, r *htt
 			case jose.PS256, jose.PS384, jose.PS512:
 				return f.findClientPublicJWK(ctx, oidcClient, t, true)
 			case jose.HS256, jose.HS384, jose.HS512:
-				return nil, errorsx.WithStack(ErrInvalidClient.WithHint("This authorization server does not support client authentication method 'client_secret_jwt'."))
+				return f.findClientSecret(ctx, client)
 			default:
 				return nil, errorsx.WithStack(ErrInvalidClient.WithHintf("The 'client_assertion' request parameter uses unsupported signing algorithm '%s'.", t.Header["alg"]))
 			}
@@ -228,6 +230,17 @@ func (f *Fosite) DefaultClientAuthenticationStrategy(ctx context.Context, r *htt
 		return nil, errorsx.WithStack(ErrInvalidClient.WithWrap(err).WithDebug(err.Error()))
 	}
 
+	if oidcClient, ok := client.(OpenIDConnectClient); ok && isTLSClientAuthMethod(oidcClient.GetTokenEndpointAuthMethod()) {
+		span.SetAttributes(attribute.String("client.token_auth_method", oidcClient.GetTokenEndpointAuthMethod()))
+		if clientSecret != "" {
+			return nil, errorsx.WithStack(ErrInvalidClient.WithHintf("The OAuth 2.0 Client supports client authentication method '%s', but a client secret was provided in the request.", oidcClient.GetTokenEndpointAuthMethod()))
+		}
+		if err := f.authenticateTLSClient(ctx, r, oidcClient); err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+
 	if oidcClient, ok := client.(OpenIDConnectClient); !ok {
 		span.SetAttributes(attribute.Bool("client.isOIDCClient", false))
 		// If this isn't an OpenID Connect client then we actually don't care about any of this, just continue!
@@ -255,6 +268,26 @@ func (f *Fosite) DefaultClientAuthenticationStrategy(ctx context.Context, r *htt
 	return client, nil
 }
 
+// findClientSecret returns the key which verifies the HMAC of client assertions of the client_secret_jwt client
+// authentication method. Client secrets are usually stored hashed, so the client manager has to be able to recover
+// the secret.
+func (f *Fosite) findClientSecret(ctx context.Context, client Client) (interface{}, error) {
+	storage, ok := f.Store.FositeClientManager().(ClientSecretStorage)
+	if !ok {
+		return nil, errorsx.WithStack(ErrInvalidClient.WithHint("This authorization server does not support client authentication method 'client_secret_jwt'."))
+	}
+
+	secret, err := storage.GetClientSecret(ctx, client)
+	if err != nil {
+		return nil, errorsx.WithStack(ErrInvalidClient.WithHint("The client secret of the OAuth 2.0 Client can not be used for client authentication method 'client_secret_jwt'.").WithWrap(err).WithDebug(err.Error()))
+	} else if len(secret) == 0 {
+		return nil, errorsx.WithStack(ErrInvalidClient.WithHint("The OAuth 2.0 Client has no client secret registered, but it is needed to complete the request."))
+	}
+
+	// The key is wrapped, because the JWT parser passes a pointer to the key on, which is not supported for []byte.
+	return jose.JSONWebKey{Key: secret}, nil
+}
+
 func audienceMatchesTokenURLs(claims jwt.MapClaims, tokenURLs []string) bool {
 	for _, tokenURL := range tokenURLs {
 		if audienceMatchesTokenURL(claims, tokenURL) {
@@ -326,7 +326,7 @@ func TestAuthenticateClient(t *testing.T) {
 			expectErr: ErrInvalidClient,
 		},
 		{
-			d:      "should fail because token auth method is not private_key_jwt, but client_secret_jwt",
+			d:      "should fail because token auth method is client_secret_jwt, but the assertion is signed with a private key",
 			client: &DefaultOpenIDConnectClient{DefaultClient: &DefaultClient{ID: "bar", Secret: barSecret}, JSONWebKeys: rsaJwks, TokenEndpointAuthMethod: "client_secret_jwt"},
 			form: url.Values{"client_assertion": {mustGenerateRSAAssertion(t, jwt.MapClaims{
 				"sub": "bar",
@@ -544,6 +544,83 @@ func TestAuthenticateClient(t *testing.T) {
 	}
 }
 
+// clientSecretStore recovers the client secrets of client_secret_jwt clients.
+type clientSecretStore struct {
+	*storage.MemoryStore
+	secrets map[string][]byte
+}
+
+func (s *clientSecretStore) FositeClientManager() ClientManager {
+	return s
+}
+
+func (s *clientSecretStore) GetClientSecret(_ context.Context, client Client) ([]byte, error) {
+	return s.secrets[client.GetID()], nil
+}
+
+func TestAuthenticateClientWithClientSecretJWT(t *testing.T) {
+	const at = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	client := &DefaultOpenIDConnectClient{
+		DefaultClient:                     &DefaultClient{ID: "bar"},
+		TokenEndpointAuthMethod:           "client_secret_jwt",
+		TokenEndpointAuthSigningAlgorithm: "HS256",
+	}
+	newFosite := func(store Storage) *Fosite {
+		return &Fosite{
+			Store: store,
+			Config: &Config{
+				JWKSFetcherStrategy: NewDefaultJWKSFetcherStrategy(),
+				ClientSecretsHasher: &BCrypt{Config: &Config{HashCost: 6}},
+				TokenURL:            "token-url",
+			},
+		}
+	}
+	newStore := func(secret string) Storage {
+		store := storage.NewMemoryStore()
+		store.Clients[client.ID] = client
+		return &clientSecretStore{MemoryStore: store, secrets: map[string][]byte{client.ID: []byte(secret)}}
+	}
+	assertion := func(secret string) url.Values {
+		token := jwt.NewWithClaims(jose.HS256, jwt.MapClaims{
+			"sub": "bar",
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"iss": "bar",
+			"jti": "12345",
+			"aud": "token-url",
+		})
+		raw, err := token.SignedString([]byte(secret))
+		require.NoError(t, err)
+		return url.Values{"client_assertion": {raw}, "client_assertion_type": {at}}
+	}
+
+	t.Run("case=authenticates the client with the client secret", func(t *testing.T) {
+		c, err := newFosite(newStore("secret")).AuthenticateClient(t.Context(), new(http.Request), assertion("secret"))
+		require.NoError(t, err)
+		assert.Equal(t, client, c)
+	})
+
+	t.Run("case=fails if the assertion is signed with another secret", func(t *testing.T) {
+		_, err := newFosite(newStore("secret")).AuthenticateClient(t.Context(), new(http.Request), assertion("other"))
+		require.ErrorIs(t, err, ErrInvalidClient)
+	})
+
+	t.Run("case=fails if the client has no client secret", func(t *testing.T) {
+		_, err := newFosite(newStore("")).AuthenticateClient(t.Context(), new(http.Request), assertion("secret"))
+		require.ErrorIs(t, err, ErrInvalidClient)
+		assert.Contains(t, ErrorToRFC6749Error(err).HintField, "has no client secret registered")
+	})
+
+	t.Run("case=fails if the client secret can not be recovered", func(t *testing.T) {
+		store := storage.NewMemoryStore()
+		store.Clients[client.ID] = client
+
+		_, err := newFosite(store).AuthenticateClient(t.Context(), new(http.Request), assertion("secret"))
+		require.ErrorIs(t, err, ErrInvalidClient)
+		assert.Contains(t, ErrorToRFC6749Error(err).HintField, "does not support client authentication method 'client_secret_jwt'")
+	})
+}
+
 func TestAuthenticateClientTwice(t *testing.T) {
 	const at = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
 
@@ -0,0 +1,100 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fosite
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"net/http"
+
+	"github.com/go-jose/go-jose/v3"
+
+	"github.com/ory/x/errorsx"
+)
+
+const (
+	// ClientAuthMethodTLSClientAuth authenticates clients with a certificate issued by a trusted certificate
+	// authority, see https://www.rfc-editor.org/rfc/rfc8705#section-2.1.
+	ClientAuthMethodTLSClientAuth = "tls_client_auth"
+
+	// ClientAuthMethodSelfSignedTLSClientAuth authenticates clients with a self-signed certificate whose public key
+	// is part of the client's JSON Web Key Set, see https://www.rfc-editor.org/rfc/rfc8705#section-2.2.
+	ClientAuthMethodSelfSignedTLSClientAuth = "self_signed_tls_client_auth"
+)
+
+func isTLSClientAuthMethod(method string) bool {
+	return method == ClientAuthMethodTLSClientAuth || method == ClientAuthMethodSelfSignedTLSClientAuth
+}
+
+// authenticateTLSClient authenticates the client with the certificate it presented in the mutual TLS handshake.
+func (f *Fosite) authenticateTLSClient(ctx context.Context, r *http.Request, client OpenIDConnectClient) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return errorsx.WithStack(ErrInvalidClient.WithHintf("The OAuth 2.0 Client supports client authentication method '%s', but no client certificate was presented in the TLS handshake.", client.GetTokenEndpointAuthMethod()))
+	}
+	cert := r.TLS.PeerCertificates[0]
+
+	switch client.GetTokenEndpointAuthMethod() {
+	case ClientAuthMethodTLSClientAuth:
+		roots := f.Config.GetTLSClientAuthRootCAs(ctx)
+		if roots == nil {
+			return errorsx.WithStack(ErrInvalidClient.WithHint("This authorization server does not support client authentication method 'tls_client_auth'."))
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, c := range r.TLS.PeerCertificates[1:] {
+			intermediates.AddCert(c)
+		}
+		if _, err := cert.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}); err != nil {
+			return errorsx.WithStack(ErrInvalidClient.WithHint("The client certificate is not issued by a trusted certificate authority.").WithWrap(err).WithDebug(err.Error()))
+		}
+
+		tlsClient, ok := client.(TLSClientAuthClient)
+		if !ok || tlsClient.GetTLSClientAuthSubjectDN() == "" {
+			return errorsx.WithStack(ErrInvalidClient.WithHint("The OAuth 2.0 Client has no expected subject distinguished name registered, but it is needed to complete the request."))
+		} else if cert.Subject.String() != tlsClient.GetTLSClientAuthSubjectDN() {
+			return errorsx.WithStack(ErrInvalidClient.WithHintf("The subject distinguished name '%s' of the client certificate does not match the one registered for the OAuth 2.0 Client.", cert.Subject.String()))
+		}
+		return nil
+	case ClientAuthMethodSelfSignedTLSClientAuth:
+		if keys := client.GetJSONWebKeys(); keys != nil {
+			return matchClientCertificate(cert, keys)
+		} else if location := client.GetJSONWebKeysURI(); location != "" {
+			keys, err := f.Config.GetJWKSFetcherStrategy(ctx).Resolve(ctx, location, false)
+			if err != nil {
+				return err
+			}
+			if err := matchClientCertificate(cert, keys); err == nil {
+				return nil
+			}
+
+			// The client may have rotated its keys, so we refresh the cache once.
+			if keys, err = f.Config.GetJWKSFetcherStrategy(ctx).Resolve(ctx, location, true); err != nil {
+				return err
+			}
+			return matchClientCertificate(cert, keys)
+		}
+		return errorsx.WithStack(ErrInvalidClient.WithHint("The OAuth 2.0 Client has no JSON Web Keys set registered, but they are needed to complete the request."))
+	}
+	return errorsx.WithStack(ErrInvalidClient.WithHintf("The OAuth 2.0 Client supports client authentication method '%s', which is not a mutual TLS client authentication method.", client.GetTokenEndpointAuthMethod()))
+}
+
+// matchClientCertificate checks that the public key of the certificate is part of the key set.
+func matchClientCertificate(cert *x509.Certificate, keys *jose.JSONWebKeySet) error {
+	if public, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); ok {
+		for _, key := range keys.Keys {
+			if key.Use != "" && key.Use != "sig" {
+				continue
+			}
+			if public.Equal(key.Public().Key) {
+				return nil
+			}
+		}
+	}
+	return errorsx.WithStack(ErrInvalidClient.WithHint("The public key of the client certificate is not part of the JSON Web Key Set of the OAuth 2.0 Client."))
+}
@@ -0,0 +1,175 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fosite_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/internal/gen"
+	"github.com/ory/hydra/v2/fosite/storage"
+)
+
+func mustCreateCertificate(t *testing.T, subject string, public crypto.PublicKey, parent *x509.Certificate, signer crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: subject, Organization: []string{"Ory"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+	if parent == nil {
+		parent = template
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, public, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestAuthenticateClientTLS(t *testing.T) {
+	caKey := gen.MustRSAKey()
+	ca := mustCreateCertificate(t, "ca", &caKey.PublicKey, nil, caKey)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	clientKey := gen.MustES256Key()
+	issued := mustCreateCertificate(t, "client", &clientKey.PublicKey, ca, caKey)
+	selfSigned := mustCreateCertificate(t, "client", &clientKey.PublicKey, nil, clientKey)
+
+	otherKey := gen.MustES256Key()
+	otherSelfSigned := mustCreateCertificate(t, "client", &otherKey.PublicKey, nil, otherKey)
+
+	newRequest := func(certs ...*x509.Certificate) *http.Request {
+		r := new(http.Request)
+		if len(certs) > 0 {
+			r.TLS = &tls.ConnectionState{PeerCertificates: certs}
+		}
+		return r
+	}
+
+	tlsClient := &DefaultOpenIDConnectClient{
+		DefaultClient:           &DefaultClient{ID: "tls-client"},
+		TokenEndpointAuthMethod: ClientAuthMethodTLSClientAuth,
+		TLSClientAuthSubjectDN:  "CN=client,O=Ory",
+	}
+	selfSignedClient := &DefaultOpenIDConnectClient{
+		DefaultClient:           &DefaultClient{ID: "self-signed-client"},
+		TokenEndpointAuthMethod: ClientAuthMethodSelfSignedTLSClientAuth,
+		JSONWebKeys: &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{KeyID: "kid-foo", Use: "sig", Key: &clientKey.PublicKey},
+		}},
+	}
+
+	for _, tc := range []struct {
+		d         string
+		client    *DefaultOpenIDConnectClient
+		roots     *x509.CertPool
+		r         *http.Request
+		form      url.Values
+		expectErr error
+	}{
+		{
+			d:      "passes with a certificate issued by a trusted certificate authority",
+			client: tlsClient,
+			roots:  roots,
+			r:      newRequest(issued),
+			form:   url.Values{"client_id": {"tls-client"}},
+		},
+		{
+			d:         "fails without a client certificate",
+			client:    tlsClient,
+			roots:     roots,
+			r:         newRequest(),
+			form:      url.Values{"client_id": {"tls-client"}},
+			expectErr: ErrInvalidClient,
+		},
+		{
+			d:         "fails if tls_client_auth is not configured",
+			client:    tlsClient,
+			r:         newRequest(issued),
+			form:      url.Values{"client_id": {"tls-client"}},
+			expectErr: ErrInvalidClient,
+		},
+		{
+			d:         "fails with a certificate which is not issued by a trusted certificate authority",
+			client:    tlsClient,
+			roots:     roots,
+			r:         newRequest(selfSigned),
+			form:      url.Values{"client_id": {"tls-client"}},
+			expectErr: ErrInvalidClient,
+		},
+		{
+			d: "fails if the subject distinguished name does not match",
+			client: &DefaultOpenIDConnectClient{
+				DefaultClient:           &DefaultClient{ID: "tls-client"},
+				TokenEndpointAuthMethod: ClientAuthMethodTLSClientAuth,
+				TLSClientAuthSubjectDN:  "CN=other,O=Ory",
+			},
+			roots:     roots,
+			r:         newRequest(issued),
+			form:      url.Values{"client_id": {"tls-client"}},
+			expectErr: ErrInvalidClient,
+		},
+		{
+			d:         "fails if a client secret is provided",
+			client:    tlsClient,
+			roots:     roots,
+			r:         newRequest(issued),
+			form:      url.Values{"client_id": {"tls-client"}, "client_secret": {"secret"}},
+			expectErr: ErrInvalidClient,
+		},
+		{
+			d:      "passes with a self-signed certificate of a key in the key set",
+			client: selfSignedClient,
+			r:      newRequest(selfSigned),
+			form:   url.Values{"client_id": {"self-signed-client"}},
+		},
+		{
+			d:         "fails with a self-signed certificate of a key not in the key set",
+			client:    selfSignedClient,
+			r:         newRequest(otherSelfSigned),
+			form:      url.Values{"client_id": {"self-signed-client"}},
+			expectErr: ErrInvalidClient,
+		},
+	} {
+		t.Run("case="+tc.d, func(t *testing.T) {
+			store := storage.NewMemoryStore()
+			store.Clients[tc.client.ID] = tc.client
+			f := &Fosite{
+				Store: store,
+				Config: &Config{
+					JWKSFetcherStrategy:  NewDefaultJWKSFetcherStrategy(),
+					TLSClientAuthRootCAs: tc.roots,
+				},
+			}
+
+			c, err := f.AuthenticateClient(context.Background(), tc.r, tc.form)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.client, c)
+		})
+	}
+}
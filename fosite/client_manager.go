@@ -22,3 +22,12 @@ type ClientManager interface {
 	// not be replayed due to the expiry.
 	SetClientAssertionJWT(ctx context.Context, jti string, exp time.Time) error
 }
+
+// ClientSecretStorage is implemented by client managers which can recover the client secret, which is needed to
+// verify the client assertions of the client_secret_jwt client authentication method, see
+// https://openid.net/specs/openid-connect-core-1_0.html#ClientAuthentication.
+type ClientSecretStorage interface {
+	// GetClientSecret returns the client secret of the client in plain text, or an error if it can not be
+	// recovered, for example because only its hash is stored.
+	GetClientSecret(ctx context.Context, client Client) ([]byte, error)
+}
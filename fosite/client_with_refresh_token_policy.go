@@ -0,0 +1,17 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fosite
+
+import "time"
+
+// ClientWithRefreshTokenPolicy is a client which customizes the rotation and the lifetime of its refresh tokens.
+type ClientWithRefreshTokenPolicy interface {
+	// IsRefreshTokenRotationDisabled returns true if the refresh token grant returns the presented refresh token
+	// instead of issuing a new one.
+	IsRefreshTokenRotationDisabled() bool
+
+	// GetRefreshTokenMaxLifespan returns the maximum lifetime of the refresh tokens of an authorization, measured
+	// from the authentication of the end-user. Zero means that the lifetime is not limited.
+	GetRefreshTokenMaxLifespan() time.Duration
+}
@@ -5,6 +5,7 @@ package fosite
 
 import (
 	"context"
+	"crypto/x509"
 	"hash"
 	"html/template"
 	"net/url"
@@ -117,6 +118,13 @@ type IDTokenIssuerProvider interface {
 	GetIDTokenIssuer(ctx context.Context) string
 }
 
+// AuthorizationResponseIssuerProvider returns the provider for configuring the authorization response issuer.
+type AuthorizationResponseIssuerProvider interface {
+	// GetAuthorizationResponseIssuer returns the issuer to be added to authorization responses as the "iss"
+	// parameter, see https://www.rfc-editor.org/rfc/rfc9207. If empty, the parameter is omitted.
+	GetAuthorizationResponseIssuer(ctx context.Context) string
+}
+
 // JWTScopeFieldProvider returns the provider for configuring the JWT scope field.
 type JWTScopeFieldProvider interface {
 	// GetJWTScopeField returns the JWT scope field.
@@ -263,6 +271,14 @@ type FormPostHTMLTemplateProvider interface {
 	GetFormPostHTMLTemplate(ctx context.Context) *template.Template
 }
 
+// TLSClientAuthRootCAsProvider returns the provider for configuring mutual TLS client authentication.
+type TLSClientAuthRootCAsProvider interface {
+	// GetTLSClientAuthRootCAs returns the certificate authorities which issue the client certificates of the
+	// tls_client_auth method, see https://www.rfc-editor.org/rfc/rfc8705#section-2.1. If nil, the method is not
+	// supported.
+	GetTLSClientAuthRootCAs(ctx context.Context) *x509.CertPool
+}
+
 type TokenURLProvider interface {
 	// GetTokenURLs returns the token URL.
 	GetTokenURLs(ctx context.Context) []string
@@ -5,6 +5,7 @@ package fosite
 
 import (
 	"context"
+	"crypto/x509"
 	"hash"
 	"html/template"
 	"net/url"
@@ -35,6 +36,8 @@ var (
 	_ RefreshTokenScopesProvider                   = (*Config)(nil)
 	_ DisableRefreshTokenValidationProvider        = (*Config)(nil)
 	_ AccessTokenIssuerProvider                    = (*Config)(nil)
+	_ AuthorizationResponseIssuerProvider          = (*Config)(nil)
+	_ TLSClientAuthRootCAsProvider                 = (*Config)(nil)
 	_ JWTScopeFieldProvider                        = (*Config)(nil)
 	_ AllowedPromptsProvider                       = (*Config)(nil)
 	_ OmitRedirectScopeParamProvider               = (*Config)(nil)
@@ -188,6 +191,13 @@ type Config struct {
 	// AccessTokenIssuer is the issuer to be used when generating access tokens.
 	AccessTokenIssuer string
 
+	// AuthorizationResponseIssuer is the issuer to be added to authorization responses as the "iss" parameter.
+	AuthorizationResponseIssuer string
+
+	// TLSClientAuthRootCAs are the certificate authorities which issue the client certificates of the
+	// tls_client_auth method. If nil, the method is not supported.
+	TLSClientAuthRootCAs *x509.CertPool
+
 	// ClientSecretsHasher is the hasher used to hash OAuth2 Client Secrets.
 	ClientSecretsHasher Hasher
 
@@ -289,6 +299,10 @@ func (c *Config) GetSecretsHasher(ctx context.Context) Hasher {
 	return c.ClientSecretsHasher
 }
 
+func (c *Config) GetTLSClientAuthRootCAs(ctx context.Context) *x509.CertPool {
+	return c.TLSClientAuthRootCAs
+}
+
 func (c *Config) GetTokenURLs(ctx context.Context) []string {
 	return []string{c.TokenURL}
 }
@@ -357,6 +371,10 @@ func (c *Config) GetAccessTokenIssuer(ctx context.Context) string {
 	return c.AccessTokenIssuer
 }
 
+func (c *Config) GetAuthorizationResponseIssuer(ctx context.Context) string {
+	return c.AuthorizationResponseIssuer
+}
+
 func (c *Config) GetJWTScopeField(ctx context.Context) jwt.JWTScopeFieldEnum {
 	return c.JWTScopeClaimKey
 }
@@ -116,6 +116,7 @@ type Configurator interface {
 	SanitationAllowedProvider
 	JWTScopeFieldProvider
 	AccessTokenIssuerProvider
+	AuthorizationResponseIssuerProvider
 	DisableRefreshTokenValidationProvider
 	RefreshTokenScopesProvider
 	AccessTokenLifespanProvider
@@ -141,6 +142,7 @@ type Configurator interface {
 	MessageCatalogProvider
 	FormPostHTMLTemplateProvider
 	TokenURLProvider
+	TLSClientAuthRootCAsProvider
 	GetSecretsHashingProvider
 	AuthorizeEndpointHandlersProvider
 	TokenEndpointHandlersProvider
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
 )
 
 var _ fosite.TokenEndpointHandler = (*RefreshTokenGrantHandler)(nil)
@@ -89,6 +90,14 @@ func (c *RefreshTokenGrantHandler) HandleTokenEndpointRequest(ctx context.Contex
 		return errorsx.WithStack(fosite.ErrInvalidGrant.WithHint("The OAuth 2.0 Client ID from this request does not match the ID during the initial token issuance."))
 	}
 
+	// The refresh tokens of an authorization can not outlive the maximum lifetime configured by the client, after
+	// which the end-user has to authenticate again.
+	deadline := refreshTokenDeadline(request.GetClient(), originalRequest.GetSession())
+	if !deadline.IsZero() && time.Now().UTC().After(deadline) {
+		return errorsx.WithStack(fosite.ErrInvalidGrant.
+			WithHint("The refresh token exceeded the maximum lifetime of the authorization, the end-user must authenticate again."))
+	}
+
 	request.SetID(originalRequest.GetID())
 	request.SetSession(originalRequest.GetSession().Clone())
 	request.SetRequestedScopes(originalRequest.GetRequestedScopes())
@@ -112,14 +121,42 @@ func (c *RefreshTokenGrantHandler) HandleTokenEndpointRequest(ctx context.Contex
 	atLifespan := fosite.GetEffectiveLifespan(request.GetClient(), fosite.GrantTypeRefreshToken, fosite.AccessToken, c.Config.GetAccessTokenLifespan(ctx))
 	request.GetSession().SetExpiresAt(fosite.AccessToken, time.Now().UTC().Add(atLifespan).Round(time.Second))
 
+	if isRefreshTokenRotationDisabled(request.GetClient()) {
+		// The presented refresh token is returned again and keeps its expiry.
+		return nil
+	}
+
 	rtLifespan := fosite.GetEffectiveLifespan(request.GetClient(), fosite.GrantTypeRefreshToken, fosite.RefreshToken, c.Config.GetRefreshTokenLifespan(ctx))
 	if rtLifespan > -1 {
 		request.GetSession().SetExpiresAt(fosite.RefreshToken, time.Now().UTC().Add(rtLifespan).Round(time.Second))
 	}
+	if !deadline.IsZero() && (rtLifespan < 0 || request.GetSession().GetExpiresAt(fosite.RefreshToken).After(deadline)) {
+		request.GetSession().SetExpiresAt(fosite.RefreshToken, deadline)
+	}
 
 	return nil
 }
 
+// isRefreshTokenRotationDisabled returns true if the client opted out of refresh token rotation.
+func isRefreshTokenRotationDisabled(client fosite.Client) bool {
+	c, ok := client.(fosite.ClientWithRefreshTokenPolicy)
+	return ok && c.IsRefreshTokenRotationDisabled()
+}
+
+// refreshTokenDeadline returns the time after which the refresh tokens of the session are no longer accepted, or
+// the zero time if the client does not limit their lifetime or the time of the authentication is unknown.
+func refreshTokenDeadline(client fosite.Client, session fosite.Session) time.Time {
+	c, ok := client.(fosite.ClientWithRefreshTokenPolicy)
+	if !ok || c.GetRefreshTokenMaxLifespan() <= 0 {
+		return time.Time{}
+	}
+	s, ok := session.(interface{ IDTokenClaims() *jwt.IDTokenClaims })
+	if !ok || s.IDTokenClaims() == nil || s.IDTokenClaims().AuthTime.IsZero() {
+		return time.Time{}
+	}
+	return s.IDTokenClaims().AuthTime.UTC().Add(c.GetRefreshTokenMaxLifespan()).Round(time.Second)
+}
+
 // PopulateTokenEndpointResponse implements https://tools.ietf.org/html/rfc6749#section-6
 func (c *RefreshTokenGrantHandler) PopulateTokenEndpointResponse(ctx context.Context, requester fosite.AccessRequester, responder fosite.AccessResponder) (err error) {
 	if !c.CanHandleTokenEndpointRequest(ctx, requester) {
@@ -131,6 +168,17 @@ func (c *RefreshTokenGrantHandler) PopulateTokenEndpointResponse(ctx context.Con
 		return errors.WithStack(fosite.ErrServerError.WithWrap(err).WithDebug(err.Error()))
 	}
 
+	storeReq := requester.Sanitize([]string{})
+	storeReq.SetID(requester.GetID())
+
+	if isRefreshTokenRotationDisabled(requester.GetClient()) {
+		if err := c.Storage.AccessTokenStorage().CreateAccessTokenSession(ctx, accessSignature, storeReq); err != nil {
+			return handleRefreshTokenEndpointStorageError(err)
+		}
+		c.populateResponse(ctx, requester, responder, accessToken, requester.GetRequestForm().Get("refresh_token"))
+		return nil
+	}
+
 	refreshToken, refreshSignature, err := c.Strategy.RefreshTokenStrategy().GenerateRefreshToken(ctx, requester)
 	if err != nil {
 		return errors.WithStack(fosite.ErrServerError.WithWrap(err).WithDebug(err.Error()))
@@ -138,11 +186,8 @@ func (c *RefreshTokenGrantHandler) PopulateTokenEndpointResponse(ctx context.Con
 
 	signature := c.Strategy.RefreshTokenStrategy().RefreshTokenSignature(ctx, requester.GetRequestForm().Get("refresh_token"))
 
-	storeReq := requester.Sanitize([]string{})
-	storeReq.SetID(requester.GetID())
-
 	err = c.Storage.Transaction(ctx, func(ctx context.Context) error {
-		if err := c.Storage.RefreshTokenStorage().RotateRefreshToken(ctx, requester.GetID(), signature); err != nil {
+		if err := c.rotateRefreshToken(ctx, requester, signature); err != nil {
 			return err
 		}
 		if err := c.Storage.AccessTokenStorage().CreateAccessTokenSession(ctx, accessSignature, storeReq); err != nil {
@@ -157,14 +202,27 @@ func (c *RefreshTokenGrantHandler) PopulateTokenEndpointResponse(ctx context.Con
 		return handleRefreshTokenEndpointStorageError(err)
 	}
 
+	c.populateResponse(ctx, requester, responder, accessToken, refreshToken)
+	return nil
+}
+
+// rotateRefreshToken rotates the refresh token with the given signature, passing the client of the request to the
+// storage if it implements ClientRefreshTokenRotator.
+func (c *RefreshTokenGrantHandler) rotateRefreshToken(ctx context.Context, requester fosite.AccessRequester, signature string) error {
+	storage := c.Storage.RefreshTokenStorage()
+	if r, ok := storage.(ClientRefreshTokenRotator); ok {
+		return r.RotateClientRefreshToken(ctx, requester.GetClient(), requester.GetID(), signature)
+	}
+	return storage.RotateRefreshToken(ctx, requester.GetID(), signature)
+}
+
+func (c *RefreshTokenGrantHandler) populateResponse(ctx context.Context, requester fosite.AccessRequester, responder fosite.AccessResponder, accessToken, refreshToken string) {
 	responder.SetAccessToken(accessToken)
 	responder.SetTokenType("bearer")
 	atLifespan := fosite.GetEffectiveLifespan(requester.GetClient(), fosite.GrantTypeRefreshToken, fosite.AccessToken, c.Config.GetAccessTokenLifespan(ctx))
 	responder.SetExpiresIn(getExpiresIn(requester, fosite.AccessToken, atLifespan, time.Now().UTC()))
 	responder.SetScopes(requester.GetGrantedScopes())
 	responder.SetExtra("refresh_token", refreshToken)
-
-	return nil
 }
 
 // Reference: https://tools.ietf.org/html/rfc6819#section-5.2.2.3
@@ -189,7 +247,14 @@ func (c *RefreshTokenGrantHandler) handleRefreshTokenReuse(ctx context.Context,
 		}
 		return nil
 	})
-	return handleRefreshTokenEndpointStorageError(err)
+	if err != nil {
+		return handleRefreshTokenEndpointStorageError(err)
+	}
+
+	if p, ok := c.Storage.(RefreshTokenReuseHandlerProvider); ok {
+		return handleRefreshTokenEndpointStorageError(p.RefreshTokenReuseHandler().HandleRefreshTokenReuse(ctx, req))
+	}
+	return nil
 }
 
 func handleRefreshTokenEndpointStorageError(storageErr error) (err error) {
@@ -18,8 +18,10 @@ import (
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/compose"
 	"github.com/ory/hydra/v2/fosite/handler/oauth2"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/fosite/internal"
 	"github.com/ory/hydra/v2/fosite/storage"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
 )
 
 func TestRefreshFlow_HandleTokenEndpointRequest(t *testing.T) {
@@ -459,6 +461,80 @@ func TestRefreshFlowTransactional_HandleTokenEndpointRequest(t *testing.T) {
 	}
 }
 
+type refreshTokenReuseHandlerFunc func(ctx context.Context, request fosite.Requester) error
+
+func (f refreshTokenReuseHandlerFunc) HandleRefreshTokenReuse(ctx context.Context, request fosite.Requester) error {
+	return f(ctx, request)
+}
+
+type refreshTokenReuseHandlerProvider refreshTokenReuseHandlerFunc
+
+func (p refreshTokenReuseHandlerProvider) RefreshTokenReuseHandler() oauth2.RefreshTokenReuseHandler {
+	return refreshTokenReuseHandlerFunc(p)
+}
+
+func TestRefreshFlowTransactional_HandleTokenEndpointRequest_ReuseHandler(t *testing.T) {
+	ctx := context.Background()
+	request := fosite.NewAccessRequest(&fosite.DefaultSession{})
+	request.GrantTypes = fosite.Arguments{"refresh_token"}
+	request.Client = &fosite.DefaultClient{ID: "foo", GrantTypes: fosite.Arguments{"refresh_token"}}
+
+	for _, tc := range []struct {
+		description string
+		handlerErr  error
+		expectError error
+	}{
+		{description: "should notify the reuse handler", expectError: fosite.ErrInvalidGrant},
+		{description: "should fail if the reuse handler fails", handlerErr: errors.New("boom"), expectError: fosite.ErrServerError},
+	} {
+		t.Run("scenario="+tc.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			mockTransactional := internal.NewMockTransactional(ctrl)
+			mockTokenRevocationStorageProvider := internal.NewMockTokenRevocationStorageProvider(ctrl)
+			mockTokenRevocationStorage := internal.NewMockTokenRevocationStorage(ctrl)
+			mockRefreshTokenStorageProvider := internal.NewMockRefreshTokenStorageProvider(ctrl)
+			mockRefreshTokenStorage := internal.NewMockRefreshTokenStorage(ctrl)
+
+			mockRefreshTokenStorageProvider.EXPECT().RefreshTokenStorage().Return(mockRefreshTokenStorage).Times(2)
+			mockRefreshTokenStorage.EXPECT().GetRefreshTokenSession(ctx, gomock.Any(), gomock.Any()).Return(request, fosite.ErrInactiveToken)
+			mockTransactional.EXPECT().Transaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error { return f(ctx) })
+			mockRefreshTokenStorage.EXPECT().DeleteRefreshTokenSession(ctx, gomock.Any()).Return(nil)
+			mockTokenRevocationStorageProvider.EXPECT().TokenRevocationStorage().Return(mockTokenRevocationStorage).Times(2)
+			mockTokenRevocationStorage.EXPECT().RevokeRefreshToken(ctx, gomock.Any()).Return(nil)
+			mockTokenRevocationStorage.EXPECT().RevokeAccessToken(ctx, gomock.Any()).Return(nil)
+
+			var reused fosite.Requester
+			handler := oauth2.RefreshTokenGrantHandler{
+				Storage: &struct {
+					*internal.MockAccessTokenStorageProvider
+					*internal.MockRefreshTokenStorageProvider
+					*internal.MockTokenRevocationStorageProvider
+					*internal.MockTransactional
+					refreshTokenReuseHandlerProvider
+				}{
+					MockAccessTokenStorageProvider:     internal.NewMockAccessTokenStorageProvider(ctrl),
+					MockRefreshTokenStorageProvider:    mockRefreshTokenStorageProvider,
+					MockTokenRevocationStorageProvider: mockTokenRevocationStorageProvider,
+					MockTransactional:                  mockTransactional,
+					refreshTokenReuseHandlerProvider: refreshTokenReuseHandlerProvider(func(_ context.Context, r fosite.Requester) error {
+						reused = r
+						return tc.handlerErr
+					}),
+				},
+				Strategy: &compose.CommonStrategyProvider{CoreStrategy: hmacshaStrategy},
+				Config:   &fosite.Config{},
+			}
+
+			err := handler.HandleTokenEndpointRequest(ctx, request)
+			require.ErrorIs(t, err, tc.expectError)
+			assert.Equal(t, request, reused)
+		})
+	}
+}
+
 func TestRefreshFlow_PopulateTokenEndpointResponse(t *testing.T) {
 	var areq *fosite.AccessRequest
 	var aresp *fosite.AccessResponse
@@ -871,3 +947,112 @@ func TestRefreshFlowTransactional_PopulateTokenEndpointResponse(t *testing.T) {
 		})
 	}
 }
+
+type refreshTokenPolicyClient struct {
+	*fosite.DefaultClient
+	rotationDisabled bool
+	maxLifespan      time.Duration
+}
+
+func (c *refreshTokenPolicyClient) IsRefreshTokenRotationDisabled() bool {
+	return c.rotationDisabled
+}
+
+func (c *refreshTokenPolicyClient) GetRefreshTokenMaxLifespan() time.Duration {
+	return c.maxLifespan
+}
+
+func TestRefreshFlow_RefreshTokenPolicy(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryStore()
+	h := &oauth2.RefreshTokenGrantHandler{
+		Storage:  store,
+		Strategy: &compose.CommonStrategyProvider{CoreStrategy: hmacshaStrategy},
+		Config: &fosite.Config{
+			AccessTokenLifespan:      time.Hour,
+			RefreshTokenLifespan:     time.Hour,
+			ScopeStrategy:            fosite.HierarchicScopeStrategy,
+			AudienceMatchingStrategy: fosite.DefaultAudienceMatchingStrategy,
+		},
+	}
+
+	issue := func(t *testing.T, client fosite.Client, authTime time.Time) string {
+		token, signature, err := hmacshaStrategy.GenerateRefreshToken(ctx, nil)
+		require.NoError(t, err)
+		req := fosite.NewRequest()
+		req.Client = client
+		req.GrantedScope = fosite.Arguments{"offline"}
+		req.Session = &openid.DefaultSession{Claims: &jwt.IDTokenClaims{AuthTime: authTime}, Headers: &jwt.Headers{}}
+		require.NoError(t, store.CreateRefreshTokenSession(ctx, signature, "", req))
+		return token
+	}
+	refresh := func(t *testing.T, client fosite.Client, token string) (*fosite.AccessRequest, *fosite.AccessResponse, error) {
+		areq := fosite.NewAccessRequest(openid.NewDefaultSession())
+		areq.GrantTypes = fosite.Arguments{"refresh_token"}
+		areq.Client = client
+		areq.Form = url.Values{"refresh_token": {token}}
+		if err := h.HandleTokenEndpointRequest(ctx, areq); err != nil {
+			return areq, nil, err
+		}
+		aresp := fosite.NewAccessResponse()
+		return areq, aresp, h.PopulateTokenEndpointResponse(ctx, areq, aresp)
+	}
+	newClient := func(rotationDisabled bool, maxLifespan time.Duration) *refreshTokenPolicyClient {
+		return &refreshTokenPolicyClient{
+			DefaultClient:    &fosite.DefaultClient{ID: "foo", GrantTypes: fosite.Arguments{"refresh_token"}, Scopes: []string{"offline"}},
+			rotationDisabled: rotationDisabled,
+			maxLifespan:      maxLifespan,
+		}
+	}
+
+	t.Run("case=rotation disabled returns the presented refresh token", func(t *testing.T) {
+		client := newClient(true, 0)
+		token := issue(t, client, time.Now().UTC())
+
+		for range 2 {
+			_, aresp, err := refresh(t, client, token)
+			require.NoError(t, err)
+			assert.Equal(t, token, aresp.ToMap()["refresh_token"])
+			assert.NotEmpty(t, aresp.GetAccessToken())
+		}
+	})
+
+	t.Run("case=rotation enabled issues a new refresh token", func(t *testing.T) {
+		client := newClient(false, 0)
+		token := issue(t, client, time.Now().UTC())
+
+		_, aresp, err := refresh(t, client, token)
+		require.NoError(t, err)
+		assert.NotEqual(t, token, aresp.ToMap()["refresh_token"])
+
+		_, _, err = refresh(t, client, token)
+		assert.ErrorIs(t, err, fosite.ErrInvalidGrant)
+	})
+
+	t.Run("case=refresh tokens expire at the maximum lifespan", func(t *testing.T) {
+		client := newClient(false, time.Hour)
+		authTime := time.Now().UTC().Add(-30 * time.Minute).Round(time.Second)
+		token := issue(t, client, authTime)
+
+		areq, _, err := refresh(t, client, token)
+		require.NoError(t, err)
+		assert.Equal(t, authTime.Add(time.Hour), areq.GetSession().GetExpiresAt(fosite.RefreshToken))
+	})
+
+	t.Run("case=refresh is rejected after the maximum lifespan", func(t *testing.T) {
+		client := newClient(true, time.Hour)
+		token := issue(t, client, time.Now().UTC().Add(-2*time.Hour))
+
+		_, _, err := refresh(t, client, token)
+		require.ErrorIs(t, err, fosite.ErrInvalidGrant)
+		assert.Contains(t, fosite.ErrorToRFC6749Error(err).HintField, "authenticate again")
+	})
+
+	t.Run("case=the maximum lifespan is not enforced without an authentication time", func(t *testing.T) {
+		client := newClient(false, time.Hour)
+		token := issue(t, client, time.Time{})
+
+		_, _, err := refresh(t, client, token)
+		require.NoError(t, err)
+	})
+}
@@ -66,6 +66,24 @@ type RefreshTokenStorage interface {
 	RotateRefreshToken(ctx context.Context, requestID string, refreshTokenSignature string) (err error)
 }
 
+// ClientRefreshTokenRotator is an optional interface of the RefreshTokenStorage. If implemented, the
+// RefreshTokenGrantHandler rotates refresh tokens using it instead of RotateRefreshToken. It receives the client of
+// the request, so that the storage can apply the rotation policy of the client without loading the client again.
+type ClientRefreshTokenRotator interface {
+	RotateClientRefreshToken(ctx context.Context, client fosite.Client, requestID string, refreshTokenSignature string) (err error)
+}
+
 type RefreshTokenStorageProvider interface {
 	RefreshTokenStorage() RefreshTokenStorage
 }
+
+// RefreshTokenReuseHandler is notified when a refresh token which was already used is presented again. The
+// tokens of the request have been revoked when it is called.
+type RefreshTokenReuseHandler interface {
+	HandleRefreshTokenReuse(ctx context.Context, request fosite.Requester) (err error)
+}
+
+// RefreshTokenReuseHandlerProvider is an optional interface of the storage of the RefreshTokenGrantHandler.
+type RefreshTokenReuseHandlerProvider interface {
+	RefreshTokenReuseHandler() RefreshTokenReuseHandler
+}
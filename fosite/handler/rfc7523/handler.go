@@ -65,7 +65,7 @@ func (c *Handler) HandleTokenEndpointRequest(ctx context.Context, request fosite
 		return err
 	}
 
-	key, err := c.findPublicKeyForToken(ctx, token)
+	key, trust, err := c.findPublicKeyForToken(ctx, token)
 	if err != nil {
 		return err
 	}
@@ -82,8 +82,10 @@ func (c *Handler) HandleTokenEndpointRequest(ctx context.Context, request fosite
 		return err
 	}
 
-	scopes, err := c.Storage.RFC7523KeyStorage().GetPublicKeyScopes(ctx, claims.Issuer, claims.Subject, key.KeyID)
-	if err != nil {
+	var scopes []string
+	if trust != nil {
+		scopes = trust.Scopes
+	} else if scopes, err = c.Storage.RFC7523KeyStorage().GetPublicKeyScopes(ctx, claims.Issuer, claims.Subject, key.KeyID); err != nil {
 		return errorsx.WithStack(fosite.ErrServerError.WithWrap(err).WithDebug(err.Error()))
 	}
 
@@ -107,6 +109,12 @@ func (c *Handler) HandleTokenEndpointRequest(ctx context.Context, request fosite
 		request.GrantAudience(audience)
 	}
 
+	if trust != nil {
+		for _, audience := range trust.Audience {
+			request.GrantAudience(audience)
+		}
+	}
+
 	session, err := c.getSessionFromRequest(request)
 	if err != nil {
 		return err
@@ -201,10 +209,10 @@ func (c *Handler) validateTokenPreRequisites(token *jwt.JSONWebToken) error {
 	return nil
 }
 
-func (c *Handler) findPublicKeyForToken(ctx context.Context, token *jwt.JSONWebToken) (*jose.JSONWebKey, error) {
+func (c *Handler) findPublicKeyForToken(ctx context.Context, token *jwt.JSONWebToken) (*jose.JSONWebKey, *FederatedTrust, error) {
 	unverifiedClaims := jwt.Claims{}
 	if err := token.UnsafeClaimsWithoutVerification(&unverifiedClaims); err != nil {
-		return nil, errorsx.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithDebug(err.Error()))
+		return nil, nil, errorsx.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithDebug(err.Error()))
 	}
 
 	var keyID string
@@ -220,28 +228,61 @@ func (c *Handler) findPublicKeyForToken(ctx context.Context, token *jwt.JSONWebT
 		unverifiedClaims.Issuer,
 		unverifiedClaims.Subject,
 	)
+	storage := c.Storage.RFC7523KeyStorage()
 	if keyID != "" {
-		key, err := c.Storage.RFC7523KeyStorage().GetPublicKey(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject, keyID)
+		key, err := storage.GetPublicKey(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject, keyID)
+		if err == nil {
+			return key, nil, nil
+		}
+		keyNotFoundErr = keyNotFoundErr.WithWrap(err).WithDebug(err.Error())
+	} else {
+		keys, err := storage.GetPublicKeys(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject)
 		if err != nil {
-			return nil, errorsx.WithStack(keyNotFoundErr.WithWrap(err).WithDebug(err.Error()))
+			return nil, nil, errorsx.WithStack(keyNotFoundErr.WithWrap(err).WithDebug(err.Error()))
+		}
+
+		claims := jwt.Claims{}
+		for _, key := range keys.Keys {
+			err := token.Claims(key, &claims)
+			if err == nil {
+				return &key, nil, nil
+			}
 		}
-		return key, nil
 	}
 
-	keys, err := c.Storage.RFC7523KeyStorage().GetPublicKeys(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject)
+	federated, ok := storage.(RFC7523FederatedKeyStorage)
+	if !ok {
+		return nil, nil, errorsx.WithStack(keyNotFoundErr)
+	}
+	return c.findFederatedPublicKeyForToken(ctx, federated, token, keyID, keyNotFoundErr)
+}
+
+// findFederatedPublicKeyForToken looks up the key which signed the token in the trust relationships with issuers
+// which publish their public keys.
+func (c *Handler) findFederatedPublicKeyForToken(ctx context.Context, storage RFC7523FederatedKeyStorage, token *jwt.JSONWebToken, keyID string, keyNotFoundErr *fosite.RFC6749Error) (*jose.JSONWebKey, *FederatedTrust, error) {
+	unverifiedClaims := map[string]any{}
+	if err := token.UnsafeClaimsWithoutVerification(&unverifiedClaims); err != nil {
+		return nil, nil, errorsx.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithDebug(err.Error()))
+	}
+
+	trusts, err := storage.GetFederatedTrusts(ctx, unverifiedClaims, keyID)
 	if err != nil {
-		return nil, errorsx.WithStack(keyNotFoundErr.WithWrap(err).WithDebug(err.Error()))
+		return nil, nil, err
 	}
 
 	claims := jwt.Claims{}
-	for _, key := range keys.Keys {
-		err := token.Claims(key, &claims)
-		if err == nil {
-			return &key, nil
+	for i := range trusts {
+		for _, key := range trusts[i].Keys.Keys {
+			if keyID != "" && key.KeyID != keyID {
+				continue
+			}
+			if err := token.Claims(key, &claims); err == nil {
+				return &key, &trusts[i], nil
+			}
 		}
 	}
 
-	return nil, errorsx.WithStack(keyNotFoundErr)
+	return nil, nil, errorsx.WithStack(keyNotFoundErr)
 }
 
 func (c *Handler) validateTokenClaims(ctx context.Context, claims jwt.Claims, key *jose.JSONWebKey) error {
@@ -35,3 +35,24 @@ type RFC7523KeyStorage interface {
 type RFC7523KeyStorageProvider interface {
 	RFC7523KeyStorage() RFC7523KeyStorage
 }
+
+// RFC7523FederatedKeyStorage is optionally implemented by an RFC7523KeyStorage to trust issuers which publish their
+// public keys themselves, and to authorize assertions based on all of their claims.
+type RFC7523FederatedKeyStorage interface {
+	// GetFederatedTrusts returns the trust relationships with the issuer of the assertion which apply to the given
+	// claims. The claims are not verified yet, so a trust relationship must only be used if the assertion is signed
+	// by one of its keys. If keyID is not empty, only keys with that ID need to be returned.
+	GetFederatedTrusts(ctx context.Context, claims map[string]any, keyID string) ([]FederatedTrust, error)
+}
+
+// FederatedTrust is a trust relationship with an issuer which publishes its public keys.
+type FederatedTrust struct {
+	// Keys contains the public keys of the issuer.
+	Keys *jose.JSONWebKeySet
+
+	// Scopes contains the scopes the assertion is allowed to request.
+	Scopes []string
+
+	// Audience contains the audience which is granted to the assertion.
+	Audience []string
+}
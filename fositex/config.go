@@ -6,14 +6,17 @@ package fositex
 import (
 	"context"
 	"crypto/sha512"
+	"crypto/x509"
 	"hash"
 	"html/template"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 
 	"github.com/ory/x/httpx"
 
+	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/compose"
@@ -43,6 +46,7 @@ type (
 		tokenIntrospectionHandlers fosite.TokenIntrospectionHandlers
 		revocationHandlers         fosite.RevocationHandlers
 		deviceEndpointHandlers     fosite.DeviceEndpointHandlers
+		pushedAuthorizeHandlers    fosite.PushedAuthorizeEndpointHandlers
 		jwksFetcherStrategy        fosite.JWKSFetcherStrategy
 
 		*config.DefaultProvider
@@ -71,6 +75,7 @@ var (
 		compose.RFC8628DeviceFactory,
 		compose.RFC8628DeviceAuthorizationTokenFactory,
 		compose.OpenIDConnectDeviceFactory,
+		compose.PushedAuthorizeHandlerFactory,
 	}
 )
 
@@ -104,6 +109,9 @@ func (c *Config) LoadDefaultHandlers(storage fosite.Storage, strategy interface{
 		if dh, ok := res.(fosite.DeviceEndpointHandler); ok {
 			c.deviceEndpointHandlers.Append(dh)
 		}
+		if ph, ok := res.(fosite.PushedAuthorizeEndpointHandler); ok {
+			c.pushedAuthorizeHandlers.Append(ph)
+		}
 	}
 }
 
@@ -141,6 +149,30 @@ func (c *Config) GetDeviceEndpointHandlers(context.Context) fosite.DeviceEndpoin
 	return c.deviceEndpointHandlers
 }
 
+func (c *Config) GetPushedAuthorizeEndpointHandlers(context.Context) fosite.PushedAuthorizeEndpointHandlers {
+	return c.pushedAuthorizeHandlers
+}
+
+func (c *Config) GetPushedAuthorizeRequestURIPrefix(context.Context) string {
+	return oauth2.PushedAuthorizeRequestURIPrefix
+}
+
+// securityProfileAuthorizeCodeLifespan is the maximum lifespan of authorization codes issued to clients with a
+// security profile.
+const securityProfileAuthorizeCodeLifespan = time.Minute
+
+// GetAuthorizeCodeLifespan returns the lifespan of authorization codes, which is capped for clients with a security
+// profile.
+func (c *Config) GetAuthorizeCodeLifespan(ctx context.Context) time.Duration {
+	lifespan := c.DefaultProvider.GetAuthorizeCodeLifespan(ctx)
+	if ar, ok := ctx.Value(fosite.AuthorizeRequestContextKey).(fosite.AuthorizeRequester); ok {
+		if cl, ok := ar.GetClient().(*client.Client); ok && cl.SecurityProfile != "" {
+			return min(lifespan, securityProfileAuthorizeCodeLifespan)
+		}
+	}
+	return lifespan
+}
+
 func (c *Config) GetGrantTypeJWTBearerCanSkipClientAuth(context.Context) bool {
 	return false
 }
@@ -219,6 +251,14 @@ func (c *Config) GetAccessTokenIssuer(ctx context.Context) string {
 	return c.deps.Config().IssuerURL(ctx).String()
 }
 
+func (c *Config) GetAuthorizationResponseIssuer(ctx context.Context) string {
+	return c.deps.Config().IssuerURL(ctx).String()
+}
+
+func (c *Config) GetTLSClientAuthRootCAs(ctx context.Context) *x509.CertPool {
+	return c.deps.Config().MTLSCACertificates(ctx)
+}
+
 func (c *Config) GetJWTScopeField(ctx context.Context) jwt.JWTScopeFieldEnum {
 	return c.deps.Config().GetJWTScopeField(ctx)
 }
@@ -0,0 +1,33 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fositex
+
+import (
+	"context"
+	"time"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/jwk"
+)
+
+var _ openid.OpenIDConnectTokenStrategy = (*IDTokenStrategy)(nil)
+
+// IDTokenStrategy signs ID tokens with the key for the id_token_signed_response_alg of the client.
+type IDTokenStrategy struct {
+	openid.OpenIDConnectTokenStrategy
+}
+
+// NewIDTokenStrategy returns a new IDTokenStrategy which generates the ID tokens using the given strategy.
+func NewIDTokenStrategy(s openid.OpenIDConnectTokenStrategy) *IDTokenStrategy {
+	return &IDTokenStrategy{OpenIDConnectTokenStrategy: s}
+}
+
+func (s *IDTokenStrategy) GenerateIDToken(ctx context.Context, lifespan time.Duration, requester fosite.Requester) (string, error) {
+	if c, ok := requester.GetClient().(*client.Client); ok {
+		ctx = jwk.WithSigningAlgorithm(ctx, c.IDTokenSignedResponseAlg)
+	}
+	return s.OpenIDConnectTokenStrategy.GenerateIDToken(ctx, lifespan, requester)
+}
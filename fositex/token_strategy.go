@@ -11,18 +11,20 @@ import (
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 	foauth2 "github.com/ory/hydra/v2/fosite/handler/oauth2"
+	hydraoauth2 "github.com/ory/hydra/v2/oauth2"
 )
 
 var _ foauth2.CoreStrategy = (*TokenStrategy)(nil)
 
 type (
-	// TokenStrategy uses the correct token strategy (jwt, opaque) depending on the configuration.
+	// TokenStrategy uses the correct token strategy (jwt, opaque, stateless) depending on the configuration.
 	TokenStrategy struct {
 		d tokenStrategyDependencies
 	}
 	tokenStrategyDependencies interface {
 		OAuth2HMACStrategy() foauth2.CoreStrategy
 		OAuth2JWTStrategy() foauth2.AccessTokenStrategy
+		OAuth2StatelessRefreshTokenStrategy() foauth2.RefreshTokenStrategy
 		OAuth2AuthorizeCodeStrategy() foauth2.AuthorizeCodeStrategy
 		config.Provider
 	}
@@ -52,16 +54,28 @@ func (t TokenStrategy) ValidateAccessToken(ctx context.Context, requester fosite
 	return t.gs(ctx, withRequester(requester)).ValidateAccessToken(ctx, requester, token)
 }
 
+// rs returns the strategy which issued the refresh token. Refresh tokens issued before the strategy was changed
+// remain valid.
+func (t TokenStrategy) rs(token string) foauth2.RefreshTokenStrategy {
+	if hydraoauth2.IsStatelessRefreshToken(token) {
+		return t.d.OAuth2StatelessRefreshTokenStrategy()
+	}
+	return t.d.OAuth2HMACStrategy()
+}
+
 func (t TokenStrategy) RefreshTokenSignature(ctx context.Context, token string) string {
-	return t.d.OAuth2HMACStrategy().RefreshTokenSignature(ctx, token)
+	return t.rs(token).RefreshTokenSignature(ctx, token)
 }
 
 func (t TokenStrategy) GenerateRefreshToken(ctx context.Context, requester fosite.Requester) (token, signature string, err error) {
+	if t.d.Config().RefreshTokenStrategy(ctx) == config.RefreshTokenStatelessStrategy {
+		return t.d.OAuth2StatelessRefreshTokenStrategy().GenerateRefreshToken(ctx, requester)
+	}
 	return t.d.OAuth2HMACStrategy().GenerateRefreshToken(ctx, requester)
 }
 
 func (t TokenStrategy) ValidateRefreshToken(ctx context.Context, requester fosite.Requester, token string) (err error) {
-	return t.d.OAuth2HMACStrategy().ValidateRefreshToken(ctx, requester, token)
+	return t.rs(token).ValidateRefreshToken(ctx, requester, token)
 }
 
 func (t TokenStrategy) AuthorizeCodeSignature(ctx context.Context, token string) string {
@@ -17,6 +17,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 )
 
@@ -549,6 +550,139 @@ func (a *OAuth2APIService) AcceptUserCodeRequestExecute(r ApiAcceptUserCodeReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCreateInitialAccessTokenRequest struct {
+	ctx                      context.Context
+	ApiService               *OAuth2APIService
+	createInitialAccessToken *CreateInitialAccessToken
+}
+
+func (r ApiCreateInitialAccessTokenRequest) CreateInitialAccessToken(createInitialAccessToken CreateInitialAccessToken) ApiCreateInitialAccessTokenRequest {
+	r.createInitialAccessToken = &createInitialAccessToken
+	return r
+}
+
+func (r ApiCreateInitialAccessTokenRequest) Execute() (*InitialAccessToken, *http.Response, error) {
+	return r.ApiService.CreateInitialAccessTokenExecute(r)
+}
+
+/*
+CreateInitialAccessToken Create an Initial Access Token
+
+Use this endpoint to issue an initial access token, which authorizes the registration of OAuth 2.0 Clients
+using OpenID Connect Dynamic Client Registration. Present the token as bearer token to the registration endpoint.
+
+Clients registered with the token may only use the grant types, scope and redirect URI hosts allowed by the
+token, also when they are updated later on. Registration can be restricted to requests presenting an initial
+access token in the configuration.
+
+The token is returned in the response and you will not be able to retrieve it later on.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCreateInitialAccessTokenRequest
+*/
+func (a *OAuth2APIService) CreateInitialAccessToken(ctx context.Context) ApiCreateInitialAccessTokenRequest {
+	return ApiCreateInitialAccessTokenRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return InitialAccessToken
+func (a *OAuth2APIService) CreateInitialAccessTokenExecute(r ApiCreateInitialAccessTokenRequest) (*InitialAccessToken, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *InitialAccessToken
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.CreateInitialAccessToken")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/clients/registration/initial-access-tokens"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.createInitialAccessToken
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorOAuth2
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCreateOAuth2ClientRequest struct {
 	ctx          context.Context
 	ApiService   *OAuth2APIService
@@ -681,6 +815,107 @@ func (a *OAuth2APIService) CreateOAuth2ClientExecute(r ApiCreateOAuth2ClientRequ
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiDeleteInitialAccessTokenRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	id         string
+}
+
+func (r ApiDeleteInitialAccessTokenRequest) Execute() (*http.Response, error) {
+	return r.ApiService.DeleteInitialAccessTokenExecute(r)
+}
+
+/*
+DeleteInitialAccessToken Delete an Initial Access Token
+
+Use this endpoint to delete an initial access token. Once deleted, the token can no longer be used to register
+OAuth 2.0 Clients. Clients registered with the token can no longer be updated using OpenID Connect Dynamic Client
+Registration, because the registration policy of the token can no longer be enforced.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id The id of the initial access token.
+	@return ApiDeleteInitialAccessTokenRequest
+*/
+func (a *OAuth2APIService) DeleteInitialAccessToken(ctx context.Context, id string) ApiDeleteInitialAccessTokenRequest {
+	return ApiDeleteInitialAccessTokenRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+func (a *OAuth2APIService) DeleteInitialAccessTokenExecute(r ApiDeleteInitialAccessTokenRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.DeleteInitialAccessToken")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/clients/registration/initial-access-tokens/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type ApiDeleteOAuth2ClientRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
@@ -997,30 +1232,27 @@ func (a *OAuth2APIService) DeleteTrustedOAuth2JwtGrantIssuerExecute(r ApiDeleteT
 	return localVarHTTPResponse, nil
 }
 
-type ApiGetOAuth2ClientRequest struct {
+type ApiGetInitialAccessTokenRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
 	id         string
 }
 
-func (r ApiGetOAuth2ClientRequest) Execute() (*OAuth2Client, *http.Response, error) {
-	return r.ApiService.GetOAuth2ClientExecute(r)
+func (r ApiGetInitialAccessTokenRequest) Execute() (*InitialAccessToken, *http.Response, error) {
+	return r.ApiService.GetInitialAccessTokenExecute(r)
 }
 
 /*
-GetOAuth2Client Get an OAuth 2.0 Client
-
-Get an OAuth 2.0 client by its ID. This endpoint never returns the client secret.
+GetInitialAccessToken Get an Initial Access Token
 
-OAuth 2.0 clients are used to perform OAuth 2.0 and OpenID Connect flows. Usually, OAuth 2.0 clients are
-generated for applications which want to consume your OAuth 2.0 or OpenID Connect capabilities.
+Use this endpoint to get an initial access token. The token itself is not returned.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param id The id of the OAuth 2.0 Client.
-	@return ApiGetOAuth2ClientRequest
+	@param id The id of the initial access token.
+	@return ApiGetInitialAccessTokenRequest
 */
-func (a *OAuth2APIService) GetOAuth2Client(ctx context.Context, id string) ApiGetOAuth2ClientRequest {
-	return ApiGetOAuth2ClientRequest{
+func (a *OAuth2APIService) GetInitialAccessToken(ctx context.Context, id string) ApiGetInitialAccessTokenRequest {
+	return ApiGetInitialAccessTokenRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1029,27 +1261,26 @@ func (a *OAuth2APIService) GetOAuth2Client(ctx context.Context, id string) ApiGe
 
 // Execute executes the request
 //
-//	@return OAuth2Client
-func (a *OAuth2APIService) GetOAuth2ClientExecute(r ApiGetOAuth2ClientRequest) (*OAuth2Client, *http.Response, error) {
+//	@return InitialAccessToken
+func (a *OAuth2APIService) GetInitialAccessTokenExecute(r ApiGetInitialAccessTokenRequest) (*InitialAccessToken, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OAuth2Client
+		localVarReturnValue *InitialAccessToken
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2Client")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetInitialAccessToken")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/clients/{id}"
+	localVarPath := localBasePath + "/admin/clients/registration/initial-access-tokens/{id}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1112,41 +1343,156 @@ func (a *OAuth2APIService) GetOAuth2ClientExecute(r ApiGetOAuth2ClientRequest) (
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetOAuth2ConsentRequestRequest struct {
-	ctx              context.Context
-	ApiService       *OAuth2APIService
-	consentChallenge *string
-}
-
-// OAuth 2.0 Consent Request Challenge
-func (r ApiGetOAuth2ConsentRequestRequest) ConsentChallenge(consentChallenge string) ApiGetOAuth2ConsentRequestRequest {
-	r.consentChallenge = &consentChallenge
-	return r
+type ApiGetOAuth2ClientRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	id         string
 }
 
-func (r ApiGetOAuth2ConsentRequestRequest) Execute() (*OAuth2ConsentRequest, *http.Response, error) {
-	return r.ApiService.GetOAuth2ConsentRequestExecute(r)
+func (r ApiGetOAuth2ClientRequest) Execute() (*OAuth2Client, *http.Response, error) {
+	return r.ApiService.GetOAuth2ClientExecute(r)
 }
 
 /*
-GetOAuth2ConsentRequest Get OAuth 2.0 Consent Request
-
-When an authorization code, hybrid, or implicit OAuth 2.0 Flow is initiated, Ory asks the login provider
-to authenticate the subject and then tell Ory now about it. If the subject authenticated, he/she must now be asked if
-the OAuth 2.0 Client which initiated the flow should be allowed to access the resources on the subject's behalf.
+GetOAuth2Client Get an OAuth 2.0 Client
 
-The consent challenge is appended to the consent provider's URL to which the subject's user-agent (browser) is redirected to. The consent
-provider uses that challenge to fetch information on the OAuth2 request and then tells Ory if the subject accepted
-or rejected the request.
+Get an OAuth 2.0 client by its ID. This endpoint never returns the client secret.
 
-The default consent provider is available via the Ory Managed Account Experience. To customize the consent provider, please
-head over to the OAuth 2.0 documentation.
+OAuth 2.0 clients are used to perform OAuth 2.0 and OpenID Connect flows. Usually, OAuth 2.0 clients are
+generated for applications which want to consume your OAuth 2.0 or OpenID Connect capabilities.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiGetOAuth2ConsentRequestRequest
+	@param id The id of the OAuth 2.0 Client.
+	@return ApiGetOAuth2ClientRequest
 */
-func (a *OAuth2APIService) GetOAuth2ConsentRequest(ctx context.Context) ApiGetOAuth2ConsentRequestRequest {
-	return ApiGetOAuth2ConsentRequestRequest{
+func (a *OAuth2APIService) GetOAuth2Client(ctx context.Context, id string) ApiGetOAuth2ClientRequest {
+	return ApiGetOAuth2ClientRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OAuth2Client
+func (a *OAuth2APIService) GetOAuth2ClientExecute(r ApiGetOAuth2ClientRequest) (*OAuth2Client, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OAuth2Client
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2Client")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/clients/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetOAuth2ConsentRequestRequest struct {
+	ctx              context.Context
+	ApiService       *OAuth2APIService
+	consentChallenge *string
+}
+
+// OAuth 2.0 Consent Request Challenge
+func (r ApiGetOAuth2ConsentRequestRequest) ConsentChallenge(consentChallenge string) ApiGetOAuth2ConsentRequestRequest {
+	r.consentChallenge = &consentChallenge
+	return r
+}
+
+func (r ApiGetOAuth2ConsentRequestRequest) Execute() (*OAuth2ConsentRequest, *http.Response, error) {
+	return r.ApiService.GetOAuth2ConsentRequestExecute(r)
+}
+
+/*
+GetOAuth2ConsentRequest Get OAuth 2.0 Consent Request
+
+When an authorization code, hybrid, or implicit OAuth 2.0 Flow is initiated, Ory asks the login provider
+to authenticate the subject and then tell Ory now about it. If the subject authenticated, he/she must now be asked if
+the OAuth 2.0 Client which initiated the flow should be allowed to access the resources on the subject's behalf.
+
+The consent challenge is appended to the consent provider's URL to which the subject's user-agent (browser) is redirected to. The consent
+provider uses that challenge to fetch information on the OAuth2 request and then tells Ory if the subject accepted
+or rejected the request.
+
+The default consent provider is available via the Ory Managed Account Experience. To customize the consent provider, please
+head over to the OAuth 2.0 documentation.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetOAuth2ConsentRequestRequest
+*/
+func (a *OAuth2APIService) GetOAuth2ConsentRequest(ctx context.Context) ApiGetOAuth2ConsentRequestRequest {
+	return ApiGetOAuth2ConsentRequestRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -1386,34 +1732,296 @@ func (a *OAuth2APIService) GetOAuth2LoginRequestExecute(r ApiGetOAuth2LoginReque
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
-	return localVarReturnValue, localVarHTTPResponse, nil
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetOAuth2LogoutRequestRequest struct {
+	ctx             context.Context
+	ApiService      *OAuth2APIService
+	logoutChallenge *string
+}
+
+func (r ApiGetOAuth2LogoutRequestRequest) LogoutChallenge(logoutChallenge string) ApiGetOAuth2LogoutRequestRequest {
+	r.logoutChallenge = &logoutChallenge
+	return r
+}
+
+func (r ApiGetOAuth2LogoutRequestRequest) Execute() (*OAuth2LogoutRequest, *http.Response, error) {
+	return r.ApiService.GetOAuth2LogoutRequestExecute(r)
+}
+
+/*
+GetOAuth2LogoutRequest Get OAuth 2.0 Session Logout Request
+
+Use this endpoint to fetch an Ory OAuth 2.0 logout request.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetOAuth2LogoutRequestRequest
+*/
+func (a *OAuth2APIService) GetOAuth2LogoutRequest(ctx context.Context) ApiGetOAuth2LogoutRequestRequest {
+	return ApiGetOAuth2LogoutRequestRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OAuth2LogoutRequest
+func (a *OAuth2APIService) GetOAuth2LogoutRequestExecute(r ApiGetOAuth2LogoutRequestRequest) (*OAuth2LogoutRequest, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OAuth2LogoutRequest
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2LogoutRequest")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/requests/logout"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.logoutChallenge == nil {
+		return localVarReturnValue, nil, reportError("logoutChallenge is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "logout_challenge", r.logoutChallenge, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 410 {
+			var v OAuth2RedirectTo
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetTrustedOAuth2JwtGrantIssuerRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	id         string
+}
+
+func (r ApiGetTrustedOAuth2JwtGrantIssuerRequest) Execute() (*TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+	return r.ApiService.GetTrustedOAuth2JwtGrantIssuerExecute(r)
+}
+
+/*
+GetTrustedOAuth2JwtGrantIssuer Get Trusted OAuth2 JWT Bearer Grant Type Issuer
+
+Use this endpoint to get a trusted JWT Bearer Grant Type Issuer. The ID is the one returned when you
+created the trust relationship.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id The id of the desired grant
+	@return ApiGetTrustedOAuth2JwtGrantIssuerRequest
+*/
+func (a *OAuth2APIService) GetTrustedOAuth2JwtGrantIssuer(ctx context.Context, id string) ApiGetTrustedOAuth2JwtGrantIssuerRequest {
+	return ApiGetTrustedOAuth2JwtGrantIssuerRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return TrustedOAuth2JwtGrantIssuer
+func (a *OAuth2APIService) GetTrustedOAuth2JwtGrantIssuerExecute(r ApiGetTrustedOAuth2JwtGrantIssuerRequest) (*TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *TrustedOAuth2JwtGrantIssuer
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetTrustedOAuth2JwtGrantIssuer")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/trust/grants/jwt-bearer/issuers/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GenericError
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiIntrospectOAuth2TokenRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	token      *string
+	scope      *string
+	clientId   *string
+}
+
+// The string value of the token. For access tokens, this is the \\\&quot;access_token\\\&quot; value returned from the token endpoint defined in OAuth 2.0. For refresh tokens, this is the \\\&quot;refresh_token\\\&quot; value returned.
+func (r ApiIntrospectOAuth2TokenRequest) Token(token string) ApiIntrospectOAuth2TokenRequest {
+	r.token = &token
+	return r
 }
 
-type ApiGetOAuth2LogoutRequestRequest struct {
-	ctx             context.Context
-	ApiService      *OAuth2APIService
-	logoutChallenge *string
+// An optional, space separated list of required scopes. If the access token was not granted one of the scopes, the result of active will be false.
+func (r ApiIntrospectOAuth2TokenRequest) Scope(scope string) ApiIntrospectOAuth2TokenRequest {
+	r.scope = &scope
+	return r
 }
 
-func (r ApiGetOAuth2LogoutRequestRequest) LogoutChallenge(logoutChallenge string) ApiGetOAuth2LogoutRequestRequest {
-	r.logoutChallenge = &logoutChallenge
+// The ID of the OAuth 2.0 Client of the resource server. If a JWT introspection response is requested with the Accept header application/token-introspection+jwt, the token is issued for this client and signed and encrypted using the algorithms of its metadata.
+func (r ApiIntrospectOAuth2TokenRequest) ClientId(clientId string) ApiIntrospectOAuth2TokenRequest {
+	r.clientId = &clientId
 	return r
 }
 
-func (r ApiGetOAuth2LogoutRequestRequest) Execute() (*OAuth2LogoutRequest, *http.Response, error) {
-	return r.ApiService.GetOAuth2LogoutRequestExecute(r)
+func (r ApiIntrospectOAuth2TokenRequest) Execute() (*IntrospectedOAuth2Token, *http.Response, error) {
+	return r.ApiService.IntrospectOAuth2TokenExecute(r)
 }
 
 /*
-GetOAuth2LogoutRequest Get OAuth 2.0 Session Logout Request
+IntrospectOAuth2Token Introspect OAuth2 Access and Refresh Tokens
 
-Use this endpoint to fetch an Ory OAuth 2.0 logout request.
+The introspection endpoint allows to check if a token (both refresh and access) is active or not. An active token
+is neither expired nor revoked. If a token is active, additional information on the token will be included. You can
+set additional data for a token by setting `session.access_token` during the consent flow.
+
+If enabled in the configuration, the response is a JSON Web Token as defined in RFC 9701 when requested with the
+Accept header application/token-introspection+jwt.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiGetOAuth2LogoutRequestRequest
+	@return ApiIntrospectOAuth2TokenRequest
 */
-func (a *OAuth2APIService) GetOAuth2LogoutRequest(ctx context.Context) ApiGetOAuth2LogoutRequestRequest {
-	return ApiGetOAuth2LogoutRequestRequest{
+func (a *OAuth2APIService) IntrospectOAuth2Token(ctx context.Context) ApiIntrospectOAuth2TokenRequest {
+	return ApiIntrospectOAuth2TokenRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -1421,32 +2029,31 @@ func (a *OAuth2APIService) GetOAuth2LogoutRequest(ctx context.Context) ApiGetOAu
 
 // Execute executes the request
 //
-//	@return OAuth2LogoutRequest
-func (a *OAuth2APIService) GetOAuth2LogoutRequestExecute(r ApiGetOAuth2LogoutRequestRequest) (*OAuth2LogoutRequest, *http.Response, error) {
+//	@return IntrospectedOAuth2Token
+func (a *OAuth2APIService) IntrospectOAuth2TokenExecute(r ApiIntrospectOAuth2TokenRequest) (*IntrospectedOAuth2Token, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OAuth2LogoutRequest
+		localVarReturnValue *IntrospectedOAuth2Token
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2LogoutRequest")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.IntrospectOAuth2Token")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/oauth2/auth/requests/logout"
+	localVarPath := localBasePath + "/admin/oauth2/introspect"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.logoutChallenge == nil {
-		return localVarReturnValue, nil, reportError("logoutChallenge is required and must be specified")
+	if r.token == nil {
+		return localVarReturnValue, nil, reportError("token is required and must be specified")
 	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "logout_challenge", r.logoutChallenge, "form", "")
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/x-www-form-urlencoded"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1462,6 +2069,13 @@ func (a *OAuth2APIService) GetOAuth2LogoutRequestExecute(r ApiGetOAuth2LogoutReq
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if r.clientId != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "client_id", r.clientId, "", "")
+	}
+	if r.scope != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "scope", r.scope, "", "")
+	}
+	parameterAddToHeaderOrQuery(localVarFormParams, "token", r.token, "", "")
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1484,17 +2098,6 @@ func (a *OAuth2APIService) GetOAuth2LogoutRequestExecute(r ApiGetOAuth2LogoutReq
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 410 {
-			var v OAuth2RedirectTo
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHTTPResponse, newErr
-			}
-			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
-			newErr.model = v
-			return localVarReturnValue, localVarHTTPResponse, newErr
-		}
 		var v ErrorOAuth2
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
@@ -1518,57 +2121,75 @@ func (a *OAuth2APIService) GetOAuth2LogoutRequestExecute(r ApiGetOAuth2LogoutReq
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetTrustedOAuth2JwtGrantIssuerRequest struct {
+type ApiListInitialAccessTokensRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
-	id         string
+	pageSize   *int64
+	pageToken  *string
 }
 
-func (r ApiGetTrustedOAuth2JwtGrantIssuerRequest) Execute() (*TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
-	return r.ApiService.GetTrustedOAuth2JwtGrantIssuerExecute(r)
+// Items per Page  This is the number of items per page to return. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListInitialAccessTokensRequest) PageSize(pageSize int64) ApiListInitialAccessTokensRequest {
+	r.pageSize = &pageSize
+	return r
+}
+
+// Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListInitialAccessTokensRequest) PageToken(pageToken string) ApiListInitialAccessTokensRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r ApiListInitialAccessTokensRequest) Execute() ([]InitialAccessToken, *http.Response, error) {
+	return r.ApiService.ListInitialAccessTokensExecute(r)
 }
 
 /*
-GetTrustedOAuth2JwtGrantIssuer Get Trusted OAuth2 JWT Bearer Grant Type Issuer
+ListInitialAccessTokens List Initial Access Tokens
 
-Use this endpoint to get a trusted JWT Bearer Grant Type Issuer. The ID is the one returned when you
-created the trust relationship.
+This endpoint lists all initial access tokens, and never returns the tokens themselves.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param id The id of the desired grant
-	@return ApiGetTrustedOAuth2JwtGrantIssuerRequest
+	@return ApiListInitialAccessTokensRequest
 */
-func (a *OAuth2APIService) GetTrustedOAuth2JwtGrantIssuer(ctx context.Context, id string) ApiGetTrustedOAuth2JwtGrantIssuerRequest {
-	return ApiGetTrustedOAuth2JwtGrantIssuerRequest{
+func (a *OAuth2APIService) ListInitialAccessTokens(ctx context.Context) ApiListInitialAccessTokensRequest {
+	return ApiListInitialAccessTokensRequest{
 		ApiService: a,
 		ctx:        ctx,
-		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return TrustedOAuth2JwtGrantIssuer
-func (a *OAuth2APIService) GetTrustedOAuth2JwtGrantIssuerExecute(r ApiGetTrustedOAuth2JwtGrantIssuerRequest) (*TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+//	@return []InitialAccessToken
+func (a *OAuth2APIService) ListInitialAccessTokensExecute(r ApiListInitialAccessTokensRequest) ([]InitialAccessToken, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *TrustedOAuth2JwtGrantIssuer
+		localVarReturnValue []InitialAccessToken
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetTrustedOAuth2JwtGrantIssuer")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListInitialAccessTokens")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/trust/grants/jwt-bearer/issuers/{id}"
-	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath := localBasePath + "/admin/clients/registration/initial-access-tokens"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.pageSize != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
+	} else {
+		var defaultValue int64 = 250
+		r.pageSize = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1608,7 +2229,7 @@ func (a *OAuth2APIService) GetTrustedOAuth2JwtGrantIssuerExecute(r ApiGetTrusted
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		var v GenericError
+		var v ErrorOAuth2
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.error = err.Error()
@@ -1631,41 +2252,54 @@ func (a *OAuth2APIService) GetTrustedOAuth2JwtGrantIssuerExecute(r ApiGetTrusted
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiIntrospectOAuth2TokenRequest struct {
+type ApiListOAuth2ClientsRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
-	token      *string
-	scope      *string
+	pageSize   *int64
+	pageToken  *string
+	clientName *string
+	owner      *string
 }
 
-// The string value of the token. For access tokens, this is the \\\&quot;access_token\\\&quot; value returned from the token endpoint defined in OAuth 2.0. For refresh tokens, this is the \\\&quot;refresh_token\\\&quot; value returned.
-func (r ApiIntrospectOAuth2TokenRequest) Token(token string) ApiIntrospectOAuth2TokenRequest {
-	r.token = &token
+// Items per Page  This is the number of items per page to return. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2ClientsRequest) PageSize(pageSize int64) ApiListOAuth2ClientsRequest {
+	r.pageSize = &pageSize
 	return r
 }
 
-// An optional, space separated list of required scopes. If the access token was not granted one of the scopes, the result of active will be false.
-func (r ApiIntrospectOAuth2TokenRequest) Scope(scope string) ApiIntrospectOAuth2TokenRequest {
-	r.scope = &scope
+// Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2ClientsRequest) PageToken(pageToken string) ApiListOAuth2ClientsRequest {
+	r.pageToken = &pageToken
 	return r
 }
 
-func (r ApiIntrospectOAuth2TokenRequest) Execute() (*IntrospectedOAuth2Token, *http.Response, error) {
-	return r.ApiService.IntrospectOAuth2TokenExecute(r)
+// The name of the clients to filter by.
+func (r ApiListOAuth2ClientsRequest) ClientName(clientName string) ApiListOAuth2ClientsRequest {
+	r.clientName = &clientName
+	return r
+}
+
+// The owner of the clients to filter by.
+func (r ApiListOAuth2ClientsRequest) Owner(owner string) ApiListOAuth2ClientsRequest {
+	r.owner = &owner
+	return r
+}
+
+func (r ApiListOAuth2ClientsRequest) Execute() ([]OAuth2Client, *http.Response, error) {
+	return r.ApiService.ListOAuth2ClientsExecute(r)
 }
 
 /*
-IntrospectOAuth2Token Introspect OAuth2 Access and Refresh Tokens
+ListOAuth2Clients List OAuth 2.0 Clients
 
-The introspection endpoint allows to check if a token (both refresh and access) is active or not. An active token
-is neither expired nor revoked. If a token is active, additional information on the token will be included. You can
-set additional data for a token by setting `session.access_token` during the consent flow.
+This endpoint lists all clients in the database, and never returns client secrets.
+As a default it lists the first 100 clients.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiIntrospectOAuth2TokenRequest
+	@return ApiListOAuth2ClientsRequest
 */
-func (a *OAuth2APIService) IntrospectOAuth2Token(ctx context.Context) ApiIntrospectOAuth2TokenRequest {
-	return ApiIntrospectOAuth2TokenRequest{
+func (a *OAuth2APIService) ListOAuth2Clients(ctx context.Context) ApiListOAuth2ClientsRequest {
+	return ApiListOAuth2ClientsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -1673,31 +2307,43 @@ func (a *OAuth2APIService) IntrospectOAuth2Token(ctx context.Context) ApiIntrosp
 
 // Execute executes the request
 //
-//	@return IntrospectedOAuth2Token
-func (a *OAuth2APIService) IntrospectOAuth2TokenExecute(r ApiIntrospectOAuth2TokenRequest) (*IntrospectedOAuth2Token, *http.Response, error) {
+//	@return []OAuth2Client
+func (a *OAuth2APIService) ListOAuth2ClientsExecute(r ApiListOAuth2ClientsRequest) ([]OAuth2Client, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *IntrospectedOAuth2Token
+		localVarReturnValue []OAuth2Client
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.IntrospectOAuth2Token")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListOAuth2Clients")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/oauth2/introspect"
+	localVarPath := localBasePath + "/admin/clients"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.token == nil {
-		return localVarReturnValue, nil, reportError("token is required and must be specified")
-	}
 
+	if r.pageSize != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
+	} else {
+		var defaultValue int64 = 250
+		r.pageSize = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
+	}
+	if r.clientName != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "client_name", r.clientName, "form", "")
+	}
+	if r.owner != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "owner", r.owner, "form", "")
+	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/x-www-form-urlencoded"}
+	localVarHTTPContentTypes := []string{}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1713,10 +2359,6 @@ func (a *OAuth2APIService) IntrospectOAuth2TokenExecute(r ApiIntrospectOAuth2Tok
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	if r.scope != nil {
-		parameterAddToHeaderOrQuery(localVarFormParams, "scope", r.scope, "", "")
-	}
-	parameterAddToHeaderOrQuery(localVarFormParams, "token", r.token, "", "")
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1762,54 +2404,54 @@ func (a *OAuth2APIService) IntrospectOAuth2TokenExecute(r ApiIntrospectOAuth2Tok
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiListOAuth2ClientsRequest struct {
+type ApiListOAuth2ConsentHistoryRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
 	pageSize   *int64
 	pageToken  *string
-	clientName *string
-	owner      *string
+	subject    *string
+	client     *string
 }
 
 // Items per Page  This is the number of items per page to return. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
-func (r ApiListOAuth2ClientsRequest) PageSize(pageSize int64) ApiListOAuth2ClientsRequest {
+func (r ApiListOAuth2ConsentHistoryRequest) PageSize(pageSize int64) ApiListOAuth2ConsentHistoryRequest {
 	r.pageSize = &pageSize
 	return r
 }
 
 // Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
-func (r ApiListOAuth2ClientsRequest) PageToken(pageToken string) ApiListOAuth2ClientsRequest {
+func (r ApiListOAuth2ConsentHistoryRequest) PageToken(pageToken string) ApiListOAuth2ConsentHistoryRequest {
 	r.pageToken = &pageToken
 	return r
 }
 
-// The name of the clients to filter by.
-func (r ApiListOAuth2ClientsRequest) ClientName(clientName string) ApiListOAuth2ClientsRequest {
-	r.clientName = &clientName
+// The subject to list the consent history for.
+func (r ApiListOAuth2ConsentHistoryRequest) Subject(subject string) ApiListOAuth2ConsentHistoryRequest {
+	r.subject = &subject
 	return r
 }
 
-// The owner of the clients to filter by.
-func (r ApiListOAuth2ClientsRequest) Owner(owner string) ApiListOAuth2ClientsRequest {
-	r.owner = &owner
+// If set, only the consent history of this OAuth 2.0 Client ID is listed.
+func (r ApiListOAuth2ConsentHistoryRequest) Client(client string) ApiListOAuth2ConsentHistoryRequest {
+	r.client = &client
 	return r
 }
 
-func (r ApiListOAuth2ClientsRequest) Execute() ([]OAuth2Client, *http.Response, error) {
-	return r.ApiService.ListOAuth2ClientsExecute(r)
+func (r ApiListOAuth2ConsentHistoryRequest) Execute() ([]OAuth2ConsentHistoryEvent, *http.Response, error) {
+	return r.ApiService.ListOAuth2ConsentHistoryExecute(r)
 }
 
 /*
-ListOAuth2Clients List OAuth 2.0 Clients
+ListOAuth2ConsentHistory List OAuth 2.0 Consent History of a Subject
 
-This endpoint lists all clients in the database, and never returns client secrets.
-As a default it lists the first 100 clients.
+This endpoint lists when a subject granted consent to clients and when consent, or some of its scopes and
+audiences, were revoked, oldest first. If the subject is unknown, the endpoint returns an empty JSON array.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiListOAuth2ClientsRequest
+	@return ApiListOAuth2ConsentHistoryRequest
 */
-func (a *OAuth2APIService) ListOAuth2Clients(ctx context.Context) ApiListOAuth2ClientsRequest {
-	return ApiListOAuth2ClientsRequest{
+func (a *OAuth2APIService) ListOAuth2ConsentHistory(ctx context.Context) ApiListOAuth2ConsentHistoryRequest {
+	return ApiListOAuth2ConsentHistoryRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -1817,25 +2459,28 @@ func (a *OAuth2APIService) ListOAuth2Clients(ctx context.Context) ApiListOAuth2C
 
 // Execute executes the request
 //
-//	@return []OAuth2Client
-func (a *OAuth2APIService) ListOAuth2ClientsExecute(r ApiListOAuth2ClientsRequest) ([]OAuth2Client, *http.Response, error) {
+//	@return []OAuth2ConsentHistoryEvent
+func (a *OAuth2APIService) ListOAuth2ConsentHistoryExecute(r ApiListOAuth2ConsentHistoryRequest) ([]OAuth2ConsentHistoryEvent, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []OAuth2Client
+		localVarReturnValue []OAuth2ConsentHistoryEvent
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListOAuth2Clients")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListOAuth2ConsentHistory")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/clients"
+	localVarPath := localBasePath + "/admin/oauth2/auth/sessions/consent/history"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.subject == nil {
+		return localVarReturnValue, nil, reportError("subject is required and must be specified")
+	}
 
 	if r.pageSize != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
@@ -1846,11 +2491,9 @@ func (a *OAuth2APIService) ListOAuth2ClientsExecute(r ApiListOAuth2ClientsReques
 	if r.pageToken != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
 	}
-	if r.clientName != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "client_name", r.clientName, "form", "")
-	}
-	if r.owner != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "owner", r.owner, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "subject", r.subject, "form", "")
+	if r.client != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "client", r.client, "form", "")
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -3231,6 +3874,8 @@ type ApiRevokeOAuth2ConsentSessionsRequest struct {
 	client           *string
 	consentRequestId *string
 	all              *bool
+	scope            *[]string
+	audience         *[]string
 }
 
 // OAuth 2.0 Consent Subject  The subject whose consent sessions should be deleted.
@@ -3257,6 +3902,18 @@ func (r ApiRevokeOAuth2ConsentSessionsRequest) All(all bool) ApiRevokeOAuth2Cons
 	return r
 }
 
+// Revoke Scopes  If set, only these scopes are revoked from the consent sessions the Subject granted to the Client. Requires the &#x60;subject&#x60; and &#x60;client&#x60; parameters.
+func (r ApiRevokeOAuth2ConsentSessionsRequest) Scope(scope []string) ApiRevokeOAuth2ConsentSessionsRequest {
+	r.scope = &scope
+	return r
+}
+
+// Revoke Audiences  If set, only these audiences are revoked from the consent sessions the Subject granted to the Client. Requires the &#x60;subject&#x60; and &#x60;client&#x60; parameters.
+func (r ApiRevokeOAuth2ConsentSessionsRequest) Audience(audience []string) ApiRevokeOAuth2ConsentSessionsRequest {
+	r.audience = &audience
+	return r
+}
+
 func (r ApiRevokeOAuth2ConsentSessionsRequest) Execute() (*http.Response, error) {
 	return r.ApiService.RevokeOAuth2ConsentSessionsExecute(r)
 }
@@ -3267,6 +3924,10 @@ RevokeOAuth2ConsentSessions Revoke OAuth 2.0 Consent Sessions of a Subject
 This endpoint revokes a subject's granted consent sessions and invalidates all
 associated OAuth 2.0 Access Tokens. You may also only revoke sessions for a specific OAuth 2.0 Client ID.
 
+If `scope` or `audience` are set, only those scopes and audiences are revoked from the consent sessions of the
+client. The associated access tokens are revoked, while the refresh tokens are downscoped or revoked depending
+on the `oauth2.grant.refresh_token.partial_revocation` configuration.
+
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiRevokeOAuth2ConsentSessionsRequest
 */
@@ -3308,6 +3969,28 @@ func (a *OAuth2APIService) RevokeOAuth2ConsentSessionsExecute(r ApiRevokeOAuth2C
 	if r.all != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "all", r.all, "form", "")
 	}
+	if r.scope != nil {
+		t := *r.scope
+		if reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				parameterAddToHeaderOrQuery(localVarQueryParams, "scope", s.Index(i).Interface(), "form", "multi")
+			}
+		} else {
+			parameterAddToHeaderOrQuery(localVarQueryParams, "scope", t, "form", "multi")
+		}
+	}
+	if r.audience != nil {
+		t := *r.audience
+		if reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				parameterAddToHeaderOrQuery(localVarQueryParams, "audience", s.Index(i).Interface(), "form", "multi")
+			}
+		} else {
+			parameterAddToHeaderOrQuery(localVarQueryParams, "audience", t, "form", "multi")
+		}
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -51,14 +51,20 @@ type APIClient struct {
 
 	// API Services
 
+	AuditAPI *AuditAPIService
+
 	JwkAPI *JwkAPIService
 
 	MetadataAPI *MetadataAPIService
 
+	NetworkAPI *NetworkAPIService
+
 	OAuth2API *OAuth2APIService
 
 	OidcAPI *OidcAPIService
 
+	SsfAPI *SsfAPIService
+
 	WellknownAPI *WellknownAPIService
 }
 
@@ -78,10 +84,13 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.AuditAPI = (*AuditAPIService)(&c.common)
 	c.JwkAPI = (*JwkAPIService)(&c.common)
 	c.MetadataAPI = (*MetadataAPIService)(&c.common)
+	c.NetworkAPI = (*NetworkAPIService)(&c.common)
 	c.OAuth2API = (*OAuth2APIService)(&c.common)
 	c.OidcAPI = (*OidcAPIService)(&c.common)
+	c.SsfAPI = (*SsfAPIService)(&c.common)
 	c.WellknownAPI = (*WellknownAPIService)(&c.common)
 
 	return c
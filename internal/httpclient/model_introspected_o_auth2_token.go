@@ -28,6 +28,8 @@ type IntrospectedOAuth2Token struct {
 	Aud []string `json:"aud,omitempty"`
 	// ID is a client identifier for the OAuth 2.0 client that requested this token.
 	ClientId *string `json:"client_id,omitempty"`
+	// Confirmation contains the thumbprint of the DPoP key the access token is bound to, see https://www.rfc-editor.org/rfc/rfc9449#section-6.2.
+	Cnf map[string]string `json:"cnf,omitempty"`
 	// Expires at is an integer timestamp, measured in the number of seconds since January 1 1970 UTC, indicating when this token will expire.
 	Exp *int64 `json:"exp,omitempty"`
 	// Extra is arbitrary data set by the session.
@@ -160,6 +162,38 @@ func (o *IntrospectedOAuth2Token) SetClientId(v string) {
 	o.ClientId = &v
 }
 
+// GetCnf returns the Cnf field value if set, zero value otherwise.
+func (o *IntrospectedOAuth2Token) GetCnf() map[string]string {
+	if o == nil || IsNil(o.Cnf) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Cnf
+}
+
+// GetCnfOk returns a tuple with the Cnf field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *IntrospectedOAuth2Token) GetCnfOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Cnf) {
+		return nil, false
+	}
+	return o.Cnf, true
+}
+
+// HasCnf returns a boolean if a field has been set.
+func (o *IntrospectedOAuth2Token) HasCnf() bool {
+	if o != nil && !IsNil(o.Cnf) {
+		return true
+	}
+
+	return false
+}
+
+// SetCnf gets a reference to the given map[string]string and assigns it to the Cnf field.
+func (o *IntrospectedOAuth2Token) SetCnf(v map[string]string) {
+	o.Cnf = v
+}
+
 // GetExp returns the Exp field value if set, zero value otherwise.
 func (o *IntrospectedOAuth2Token) GetExp() int64 {
 	if o == nil || IsNil(o.Exp) {
@@ -529,6 +563,9 @@ func (o IntrospectedOAuth2Token) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ClientId) {
 		toSerialize["client_id"] = o.ClientId
 	}
+	if !IsNil(o.Cnf) {
+		toSerialize["cnf"] = o.Cnf
+	}
 	if !IsNil(o.Exp) {
 		toSerialize["exp"] = o.Exp
 	}
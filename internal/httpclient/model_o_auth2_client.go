@@ -25,6 +25,8 @@ type OAuth2Client struct {
 	AccessTokenStrategy *string `json:"access_token_strategy,omitempty"`
 	// OAuth 2.0 Client Allowed CORS Origins  One or more URLs (scheme://host[:port]) which are allowed to make CORS requests to the /oauth/token endpoint. If this array is empty, the server's CORS origin configuration (`CORS_ALLOWED_ORIGINS`) will be used instead. If this array is set, the allowed origins are appended to the server's CORS origin configuration. Be aware that environment variable `CORS_ENABLED` MUST be set to `true` for this to work.
 	AllowedCorsOrigins []string `json:"allowed_cors_origins,omitempty"`
+	// OpenID Connect Client Application Type  Kind of the application, either \"web\" or \"native\". Web clients must use https redirect URIs which are not on the loopback interface. Native clients must use private-use URI schemes in reverse domain name notation, https or loopback redirect URIs, must not have a client secret and must use PKCE. If omitted, neither profile is enforced.
+	ApplicationType *string `json:"application_type,omitempty"`
 	// OAuth 2.0 Client Audience  An allow-list defining the audiences this client is allowed to request tokens for. An audience limits the applicability of an OAuth 2.0 Access Token to, for example, certain API endpoints. The value is a list of URLs. URLs MUST NOT contain whitespaces.
 	Audience []string `json:"audience,omitempty"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
@@ -65,11 +67,19 @@ type OAuth2Client struct {
 	FrontchannelLogoutUri *string `json:"frontchannel_logout_uri,omitempty"`
 	// OAuth 2.0 Client Grant Types  An array of OAuth 2.0 grant types the client is allowed to use. Can be one of:  Client Credentials Grant: `client_credentials` Authorization Code Grant: `authorization_code` OpenID Connect Implicit Grant (deprecated!): `implicit` Refresh Token Grant: `refresh_token` OAuth 2.0 Token Exchange: `urn:ietf:params:oauth:grant-type:jwt-bearer` OAuth 2.0 Device Code Grant: `urn:ietf:params:oauth:grant-type:device_code`
 	GrantTypes []string `json:"grant_types,omitempty"`
+	// OpenID Connect ID Token Signed Response Algorithm  JWS alg algorithm [JWA] REQUIRED for signing the ID Token issued to this Client. One of "RS256", "ES256", "ES384", "PS256" and "EdDSA". The ID Token is signed with the active key of the algorithm in the "hydra.openid.id-token" JSON Web Key Set, which is the most recent key of that algorithm. If omitted, the most recent key of the set is used.
+	IdTokenSignedResponseAlg *string `json:"id_token_signed_response_alg,omitempty"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
 	ImplicitGrantAccessTokenLifespan *string `json:"implicit_grant_access_token_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
 	ImplicitGrantIdTokenLifespan *string        `json:"implicit_grant_id_token_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
 	Jwks                         *JsonWebKeySet `json:"jwks,omitempty"`
+	// OAuth 2.0 Token Introspection Encrypted Response Algorithm  JWE alg algorithm [JWA] for encrypting JWT introspection responses [RFC9701] requested by this Client as a resource server. One of \"RSA-OAEP\", \"RSA-OAEP-256\", \"ECDH-ES\", \"ECDH-ES+A128KW\" and \"ECDH-ES+A256KW\". The response is encrypted with a key of the client's JSON Web Key Set. If omitted, the response is not encrypted.
+	IntrospectionEncryptedResponseAlg *string `json:"introspection_encrypted_response_alg,omitempty"`
+	// OAuth 2.0 Token Introspection Encrypted Response Encryption Algorithm  JWE enc algorithm [JWA] for encrypting JWT introspection responses [RFC9701] requested by this Client as a resource server. One of \"A128CBC-HS256\", \"A256CBC-HS512\", \"A128GCM\" and \"A256GCM\". Defaults to \"A128CBC-HS256\" if introspection_encrypted_response_alg is set.
+	IntrospectionEncryptedResponseEnc *string `json:"introspection_encrypted_response_enc,omitempty"`
+	// OAuth 2.0 Token Introspection Signed Response Algorithm  JWS alg algorithm [JWA] for signing JWT introspection responses [RFC9701] requested by this Client as a resource server. One of \"RS256\", \"ES256\", \"ES384\", \"PS256\" and \"EdDSA\". The response is signed with the active key of the algorithm in the \"hydra.oauth2.introspection\" JSON Web Key Set. Defaults to \"RS256\".
+	IntrospectionSignedResponseAlg *string `json:"introspection_signed_response_alg,omitempty"`
 	// OAuth 2.0 Client JSON Web Key Set URL  URL for the Client's JSON Web Key Set [JWK] document. If the Client signs requests to the Server, it contains the signing key(s) the Server uses to validate signatures from the Client. The JWK Set MAY also contain the Client's encryption keys(s), which are used by the Server to encrypt responses to the Client. When both signing and encryption keys are made available, a use (Key Use) parameter value is REQUIRED for all keys in the referenced JWK Set to indicate each key's intended usage. Although some algorithms allow the same key to be used for both signatures and encryption, doing so is NOT RECOMMENDED, as it is less secure. The JWK x5c parameter MAY be used to provide X.509 representations of keys provided. When used, the bare key values MUST still be present and MUST match those in the certificate.
 	JwksUri *string `json:"jwks_uri,omitempty"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
@@ -91,6 +101,18 @@ type OAuth2Client struct {
 	RefreshTokenGrantIdTokenLifespan *string `json:"refresh_token_grant_id_token_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
 	RefreshTokenGrantRefreshTokenLifespan *string `json:"refresh_token_grant_refresh_token_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// Specify a time duration in milliseconds, seconds, minutes, hours.
+	RefreshTokenIdleLifespan *string `json:"refresh_token_idle_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// Specify a time duration in milliseconds, seconds, minutes, hours.
+	RefreshTokenMaxLifespan *string `json:"refresh_token_max_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// OAuth 2.0 Refresh Token Reuse Policy  RefreshTokenReusePolicy defines what is revoked when a refresh token which was already used is presented again. All tokens issued with the same authorization are always revoked. Valid options are `revoke_tokens` (the default), `revoke_login_session` which additionally revokes the login session the tokens were issued in, and `revoke_consent` which additionally revokes the consent.
+	RefreshTokenReusePolicy *string `json:"refresh_token_reuse_policy,omitempty"`
+	// OAuth2 2.0 Refresh Token Rotation Disabled  If set to true, the OAuth2 2.0 Refresh Token Grant returns the presented refresh token instead of issuing a new one. Can not be combined with a refresh token idle lifespan or a rotation grace period.
+	RefreshTokenRotationDisabled *bool `json:"refresh_token_rotation_disabled,omitempty"`
+	// Specify a time duration in milliseconds, seconds, minutes, hours.
+	RefreshTokenRotationGracePeriod *string `json:"refresh_token_rotation_grace_period,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// OAuth2 2.0 Refresh Token Rotation Grace Reuse Count  How often a rotated refresh token of this OAuth 2.0 Client can be used within the grace period. Overrides `oauth2.grant.refresh_token.rotation_grace_reuse_count`.
+	RefreshTokenRotationGraceReuseCount *int64 `json:"refresh_token_rotation_grace_reuse_count,omitempty"`
 	// OpenID Connect Dynamic Client Registration Access Token  RegistrationAccessToken can be used to update, get, or delete the OAuth2 Client. It is sent when creating a client using Dynamic Client Registration.
 	RegistrationAccessToken *string `json:"registration_access_token,omitempty"`
 	// OpenID Connect Dynamic Client Registration URL  RegistrationClientURI is the URL used to update, get, or delete the OAuth2 Client.
@@ -105,13 +127,19 @@ type OAuth2Client struct {
 	Scope *string `json:"scope,omitempty"`
 	// OpenID Connect Sector Identifier URI  URL using the https scheme to be used in calculating Pseudonymous Identifiers by the OP. The URL references a file with a single JSON array of redirect_uri values.
 	SectorIdentifierUri *string `json:"sector_identifier_uri,omitempty"`
+	// OAuth 2.0 Client Security Profile  The security profile enforced for this client. One of \"fapi2_security\" and \"fapi2_message_signing\". If set, authorization requests must be pushed and use S256 PKCE, the client must authenticate with private_key_jwt, tls_client_auth or self_signed_tls_client_auth, access tokens are sender-constrained with DPoP, and authorization codes expire after at most 60 seconds. The \"fapi2_message_signing\" profile additionally requires signed request objects.
+	SecurityProfile *string `json:"security_profile,omitempty"`
 	// SkipConsent skips the consent screen for this client. This field can only be set from the admin API.
 	SkipConsent *bool `json:"skip_consent,omitempty"`
 	// SkipLogoutConsent skips the logout consent screen for this client. This field can only be set from the admin API.
 	SkipLogoutConsent *bool `json:"skip_logout_consent,omitempty"`
+	// OpenID Connect Dynamic Client Registration Software Statement  SoftwareStatement is a JSON Web Token signed by a trusted issuer which asserts client metadata (RFC 7591). The claims of the software statement override the client metadata sent in the registration request. It is echoed in the registration response, but not stored.
+	SoftwareStatement *string `json:"software_statement,omitempty"`
 	// OpenID Connect Subject Type  The `subject_types_supported` Discovery parameter contains a list of the supported subject_type values for this server. Valid types include `pairwise` and `public`.
 	SubjectType *string `json:"subject_type,omitempty"`
-	// OAuth 2.0 Token Endpoint Authentication Method  Requested Client Authentication method for the Token Endpoint. The options are:  `client_secret_basic`: (default) Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` encoded in the HTTP Authorization header. `client_secret_post`: Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` in the HTTP body. `private_key_jwt`: Use JSON Web Tokens to authenticate the client. `none`: Used for public clients (native apps, mobile apps) which can not have secrets.
+	// OAuth 2.0 Client TLS Client Auth Subject DN  The expected subject distinguished name of the certificate the client uses for mutual TLS client authentication [RFC8705], for example \"CN=client,O=Example\". Required if token_endpoint_auth_method is \"tls_client_auth\".
+	TlsClientAuthSubjectDn *string `json:"tls_client_auth_subject_dn,omitempty"`
+	// OAuth 2.0 Token Endpoint Authentication Method  Requested Client Authentication method for the Token Endpoint. The options are:  `client_secret_basic`: (default) Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` encoded in the HTTP Authorization header. `client_secret_post`: Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` in the HTTP body. `private_key_jwt`: Use JSON Web Tokens to authenticate the client. `tls_client_auth`: Use a mutual TLS client certificate issued by a trusted certificate authority (RFC 8705). `self_signed_tls_client_auth`: Use a self-signed mutual TLS client certificate whose key is in the client's JSON Web Key Set (RFC 8705). `none`: Used for public clients (native apps, mobile apps) which can not have secrets.
 	TokenEndpointAuthMethod *string `json:"token_endpoint_auth_method,omitempty"`
 	// OAuth 2.0 Token Endpoint Signing Algorithm  Requested Client Authentication signing algorithm for the Token Endpoint.
 	TokenEndpointAuthSigningAlg *string `json:"token_endpoint_auth_signing_alg,omitempty"`
@@ -119,7 +147,7 @@ type OAuth2Client struct {
 	TosUri *string `json:"tos_uri,omitempty"`
 	// OAuth 2.0 Client Last Update Date  UpdatedAt returns the timestamp of the last update.
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
-	// OpenID Connect Request Userinfo Signed Response Algorithm  JWS alg algorithm [JWA] REQUIRED for signing UserInfo Responses. If this is specified, the response will be JWT [JWT] serialized, and signed using JWS. The default, if omitted, is for the UserInfo Response to return the Claims as a UTF-8 encoded JSON object using the application/json content-type.
+	// OpenID Connect Request Userinfo Signed Response Algorithm  JWS alg algorithm [JWA] REQUIRED for signing UserInfo Responses. If this is specified, the response will be JWT [JWT] serialized, and signed using JWS. The default, if omitted, is for the UserInfo Response to return the Claims as a UTF-8 encoded JSON object using the application/json content-type.  One of "none", "RS256", "ES256", "ES384", "PS256" and "EdDSA". The response is signed with the active key of the algorithm in the "hydra.openid.id-token" JSON Web Key Set.
 	UserinfoSignedResponseAlg *string `json:"userinfo_signed_response_alg,omitempty"`
 }
 
@@ -208,6 +236,38 @@ func (o *OAuth2Client) SetAllowedCorsOrigins(v []string) {
 	o.AllowedCorsOrigins = v
 }
 
+// GetApplicationType returns the ApplicationType field value if set, zero value otherwise.
+func (o *OAuth2Client) GetApplicationType() string {
+	if o == nil || IsNil(o.ApplicationType) {
+		var ret string
+		return ret
+	}
+	return *o.ApplicationType
+}
+
+// GetApplicationTypeOk returns a tuple with the ApplicationType field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetApplicationTypeOk() (*string, bool) {
+	if o == nil || IsNil(o.ApplicationType) {
+		return nil, false
+	}
+	return o.ApplicationType, true
+}
+
+// HasApplicationType returns a boolean if a field has been set.
+func (o *OAuth2Client) HasApplicationType() bool {
+	if o != nil && !IsNil(o.ApplicationType) {
+		return true
+	}
+
+	return false
+}
+
+// SetApplicationType gets a reference to the given string and assigns it to the ApplicationType field.
+func (o *OAuth2Client) SetApplicationType(v string) {
+	o.ApplicationType = &v
+}
+
 // GetAudience returns the Audience field value if set, zero value otherwise.
 func (o *OAuth2Client) GetAudience() []string {
 	if o == nil || IsNil(o.Audience) {
@@ -848,6 +908,38 @@ func (o *OAuth2Client) SetGrantTypes(v []string) {
 	o.GrantTypes = v
 }
 
+// GetIdTokenSignedResponseAlg returns the IdTokenSignedResponseAlg field value if set, zero value otherwise.
+func (o *OAuth2Client) GetIdTokenSignedResponseAlg() string {
+	if o == nil || IsNil(o.IdTokenSignedResponseAlg) {
+		var ret string
+		return ret
+	}
+	return *o.IdTokenSignedResponseAlg
+}
+
+// GetIdTokenSignedResponseAlgOk returns a tuple with the IdTokenSignedResponseAlg field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetIdTokenSignedResponseAlgOk() (*string, bool) {
+	if o == nil || IsNil(o.IdTokenSignedResponseAlg) {
+		return nil, false
+	}
+	return o.IdTokenSignedResponseAlg, true
+}
+
+// HasIdTokenSignedResponseAlg returns a boolean if a field has been set.
+func (o *OAuth2Client) HasIdTokenSignedResponseAlg() bool {
+	if o != nil && !IsNil(o.IdTokenSignedResponseAlg) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdTokenSignedResponseAlg gets a reference to the given string and assigns it to the IdTokenSignedResponseAlg field.
+func (o *OAuth2Client) SetIdTokenSignedResponseAlg(v string) {
+	o.IdTokenSignedResponseAlg = &v
+}
+
 // GetImplicitGrantAccessTokenLifespan returns the ImplicitGrantAccessTokenLifespan field value if set, zero value otherwise.
 func (o *OAuth2Client) GetImplicitGrantAccessTokenLifespan() string {
 	if o == nil || IsNil(o.ImplicitGrantAccessTokenLifespan) {
@@ -944,6 +1036,102 @@ func (o *OAuth2Client) SetJwks(v JsonWebKeySet) {
 	o.Jwks = &v
 }
 
+// GetIntrospectionEncryptedResponseAlg returns the IntrospectionEncryptedResponseAlg field value if set, zero value otherwise.
+func (o *OAuth2Client) GetIntrospectionEncryptedResponseAlg() string {
+	if o == nil || IsNil(o.IntrospectionEncryptedResponseAlg) {
+		var ret string
+		return ret
+	}
+	return *o.IntrospectionEncryptedResponseAlg
+}
+
+// GetIntrospectionEncryptedResponseAlgOk returns a tuple with the IntrospectionEncryptedResponseAlg field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetIntrospectionEncryptedResponseAlgOk() (*string, bool) {
+	if o == nil || IsNil(o.IntrospectionEncryptedResponseAlg) {
+		return nil, false
+	}
+	return o.IntrospectionEncryptedResponseAlg, true
+}
+
+// HasIntrospectionEncryptedResponseAlg returns a boolean if a field has been set.
+func (o *OAuth2Client) HasIntrospectionEncryptedResponseAlg() bool {
+	if o != nil && !IsNil(o.IntrospectionEncryptedResponseAlg) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionEncryptedResponseAlg gets a reference to the given string and assigns it to the IntrospectionEncryptedResponseAlg field.
+func (o *OAuth2Client) SetIntrospectionEncryptedResponseAlg(v string) {
+	o.IntrospectionEncryptedResponseAlg = &v
+}
+
+// GetIntrospectionEncryptedResponseEnc returns the IntrospectionEncryptedResponseEnc field value if set, zero value otherwise.
+func (o *OAuth2Client) GetIntrospectionEncryptedResponseEnc() string {
+	if o == nil || IsNil(o.IntrospectionEncryptedResponseEnc) {
+		var ret string
+		return ret
+	}
+	return *o.IntrospectionEncryptedResponseEnc
+}
+
+// GetIntrospectionEncryptedResponseEncOk returns a tuple with the IntrospectionEncryptedResponseEnc field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetIntrospectionEncryptedResponseEncOk() (*string, bool) {
+	if o == nil || IsNil(o.IntrospectionEncryptedResponseEnc) {
+		return nil, false
+	}
+	return o.IntrospectionEncryptedResponseEnc, true
+}
+
+// HasIntrospectionEncryptedResponseEnc returns a boolean if a field has been set.
+func (o *OAuth2Client) HasIntrospectionEncryptedResponseEnc() bool {
+	if o != nil && !IsNil(o.IntrospectionEncryptedResponseEnc) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionEncryptedResponseEnc gets a reference to the given string and assigns it to the IntrospectionEncryptedResponseEnc field.
+func (o *OAuth2Client) SetIntrospectionEncryptedResponseEnc(v string) {
+	o.IntrospectionEncryptedResponseEnc = &v
+}
+
+// GetIntrospectionSignedResponseAlg returns the IntrospectionSignedResponseAlg field value if set, zero value otherwise.
+func (o *OAuth2Client) GetIntrospectionSignedResponseAlg() string {
+	if o == nil || IsNil(o.IntrospectionSignedResponseAlg) {
+		var ret string
+		return ret
+	}
+	return *o.IntrospectionSignedResponseAlg
+}
+
+// GetIntrospectionSignedResponseAlgOk returns a tuple with the IntrospectionSignedResponseAlg field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetIntrospectionSignedResponseAlgOk() (*string, bool) {
+	if o == nil || IsNil(o.IntrospectionSignedResponseAlg) {
+		return nil, false
+	}
+	return o.IntrospectionSignedResponseAlg, true
+}
+
+// HasIntrospectionSignedResponseAlg returns a boolean if a field has been set.
+func (o *OAuth2Client) HasIntrospectionSignedResponseAlg() bool {
+	if o != nil && !IsNil(o.IntrospectionSignedResponseAlg) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionSignedResponseAlg gets a reference to the given string and assigns it to the IntrospectionSignedResponseAlg field.
+func (o *OAuth2Client) SetIntrospectionSignedResponseAlg(v string) {
+	o.IntrospectionSignedResponseAlg = &v
+}
+
 // GetJwksUri returns the JwksUri field value if set, zero value otherwise.
 func (o *OAuth2Client) GetJwksUri() string {
 	if o == nil || IsNil(o.JwksUri) {
@@ -1297,6 +1485,198 @@ func (o *OAuth2Client) SetRefreshTokenGrantRefreshTokenLifespan(v string) {
 	o.RefreshTokenGrantRefreshTokenLifespan = &v
 }
 
+// GetRefreshTokenIdleLifespan returns the RefreshTokenIdleLifespan field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRefreshTokenIdleLifespan() string {
+	if o == nil || IsNil(o.RefreshTokenIdleLifespan) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenIdleLifespan
+}
+
+// GetRefreshTokenIdleLifespanOk returns a tuple with the RefreshTokenIdleLifespan field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRefreshTokenIdleLifespanOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenIdleLifespan) {
+		return nil, false
+	}
+	return o.RefreshTokenIdleLifespan, true
+}
+
+// HasRefreshTokenIdleLifespan returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRefreshTokenIdleLifespan() bool {
+	if o != nil && !IsNil(o.RefreshTokenIdleLifespan) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenIdleLifespan gets a reference to the given string and assigns it to the RefreshTokenIdleLifespan field.
+func (o *OAuth2Client) SetRefreshTokenIdleLifespan(v string) {
+	o.RefreshTokenIdleLifespan = &v
+}
+
+// GetRefreshTokenMaxLifespan returns the RefreshTokenMaxLifespan field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRefreshTokenMaxLifespan() string {
+	if o == nil || IsNil(o.RefreshTokenMaxLifespan) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenMaxLifespan
+}
+
+// GetRefreshTokenMaxLifespanOk returns a tuple with the RefreshTokenMaxLifespan field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRefreshTokenMaxLifespanOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenMaxLifespan) {
+		return nil, false
+	}
+	return o.RefreshTokenMaxLifespan, true
+}
+
+// HasRefreshTokenMaxLifespan returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRefreshTokenMaxLifespan() bool {
+	if o != nil && !IsNil(o.RefreshTokenMaxLifespan) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenMaxLifespan gets a reference to the given string and assigns it to the RefreshTokenMaxLifespan field.
+func (o *OAuth2Client) SetRefreshTokenMaxLifespan(v string) {
+	o.RefreshTokenMaxLifespan = &v
+}
+
+// GetRefreshTokenReusePolicy returns the RefreshTokenReusePolicy field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRefreshTokenReusePolicy() string {
+	if o == nil || IsNil(o.RefreshTokenReusePolicy) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenReusePolicy
+}
+
+// GetRefreshTokenReusePolicyOk returns a tuple with the RefreshTokenReusePolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRefreshTokenReusePolicyOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenReusePolicy) {
+		return nil, false
+	}
+	return o.RefreshTokenReusePolicy, true
+}
+
+// HasRefreshTokenReusePolicy returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRefreshTokenReusePolicy() bool {
+	if o != nil && !IsNil(o.RefreshTokenReusePolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenReusePolicy gets a reference to the given string and assigns it to the RefreshTokenReusePolicy field.
+func (o *OAuth2Client) SetRefreshTokenReusePolicy(v string) {
+	o.RefreshTokenReusePolicy = &v
+}
+
+// GetRefreshTokenRotationDisabled returns the RefreshTokenRotationDisabled field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRefreshTokenRotationDisabled() bool {
+	if o == nil || IsNil(o.RefreshTokenRotationDisabled) {
+		var ret bool
+		return ret
+	}
+	return *o.RefreshTokenRotationDisabled
+}
+
+// GetRefreshTokenRotationDisabledOk returns a tuple with the RefreshTokenRotationDisabled field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRefreshTokenRotationDisabledOk() (*bool, bool) {
+	if o == nil || IsNil(o.RefreshTokenRotationDisabled) {
+		return nil, false
+	}
+	return o.RefreshTokenRotationDisabled, true
+}
+
+// HasRefreshTokenRotationDisabled returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRefreshTokenRotationDisabled() bool {
+	if o != nil && !IsNil(o.RefreshTokenRotationDisabled) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenRotationDisabled gets a reference to the given bool and assigns it to the RefreshTokenRotationDisabled field.
+func (o *OAuth2Client) SetRefreshTokenRotationDisabled(v bool) {
+	o.RefreshTokenRotationDisabled = &v
+}
+
+// GetRefreshTokenRotationGracePeriod returns the RefreshTokenRotationGracePeriod field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRefreshTokenRotationGracePeriod() string {
+	if o == nil || IsNil(o.RefreshTokenRotationGracePeriod) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenRotationGracePeriod
+}
+
+// GetRefreshTokenRotationGracePeriodOk returns a tuple with the RefreshTokenRotationGracePeriod field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRefreshTokenRotationGracePeriodOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenRotationGracePeriod) {
+		return nil, false
+	}
+	return o.RefreshTokenRotationGracePeriod, true
+}
+
+// HasRefreshTokenRotationGracePeriod returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRefreshTokenRotationGracePeriod() bool {
+	if o != nil && !IsNil(o.RefreshTokenRotationGracePeriod) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenRotationGracePeriod gets a reference to the given string and assigns it to the RefreshTokenRotationGracePeriod field.
+func (o *OAuth2Client) SetRefreshTokenRotationGracePeriod(v string) {
+	o.RefreshTokenRotationGracePeriod = &v
+}
+
+// GetRefreshTokenRotationGraceReuseCount returns the RefreshTokenRotationGraceReuseCount field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRefreshTokenRotationGraceReuseCount() int64 {
+	if o == nil || IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		var ret int64
+		return ret
+	}
+	return *o.RefreshTokenRotationGraceReuseCount
+}
+
+// GetRefreshTokenRotationGraceReuseCountOk returns a tuple with the RefreshTokenRotationGraceReuseCount field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRefreshTokenRotationGraceReuseCountOk() (*int64, bool) {
+	if o == nil || IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		return nil, false
+	}
+	return o.RefreshTokenRotationGraceReuseCount, true
+}
+
+// HasRefreshTokenRotationGraceReuseCount returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRefreshTokenRotationGraceReuseCount() bool {
+	if o != nil && !IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenRotationGraceReuseCount gets a reference to the given int64 and assigns it to the RefreshTokenRotationGraceReuseCount field.
+func (o *OAuth2Client) SetRefreshTokenRotationGraceReuseCount(v int64) {
+	o.RefreshTokenRotationGraceReuseCount = &v
+}
+
 // GetRegistrationAccessToken returns the RegistrationAccessToken field value if set, zero value otherwise.
 func (o *OAuth2Client) GetRegistrationAccessToken() string {
 	if o == nil || IsNil(o.RegistrationAccessToken) {
@@ -1521,6 +1901,38 @@ func (o *OAuth2Client) SetSectorIdentifierUri(v string) {
 	o.SectorIdentifierUri = &v
 }
 
+// GetSecurityProfile returns the SecurityProfile field value if set, zero value otherwise.
+func (o *OAuth2Client) GetSecurityProfile() string {
+	if o == nil || IsNil(o.SecurityProfile) {
+		var ret string
+		return ret
+	}
+	return *o.SecurityProfile
+}
+
+// GetSecurityProfileOk returns a tuple with the SecurityProfile field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetSecurityProfileOk() (*string, bool) {
+	if o == nil || IsNil(o.SecurityProfile) {
+		return nil, false
+	}
+	return o.SecurityProfile, true
+}
+
+// HasSecurityProfile returns a boolean if a field has been set.
+func (o *OAuth2Client) HasSecurityProfile() bool {
+	if o != nil && !IsNil(o.SecurityProfile) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurityProfile gets a reference to the given string and assigns it to the SecurityProfile field.
+func (o *OAuth2Client) SetSecurityProfile(v string) {
+	o.SecurityProfile = &v
+}
+
 // GetSkipConsent returns the SkipConsent field value if set, zero value otherwise.
 func (o *OAuth2Client) GetSkipConsent() bool {
 	if o == nil || IsNil(o.SkipConsent) {
@@ -1585,6 +1997,38 @@ func (o *OAuth2Client) SetSkipLogoutConsent(v bool) {
 	o.SkipLogoutConsent = &v
 }
 
+// GetSoftwareStatement returns the SoftwareStatement field value if set, zero value otherwise.
+func (o *OAuth2Client) GetSoftwareStatement() string {
+	if o == nil || IsNil(o.SoftwareStatement) {
+		var ret string
+		return ret
+	}
+	return *o.SoftwareStatement
+}
+
+// GetSoftwareStatementOk returns a tuple with the SoftwareStatement field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetSoftwareStatementOk() (*string, bool) {
+	if o == nil || IsNil(o.SoftwareStatement) {
+		return nil, false
+	}
+	return o.SoftwareStatement, true
+}
+
+// HasSoftwareStatement returns a boolean if a field has been set.
+func (o *OAuth2Client) HasSoftwareStatement() bool {
+	if o != nil && !IsNil(o.SoftwareStatement) {
+		return true
+	}
+
+	return false
+}
+
+// SetSoftwareStatement gets a reference to the given string and assigns it to the SoftwareStatement field.
+func (o *OAuth2Client) SetSoftwareStatement(v string) {
+	o.SoftwareStatement = &v
+}
+
 // GetSubjectType returns the SubjectType field value if set, zero value otherwise.
 func (o *OAuth2Client) GetSubjectType() string {
 	if o == nil || IsNil(o.SubjectType) {
@@ -1617,6 +2061,38 @@ func (o *OAuth2Client) SetSubjectType(v string) {
 	o.SubjectType = &v
 }
 
+// GetTlsClientAuthSubjectDn returns the TlsClientAuthSubjectDn field value if set, zero value otherwise.
+func (o *OAuth2Client) GetTlsClientAuthSubjectDn() string {
+	if o == nil || IsNil(o.TlsClientAuthSubjectDn) {
+		var ret string
+		return ret
+	}
+	return *o.TlsClientAuthSubjectDn
+}
+
+// GetTlsClientAuthSubjectDnOk returns a tuple with the TlsClientAuthSubjectDn field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetTlsClientAuthSubjectDnOk() (*string, bool) {
+	if o == nil || IsNil(o.TlsClientAuthSubjectDn) {
+		return nil, false
+	}
+	return o.TlsClientAuthSubjectDn, true
+}
+
+// HasTlsClientAuthSubjectDn returns a boolean if a field has been set.
+func (o *OAuth2Client) HasTlsClientAuthSubjectDn() bool {
+	if o != nil && !IsNil(o.TlsClientAuthSubjectDn) {
+		return true
+	}
+
+	return false
+}
+
+// SetTlsClientAuthSubjectDn gets a reference to the given string and assigns it to the TlsClientAuthSubjectDn field.
+func (o *OAuth2Client) SetTlsClientAuthSubjectDn(v string) {
+	o.TlsClientAuthSubjectDn = &v
+}
+
 // GetTokenEndpointAuthMethod returns the TokenEndpointAuthMethod field value if set, zero value otherwise.
 func (o *OAuth2Client) GetTokenEndpointAuthMethod() string {
 	if o == nil || IsNil(o.TokenEndpointAuthMethod) {
@@ -1793,6 +2269,9 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.AllowedCorsOrigins) {
 		toSerialize["allowed_cors_origins"] = o.AllowedCorsOrigins
 	}
+	if !IsNil(o.ApplicationType) {
+		toSerialize["application_type"] = o.ApplicationType
+	}
 	if !IsNil(o.Audience) {
 		toSerialize["audience"] = o.Audience
 	}
@@ -1853,6 +2332,9 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GrantTypes) {
 		toSerialize["grant_types"] = o.GrantTypes
 	}
+	if !IsNil(o.IdTokenSignedResponseAlg) {
+		toSerialize["id_token_signed_response_alg"] = o.IdTokenSignedResponseAlg
+	}
 	if !IsNil(o.ImplicitGrantAccessTokenLifespan) {
 		toSerialize["implicit_grant_access_token_lifespan"] = o.ImplicitGrantAccessTokenLifespan
 	}
@@ -1862,6 +2344,15 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Jwks) {
 		toSerialize["jwks"] = o.Jwks
 	}
+	if !IsNil(o.IntrospectionEncryptedResponseAlg) {
+		toSerialize["introspection_encrypted_response_alg"] = o.IntrospectionEncryptedResponseAlg
+	}
+	if !IsNil(o.IntrospectionEncryptedResponseEnc) {
+		toSerialize["introspection_encrypted_response_enc"] = o.IntrospectionEncryptedResponseEnc
+	}
+	if !IsNil(o.IntrospectionSignedResponseAlg) {
+		toSerialize["introspection_signed_response_alg"] = o.IntrospectionSignedResponseAlg
+	}
 	if !IsNil(o.JwksUri) {
 		toSerialize["jwks_uri"] = o.JwksUri
 	}
@@ -1895,6 +2386,24 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.RefreshTokenGrantRefreshTokenLifespan) {
 		toSerialize["refresh_token_grant_refresh_token_lifespan"] = o.RefreshTokenGrantRefreshTokenLifespan
 	}
+	if !IsNil(o.RefreshTokenIdleLifespan) {
+		toSerialize["refresh_token_idle_lifespan"] = o.RefreshTokenIdleLifespan
+	}
+	if !IsNil(o.RefreshTokenMaxLifespan) {
+		toSerialize["refresh_token_max_lifespan"] = o.RefreshTokenMaxLifespan
+	}
+	if !IsNil(o.RefreshTokenReusePolicy) {
+		toSerialize["refresh_token_reuse_policy"] = o.RefreshTokenReusePolicy
+	}
+	if !IsNil(o.RefreshTokenRotationDisabled) {
+		toSerialize["refresh_token_rotation_disabled"] = o.RefreshTokenRotationDisabled
+	}
+	if !IsNil(o.RefreshTokenRotationGracePeriod) {
+		toSerialize["refresh_token_rotation_grace_period"] = o.RefreshTokenRotationGracePeriod
+	}
+	if !IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		toSerialize["refresh_token_rotation_grace_reuse_count"] = o.RefreshTokenRotationGraceReuseCount
+	}
 	if !IsNil(o.RegistrationAccessToken) {
 		toSerialize["registration_access_token"] = o.RegistrationAccessToken
 	}
@@ -1916,15 +2425,24 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.SectorIdentifierUri) {
 		toSerialize["sector_identifier_uri"] = o.SectorIdentifierUri
 	}
+	if !IsNil(o.SecurityProfile) {
+		toSerialize["security_profile"] = o.SecurityProfile
+	}
 	if !IsNil(o.SkipConsent) {
 		toSerialize["skip_consent"] = o.SkipConsent
 	}
 	if !IsNil(o.SkipLogoutConsent) {
 		toSerialize["skip_logout_consent"] = o.SkipLogoutConsent
 	}
+	if !IsNil(o.SoftwareStatement) {
+		toSerialize["software_statement"] = o.SoftwareStatement
+	}
 	if !IsNil(o.SubjectType) {
 		toSerialize["subject_type"] = o.SubjectType
 	}
+	if !IsNil(o.TlsClientAuthSubjectDn) {
+		toSerialize["tls_client_auth_subject_dn"] = o.TlsClientAuthSubjectDn
+	}
 	if !IsNil(o.TokenEndpointAuthMethod) {
 		toSerialize["token_endpoint_auth_method"] = o.TokenEndpointAuthMethod
 	}
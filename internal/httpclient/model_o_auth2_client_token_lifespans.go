@@ -46,6 +46,16 @@ type OAuth2ClientTokenLifespans struct {
 	RefreshTokenGrantIdTokenLifespan *string `json:"refresh_token_grant_id_token_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
 	RefreshTokenGrantRefreshTokenLifespan *string `json:"refresh_token_grant_refresh_token_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// Specify a time duration in milliseconds, seconds, minutes, hours.
+	RefreshTokenIdleLifespan *string `json:"refresh_token_idle_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// Specify a time duration in milliseconds, seconds, minutes, hours.
+	RefreshTokenMaxLifespan *string `json:"refresh_token_max_lifespan,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// OAuth2 2.0 Refresh Token Rotation Disabled  If set to true, the OAuth2 2.0 Refresh Token Grant returns the presented refresh token instead of issuing a new one. Can not be combined with a refresh token idle lifespan or a rotation grace period.
+	RefreshTokenRotationDisabled *bool `json:"refresh_token_rotation_disabled,omitempty"`
+	// Specify a time duration in milliseconds, seconds, minutes, hours.
+	RefreshTokenRotationGracePeriod *string `json:"refresh_token_rotation_grace_period,omitempty" validate:"regexp=^([0-9]+(ns|us|ms|s|m|h))*$"`
+	// OAuth2 2.0 Refresh Token Rotation Grace Reuse Count  How often a rotated refresh token of this OAuth 2.0 Client can be used within the grace period. Overrides `oauth2.grant.refresh_token.rotation_grace_reuse_count`.
+	RefreshTokenRotationGraceReuseCount *int64 `json:"refresh_token_rotation_grace_reuse_count,omitempty"`
 }
 
 // NewOAuth2ClientTokenLifespans instantiates a new OAuth2ClientTokenLifespans object
@@ -481,6 +491,166 @@ func (o *OAuth2ClientTokenLifespans) SetRefreshTokenGrantRefreshTokenLifespan(v
 	o.RefreshTokenGrantRefreshTokenLifespan = &v
 }
 
+// GetRefreshTokenIdleLifespan returns the RefreshTokenIdleLifespan field value if set, zero value otherwise.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenIdleLifespan() string {
+	if o == nil || IsNil(o.RefreshTokenIdleLifespan) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenIdleLifespan
+}
+
+// GetRefreshTokenIdleLifespanOk returns a tuple with the RefreshTokenIdleLifespan field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenIdleLifespanOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenIdleLifespan) {
+		return nil, false
+	}
+	return o.RefreshTokenIdleLifespan, true
+}
+
+// HasRefreshTokenIdleLifespan returns a boolean if a field has been set.
+func (o *OAuth2ClientTokenLifespans) HasRefreshTokenIdleLifespan() bool {
+	if o != nil && !IsNil(o.RefreshTokenIdleLifespan) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenIdleLifespan gets a reference to the given string and assigns it to the RefreshTokenIdleLifespan field.
+func (o *OAuth2ClientTokenLifespans) SetRefreshTokenIdleLifespan(v string) {
+	o.RefreshTokenIdleLifespan = &v
+}
+
+// GetRefreshTokenMaxLifespan returns the RefreshTokenMaxLifespan field value if set, zero value otherwise.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenMaxLifespan() string {
+	if o == nil || IsNil(o.RefreshTokenMaxLifespan) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenMaxLifespan
+}
+
+// GetRefreshTokenMaxLifespanOk returns a tuple with the RefreshTokenMaxLifespan field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenMaxLifespanOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenMaxLifespan) {
+		return nil, false
+	}
+	return o.RefreshTokenMaxLifespan, true
+}
+
+// HasRefreshTokenMaxLifespan returns a boolean if a field has been set.
+func (o *OAuth2ClientTokenLifespans) HasRefreshTokenMaxLifespan() bool {
+	if o != nil && !IsNil(o.RefreshTokenMaxLifespan) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenMaxLifespan gets a reference to the given string and assigns it to the RefreshTokenMaxLifespan field.
+func (o *OAuth2ClientTokenLifespans) SetRefreshTokenMaxLifespan(v string) {
+	o.RefreshTokenMaxLifespan = &v
+}
+
+// GetRefreshTokenRotationDisabled returns the RefreshTokenRotationDisabled field value if set, zero value otherwise.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenRotationDisabled() bool {
+	if o == nil || IsNil(o.RefreshTokenRotationDisabled) {
+		var ret bool
+		return ret
+	}
+	return *o.RefreshTokenRotationDisabled
+}
+
+// GetRefreshTokenRotationDisabledOk returns a tuple with the RefreshTokenRotationDisabled field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenRotationDisabledOk() (*bool, bool) {
+	if o == nil || IsNil(o.RefreshTokenRotationDisabled) {
+		return nil, false
+	}
+	return o.RefreshTokenRotationDisabled, true
+}
+
+// HasRefreshTokenRotationDisabled returns a boolean if a field has been set.
+func (o *OAuth2ClientTokenLifespans) HasRefreshTokenRotationDisabled() bool {
+	if o != nil && !IsNil(o.RefreshTokenRotationDisabled) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenRotationDisabled gets a reference to the given bool and assigns it to the RefreshTokenRotationDisabled field.
+func (o *OAuth2ClientTokenLifespans) SetRefreshTokenRotationDisabled(v bool) {
+	o.RefreshTokenRotationDisabled = &v
+}
+
+// GetRefreshTokenRotationGracePeriod returns the RefreshTokenRotationGracePeriod field value if set, zero value otherwise.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenRotationGracePeriod() string {
+	if o == nil || IsNil(o.RefreshTokenRotationGracePeriod) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenRotationGracePeriod
+}
+
+// GetRefreshTokenRotationGracePeriodOk returns a tuple with the RefreshTokenRotationGracePeriod field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenRotationGracePeriodOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenRotationGracePeriod) {
+		return nil, false
+	}
+	return o.RefreshTokenRotationGracePeriod, true
+}
+
+// HasRefreshTokenRotationGracePeriod returns a boolean if a field has been set.
+func (o *OAuth2ClientTokenLifespans) HasRefreshTokenRotationGracePeriod() bool {
+	if o != nil && !IsNil(o.RefreshTokenRotationGracePeriod) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenRotationGracePeriod gets a reference to the given string and assigns it to the RefreshTokenRotationGracePeriod field.
+func (o *OAuth2ClientTokenLifespans) SetRefreshTokenRotationGracePeriod(v string) {
+	o.RefreshTokenRotationGracePeriod = &v
+}
+
+// GetRefreshTokenRotationGraceReuseCount returns the RefreshTokenRotationGraceReuseCount field value if set, zero value otherwise.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenRotationGraceReuseCount() int64 {
+	if o == nil || IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		var ret int64
+		return ret
+	}
+	return *o.RefreshTokenRotationGraceReuseCount
+}
+
+// GetRefreshTokenRotationGraceReuseCountOk returns a tuple with the RefreshTokenRotationGraceReuseCount field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ClientTokenLifespans) GetRefreshTokenRotationGraceReuseCountOk() (*int64, bool) {
+	if o == nil || IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		return nil, false
+	}
+	return o.RefreshTokenRotationGraceReuseCount, true
+}
+
+// HasRefreshTokenRotationGraceReuseCount returns a boolean if a field has been set.
+func (o *OAuth2ClientTokenLifespans) HasRefreshTokenRotationGraceReuseCount() bool {
+	if o != nil && !IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenRotationGraceReuseCount gets a reference to the given int64 and assigns it to the RefreshTokenRotationGraceReuseCount field.
+func (o *OAuth2ClientTokenLifespans) SetRefreshTokenRotationGraceReuseCount(v int64) {
+	o.RefreshTokenRotationGraceReuseCount = &v
+}
+
 func (o OAuth2ClientTokenLifespans) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -530,6 +700,21 @@ func (o OAuth2ClientTokenLifespans) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.RefreshTokenGrantRefreshTokenLifespan) {
 		toSerialize["refresh_token_grant_refresh_token_lifespan"] = o.RefreshTokenGrantRefreshTokenLifespan
 	}
+	if !IsNil(o.RefreshTokenIdleLifespan) {
+		toSerialize["refresh_token_idle_lifespan"] = o.RefreshTokenIdleLifespan
+	}
+	if !IsNil(o.RefreshTokenMaxLifespan) {
+		toSerialize["refresh_token_max_lifespan"] = o.RefreshTokenMaxLifespan
+	}
+	if !IsNil(o.RefreshTokenRotationDisabled) {
+		toSerialize["refresh_token_rotation_disabled"] = o.RefreshTokenRotationDisabled
+	}
+	if !IsNil(o.RefreshTokenRotationGracePeriod) {
+		toSerialize["refresh_token_rotation_grace_period"] = o.RefreshTokenRotationGracePeriod
+	}
+	if !IsNil(o.RefreshTokenRotationGraceReuseCount) {
+		toSerialize["refresh_token_rotation_grace_reuse_count"] = o.RefreshTokenRotationGraceReuseCount
+	}
 	return toSerialize, nil
 }
 
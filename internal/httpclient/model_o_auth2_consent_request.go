@@ -37,6 +37,7 @@ type OAuth2ConsentRequest struct {
 	// LoginSessionID is the login session ID. If the user-agent reuses a login session (via cookie / remember flag) this ID will remain the same. If the user-agent did not have an existing authentication session (e.g. remember is false) this will be a new random value. This value is used as the \"sid\" parameter in the ID Token and in OIDC Front-/Back- channel logout. It's value can generally be used to associate consecutive login requests by a certain user.
 	LoginSessionId *string                                   `json:"login_session_id,omitempty"`
 	OidcContext    *OAuth2ConsentRequestOpenIDConnectContext `json:"oidc_context,omitempty"`
+	Policy         *OAuth2ConsentPolicyDecision              `json:"policy,omitempty"`
 	// RequestURL is the original OAuth 2.0 Authorization URL requested by the OAuth 2.0 client. It is the URL which initiates the OAuth 2.0 Authorization Code or OAuth 2.0 Implicit flow. This URL is typically not needed, but might come in handy if you want to deal with additional request parameters.
 	RequestUrl *string `json:"request_url,omitempty"`
 	// RequestedAudience contains the access token audience as requested by the OAuth 2.0 Client.
@@ -350,6 +351,38 @@ func (o *OAuth2ConsentRequest) SetOidcContext(v OAuth2ConsentRequestOpenIDConnec
 	o.OidcContext = &v
 }
 
+// GetPolicy returns the Policy field value if set, zero value otherwise.
+func (o *OAuth2ConsentRequest) GetPolicy() OAuth2ConsentPolicyDecision {
+	if o == nil || IsNil(o.Policy) {
+		var ret OAuth2ConsentPolicyDecision
+		return ret
+	}
+	return *o.Policy
+}
+
+// GetPolicyOk returns a tuple with the Policy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ConsentRequest) GetPolicyOk() (*OAuth2ConsentPolicyDecision, bool) {
+	if o == nil || IsNil(o.Policy) {
+		return nil, false
+	}
+	return o.Policy, true
+}
+
+// HasPolicy returns a boolean if a field has been set.
+func (o *OAuth2ConsentRequest) HasPolicy() bool {
+	if o != nil && !IsNil(o.Policy) {
+		return true
+	}
+
+	return false
+}
+
+// SetPolicy gets a reference to the given OAuth2ConsentPolicyDecision and assigns it to the Policy field.
+func (o *OAuth2ConsentRequest) SetPolicy(v OAuth2ConsentPolicyDecision) {
+	o.Policy = &v
+}
+
 // GetRequestUrl returns the RequestUrl field value if set, zero value otherwise.
 func (o *OAuth2ConsentRequest) GetRequestUrl() string {
 	if o == nil || IsNil(o.RequestUrl) {
@@ -545,6 +578,9 @@ func (o OAuth2ConsentRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.OidcContext) {
 		toSerialize["oidc_context"] = o.OidcContext
 	}
+	if !IsNil(o.Policy) {
+		toSerialize["policy"] = o.Policy
+	}
 	if !IsNil(o.RequestUrl) {
 		toSerialize["request_url"] = o.RequestUrl
 	}
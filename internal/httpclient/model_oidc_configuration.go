@@ -24,6 +24,8 @@ var _ MappedNullable = &OidcConfiguration{}
 type OidcConfiguration struct {
 	// OAuth 2.0 Authorization Endpoint URL
 	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	// OAuth 2.0 Authorization Response Issuer Parameter Supported  Boolean value indicating whether the authorization server provides the iss parameter in the authorization response [RFC9207].
+	AuthorizationResponseIssParameterSupported *bool `json:"authorization_response_iss_parameter_supported,omitempty"`
 	// OpenID Connect Back-Channel Logout Session Required  Boolean value specifying whether the OP can pass a sid (session ID) Claim in the Logout Token to identify the RP session with the OP. If supported, the sid Claim is also included in ID Tokens issued by the OP
 	BackchannelLogoutSessionSupported *bool `json:"backchannel_logout_session_supported,omitempty"`
 	// OpenID Connect Back-Channel Logout Supported  Boolean value specifying whether the OP supports back-channel logout, with true indicating support.
@@ -40,6 +42,8 @@ type OidcConfiguration struct {
 	CredentialsSupportedDraft00 []CredentialSupportedDraft00 `json:"credentials_supported_draft_00,omitempty"`
 	// OAuth 2.0 Device Authorization Endpoint URL
 	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	// OAuth 2.0 DPoP Signing Algorithms Supported  JSON array containing a list of the JWS alg values supported by the authorization server for DPoP proof JWTs [RFC9449].
+	DpopSigningAlgValuesSupported []string `json:"dpop_signing_alg_values_supported,omitempty"`
 	// OpenID Connect End-Session Endpoint  URL at the OP to which an RP can perform a redirect to request that the End-User be logged out at the OP.
 	EndSessionEndpoint *string `json:"end_session_endpoint,omitempty"`
 	// OpenID Connect Front-Channel Logout Session Required  Boolean value specifying whether the OP can pass iss (issuer) and sid (session ID) query parameters to identify the RP session with the OP when the frontchannel_logout_uri is used. If supported, the sid Claim is also included in ID Tokens issued by the OP.
@@ -52,10 +56,24 @@ type OidcConfiguration struct {
 	IdTokenSignedResponseAlg []string `json:"id_token_signed_response_alg"`
 	// OpenID Connect Supported ID Token Signing Algorithms  JSON array containing a list of the JWS signing algorithms (alg values) supported by the OP for the ID Token to encode the Claims in a JWT.
 	IdTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	// OAuth 2.0 Introspection Encryption Algorithms Supported  JSON array containing a list of the JWE alg values supported by the introspection endpoint to encrypt the content encryption key of JWT introspection responses [RFC9701]. Only set if JWT introspection responses are enabled.
+	IntrospectionEncryptionAlgValuesSupported []string `json:"introspection_encryption_alg_values_supported,omitempty"`
+	// OAuth 2.0 Introspection Encryption Encodings Supported  JSON array containing a list of the JWE enc values supported by the introspection endpoint to encrypt JWT introspection responses [RFC9701]. Only set if JWT introspection responses are enabled.
+	IntrospectionEncryptionEncValuesSupported []string `json:"introspection_encryption_enc_values_supported,omitempty"`
+	// OAuth 2.0 Introspection Endpoint  URL of the authorization server's OAuth 2.0 introspection endpoint [RFC8414]. Only set in the OAuth 2.0 authorization server metadata if public introspection is enabled.
+	IntrospectionEndpoint *string `json:"introspection_endpoint,omitempty"`
+	// OAuth 2.0 Introspection Endpoint Authentication Methods Supported  JSON array containing a list of client authentication methods supported by the introspection endpoint [RFC8414]. Only set in the OAuth 2.0 authorization server metadata if public introspection is enabled.
+	IntrospectionEndpointAuthMethodsSupported []string `json:"introspection_endpoint_auth_methods_supported,omitempty"`
+	// OAuth 2.0 Introspection Signing Algorithms Supported  JSON array containing a list of the JWS alg values supported by the introspection endpoint to sign JWT introspection responses [RFC9701]. Only set if JWT introspection responses are enabled.
+	IntrospectionSigningAlgValuesSupported []string `json:"introspection_signing_alg_values_supported,omitempty"`
 	// OpenID Connect Issuer URL  An URL using the https scheme with no query or fragment component that the OP asserts as its IssuerURL Identifier. If IssuerURL discovery is supported , this value MUST be identical to the issuer value returned by WebFinger. This also MUST be identical to the iss Claim value in ID Tokens issued from this IssuerURL.
 	Issuer string `json:"issuer"`
 	// OpenID Connect Well-Known JSON Web Keys URL  URL of the OP's JSON Web Key Set [JWK] document. This contains the signing key(s) the RP uses to validate signatures from the OP. The JWK Set MAY also contain the Server's encryption key(s), which are used by RPs to encrypt requests to the Server. When both signing and encryption keys are made available, a use (Key Use) parameter value is REQUIRED for all keys in the referenced JWK Set to indicate each key's intended usage. Although some algorithms allow the same key to be used for both signatures and encryption, doing so is NOT RECOMMENDED, as it is less secure. The JWK x5c parameter MAY be used to provide X.509 representations of keys provided. When used, the bare key values MUST still be present and MUST match those in the certificate.
 	JwksUri string `json:"jwks_uri"`
+	// OAuth 2.0 Mutual TLS Endpoint Aliases  JSON object containing the URLs of the endpoints which OAuth 2.0 Clients use for mutual TLS client authentication [RFC8705], keyed by the metadata name of the endpoint. Only set if mutual TLS client authentication is enabled.
+	MtlsEndpointAliases map[string]string `json:"mtls_endpoint_aliases,omitempty"`
+	// OAuth 2.0 Pushed Authorization Request Endpoint  URL of the authorization server's pushed authorization request endpoint [RFC9126].
+	PushedAuthorizationRequestEndpoint *string `json:"pushed_authorization_request_endpoint,omitempty"`
 	// OpenID Connect Dynamic Client Registration Endpoint URL
 	RegistrationEndpoint *string `json:"registration_endpoint,omitempty"`
 	// OpenID Connect Supported Request Object Signing Algorithms  JSON array containing a list of the JWS signing algorithms (alg values) supported by the OP for Request Objects, which are described in Section 6.1 of OpenID Connect Core 1.0 [OpenID.Core]. These algorithms are used both when the Request Object is passed by value (using the request parameter) and when it is passed by reference (using the request_uri parameter).
@@ -64,6 +82,8 @@ type OidcConfiguration struct {
 	RequestParameterSupported *bool `json:"request_parameter_supported,omitempty"`
 	// OpenID Connect Request URI Parameter Supported  Boolean value specifying whether the OP supports use of the request_uri parameter, with true indicating support.
 	RequestUriParameterSupported *bool `json:"request_uri_parameter_supported,omitempty"`
+	// OAuth 2.0 Pushed Authorization Requests Required  Boolean value indicating whether the authorization server accepts authorization request data only via the pushed authorization request endpoint.
+	RequirePushedAuthorizationRequests *bool `json:"require_pushed_authorization_requests,omitempty"`
 	// OpenID Connect Requires Request URI Registration  Boolean value specifying whether the OP requires any request_uri values used to be pre-registered using the request_uris registration parameter.
 	RequireRequestUriRegistration *bool `json:"require_request_uri_registration,omitempty"`
 	// OAuth 2.0 Supported Response Modes  JSON array containing a list of the OAuth 2.0 response_mode values that this OP supports.
@@ -72,8 +92,12 @@ type OidcConfiguration struct {
 	ResponseTypesSupported []string `json:"response_types_supported"`
 	// OAuth 2.0 Token Revocation URL  URL of the authorization server's OAuth 2.0 revocation endpoint.
 	RevocationEndpoint *string `json:"revocation_endpoint,omitempty"`
+	// OAuth 2.0 Revocation Endpoint Authentication Methods Supported  JSON array containing a list of client authentication methods supported by the revocation endpoint [RFC8414]. Only set in the OAuth 2.0 authorization server metadata.
+	RevocationEndpointAuthMethodsSupported []string `json:"revocation_endpoint_auth_methods_supported,omitempty"`
 	// OAuth 2.0 Supported Scope Values  JSON array containing a list of the OAuth 2.0 [RFC6749] scope values that this server supports. The server MUST support the openid scope value. Servers MAY choose not to advertise some supported scope values even when this parameter is used
 	ScopesSupported []string `json:"scopes_supported,omitempty"`
+	// OAuth 2.0 Signed Metadata  JSON Web Token containing the metadata as claims [RFC8414]. Only set in the OAuth 2.0 authorization server metadata if signed metadata is enabled.
+	SignedMetadata *string `json:"signed_metadata,omitempty"`
 	// OpenID Connect Supported Subject Types  JSON array containing a list of the Subject Identifier types that this OP supports. Valid types include pairwise and public.
 	SubjectTypesSupported []string `json:"subject_types_supported"`
 	// OAuth 2.0 Token Endpoint URL
@@ -141,6 +165,38 @@ func (o *OidcConfiguration) SetAuthorizationEndpoint(v string) {
 	o.AuthorizationEndpoint = v
 }
 
+// GetAuthorizationResponseIssParameterSupported returns the AuthorizationResponseIssParameterSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetAuthorizationResponseIssParameterSupported() bool {
+	if o == nil || IsNil(o.AuthorizationResponseIssParameterSupported) {
+		var ret bool
+		return ret
+	}
+	return *o.AuthorizationResponseIssParameterSupported
+}
+
+// GetAuthorizationResponseIssParameterSupportedOk returns a tuple with the AuthorizationResponseIssParameterSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetAuthorizationResponseIssParameterSupportedOk() (*bool, bool) {
+	if o == nil || IsNil(o.AuthorizationResponseIssParameterSupported) {
+		return nil, false
+	}
+	return o.AuthorizationResponseIssParameterSupported, true
+}
+
+// HasAuthorizationResponseIssParameterSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasAuthorizationResponseIssParameterSupported() bool {
+	if o != nil && !IsNil(o.AuthorizationResponseIssParameterSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetAuthorizationResponseIssParameterSupported gets a reference to the given bool and assigns it to the AuthorizationResponseIssParameterSupported field.
+func (o *OidcConfiguration) SetAuthorizationResponseIssParameterSupported(v bool) {
+	o.AuthorizationResponseIssParameterSupported = &v
+}
+
 // GetBackchannelLogoutSessionSupported returns the BackchannelLogoutSessionSupported field value if set, zero value otherwise.
 func (o *OidcConfiguration) GetBackchannelLogoutSessionSupported() bool {
 	if o == nil || IsNil(o.BackchannelLogoutSessionSupported) {
@@ -389,6 +445,38 @@ func (o *OidcConfiguration) SetDeviceAuthorizationEndpoint(v string) {
 	o.DeviceAuthorizationEndpoint = v
 }
 
+// GetDpopSigningAlgValuesSupported returns the DpopSigningAlgValuesSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetDpopSigningAlgValuesSupported() []string {
+	if o == nil || IsNil(o.DpopSigningAlgValuesSupported) {
+		var ret []string
+		return ret
+	}
+	return o.DpopSigningAlgValuesSupported
+}
+
+// GetDpopSigningAlgValuesSupportedOk returns a tuple with the DpopSigningAlgValuesSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetDpopSigningAlgValuesSupportedOk() ([]string, bool) {
+	if o == nil || IsNil(o.DpopSigningAlgValuesSupported) {
+		return nil, false
+	}
+	return o.DpopSigningAlgValuesSupported, true
+}
+
+// HasDpopSigningAlgValuesSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasDpopSigningAlgValuesSupported() bool {
+	if o != nil && !IsNil(o.DpopSigningAlgValuesSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetDpopSigningAlgValuesSupported gets a reference to the given []string and assigns it to the DpopSigningAlgValuesSupported field.
+func (o *OidcConfiguration) SetDpopSigningAlgValuesSupported(v []string) {
+	o.DpopSigningAlgValuesSupported = v
+}
+
 // GetEndSessionEndpoint returns the EndSessionEndpoint field value if set, zero value otherwise.
 func (o *OidcConfiguration) GetEndSessionEndpoint() string {
 	if o == nil || IsNil(o.EndSessionEndpoint) {
@@ -565,6 +653,166 @@ func (o *OidcConfiguration) SetIdTokenSigningAlgValuesSupported(v []string) {
 	o.IdTokenSigningAlgValuesSupported = v
 }
 
+// GetIntrospectionEncryptionAlgValuesSupported returns the IntrospectionEncryptionAlgValuesSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetIntrospectionEncryptionAlgValuesSupported() []string {
+	if o == nil || IsNil(o.IntrospectionEncryptionAlgValuesSupported) {
+		var ret []string
+		return ret
+	}
+	return o.IntrospectionEncryptionAlgValuesSupported
+}
+
+// GetIntrospectionEncryptionAlgValuesSupportedOk returns a tuple with the IntrospectionEncryptionAlgValuesSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetIntrospectionEncryptionAlgValuesSupportedOk() ([]string, bool) {
+	if o == nil || IsNil(o.IntrospectionEncryptionAlgValuesSupported) {
+		return nil, false
+	}
+	return o.IntrospectionEncryptionAlgValuesSupported, true
+}
+
+// HasIntrospectionEncryptionAlgValuesSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasIntrospectionEncryptionAlgValuesSupported() bool {
+	if o != nil && !IsNil(o.IntrospectionEncryptionAlgValuesSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionEncryptionAlgValuesSupported gets a reference to the given []string and assigns it to the IntrospectionEncryptionAlgValuesSupported field.
+func (o *OidcConfiguration) SetIntrospectionEncryptionAlgValuesSupported(v []string) {
+	o.IntrospectionEncryptionAlgValuesSupported = v
+}
+
+// GetIntrospectionEncryptionEncValuesSupported returns the IntrospectionEncryptionEncValuesSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetIntrospectionEncryptionEncValuesSupported() []string {
+	if o == nil || IsNil(o.IntrospectionEncryptionEncValuesSupported) {
+		var ret []string
+		return ret
+	}
+	return o.IntrospectionEncryptionEncValuesSupported
+}
+
+// GetIntrospectionEncryptionEncValuesSupportedOk returns a tuple with the IntrospectionEncryptionEncValuesSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetIntrospectionEncryptionEncValuesSupportedOk() ([]string, bool) {
+	if o == nil || IsNil(o.IntrospectionEncryptionEncValuesSupported) {
+		return nil, false
+	}
+	return o.IntrospectionEncryptionEncValuesSupported, true
+}
+
+// HasIntrospectionEncryptionEncValuesSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasIntrospectionEncryptionEncValuesSupported() bool {
+	if o != nil && !IsNil(o.IntrospectionEncryptionEncValuesSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionEncryptionEncValuesSupported gets a reference to the given []string and assigns it to the IntrospectionEncryptionEncValuesSupported field.
+func (o *OidcConfiguration) SetIntrospectionEncryptionEncValuesSupported(v []string) {
+	o.IntrospectionEncryptionEncValuesSupported = v
+}
+
+// GetIntrospectionEndpoint returns the IntrospectionEndpoint field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetIntrospectionEndpoint() string {
+	if o == nil || IsNil(o.IntrospectionEndpoint) {
+		var ret string
+		return ret
+	}
+	return *o.IntrospectionEndpoint
+}
+
+// GetIntrospectionEndpointOk returns a tuple with the IntrospectionEndpoint field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetIntrospectionEndpointOk() (*string, bool) {
+	if o == nil || IsNil(o.IntrospectionEndpoint) {
+		return nil, false
+	}
+	return o.IntrospectionEndpoint, true
+}
+
+// HasIntrospectionEndpoint returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasIntrospectionEndpoint() bool {
+	if o != nil && !IsNil(o.IntrospectionEndpoint) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionEndpoint gets a reference to the given string and assigns it to the IntrospectionEndpoint field.
+func (o *OidcConfiguration) SetIntrospectionEndpoint(v string) {
+	o.IntrospectionEndpoint = &v
+}
+
+// GetIntrospectionEndpointAuthMethodsSupported returns the IntrospectionEndpointAuthMethodsSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetIntrospectionEndpointAuthMethodsSupported() []string {
+	if o == nil || IsNil(o.IntrospectionEndpointAuthMethodsSupported) {
+		var ret []string
+		return ret
+	}
+	return o.IntrospectionEndpointAuthMethodsSupported
+}
+
+// GetIntrospectionEndpointAuthMethodsSupportedOk returns a tuple with the IntrospectionEndpointAuthMethodsSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetIntrospectionEndpointAuthMethodsSupportedOk() ([]string, bool) {
+	if o == nil || IsNil(o.IntrospectionEndpointAuthMethodsSupported) {
+		return nil, false
+	}
+	return o.IntrospectionEndpointAuthMethodsSupported, true
+}
+
+// HasIntrospectionEndpointAuthMethodsSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasIntrospectionEndpointAuthMethodsSupported() bool {
+	if o != nil && !IsNil(o.IntrospectionEndpointAuthMethodsSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionEndpointAuthMethodsSupported gets a reference to the given []string and assigns it to the IntrospectionEndpointAuthMethodsSupported field.
+func (o *OidcConfiguration) SetIntrospectionEndpointAuthMethodsSupported(v []string) {
+	o.IntrospectionEndpointAuthMethodsSupported = v
+}
+
+// GetIntrospectionSigningAlgValuesSupported returns the IntrospectionSigningAlgValuesSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetIntrospectionSigningAlgValuesSupported() []string {
+	if o == nil || IsNil(o.IntrospectionSigningAlgValuesSupported) {
+		var ret []string
+		return ret
+	}
+	return o.IntrospectionSigningAlgValuesSupported
+}
+
+// GetIntrospectionSigningAlgValuesSupportedOk returns a tuple with the IntrospectionSigningAlgValuesSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetIntrospectionSigningAlgValuesSupportedOk() ([]string, bool) {
+	if o == nil || IsNil(o.IntrospectionSigningAlgValuesSupported) {
+		return nil, false
+	}
+	return o.IntrospectionSigningAlgValuesSupported, true
+}
+
+// HasIntrospectionSigningAlgValuesSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasIntrospectionSigningAlgValuesSupported() bool {
+	if o != nil && !IsNil(o.IntrospectionSigningAlgValuesSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetIntrospectionSigningAlgValuesSupported gets a reference to the given []string and assigns it to the IntrospectionSigningAlgValuesSupported field.
+func (o *OidcConfiguration) SetIntrospectionSigningAlgValuesSupported(v []string) {
+	o.IntrospectionSigningAlgValuesSupported = v
+}
+
 // GetIssuer returns the Issuer field value
 func (o *OidcConfiguration) GetIssuer() string {
 	if o == nil {
@@ -613,6 +861,70 @@ func (o *OidcConfiguration) SetJwksUri(v string) {
 	o.JwksUri = v
 }
 
+// GetMtlsEndpointAliases returns the MtlsEndpointAliases field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetMtlsEndpointAliases() map[string]string {
+	if o == nil || IsNil(o.MtlsEndpointAliases) {
+		var ret map[string]string
+		return ret
+	}
+	return o.MtlsEndpointAliases
+}
+
+// GetMtlsEndpointAliasesOk returns a tuple with the MtlsEndpointAliases field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetMtlsEndpointAliasesOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.MtlsEndpointAliases) {
+		return nil, false
+	}
+	return o.MtlsEndpointAliases, true
+}
+
+// HasMtlsEndpointAliases returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasMtlsEndpointAliases() bool {
+	if o != nil && !IsNil(o.MtlsEndpointAliases) {
+		return true
+	}
+
+	return false
+}
+
+// SetMtlsEndpointAliases gets a reference to the given map[string]string and assigns it to the MtlsEndpointAliases field.
+func (o *OidcConfiguration) SetMtlsEndpointAliases(v map[string]string) {
+	o.MtlsEndpointAliases = v
+}
+
+// GetPushedAuthorizationRequestEndpoint returns the PushedAuthorizationRequestEndpoint field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetPushedAuthorizationRequestEndpoint() string {
+	if o == nil || IsNil(o.PushedAuthorizationRequestEndpoint) {
+		var ret string
+		return ret
+	}
+	return *o.PushedAuthorizationRequestEndpoint
+}
+
+// GetPushedAuthorizationRequestEndpointOk returns a tuple with the PushedAuthorizationRequestEndpoint field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetPushedAuthorizationRequestEndpointOk() (*string, bool) {
+	if o == nil || IsNil(o.PushedAuthorizationRequestEndpoint) {
+		return nil, false
+	}
+	return o.PushedAuthorizationRequestEndpoint, true
+}
+
+// HasPushedAuthorizationRequestEndpoint returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasPushedAuthorizationRequestEndpoint() bool {
+	if o != nil && !IsNil(o.PushedAuthorizationRequestEndpoint) {
+		return true
+	}
+
+	return false
+}
+
+// SetPushedAuthorizationRequestEndpoint gets a reference to the given string and assigns it to the PushedAuthorizationRequestEndpoint field.
+func (o *OidcConfiguration) SetPushedAuthorizationRequestEndpoint(v string) {
+	o.PushedAuthorizationRequestEndpoint = &v
+}
+
 // GetRegistrationEndpoint returns the RegistrationEndpoint field value if set, zero value otherwise.
 func (o *OidcConfiguration) GetRegistrationEndpoint() string {
 	if o == nil || IsNil(o.RegistrationEndpoint) {
@@ -741,6 +1053,38 @@ func (o *OidcConfiguration) SetRequestUriParameterSupported(v bool) {
 	o.RequestUriParameterSupported = &v
 }
 
+// GetRequirePushedAuthorizationRequests returns the RequirePushedAuthorizationRequests field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetRequirePushedAuthorizationRequests() bool {
+	if o == nil || IsNil(o.RequirePushedAuthorizationRequests) {
+		var ret bool
+		return ret
+	}
+	return *o.RequirePushedAuthorizationRequests
+}
+
+// GetRequirePushedAuthorizationRequestsOk returns a tuple with the RequirePushedAuthorizationRequests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetRequirePushedAuthorizationRequestsOk() (*bool, bool) {
+	if o == nil || IsNil(o.RequirePushedAuthorizationRequests) {
+		return nil, false
+	}
+	return o.RequirePushedAuthorizationRequests, true
+}
+
+// HasRequirePushedAuthorizationRequests returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasRequirePushedAuthorizationRequests() bool {
+	if o != nil && !IsNil(o.RequirePushedAuthorizationRequests) {
+		return true
+	}
+
+	return false
+}
+
+// SetRequirePushedAuthorizationRequests gets a reference to the given bool and assigns it to the RequirePushedAuthorizationRequests field.
+func (o *OidcConfiguration) SetRequirePushedAuthorizationRequests(v bool) {
+	o.RequirePushedAuthorizationRequests = &v
+}
+
 // GetRequireRequestUriRegistration returns the RequireRequestUriRegistration field value if set, zero value otherwise.
 func (o *OidcConfiguration) GetRequireRequestUriRegistration() bool {
 	if o == nil || IsNil(o.RequireRequestUriRegistration) {
@@ -861,6 +1205,38 @@ func (o *OidcConfiguration) SetRevocationEndpoint(v string) {
 	o.RevocationEndpoint = &v
 }
 
+// GetRevocationEndpointAuthMethodsSupported returns the RevocationEndpointAuthMethodsSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetRevocationEndpointAuthMethodsSupported() []string {
+	if o == nil || IsNil(o.RevocationEndpointAuthMethodsSupported) {
+		var ret []string
+		return ret
+	}
+	return o.RevocationEndpointAuthMethodsSupported
+}
+
+// GetRevocationEndpointAuthMethodsSupportedOk returns a tuple with the RevocationEndpointAuthMethodsSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetRevocationEndpointAuthMethodsSupportedOk() ([]string, bool) {
+	if o == nil || IsNil(o.RevocationEndpointAuthMethodsSupported) {
+		return nil, false
+	}
+	return o.RevocationEndpointAuthMethodsSupported, true
+}
+
+// HasRevocationEndpointAuthMethodsSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasRevocationEndpointAuthMethodsSupported() bool {
+	if o != nil && !IsNil(o.RevocationEndpointAuthMethodsSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetRevocationEndpointAuthMethodsSupported gets a reference to the given []string and assigns it to the RevocationEndpointAuthMethodsSupported field.
+func (o *OidcConfiguration) SetRevocationEndpointAuthMethodsSupported(v []string) {
+	o.RevocationEndpointAuthMethodsSupported = v
+}
+
 // GetScopesSupported returns the ScopesSupported field value if set, zero value otherwise.
 func (o *OidcConfiguration) GetScopesSupported() []string {
 	if o == nil || IsNil(o.ScopesSupported) {
@@ -893,6 +1269,38 @@ func (o *OidcConfiguration) SetScopesSupported(v []string) {
 	o.ScopesSupported = v
 }
 
+// GetSignedMetadata returns the SignedMetadata field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetSignedMetadata() string {
+	if o == nil || IsNil(o.SignedMetadata) {
+		var ret string
+		return ret
+	}
+	return *o.SignedMetadata
+}
+
+// GetSignedMetadataOk returns a tuple with the SignedMetadata field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetSignedMetadataOk() (*string, bool) {
+	if o == nil || IsNil(o.SignedMetadata) {
+		return nil, false
+	}
+	return o.SignedMetadata, true
+}
+
+// HasSignedMetadata returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasSignedMetadata() bool {
+	if o != nil && !IsNil(o.SignedMetadata) {
+		return true
+	}
+
+	return false
+}
+
+// SetSignedMetadata gets a reference to the given string and assigns it to the SignedMetadata field.
+func (o *OidcConfiguration) SetSignedMetadata(v string) {
+	o.SignedMetadata = &v
+}
+
 // GetSubjectTypesSupported returns the SubjectTypesSupported field value
 func (o *OidcConfiguration) GetSubjectTypesSupported() []string {
 	if o == nil {
@@ -1072,6 +1480,9 @@ func (o OidcConfiguration) MarshalJSON() ([]byte, error) {
 func (o OidcConfiguration) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["authorization_endpoint"] = o.AuthorizationEndpoint
+	if !IsNil(o.AuthorizationResponseIssParameterSupported) {
+		toSerialize["authorization_response_iss_parameter_supported"] = o.AuthorizationResponseIssParameterSupported
+	}
 	if !IsNil(o.BackchannelLogoutSessionSupported) {
 		toSerialize["backchannel_logout_session_supported"] = o.BackchannelLogoutSessionSupported
 	}
@@ -1094,6 +1505,9 @@ func (o OidcConfiguration) ToMap() (map[string]interface{}, error) {
 		toSerialize["credentials_supported_draft_00"] = o.CredentialsSupportedDraft00
 	}
 	toSerialize["device_authorization_endpoint"] = o.DeviceAuthorizationEndpoint
+	if !IsNil(o.DpopSigningAlgValuesSupported) {
+		toSerialize["dpop_signing_alg_values_supported"] = o.DpopSigningAlgValuesSupported
+	}
 	if !IsNil(o.EndSessionEndpoint) {
 		toSerialize["end_session_endpoint"] = o.EndSessionEndpoint
 	}
@@ -1108,8 +1522,29 @@ func (o OidcConfiguration) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["id_token_signed_response_alg"] = o.IdTokenSignedResponseAlg
 	toSerialize["id_token_signing_alg_values_supported"] = o.IdTokenSigningAlgValuesSupported
+	if !IsNil(o.IntrospectionEncryptionAlgValuesSupported) {
+		toSerialize["introspection_encryption_alg_values_supported"] = o.IntrospectionEncryptionAlgValuesSupported
+	}
+	if !IsNil(o.IntrospectionEncryptionEncValuesSupported) {
+		toSerialize["introspection_encryption_enc_values_supported"] = o.IntrospectionEncryptionEncValuesSupported
+	}
+	if !IsNil(o.IntrospectionEndpoint) {
+		toSerialize["introspection_endpoint"] = o.IntrospectionEndpoint
+	}
+	if !IsNil(o.IntrospectionEndpointAuthMethodsSupported) {
+		toSerialize["introspection_endpoint_auth_methods_supported"] = o.IntrospectionEndpointAuthMethodsSupported
+	}
+	if !IsNil(o.IntrospectionSigningAlgValuesSupported) {
+		toSerialize["introspection_signing_alg_values_supported"] = o.IntrospectionSigningAlgValuesSupported
+	}
 	toSerialize["issuer"] = o.Issuer
 	toSerialize["jwks_uri"] = o.JwksUri
+	if !IsNil(o.MtlsEndpointAliases) {
+		toSerialize["mtls_endpoint_aliases"] = o.MtlsEndpointAliases
+	}
+	if !IsNil(o.PushedAuthorizationRequestEndpoint) {
+		toSerialize["pushed_authorization_request_endpoint"] = o.PushedAuthorizationRequestEndpoint
+	}
 	if !IsNil(o.RegistrationEndpoint) {
 		toSerialize["registration_endpoint"] = o.RegistrationEndpoint
 	}
@@ -1122,6 +1557,9 @@ func (o OidcConfiguration) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.RequestUriParameterSupported) {
 		toSerialize["request_uri_parameter_supported"] = o.RequestUriParameterSupported
 	}
+	if !IsNil(o.RequirePushedAuthorizationRequests) {
+		toSerialize["require_pushed_authorization_requests"] = o.RequirePushedAuthorizationRequests
+	}
 	if !IsNil(o.RequireRequestUriRegistration) {
 		toSerialize["require_request_uri_registration"] = o.RequireRequestUriRegistration
 	}
@@ -1132,9 +1570,15 @@ func (o OidcConfiguration) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.RevocationEndpoint) {
 		toSerialize["revocation_endpoint"] = o.RevocationEndpoint
 	}
+	if !IsNil(o.RevocationEndpointAuthMethodsSupported) {
+		toSerialize["revocation_endpoint_auth_methods_supported"] = o.RevocationEndpointAuthMethodsSupported
+	}
 	if !IsNil(o.ScopesSupported) {
 		toSerialize["scopes_supported"] = o.ScopesSupported
 	}
+	if !IsNil(o.SignedMetadata) {
+		toSerialize["signed_metadata"] = o.SignedMetadata
+	}
 	toSerialize["subject_types_supported"] = o.SubjectTypesSupported
 	toSerialize["token_endpoint"] = o.TokenEndpoint
 	if !IsNil(o.TokenEndpointAuthMethodsSupported) {
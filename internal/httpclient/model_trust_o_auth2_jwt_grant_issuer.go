@@ -25,11 +25,19 @@ var _ MappedNullable = &TrustOAuth2JwtGrantIssuer{}
 type TrustOAuth2JwtGrantIssuer struct {
 	// The \"allow_any_subject\" indicates that the issuer is allowed to have any principal as the subject of the JWT.
 	AllowAnySubject *bool `json:"allow_any_subject,omitempty"`
+	// The \"claim_conditions\" contains glob patterns which the claims of the JWT assertion must match. Claims with array values match if one of the values matches. Can only be set together with \"jwks_uri\" or \"oidc_discovery\".
+	ClaimConditions map[string]string `json:"claim_conditions,omitempty"`
+	// The \"claim_mappings\" grant additional scope and audience to JWT assertions with matching claims. Can only be set together with \"jwks_uri\" or \"oidc_discovery\".
+	ClaimMappings []TrustedOAuth2JwtGrantClaimMapping `json:"claim_mappings,omitempty"`
 	// The \"expires_at\" indicates, when grant will expire, so we will reject assertion from \"issuer\" targeting \"subject\".
 	ExpiresAt time.Time `json:"expires_at"`
 	// The \"issuer\" identifies the principal that issued the JWT assertion (same as \"iss\" claim in JWT).
-	Issuer string     `json:"issuer"`
-	Jwk    JsonWebKey `json:"jwk"`
+	Issuer string      `json:"issuer"`
+	Jwk    *JsonWebKey `json:"jwk,omitempty"`
+	// The \"jwks_uri\" is the URL of the JSON Web Key Set of \"issuer\", that will be used to check JWT assertion signature. The keys are cached and fetched again if an assertion is signed with an unknown key.
+	JwksUri *string `json:"jwks_uri,omitempty"`
+	// The \"oidc_discovery\" indicates that the JSON Web Key Set of \"issuer\" is fetched from the \"jwks_uri\" of its OpenID Connect discovery document at \"<issuer>/.well-known/openid-configuration\".
+	OidcDiscovery *bool `json:"oidc_discovery,omitempty"`
 	// The \"scope\" contains list of scope values (as described in Section 3.3 of OAuth 2.0 [RFC6749])
 	Scope []string `json:"scope"`
 	// The \"subject\" identifies the principal that is the subject of the JWT.
@@ -42,11 +50,10 @@ type _TrustOAuth2JwtGrantIssuer TrustOAuth2JwtGrantIssuer
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewTrustOAuth2JwtGrantIssuer(expiresAt time.Time, issuer string, jwk JsonWebKey, scope []string) *TrustOAuth2JwtGrantIssuer {
+func NewTrustOAuth2JwtGrantIssuer(expiresAt time.Time, issuer string, scope []string) *TrustOAuth2JwtGrantIssuer {
 	this := TrustOAuth2JwtGrantIssuer{}
 	this.ExpiresAt = expiresAt
 	this.Issuer = issuer
-	this.Jwk = jwk
 	this.Scope = scope
 	return &this
 }
@@ -91,6 +98,70 @@ func (o *TrustOAuth2JwtGrantIssuer) SetAllowAnySubject(v bool) {
 	o.AllowAnySubject = &v
 }
 
+// GetClaimConditions returns the ClaimConditions field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimConditions() map[string]string {
+	if o == nil || IsNil(o.ClaimConditions) {
+		var ret map[string]string
+		return ret
+	}
+	return o.ClaimConditions
+}
+
+// GetClaimConditionsOk returns a tuple with the ClaimConditions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimConditionsOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.ClaimConditions) {
+		return nil, false
+	}
+	return o.ClaimConditions, true
+}
+
+// HasClaimConditions returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasClaimConditions() bool {
+	if o != nil && !IsNil(o.ClaimConditions) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimConditions gets a reference to the given map[string]string and assigns it to the ClaimConditions field.
+func (o *TrustOAuth2JwtGrantIssuer) SetClaimConditions(v map[string]string) {
+	o.ClaimConditions = v
+}
+
+// GetClaimMappings returns the ClaimMappings field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimMappings() []TrustedOAuth2JwtGrantClaimMapping {
+	if o == nil || IsNil(o.ClaimMappings) {
+		var ret []TrustedOAuth2JwtGrantClaimMapping
+		return ret
+	}
+	return o.ClaimMappings
+}
+
+// GetClaimMappingsOk returns a tuple with the ClaimMappings field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimMappingsOk() ([]TrustedOAuth2JwtGrantClaimMapping, bool) {
+	if o == nil || IsNil(o.ClaimMappings) {
+		return nil, false
+	}
+	return o.ClaimMappings, true
+}
+
+// HasClaimMappings returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasClaimMappings() bool {
+	if o != nil && !IsNil(o.ClaimMappings) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimMappings gets a reference to the given []TrustedOAuth2JwtGrantClaimMapping and assigns it to the ClaimMappings field.
+func (o *TrustOAuth2JwtGrantIssuer) SetClaimMappings(v []TrustedOAuth2JwtGrantClaimMapping) {
+	o.ClaimMappings = v
+}
+
 // GetExpiresAt returns the ExpiresAt field value
 func (o *TrustOAuth2JwtGrantIssuer) GetExpiresAt() time.Time {
 	if o == nil {
@@ -139,28 +210,100 @@ func (o *TrustOAuth2JwtGrantIssuer) SetIssuer(v string) {
 	o.Issuer = v
 }
 
-// GetJwk returns the Jwk field value
+// GetJwk returns the Jwk field value if set, zero value otherwise.
 func (o *TrustOAuth2JwtGrantIssuer) GetJwk() JsonWebKey {
-	if o == nil {
+	if o == nil || IsNil(o.Jwk) {
 		var ret JsonWebKey
 		return ret
 	}
-
-	return o.Jwk
+	return *o.Jwk
 }
 
-// GetJwkOk returns a tuple with the Jwk field value
+// GetJwkOk returns a tuple with the Jwk field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *TrustOAuth2JwtGrantIssuer) GetJwkOk() (*JsonWebKey, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.Jwk) {
 		return nil, false
 	}
-	return &o.Jwk, true
+	return o.Jwk, true
+}
+
+// HasJwk returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasJwk() bool {
+	if o != nil && !IsNil(o.Jwk) {
+		return true
+	}
+
+	return false
 }
 
-// SetJwk sets field value
+// SetJwk gets a reference to the given JsonWebKey and assigns it to the Jwk field.
 func (o *TrustOAuth2JwtGrantIssuer) SetJwk(v JsonWebKey) {
-	o.Jwk = v
+	o.Jwk = &v
+}
+
+// GetJwksUri returns the JwksUri field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetJwksUri() string {
+	if o == nil || IsNil(o.JwksUri) {
+		var ret string
+		return ret
+	}
+	return *o.JwksUri
+}
+
+// GetJwksUriOk returns a tuple with the JwksUri field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetJwksUriOk() (*string, bool) {
+	if o == nil || IsNil(o.JwksUri) {
+		return nil, false
+	}
+	return o.JwksUri, true
+}
+
+// HasJwksUri returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasJwksUri() bool {
+	if o != nil && !IsNil(o.JwksUri) {
+		return true
+	}
+
+	return false
+}
+
+// SetJwksUri gets a reference to the given string and assigns it to the JwksUri field.
+func (o *TrustOAuth2JwtGrantIssuer) SetJwksUri(v string) {
+	o.JwksUri = &v
+}
+
+// GetOidcDiscovery returns the OidcDiscovery field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetOidcDiscovery() bool {
+	if o == nil || IsNil(o.OidcDiscovery) {
+		var ret bool
+		return ret
+	}
+	return *o.OidcDiscovery
+}
+
+// GetOidcDiscoveryOk returns a tuple with the OidcDiscovery field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetOidcDiscoveryOk() (*bool, bool) {
+	if o == nil || IsNil(o.OidcDiscovery) {
+		return nil, false
+	}
+	return o.OidcDiscovery, true
+}
+
+// HasOidcDiscovery returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasOidcDiscovery() bool {
+	if o != nil && !IsNil(o.OidcDiscovery) {
+		return true
+	}
+
+	return false
+}
+
+// SetOidcDiscovery gets a reference to the given bool and assigns it to the OidcDiscovery field.
+func (o *TrustOAuth2JwtGrantIssuer) SetOidcDiscovery(v bool) {
+	o.OidcDiscovery = &v
 }
 
 // GetScope returns the Scope field value
@@ -232,9 +375,23 @@ func (o TrustOAuth2JwtGrantIssuer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.AllowAnySubject) {
 		toSerialize["allow_any_subject"] = o.AllowAnySubject
 	}
+	if !IsNil(o.ClaimConditions) {
+		toSerialize["claim_conditions"] = o.ClaimConditions
+	}
+	if !IsNil(o.ClaimMappings) {
+		toSerialize["claim_mappings"] = o.ClaimMappings
+	}
 	toSerialize["expires_at"] = o.ExpiresAt
 	toSerialize["issuer"] = o.Issuer
-	toSerialize["jwk"] = o.Jwk
+	if !IsNil(o.Jwk) {
+		toSerialize["jwk"] = o.Jwk
+	}
+	if !IsNil(o.JwksUri) {
+		toSerialize["jwks_uri"] = o.JwksUri
+	}
+	if !IsNil(o.OidcDiscovery) {
+		toSerialize["oidc_discovery"] = o.OidcDiscovery
+	}
 	toSerialize["scope"] = o.Scope
 	if !IsNil(o.Subject) {
 		toSerialize["subject"] = o.Subject
@@ -249,7 +406,6 @@ func (o *TrustOAuth2JwtGrantIssuer) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"expires_at",
 		"issuer",
-		"jwk",
 		"scope",
 	}
 
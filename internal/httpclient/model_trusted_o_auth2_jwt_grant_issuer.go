@@ -23,14 +23,22 @@ var _ MappedNullable = &TrustedOAuth2JwtGrantIssuer{}
 type TrustedOAuth2JwtGrantIssuer struct {
 	// The \"allow_any_subject\" indicates that the issuer is allowed to have any principal as the subject of the JWT.
 	AllowAnySubject *bool `json:"allow_any_subject,omitempty"`
+	// The \"claim_conditions\" contains glob patterns which the claims of the JWT assertion must match.
+	ClaimConditions map[string]string `json:"claim_conditions,omitempty"`
+	// The \"claim_mappings\" grant additional scope and audience to JWT assertions with matching claims.
+	ClaimMappings []TrustedOAuth2JwtGrantClaimMapping `json:"claim_mappings,omitempty"`
 	// The \"created_at\" indicates, when grant was created.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// The \"expires_at\" indicates, when grant will expire, so we will reject assertion from \"issuer\" targeting \"subject\".
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	Id        *string    `json:"id,omitempty"`
 	// The \"issuer\" identifies the principal that issued the JWT assertion (same as \"iss\" claim in JWT).
-	Issuer    *string                          `json:"issuer,omitempty"`
-	PublicKey *TrustedOAuth2JwtGrantJsonWebKey `json:"public_key,omitempty"`
+	Issuer *string `json:"issuer,omitempty"`
+	// The \"jwks_uri\" is the URL of the JSON Web Key Set of \"issuer\", that will be used to check JWT assertion signature.
+	JwksUri *string `json:"jwks_uri,omitempty"`
+	// The \"oidc_discovery\" indicates that the JSON Web Key Set of \"issuer\" is fetched from its OpenID Connect discovery document.
+	OidcDiscovery *bool                            `json:"oidc_discovery,omitempty"`
+	PublicKey     *TrustedOAuth2JwtGrantJsonWebKey `json:"public_key,omitempty"`
 	// The \"scope\" contains list of scope values (as described in Section 3.3 of OAuth 2.0 [RFC6749])
 	Scope []string `json:"scope,omitempty"`
 	// The \"subject\" identifies the principal that is the subject of the JWT.
@@ -86,6 +94,70 @@ func (o *TrustedOAuth2JwtGrantIssuer) SetAllowAnySubject(v bool) {
 	o.AllowAnySubject = &v
 }
 
+// GetClaimConditions returns the ClaimConditions field value if set, zero value otherwise.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimConditions() map[string]string {
+	if o == nil || IsNil(o.ClaimConditions) {
+		var ret map[string]string
+		return ret
+	}
+	return o.ClaimConditions
+}
+
+// GetClaimConditionsOk returns a tuple with the ClaimConditions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimConditionsOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.ClaimConditions) {
+		return nil, false
+	}
+	return o.ClaimConditions, true
+}
+
+// HasClaimConditions returns a boolean if a field has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) HasClaimConditions() bool {
+	if o != nil && !IsNil(o.ClaimConditions) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimConditions gets a reference to the given map[string]string and assigns it to the ClaimConditions field.
+func (o *TrustedOAuth2JwtGrantIssuer) SetClaimConditions(v map[string]string) {
+	o.ClaimConditions = v
+}
+
+// GetClaimMappings returns the ClaimMappings field value if set, zero value otherwise.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimMappings() []TrustedOAuth2JwtGrantClaimMapping {
+	if o == nil || IsNil(o.ClaimMappings) {
+		var ret []TrustedOAuth2JwtGrantClaimMapping
+		return ret
+	}
+	return o.ClaimMappings
+}
+
+// GetClaimMappingsOk returns a tuple with the ClaimMappings field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimMappingsOk() ([]TrustedOAuth2JwtGrantClaimMapping, bool) {
+	if o == nil || IsNil(o.ClaimMappings) {
+		return nil, false
+	}
+	return o.ClaimMappings, true
+}
+
+// HasClaimMappings returns a boolean if a field has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) HasClaimMappings() bool {
+	if o != nil && !IsNil(o.ClaimMappings) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimMappings gets a reference to the given []TrustedOAuth2JwtGrantClaimMapping and assigns it to the ClaimMappings field.
+func (o *TrustedOAuth2JwtGrantIssuer) SetClaimMappings(v []TrustedOAuth2JwtGrantClaimMapping) {
+	o.ClaimMappings = v
+}
+
 // GetCreatedAt returns the CreatedAt field value if set, zero value otherwise.
 func (o *TrustedOAuth2JwtGrantIssuer) GetCreatedAt() time.Time {
 	if o == nil || IsNil(o.CreatedAt) {
@@ -214,6 +286,70 @@ func (o *TrustedOAuth2JwtGrantIssuer) SetIssuer(v string) {
 	o.Issuer = &v
 }
 
+// GetJwksUri returns the JwksUri field value if set, zero value otherwise.
+func (o *TrustedOAuth2JwtGrantIssuer) GetJwksUri() string {
+	if o == nil || IsNil(o.JwksUri) {
+		var ret string
+		return ret
+	}
+	return *o.JwksUri
+}
+
+// GetJwksUriOk returns a tuple with the JwksUri field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) GetJwksUriOk() (*string, bool) {
+	if o == nil || IsNil(o.JwksUri) {
+		return nil, false
+	}
+	return o.JwksUri, true
+}
+
+// HasJwksUri returns a boolean if a field has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) HasJwksUri() bool {
+	if o != nil && !IsNil(o.JwksUri) {
+		return true
+	}
+
+	return false
+}
+
+// SetJwksUri gets a reference to the given string and assigns it to the JwksUri field.
+func (o *TrustedOAuth2JwtGrantIssuer) SetJwksUri(v string) {
+	o.JwksUri = &v
+}
+
+// GetOidcDiscovery returns the OidcDiscovery field value if set, zero value otherwise.
+func (o *TrustedOAuth2JwtGrantIssuer) GetOidcDiscovery() bool {
+	if o == nil || IsNil(o.OidcDiscovery) {
+		var ret bool
+		return ret
+	}
+	return *o.OidcDiscovery
+}
+
+// GetOidcDiscoveryOk returns a tuple with the OidcDiscovery field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) GetOidcDiscoveryOk() (*bool, bool) {
+	if o == nil || IsNil(o.OidcDiscovery) {
+		return nil, false
+	}
+	return o.OidcDiscovery, true
+}
+
+// HasOidcDiscovery returns a boolean if a field has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) HasOidcDiscovery() bool {
+	if o != nil && !IsNil(o.OidcDiscovery) {
+		return true
+	}
+
+	return false
+}
+
+// SetOidcDiscovery gets a reference to the given bool and assigns it to the OidcDiscovery field.
+func (o *TrustedOAuth2JwtGrantIssuer) SetOidcDiscovery(v bool) {
+	o.OidcDiscovery = &v
+}
+
 // GetPublicKey returns the PublicKey field value if set, zero value otherwise.
 func (o *TrustedOAuth2JwtGrantIssuer) GetPublicKey() TrustedOAuth2JwtGrantJsonWebKey {
 	if o == nil || IsNil(o.PublicKey) {
@@ -323,6 +459,12 @@ func (o TrustedOAuth2JwtGrantIssuer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.AllowAnySubject) {
 		toSerialize["allow_any_subject"] = o.AllowAnySubject
 	}
+	if !IsNil(o.ClaimConditions) {
+		toSerialize["claim_conditions"] = o.ClaimConditions
+	}
+	if !IsNil(o.ClaimMappings) {
+		toSerialize["claim_mappings"] = o.ClaimMappings
+	}
 	if !IsNil(o.CreatedAt) {
 		toSerialize["created_at"] = o.CreatedAt
 	}
@@ -335,6 +477,12 @@ func (o TrustedOAuth2JwtGrantIssuer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Issuer) {
 		toSerialize["issuer"] = o.Issuer
 	}
+	if !IsNil(o.JwksUri) {
+		toSerialize["jwks_uri"] = o.JwksUri
+	}
+	if !IsNil(o.OidcDiscovery) {
+		toSerialize["oidc_discovery"] = o.OidcDiscovery
+	}
 	if !IsNil(o.PublicKey) {
 		toSerialize["public_key"] = o.PublicKey
 	}
@@ -4,6 +4,7 @@
 package testhelpers
 
 import (
+	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -16,9 +17,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	dockertestv4 "github.com/ory/dockertest/v4"
 	"github.com/ory/hydra/v2/driver"
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/persistence/kv"
 	"github.com/ory/hydra/v2/persistence/sql"
 	"github.com/ory/hydra/v2/spec"
 	"github.com/ory/pop/v6"
@@ -55,6 +58,13 @@ func NewRegistryMemory(t testing.TB, opts ...driver.OptionsModifier) *driver.Reg
 	return NewRegistrySQLFromURL(t, dbal.NewSQLiteTestDatabase(t), true, true, opts...)
 }
 
+// NewRegistryShortLived returns a registry which stores short-lived artifacts in the key-value store of the DSN and
+// everything else in SQLite.
+func NewRegistryShortLived(t testing.TB, shortLivedDSN string, opts ...driver.OptionsModifier) *driver.RegistrySQL {
+	return NewRegistrySQLFromURL(t, dbal.NewSQLiteTestDatabase(t), true, true,
+		append(opts, driver.WithConfigOptions(configx.WithValue(config.KeyDBShortLivedDSN, shortLivedDSN)))...)
+}
+
 func NewRegistrySQLFromURL(t testing.TB, dsn string, migrate, initNetwork bool, opts ...driver.OptionsModifier) *driver.RegistrySQL {
 	configOpts := append(ConfigDefaults, configx.WithValue(config.KeyDSN, dsn))
 	regOpts := append([]driver.OptionsModifier{
@@ -87,6 +97,25 @@ func ConnectToCRDB(t testing.TB) string {
 	return dockertest.RunTestCockroachDBWithVersion(t, "latest-v25.4")
 }
 
+// ConnectToRedis returns the DSN of a Redis server. It uses TEST_REDIS_URL if set, and starts a container otherwise.
+func ConnectToRedis(t testing.TB) string {
+	if dsn := os.Getenv("TEST_REDIS_URL"); dsn != "" {
+		return dsn
+	}
+
+	pool := dockertestv4.NewPoolT(t, "")
+	r := pool.RunT(t, "redis", dockertestv4.WithTag("7"), dockertestv4.WithoutReuse())
+	dsn := "redis://127.0.0.1:" + r.GetPort("6379/tcp") + "/0"
+
+	require.EventuallyWithT(t, func(ct *assert.CollectT) {
+		c, err := kv.NewRedis(dsn)
+		require.NoError(ct, err)
+		defer c.Close()
+		require.NoError(ct, c.Ping(t.Context()))
+	}, time.Minute, time.Second)
+	return dsn
+}
+
 func ConnectDatabasesURLs(t *testing.T) (pgURL, mysqlURL, crdbURL string) {
 	wg := sync.WaitGroup{}
 
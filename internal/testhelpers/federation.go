@@ -0,0 +1,186 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package testhelpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/federation"
+)
+
+type (
+	// Federation is an OpenID Federation whose entities are served by a test server. Every entity publishes its
+	// entity configuration and a fetch endpoint for the statements about its subordinates.
+	Federation struct {
+		Server   *httptest.Server
+		entities map[string]*FederationEntity
+
+		// Requests counts the requests served by the federation.
+		Requests atomic.Int64
+	}
+
+	FederationEntity struct {
+		ID             string
+		Key            jose.JSONWebKey
+		AuthorityHints []string
+		Metadata       map[string]map[string]any
+
+		// MetadataPolicy is the metadata policy included in the statements about the subordinates of the entity.
+		MetadataPolicy map[string]map[string]federation.Operators
+
+		name string
+		f    *Federation
+	}
+)
+
+func NewFederation(t testing.TB) *Federation {
+	f := &Federation{entities: map[string]*FederationEntity{}}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.Server.Close)
+	return f
+}
+
+// Entity adds an entity with the given superiors to the federation.
+func (f *Federation) Entity(t testing.TB, name string, superiors ...*FederationEntity) *FederationEntity {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	e := &FederationEntity{
+		ID:       f.Server.URL + "/" + name,
+		Key:      jose.JSONWebKey{Key: key, KeyID: name, Algorithm: string(jose.ES256), Use: "sig"},
+		Metadata: map[string]map[string]any{},
+		name:     name,
+		f:        f,
+	}
+	for _, s := range superiors {
+		e.AuthorityHints = append(e.AuthorityHints, s.ID)
+	}
+	f.entities[name] = e
+	return e
+}
+
+// TrustAnchorConfig returns the configuration value of config.KeyFederationTrustAnchors trusting the entities.
+func TrustAnchorConfig(t testing.TB, anchors ...*FederationEntity) []map[string]any {
+	result := make([]map[string]any, 0, len(anchors))
+	for _, a := range anchors {
+		raw, err := json.Marshal(a.PublicJWKS())
+		require.NoError(t, err)
+		var jwks map[string]any
+		require.NoError(t, json.Unmarshal(raw, &jwks))
+		result = append(result, map[string]any{"entity_id": a.ID, "jwks": jwks})
+	}
+	return result
+}
+
+// PublicJWKS returns the public federation keys of the entity.
+func (e *FederationEntity) PublicJWKS() *jose.JSONWebKeySet {
+	return &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{e.Key.Public()}}
+}
+
+// Sign signs the claims with the federation key of the entity.
+func (e *FederationEntity) Sign(t testing.TB, claims any, typ string) string {
+	token, err := e.sign(claims, typ)
+	require.NoError(t, err)
+	return token
+}
+
+// EntityConfiguration returns the entity configuration of the entity, with the given audience.
+func (e *FederationEntity) EntityConfiguration(t testing.TB, audience ...string) string {
+	s := e.statement(e.ID, e.PublicJWKS())
+	s.Audience = audience
+	s.AuthorityHints = e.AuthorityHints
+	s.Metadata = e.Metadata
+	return e.Sign(t, s, federation.TypeEntityStatement)
+}
+
+func (e *FederationEntity) statement(subject string, jwks *jose.JSONWebKeySet) federation.EntityStatement {
+	now := time.Now()
+	return federation.EntityStatement{
+		Issuer:    e.ID,
+		Subject:   subject,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+		JWKS:      jwks,
+	}
+}
+
+func (e *FederationEntity) sign(claims any, typ string) (string, error) {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: e.Key},
+		(&jose.SignerOptions{}).WithType(jose.ContentType(typ)),
+	)
+	if err != nil {
+		return "", err
+	}
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+func (f *Federation) serve(w http.ResponseWriter, r *http.Request) {
+	f.Requests.Add(1)
+	name, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	e, ok := f.entities[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var statement federation.EntityStatement
+	switch "/" + path {
+	case federation.EntityConfigurationPath:
+		statement = e.statement(e.ID, e.PublicJWKS())
+		statement.AuthorityHints = e.AuthorityHints
+		statement.Metadata = map[string]map[string]any{
+			federation.EntityTypeFederationEntity: {"federation_fetch_endpoint": e.ID + "/fetch"},
+		}
+		for k, v := range e.Metadata {
+			statement.Metadata[k] = v
+		}
+	case "/fetch":
+		sub, ok := f.entities[strings.TrimPrefix(r.URL.Query().Get("sub"), f.Server.URL+"/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		statement = e.statement(sub.ID, sub.PublicJWKS())
+		statement.MetadataPolicy = e.MetadataPolicy
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	token, err := e.sign(statement, federation.TypeEntityStatement)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", federation.ContentTypeEntityStatement)
+	_, _ = w.Write([]byte(token))
+}
+
+// Federation returns the federation the entity belongs to.
+func (e *FederationEntity) Federation() *Federation {
+	return e.f
+}
+
+// FederationConfig returns the configuration enabling OpenID Federation with the trust anchors.
+func FederationConfig(t testing.TB, anchors ...*FederationEntity) map[string]any {
+	return map[string]any{
+		config.KeyFederationEnabled:      true,
+		config.KeyFederationTrustAnchors: TrustAnchorConfig(t, anchors...),
+	}
+}
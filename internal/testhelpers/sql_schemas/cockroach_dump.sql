@@ -1,4 +1,4 @@
--- migrations hash: 9ca90c1aaa8c09268d225b414e946af686058788bfe285c358b8daea9ce52149152e41ef2aeb3c1e836dd6f898a3e749fbbd495f31e51d1c40bce6dadd1d9b5d
+-- migrations hash: 5a05851f1bbfe822f62f306960bc7b50200d31293f22573bf2a0f6b16a919d133fd5e4e45c2bbd0bdc0a169436f44e5635ee9d80cbd3069e51fb22b77c723c24
 
 CREATE TABLE public.schema_migration (
 	version VARCHAR(48) NOT NULL,
@@ -28,7 +28,7 @@ CREATE TABLE public.hydra_client (
 	sector_identifier_uri STRING NOT NULL,
 	jwks STRING NOT NULL,
 	jwks_uri STRING NOT NULL,
-	token_endpoint_auth_method VARCHAR(25) NOT NULL DEFAULT '':::STRING,
+	token_endpoint_auth_method VARCHAR(32) NOT NULL DEFAULT '':::STRING,
 	request_object_signing_alg VARCHAR(10) NOT NULL DEFAULT '':::STRING,
 	userinfo_signed_response_alg VARCHAR(10) NOT NULL DEFAULT '':::STRING,
 	subject_type VARCHAR(15) NOT NULL DEFAULT '':::STRING,
@@ -70,6 +70,22 @@ CREATE TABLE public.hydra_client (
 	device_authorization_grant_id_token_lifespan INT8 NULL,
 	device_authorization_grant_access_token_lifespan INT8 NULL,
 	device_authorization_grant_refresh_token_lifespan INT8 NULL,
+	refresh_token_reuse_policy VARCHAR(32) NOT NULL DEFAULT '':::STRING,
+	refresh_token_rotation_disabled BOOL NOT NULL DEFAULT false,
+	refresh_token_rotation_grace_period INT8 NULL,
+	refresh_token_rotation_grace_reuse_count INT8 NULL,
+	refresh_token_idle_lifespan INT8 NULL,
+	refresh_token_max_lifespan INT8 NULL,
+	id_token_signed_response_alg VARCHAR(10) NOT NULL DEFAULT '':::STRING,
+	initial_access_token_id UUID NULL,
+	federation_expires_at TIMESTAMP NULL,
+	security_profile VARCHAR(32) NOT NULL DEFAULT '':::STRING,
+	introspection_signed_response_alg VARCHAR(32) NOT NULL DEFAULT '':::STRING,
+	introspection_encrypted_response_alg VARCHAR(32) NOT NULL DEFAULT '':::STRING,
+	introspection_encrypted_response_enc VARCHAR(32) NOT NULL DEFAULT '':::STRING,
+	tls_client_auth_subject_dn VARCHAR(1024) NOT NULL DEFAULT '':::STRING,
+	application_type VARCHAR(10) NOT NULL DEFAULT '':::STRING,
+	client_secret_encrypted STRING NULL,
 	CONSTRAINT hydra_client_pkey PRIMARY KEY (id ASC, nid ASC),
 	UNIQUE INDEX hydra_client_id_key (id ASC, nid ASC),
 	UNIQUE INDEX hydra_client_pk_key (pk ASC)
@@ -304,12 +320,16 @@ CREATE TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer (
 	issuer VARCHAR(255) NOT NULL,
 	subject VARCHAR(255) NOT NULL,
 	scope STRING NOT NULL,
-	key_set VARCHAR(255) NOT NULL,
-	key_id VARCHAR(255) NOT NULL,
+	key_set VARCHAR(255) NULL,
+	key_id VARCHAR(255) NULL,
 	created_at TIMESTAMP NOT NULL DEFAULT now():::TIMESTAMP,
 	expires_at TIMESTAMP NOT NULL DEFAULT now():::TIMESTAMP,
 	nid UUID NOT NULL,
 	allow_any_subject BOOL NOT NULL DEFAULT false,
+	jwks_uri VARCHAR(1024) NOT NULL DEFAULT '':::STRING,
+	oidc_discovery BOOL NOT NULL DEFAULT false,
+	claim_conditions STRING NULL,
+	claim_mappings STRING NULL,
 	CONSTRAINT "primary" PRIMARY KEY (id ASC),
 	INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx (expires_at ASC),
 	INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_idx (id ASC, nid ASC),
@@ -339,6 +359,127 @@ CREATE TABLE public.hydra_oauth2_device_auth_codes (
 	INDEX hydra_oauth2_device_auth_codes_challenge_id_idx (challenge_id ASC),
 	UNIQUE INDEX hydra_oauth2_device_auth_codes_user_code_signature_idx (nid ASC, user_code_signature ASC)
 );
+CREATE TABLE public.hydra_network (
+	id UUID NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	host VARCHAR(255) NULL,
+	issuer_url VARCHAR(2048) NOT NULL,
+	config STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_network_pkey PRIMARY KEY (id ASC),
+	UNIQUE INDEX hydra_network_name_idx (name ASC),
+	UNIQUE INDEX hydra_network_host_idx (host ASC)
+);
+CREATE TABLE public.hydra_audit_event (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	seq INT8 NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	actor VARCHAR(255) NOT NULL DEFAULT '':::STRING,
+	action VARCHAR(255) NOT NULL,
+	resource_id VARCHAR(255) NOT NULL DEFAULT '':::STRING,
+	request_id VARCHAR(255) NOT NULL DEFAULT '':::STRING,
+	diff STRING NOT NULL,
+	previous_hash VARCHAR(64) NOT NULL DEFAULT '':::STRING,
+	hash VARCHAR(64) NOT NULL,
+	CONSTRAINT hydra_audit_event_pkey PRIMARY KEY (id ASC),
+	UNIQUE INDEX hydra_audit_event_nid_seq_idx (nid ASC, seq ASC),
+	INDEX hydra_audit_event_nid_action_idx (nid ASC, action ASC),
+	INDEX hydra_audit_event_nid_resource_id_idx (nid ASC, resource_id ASC)
+);
+CREATE TABLE public.hydra_oauth2_consent_history (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	subject VARCHAR(255) NOT NULL,
+	client_id VARCHAR(255) NOT NULL,
+	consent_request_id VARCHAR(40) NOT NULL,
+	type VARCHAR(32) NOT NULL,
+	scope STRING NOT NULL,
+	audience STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_oauth2_consent_history_pkey PRIMARY KEY (id ASC),
+	INDEX hydra_oauth2_consent_history_nid_subject_idx (nid ASC, subject ASC, id ASC)
+);
+CREATE TABLE public.hydra_oauth2_refresh_family (
+	request_id VARCHAR(40) NOT NULL,
+	nid UUID NOT NULL,
+	generation INT8 NOT NULL,
+	revoked BOOL NOT NULL DEFAULT false,
+	expires_at TIMESTAMP NULL,
+	CONSTRAINT hydra_oauth2_refresh_family_pkey PRIMARY KEY (request_id ASC, nid ASC),
+	INDEX hydra_oauth2_refresh_family_expires_at_idx (expires_at ASC)
+);
+CREATE TABLE public.hydra_lease (
+	name VARCHAR(64) NOT NULL,
+	holder VARCHAR(255) NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	CONSTRAINT hydra_lease_pkey PRIMARY KEY (name ASC)
+);
+CREATE TABLE public.hydra_ssf_stream (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	audience STRING NOT NULL,
+	events_requested STRING NOT NULL,
+	delivery_method VARCHAR(64) NOT NULL,
+	endpoint_url STRING NOT NULL,
+	authorization_header STRING NOT NULL,
+	description STRING NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	status_reason STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_ssf_stream_pkey PRIMARY KEY (id ASC),
+	INDEX hydra_ssf_stream_nid_idx (nid ASC)
+);
+CREATE TABLE public.hydra_ssf_event (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	stream_id UUID NOT NULL,
+	token STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	expires_at TIMESTAMP NOT NULL,
+	CONSTRAINT hydra_ssf_event_pkey PRIMARY KEY (id ASC),
+	INDEX hydra_ssf_event_nid_stream_id_created_at_idx (nid ASC, stream_id ASC, created_at ASC),
+	INDEX hydra_ssf_event_expires_at_idx (expires_at ASC)
+);
+CREATE TABLE public.hydra_oauth2_initial_access_token (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	signature VARCHAR(255) NOT NULL,
+	description STRING NOT NULL,
+	allowed_grant_types STRING NOT NULL,
+	allowed_scope STRING NOT NULL,
+	allowed_redirect_uri_hosts STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	expires_at TIMESTAMP NULL,
+	CONSTRAINT hydra_oauth2_initial_access_token_pkey PRIMARY KEY (id ASC),
+	UNIQUE INDEX hydra_oauth2_initial_access_token_signature_idx (nid ASC, signature ASC)
+);
+CREATE TABLE public.hydra_oauth2_par (
+	signature VARCHAR(255) NOT NULL,
+	request_id VARCHAR(40) NOT NULL,
+	requested_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	client_id VARCHAR(255) NOT NULL,
+	scope STRING NOT NULL,
+	granted_scope STRING NOT NULL,
+	form_data STRING NOT NULL,
+	session_data STRING NOT NULL,
+	subject VARCHAR(255) NOT NULL,
+	active BOOL NOT NULL DEFAULT true,
+	requested_audience STRING NOT NULL,
+	granted_audience STRING NOT NULL,
+	challenge_id VARCHAR(40) NULL,
+	nid UUID NOT NULL,
+	expires_at TIMESTAMP NULL,
+	CONSTRAINT hydra_oauth2_par_pkey PRIMARY KEY (signature ASC),
+	INDEX hydra_oauth2_par_client_id_idx (client_id ASC, nid ASC),
+	INDEX hydra_oauth2_par_expires_at_idx (expires_at ASC)
+);
+CREATE TABLE public.hydra_audit_lock (
+	nid UUID NOT NULL,
+	CONSTRAINT hydra_audit_lock_pkey PRIMARY KEY (nid ASC)
+);
 ALTER TABLE public.hydra_client ADD CONSTRAINT hydra_client_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_jwk ADD CONSTRAINT hydra_jwk_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_authentication_session ADD CONSTRAINT hydra_oauth2_authentication_session_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
@@ -348,7 +489,6 @@ ALTER TABLE public.hydra_oauth2_logout_request ADD CONSTRAINT hydra_oauth2_logou
 ALTER TABLE public.hydra_oauth2_logout_request ADD CONSTRAINT hydra_oauth2_logout_request_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
 ALTER TABLE public.hydra_oauth2_flow ADD CONSTRAINT hydra_oauth2_flow_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_flow ADD CONSTRAINT hydra_oauth2_flow_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
-ALTER TABLE public.hydra_oauth2_flow ADD CONSTRAINT hydra_oauth2_flow_login_session_id_fk FOREIGN KEY (login_session_id) REFERENCES public.hydra_oauth2_authentication_session(id) ON DELETE SET NULL;
 ALTER TABLE public.hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 ALTER TABLE public.hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
@@ -370,6 +510,17 @@ ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer ADD CONSTRAINT fk_key_
 ALTER TABLE public.hydra_oauth2_device_auth_codes ADD CONSTRAINT hydra_oauth2_device_auth_codes_client_id_nid_fkey FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
 ALTER TABLE public.hydra_oauth2_device_auth_codes ADD CONSTRAINT hydra_oauth2_device_auth_codes_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_device_auth_codes ADD CONSTRAINT hydra_oauth2_device_auth_codes_challenge_id_fkey FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
+ALTER TABLE public.hydra_network ADD CONSTRAINT hydra_network_id_fkey FOREIGN KEY (id) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_audit_event ADD CONSTRAINT hydra_audit_event_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_oauth2_consent_history ADD CONSTRAINT hydra_oauth2_consent_history_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_oauth2_refresh_family ADD CONSTRAINT hydra_oauth2_refresh_family_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_ssf_stream ADD CONSTRAINT hydra_ssf_stream_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_ssf_event ADD CONSTRAINT hydra_ssf_event_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_ssf_event ADD CONSTRAINT hydra_ssf_event_stream_id_fkey FOREIGN KEY (stream_id) REFERENCES public.hydra_ssf_stream(id) ON DELETE CASCADE;
+ALTER TABLE public.hydra_oauth2_initial_access_token ADD CONSTRAINT hydra_oauth2_initial_access_token_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_oauth2_par ADD CONSTRAINT hydra_oauth2_par_client_id_nid_fkey FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
+ALTER TABLE public.hydra_oauth2_par ADD CONSTRAINT hydra_oauth2_par_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_audit_lock ADD CONSTRAINT hydra_audit_lock_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_client VALIDATE CONSTRAINT hydra_client_nid_fk_idx;
 ALTER TABLE public.hydra_jwk VALIDATE CONSTRAINT hydra_jwk_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_authentication_session VALIDATE CONSTRAINT hydra_oauth2_authentication_session_nid_fk_idx;
@@ -379,7 +530,6 @@ ALTER TABLE public.hydra_oauth2_logout_request VALIDATE CONSTRAINT hydra_oauth2_
 ALTER TABLE public.hydra_oauth2_logout_request VALIDATE CONSTRAINT hydra_oauth2_logout_request_client_id_fk;
 ALTER TABLE public.hydra_oauth2_flow VALIDATE CONSTRAINT hydra_oauth2_flow_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_flow VALIDATE CONSTRAINT hydra_oauth2_flow_client_id_fk;
-ALTER TABLE public.hydra_oauth2_flow VALIDATE CONSTRAINT hydra_oauth2_flow_login_session_id_fk;
 ALTER TABLE public.hydra_oauth2_access VALIDATE CONSTRAINT hydra_oauth2_access_challenge_id_fk;
 ALTER TABLE public.hydra_oauth2_access VALIDATE CONSTRAINT hydra_oauth2_access_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_access VALIDATE CONSTRAINT hydra_oauth2_access_client_id_fk;
@@ -401,4 +551,15 @@ ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer VALIDATE CONSTRAINT fk
 ALTER TABLE public.hydra_oauth2_device_auth_codes VALIDATE CONSTRAINT hydra_oauth2_device_auth_codes_client_id_nid_fkey;
 ALTER TABLE public.hydra_oauth2_device_auth_codes VALIDATE CONSTRAINT hydra_oauth2_device_auth_codes_nid_fkey;
 ALTER TABLE public.hydra_oauth2_device_auth_codes VALIDATE CONSTRAINT hydra_oauth2_device_auth_codes_challenge_id_fkey;
+ALTER TABLE public.hydra_network VALIDATE CONSTRAINT hydra_network_id_fkey;
+ALTER TABLE public.hydra_audit_event VALIDATE CONSTRAINT hydra_audit_event_nid_fkey;
+ALTER TABLE public.hydra_oauth2_consent_history VALIDATE CONSTRAINT hydra_oauth2_consent_history_nid_fkey;
+ALTER TABLE public.hydra_oauth2_refresh_family VALIDATE CONSTRAINT hydra_oauth2_refresh_family_nid_fkey;
+ALTER TABLE public.hydra_ssf_stream VALIDATE CONSTRAINT hydra_ssf_stream_nid_fkey;
+ALTER TABLE public.hydra_ssf_event VALIDATE CONSTRAINT hydra_ssf_event_nid_fkey;
+ALTER TABLE public.hydra_ssf_event VALIDATE CONSTRAINT hydra_ssf_event_stream_id_fkey;
+ALTER TABLE public.hydra_oauth2_initial_access_token VALIDATE CONSTRAINT hydra_oauth2_initial_access_token_nid_fkey;
+ALTER TABLE public.hydra_oauth2_par VALIDATE CONSTRAINT hydra_oauth2_par_client_id_nid_fkey;
+ALTER TABLE public.hydra_oauth2_par VALIDATE CONSTRAINT hydra_oauth2_par_nid_fkey;
+ALTER TABLE public.hydra_audit_lock VALIDATE CONSTRAINT hydra_audit_lock_nid_fkey;
 
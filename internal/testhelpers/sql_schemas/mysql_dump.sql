@@ -1,4 +1,4 @@
--- migrations hash: 9ca90c1aaa8c09268d225b414e946af686058788bfe285c358b8daea9ce52149152e41ef2aeb3c1e836dd6f898a3e749fbbd495f31e51d1c40bce6dadd1d9b5d
+-- migrations hash: 5a05851f1bbfe822f62f306960bc7b50200d31293f22573bf2a0f6b16a919d133fd5e4e45c2bbd0bdc0a169436f44e5635ee9d80cbd3069e51fb22b77c723c24
 
 
 /*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
@@ -12,6 +12,39 @@
 /*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;
 /*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;
 
+DROP TABLE IF EXISTS `hydra_audit_event`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_audit_event` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `seq` bigint NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `actor` varchar(255) NOT NULL DEFAULT '',
+  `action` varchar(255) NOT NULL,
+  `resource_id` varchar(255) NOT NULL DEFAULT '',
+  `request_id` varchar(255) NOT NULL DEFAULT '',
+  `diff` mediumtext NOT NULL,
+  `previous_hash` varchar(64) NOT NULL DEFAULT '',
+  `hash` varchar(64) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `hydra_audit_event_nid_seq_idx` (`nid`,`seq`),
+  KEY `hydra_audit_event_nid_action_idx` (`nid`,`action`),
+  KEY `hydra_audit_event_nid_resource_id_idx` (`nid`,`resource_id`),
+  CONSTRAINT `hydra_audit_event_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+DROP TABLE IF EXISTS `hydra_audit_lock`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_audit_lock` (
+  `nid` char(36) NOT NULL,
+  PRIMARY KEY (`nid`),
+  CONSTRAINT `hydra_audit_lock_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_client`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -29,7 +62,7 @@ CREATE TABLE `hydra_client` (
   `sector_identifier_uri` text NOT NULL,
   `jwks` text NOT NULL,
   `jwks_uri` text NOT NULL,
-  `token_endpoint_auth_method` varchar(25) NOT NULL DEFAULT '',
+  `token_endpoint_auth_method` varchar(32) NOT NULL DEFAULT '',
   `request_object_signing_alg` varchar(10) NOT NULL DEFAULT '',
   `userinfo_signed_response_alg` varchar(10) NOT NULL DEFAULT '',
   `subject_type` varchar(15) NOT NULL DEFAULT '',
@@ -71,6 +104,22 @@ CREATE TABLE `hydra_client` (
   `device_authorization_grant_id_token_lifespan` bigint DEFAULT NULL,
   `device_authorization_grant_access_token_lifespan` bigint DEFAULT NULL,
   `device_authorization_grant_refresh_token_lifespan` bigint DEFAULT NULL,
+  `refresh_token_reuse_policy` varchar(32) NOT NULL DEFAULT '',
+  `refresh_token_rotation_disabled` tinyint(1) NOT NULL DEFAULT '0',
+  `refresh_token_rotation_grace_period` bigint DEFAULT NULL,
+  `refresh_token_rotation_grace_reuse_count` bigint DEFAULT NULL,
+  `refresh_token_idle_lifespan` bigint DEFAULT NULL,
+  `refresh_token_max_lifespan` bigint DEFAULT NULL,
+  `id_token_signed_response_alg` varchar(10) NOT NULL DEFAULT '',
+  `initial_access_token_id` char(36) DEFAULT NULL,
+  `federation_expires_at` timestamp NULL DEFAULT NULL,
+  `security_profile` varchar(32) NOT NULL DEFAULT '',
+  `introspection_signed_response_alg` varchar(32) NOT NULL DEFAULT '',
+  `introspection_encrypted_response_alg` varchar(32) NOT NULL DEFAULT '',
+  `introspection_encrypted_response_enc` varchar(32) NOT NULL DEFAULT '',
+  `tls_client_auth_subject_dn` varchar(1024) NOT NULL DEFAULT '',
+  `application_type` varchar(10) NOT NULL DEFAULT '',
+  `client_secret_encrypted` text,
   PRIMARY KEY (`id`,`nid`),
   UNIQUE KEY `hydra_client_id_key` (`id`,`nid`),
   KEY `pk_deprecated` (`pk_deprecated`),
@@ -100,6 +149,35 @@ CREATE TABLE `hydra_jwk` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_lease`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_lease` (
+  `name` varchar(64) NOT NULL,
+  `holder` varchar(255) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+DROP TABLE IF EXISTS `hydra_network`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_network` (
+  `id` char(36) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `host` varchar(255) DEFAULT NULL,
+  `issuer_url` varchar(2048) NOT NULL,
+  `config` text NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `hydra_network_name_idx` (`name`),
+  UNIQUE KEY `hydra_network_host_idx` (`host`),
+  CONSTRAINT `hydra_network_ibfk_1` FOREIGN KEY (`id`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_access`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -181,6 +259,25 @@ CREATE TABLE `hydra_oauth2_code` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_oauth2_consent_history`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_consent_history` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `client_id` varchar(255) NOT NULL,
+  `consent_request_id` varchar(40) NOT NULL,
+  `type` varchar(32) NOT NULL,
+  `scope` mediumtext NOT NULL,
+  `audience` mediumtext NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `hydra_oauth2_consent_history_nid_subject_idx` (`nid`,`subject`,`id`),
+  CONSTRAINT `hydra_oauth2_consent_history_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_device_auth_codes`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -273,11 +370,29 @@ CREATE TABLE `hydra_oauth2_flow` (
   KEY `hydra_oauth2_flow_sub_idx` (`subject`,`nid`),
   KEY `hydra_oauth2_flow_previous_consents_idx` (`subject`,`client_id`,`nid`,`consent_skip`,`consent_error`(2),`consent_remember`),
   CONSTRAINT `hydra_oauth2_flow_client_id_fk` FOREIGN KEY (`client_id`, `nid`) REFERENCES `hydra_client` (`id`, `nid`) ON DELETE CASCADE,
-  CONSTRAINT `hydra_oauth2_flow_login_session_id_fk` FOREIGN KEY (`login_session_id`) REFERENCES `hydra_oauth2_authentication_session` (`id`) ON DELETE SET NULL,
   CONSTRAINT `hydra_oauth2_flow_nid_fk_idx` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_oauth2_initial_access_token`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_initial_access_token` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `signature` varchar(255) NOT NULL,
+  `description` text NOT NULL,
+  `allowed_grant_types` text NOT NULL,
+  `allowed_scope` text NOT NULL,
+  `allowed_redirect_uri_hosts` text NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `expires_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `hydra_oauth2_initial_access_token_signature_idx` (`nid`,`signature`),
+  CONSTRAINT `hydra_oauth2_initial_access_token_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_jti_blacklist`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -366,6 +481,34 @@ CREATE TABLE `hydra_oauth2_oidc` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_oauth2_par`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_par` (
+  `signature` varchar(255) NOT NULL,
+  `request_id` varchar(40) NOT NULL,
+  `requested_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `client_id` varchar(255) NOT NULL,
+  `scope` text NOT NULL,
+  `granted_scope` text NOT NULL,
+  `form_data` text NOT NULL,
+  `session_data` text NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `active` tinyint(1) NOT NULL DEFAULT '1',
+  `requested_audience` text NOT NULL,
+  `granted_audience` text NOT NULL,
+  `challenge_id` varchar(40) DEFAULT NULL,
+  `nid` char(36) NOT NULL,
+  `expires_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`signature`),
+  KEY `hydra_oauth2_par_client_id_idx` (`client_id`,`nid`),
+  KEY `hydra_oauth2_par_expires_at_idx` (`expires_at`),
+  KEY `nid` (`nid`),
+  CONSTRAINT `hydra_oauth2_par_ibfk_1` FOREIGN KEY (`client_id`, `nid`) REFERENCES `hydra_client` (`id`, `nid`) ON DELETE CASCADE,
+  CONSTRAINT `hydra_oauth2_par_ibfk_2` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_pkce`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -431,6 +574,22 @@ CREATE TABLE `hydra_oauth2_refresh` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_oauth2_refresh_family`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_refresh_family` (
+  `request_id` varchar(40) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `generation` int NOT NULL,
+  `revoked` tinyint(1) NOT NULL DEFAULT '0',
+  `expires_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`request_id`,`nid`),
+  KEY `hydra_oauth2_refresh_family_expires_at_idx` (`expires_at`),
+  KEY `nid` (`nid`),
+  CONSTRAINT `hydra_oauth2_refresh_family_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_trusted_jwt_bearer_issuer`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -439,12 +598,16 @@ CREATE TABLE `hydra_oauth2_trusted_jwt_bearer_issuer` (
   `issuer` varchar(255) NOT NULL,
   `subject` varchar(255) NOT NULL,
   `scope` text NOT NULL,
-  `key_set` varchar(255) NOT NULL,
-  `key_id` varchar(255) CHARACTER SET ascii COLLATE ascii_general_ci NOT NULL,
+  `key_set` varchar(255) DEFAULT NULL,
+  `key_id` varchar(255) CHARACTER SET ascii COLLATE ascii_general_ci DEFAULT NULL,
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `expires_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `nid` char(36) NOT NULL,
   `allow_any_subject` tinyint(1) NOT NULL DEFAULT '0',
+  `jwks_uri` varchar(1024) NOT NULL DEFAULT '',
+  `oidc_discovery` tinyint(1) NOT NULL DEFAULT '0',
+  `claim_conditions` text,
+  `claim_mappings` text,
   PRIMARY KEY (`id`),
   UNIQUE KEY `hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx` (`nid`,`key_id`,`issuer`,`subject`),
   KEY `hydra_oauth2_trusted_jwt_bearer_issuer_ibfk_1` (`key_set`,`key_id`,`nid`),
@@ -455,6 +618,47 @@ CREATE TABLE `hydra_oauth2_trusted_jwt_bearer_issuer` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_ssf_event`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_ssf_event` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `stream_id` char(36) NOT NULL,
+  `token` text NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `expires_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `stream_id` (`stream_id`),
+  KEY `hydra_ssf_event_nid_stream_id_created_at_idx` (`nid`,`stream_id`,`created_at`),
+  KEY `hydra_ssf_event_expires_at_idx` (`expires_at`),
+  CONSTRAINT `hydra_ssf_event_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT,
+  CONSTRAINT `hydra_ssf_event_ibfk_2` FOREIGN KEY (`stream_id`) REFERENCES `hydra_ssf_stream` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+DROP TABLE IF EXISTS `hydra_ssf_stream`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_ssf_stream` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `audience` text NOT NULL,
+  `events_requested` text NOT NULL,
+  `delivery_method` varchar(64) NOT NULL,
+  `endpoint_url` text NOT NULL,
+  `authorization_header` text NOT NULL,
+  `description` text NOT NULL,
+  `status` varchar(16) NOT NULL,
+  `status_reason` text NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `hydra_ssf_stream_nid_idx` (`nid`),
+  CONSTRAINT `hydra_ssf_stream_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `networks`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -1,4 +1,4 @@
--- migrations hash: 9ca90c1aaa8c09268d225b414e946af686058788bfe285c358b8daea9ce52149152e41ef2aeb3c1e836dd6f898a3e749fbbd495f31e51d1c40bce6dadd1d9b5d
+-- migrations hash: 5a05851f1bbfe822f62f306960bc7b50200d31293f22573bf2a0f6b16a919d133fd5e4e45c2bbd0bdc0a169436f44e5635ee9d80cbd3069e51fb22b77c723c24
 
 
 
@@ -21,6 +21,28 @@ SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+CREATE TABLE public.hydra_audit_event (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    seq bigint NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    actor character varying(255) DEFAULT ''::character varying NOT NULL,
+    action character varying(255) NOT NULL,
+    resource_id character varying(255) DEFAULT ''::character varying NOT NULL,
+    request_id character varying(255) DEFAULT ''::character varying NOT NULL,
+    diff text NOT NULL,
+    previous_hash character varying(64) DEFAULT ''::character varying NOT NULL,
+    hash character varying(64) NOT NULL
+);
+
+ALTER TABLE public.hydra_audit_event OWNER TO postgres;
+
+CREATE TABLE public.hydra_audit_lock (
+    nid uuid NOT NULL
+);
+
+ALTER TABLE public.hydra_audit_lock OWNER TO postgres;
+
 CREATE TABLE public.hydra_client (
     id character varying(255) NOT NULL,
     client_name text NOT NULL,
@@ -35,7 +57,7 @@ CREATE TABLE public.hydra_client (
     sector_identifier_uri text NOT NULL,
     jwks text NOT NULL,
     jwks_uri text NOT NULL,
-    token_endpoint_auth_method character varying(25) DEFAULT ''::character varying NOT NULL,
+    token_endpoint_auth_method character varying(32) DEFAULT ''::character varying NOT NULL,
     request_object_signing_alg character varying(10) DEFAULT ''::character varying NOT NULL,
     userinfo_signed_response_alg character varying(10) DEFAULT ''::character varying NOT NULL,
     subject_type character varying(15) DEFAULT ''::character varying NOT NULL,
@@ -76,7 +98,23 @@ CREATE TABLE public.hydra_client (
     skip_logout_consent boolean,
     device_authorization_grant_id_token_lifespan bigint,
     device_authorization_grant_access_token_lifespan bigint,
-    device_authorization_grant_refresh_token_lifespan bigint
+    device_authorization_grant_refresh_token_lifespan bigint,
+    refresh_token_reuse_policy character varying(32) DEFAULT ''::character varying NOT NULL,
+    refresh_token_rotation_disabled boolean DEFAULT false NOT NULL,
+    refresh_token_rotation_grace_period bigint,
+    refresh_token_rotation_grace_reuse_count bigint,
+    refresh_token_idle_lifespan bigint,
+    refresh_token_max_lifespan bigint,
+    id_token_signed_response_alg character varying(10) DEFAULT ''::character varying NOT NULL,
+    initial_access_token_id uuid,
+    federation_expires_at timestamp without time zone,
+    security_profile character varying(32) DEFAULT ''::character varying NOT NULL,
+    introspection_signed_response_alg character varying(32) DEFAULT ''::character varying NOT NULL,
+    introspection_encrypted_response_alg character varying(32) DEFAULT ''::character varying NOT NULL,
+    introspection_encrypted_response_enc character varying(32) DEFAULT ''::character varying NOT NULL,
+    tls_client_auth_subject_dn character varying(1024) DEFAULT ''::character varying NOT NULL,
+    application_type character varying(10) DEFAULT ''::character varying NOT NULL,
+    client_secret_encrypted text
 );
 
 ALTER TABLE public.hydra_client OWNER TO postgres;
@@ -118,6 +156,26 @@ ALTER SEQUENCE public.hydra_jwk_pk_seq OWNER TO postgres;
 
 ALTER SEQUENCE public.hydra_jwk_pk_seq OWNED BY public.hydra_jwk.pk_deprecated;
 
+CREATE TABLE public.hydra_lease (
+    name character varying(64) NOT NULL,
+    holder character varying(255) NOT NULL,
+    expires_at timestamp without time zone NOT NULL
+);
+
+ALTER TABLE public.hydra_lease OWNER TO postgres;
+
+CREATE TABLE public.hydra_network (
+    id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    host character varying(255),
+    issuer_url character varying(2048) NOT NULL,
+    config text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_network OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_access (
     signature character varying(255) NOT NULL,
     request_id character varying(40) NOT NULL,
@@ -170,6 +228,20 @@ CREATE TABLE public.hydra_oauth2_code (
 
 ALTER TABLE public.hydra_oauth2_code OWNER TO postgres;
 
+CREATE TABLE public.hydra_oauth2_consent_history (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    subject character varying(255) NOT NULL,
+    client_id character varying(255) NOT NULL,
+    consent_request_id character varying(40) NOT NULL,
+    type character varying(32) NOT NULL,
+    scope text NOT NULL,
+    audience text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_oauth2_consent_history OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_device_auth_codes (
     device_code_signature character varying(255) NOT NULL,
     user_code_signature character varying(255) NOT NULL,
@@ -248,6 +320,20 @@ END) STORED
 
 ALTER TABLE public.hydra_oauth2_flow OWNER TO postgres;
 
+CREATE TABLE public.hydra_oauth2_initial_access_token (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    signature character varying(255) NOT NULL,
+    description text NOT NULL,
+    allowed_grant_types text NOT NULL,
+    allowed_scope text NOT NULL,
+    allowed_redirect_uri_hosts text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at timestamp without time zone
+);
+
+ALTER TABLE public.hydra_oauth2_initial_access_token OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_jti_blacklist (
     signature character varying(64) NOT NULL,
     expires_at timestamp without time zone DEFAULT now() NOT NULL,
@@ -304,6 +390,26 @@ CREATE TABLE public.hydra_oauth2_oidc (
 
 ALTER TABLE public.hydra_oauth2_oidc OWNER TO postgres;
 
+CREATE TABLE public.hydra_oauth2_par (
+    signature character varying(255) NOT NULL,
+    request_id character varying(40) NOT NULL,
+    requested_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    client_id character varying(255) NOT NULL,
+    scope text NOT NULL,
+    granted_scope text NOT NULL,
+    form_data text NOT NULL,
+    session_data text NOT NULL,
+    subject character varying(255) NOT NULL,
+    active boolean DEFAULT true NOT NULL,
+    requested_audience text NOT NULL,
+    granted_audience text NOT NULL,
+    challenge_id character varying(40),
+    nid uuid NOT NULL,
+    expires_at timestamp without time zone
+);
+
+ALTER TABLE public.hydra_oauth2_par OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_pkce (
     signature character varying(255) NOT NULL,
     request_id character varying(40) NOT NULL,
@@ -347,21 +453,63 @@ CREATE TABLE public.hydra_oauth2_refresh (
 
 ALTER TABLE public.hydra_oauth2_refresh OWNER TO postgres;
 
+CREATE TABLE public.hydra_oauth2_refresh_family (
+    request_id character varying(40) NOT NULL,
+    nid uuid NOT NULL,
+    generation integer NOT NULL,
+    revoked boolean DEFAULT false NOT NULL,
+    expires_at timestamp without time zone
+);
+
+ALTER TABLE public.hydra_oauth2_refresh_family OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer (
     id uuid NOT NULL,
     issuer character varying(255) NOT NULL,
     subject character varying(255) NOT NULL,
     scope text NOT NULL,
-    key_set character varying(255) NOT NULL,
-    key_id character varying(255) NOT NULL,
+    key_set character varying(255),
+    key_id character varying(255),
     created_at timestamp without time zone DEFAULT now() NOT NULL,
     expires_at timestamp without time zone DEFAULT now() NOT NULL,
     nid uuid NOT NULL,
-    allow_any_subject boolean DEFAULT false NOT NULL
+    allow_any_subject boolean DEFAULT false NOT NULL,
+    jwks_uri character varying(1024) DEFAULT ''::character varying NOT NULL,
+    oidc_discovery boolean DEFAULT false NOT NULL,
+    claim_conditions text,
+    claim_mappings text
 );
 
 ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer OWNER TO postgres;
 
+CREATE TABLE public.hydra_ssf_event (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    stream_id uuid NOT NULL,
+    token text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at timestamp without time zone NOT NULL
+);
+
+ALTER TABLE public.hydra_ssf_event OWNER TO postgres;
+
+CREATE TABLE public.hydra_ssf_stream (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    audience text NOT NULL,
+    events_requested text NOT NULL,
+    delivery_method character varying(64) NOT NULL,
+    endpoint_url text NOT NULL,
+    authorization_header text NOT NULL,
+    description text NOT NULL,
+    status character varying(16) NOT NULL,
+    status_reason text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_ssf_stream OWNER TO postgres;
+
 CREATE TABLE public.networks (
     id uuid NOT NULL,
     created_at timestamp without time zone NOT NULL,
@@ -381,12 +529,24 @@ ALTER TABLE ONLY public.hydra_client ALTER COLUMN pk_deprecated SET DEFAULT next
 
 ALTER TABLE ONLY public.hydra_jwk ALTER COLUMN pk_deprecated SET DEFAULT nextval('public.hydra_jwk_pk_seq'::regclass);
 
+ALTER TABLE ONLY public.hydra_audit_event
+    ADD CONSTRAINT hydra_audit_event_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY public.hydra_audit_lock
+    ADD CONSTRAINT hydra_audit_lock_pkey PRIMARY KEY (nid);
+
 ALTER TABLE ONLY public.hydra_client
     ADD CONSTRAINT hydra_client_pkey PRIMARY KEY (id, nid);
 
 ALTER TABLE ONLY public.hydra_jwk
     ADD CONSTRAINT hydra_jwk_pkey PRIMARY KEY (pk);
 
+ALTER TABLE ONLY public.hydra_lease
+    ADD CONSTRAINT hydra_lease_pkey PRIMARY KEY (name);
+
+ALTER TABLE ONLY public.hydra_network
+    ADD CONSTRAINT hydra_network_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY public.hydra_oauth2_access
     ADD CONSTRAINT hydra_oauth2_access_pkey PRIMARY KEY (signature);
 
@@ -396,12 +556,18 @@ ALTER TABLE ONLY public.hydra_oauth2_authentication_session
 ALTER TABLE ONLY public.hydra_oauth2_code
     ADD CONSTRAINT hydra_oauth2_code_pkey PRIMARY KEY (signature);
 
+ALTER TABLE ONLY public.hydra_oauth2_consent_history
+    ADD CONSTRAINT hydra_oauth2_consent_history_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY public.hydra_oauth2_device_auth_codes
     ADD CONSTRAINT hydra_oauth2_device_auth_codes_pkey PRIMARY KEY (device_code_signature, nid);
 
 ALTER TABLE ONLY public.hydra_oauth2_flow
     ADD CONSTRAINT hydra_oauth2_flow_pkey PRIMARY KEY (login_challenge);
 
+ALTER TABLE ONLY public.hydra_oauth2_initial_access_token
+    ADD CONSTRAINT hydra_oauth2_initial_access_token_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY public.hydra_oauth2_jti_blacklist
     ADD CONSTRAINT hydra_oauth2_jti_blacklist_pkey PRIMARY KEY (signature, nid);
 
@@ -414,9 +580,15 @@ ALTER TABLE ONLY public.hydra_oauth2_obfuscated_authentication_session
 ALTER TABLE ONLY public.hydra_oauth2_oidc
     ADD CONSTRAINT hydra_oauth2_oidc_pkey PRIMARY KEY (signature);
 
+ALTER TABLE ONLY public.hydra_oauth2_par
+    ADD CONSTRAINT hydra_oauth2_par_pkey PRIMARY KEY (signature);
+
 ALTER TABLE ONLY public.hydra_oauth2_pkce
     ADD CONSTRAINT hydra_oauth2_pkce_pkey PRIMARY KEY (signature);
 
+ALTER TABLE ONLY public.hydra_oauth2_refresh_family
+    ADD CONSTRAINT hydra_oauth2_refresh_family_pkey PRIMARY KEY (request_id, nid);
+
 ALTER TABLE ONLY public.hydra_oauth2_refresh
     ADD CONSTRAINT hydra_oauth2_refresh_pkey PRIMARY KEY (signature);
 
@@ -426,9 +598,21 @@ ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
 ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
     ADD CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issuer_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY public.hydra_ssf_event
+    ADD CONSTRAINT hydra_ssf_event_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY public.hydra_ssf_stream
+    ADD CONSTRAINT hydra_ssf_stream_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY public.networks
     ADD CONSTRAINT networks_pkey PRIMARY KEY (id);
 
+CREATE INDEX hydra_audit_event_nid_action_idx ON public.hydra_audit_event USING btree (nid, action);
+
+CREATE INDEX hydra_audit_event_nid_resource_id_idx ON public.hydra_audit_event USING btree (nid, resource_id);
+
+CREATE UNIQUE INDEX hydra_audit_event_nid_seq_idx ON public.hydra_audit_event USING btree (nid, seq);
+
 CREATE UNIQUE INDEX hydra_client_idx_id_uq ON public.hydra_client USING btree (id, nid);
 
 CREATE INDEX hydra_jwk_nid_sid_created_at_idx ON public.hydra_jwk USING btree (nid, sid, created_at);
@@ -437,6 +621,10 @@ CREATE INDEX hydra_jwk_nid_sid_kid_created_at_idx ON public.hydra_jwk USING btre
 
 CREATE UNIQUE INDEX hydra_jwk_sid_kid_nid_key ON public.hydra_jwk USING btree (sid, kid, nid);
 
+CREATE UNIQUE INDEX hydra_network_host_idx ON public.hydra_network USING btree (host);
+
+CREATE UNIQUE INDEX hydra_network_name_idx ON public.hydra_network USING btree (name);
+
 CREATE INDEX hydra_oauth2_access_challenge_id_idx ON public.hydra_oauth2_access USING btree (challenge_id);
 
 CREATE INDEX hydra_oauth2_access_client_id_idx ON public.hydra_oauth2_access USING btree (client_id, nid);
@@ -453,6 +641,8 @@ CREATE INDEX hydra_oauth2_code_challenge_id_idx ON public.hydra_oauth2_code USIN
 
 CREATE INDEX hydra_oauth2_code_client_id_idx ON public.hydra_oauth2_code USING btree (client_id, nid);
 
+CREATE INDEX hydra_oauth2_consent_history_nid_subject_idx ON public.hydra_oauth2_consent_history USING btree (nid, subject, id);
+
 CREATE INDEX hydra_oauth2_device_auth_codes_challenge_id_idx ON public.hydra_oauth2_device_auth_codes USING btree (challenge_id);
 
 CREATE INDEX hydra_oauth2_device_auth_codes_client_id_idx ON public.hydra_oauth2_device_auth_codes USING btree (client_id, nid);
@@ -475,6 +665,8 @@ CREATE INDEX hydra_oauth2_flow_previous_consents_idx ON public.hydra_oauth2_flow
 
 CREATE INDEX hydra_oauth2_flow_sub_idx ON public.hydra_oauth2_flow USING btree (subject, nid);
 
+CREATE UNIQUE INDEX hydra_oauth2_initial_access_token_signature_idx ON public.hydra_oauth2_initial_access_token USING btree (nid, signature);
+
 CREATE INDEX hydra_oauth2_jti_blacklist_expires_at_idx ON public.hydra_oauth2_jti_blacklist USING btree (expires_at, nid);
 
 CREATE INDEX hydra_oauth2_logout_request_client_id_idx ON public.hydra_oauth2_logout_request USING btree (client_id, nid);
@@ -487,6 +679,10 @@ CREATE INDEX hydra_oauth2_oidc_challenge_id_idx ON public.hydra_oauth2_oidc USIN
 
 CREATE INDEX hydra_oauth2_oidc_client_id_idx ON public.hydra_oauth2_oidc USING btree (client_id, nid);
 
+CREATE INDEX hydra_oauth2_par_client_id_idx ON public.hydra_oauth2_par USING btree (client_id, nid);
+
+CREATE INDEX hydra_oauth2_par_expires_at_idx ON public.hydra_oauth2_par USING btree (expires_at);
+
 CREATE INDEX hydra_oauth2_pkce_challenge_id_idx ON public.hydra_oauth2_pkce USING btree (challenge_id);
 
 CREATE INDEX hydra_oauth2_pkce_client_id_idx ON public.hydra_oauth2_pkce USING btree (client_id, nid);
@@ -495,6 +691,8 @@ CREATE INDEX hydra_oauth2_refresh_challenge_id_idx ON public.hydra_oauth2_refres
 
 CREATE INDEX hydra_oauth2_refresh_client_id_idx ON public.hydra_oauth2_refresh USING btree (client_id, nid);
 
+CREATE INDEX hydra_oauth2_refresh_family_expires_at_idx ON public.hydra_oauth2_refresh_family USING btree (expires_at);
+
 CREATE INDEX hydra_oauth2_refresh_nid_subject_idx ON public.hydra_oauth2_refresh USING btree (nid, subject, client_id);
 
 CREATE INDEX hydra_oauth2_refresh_request_id_idx ON public.hydra_oauth2_refresh USING btree (request_id);
@@ -507,16 +705,31 @@ CREATE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_idx ON public.hydra_oaut
 
 CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx ON public.hydra_oauth2_trusted_jwt_bearer_issuer USING btree (nid, key_id, issuer, subject);
 
+CREATE INDEX hydra_ssf_event_expires_at_idx ON public.hydra_ssf_event USING btree (expires_at);
+
+CREATE INDEX hydra_ssf_event_nid_stream_id_created_at_idx ON public.hydra_ssf_event USING btree (nid, stream_id, created_at);
+
+CREATE INDEX hydra_ssf_stream_nid_idx ON public.hydra_ssf_stream USING btree (nid);
+
 CREATE UNIQUE INDEX schema_migration_version_idx ON public.schema_migration USING btree (version);
 
 CREATE INDEX schema_migration_version_self_idx ON public.schema_migration USING btree (version_self);
 
+ALTER TABLE ONLY public.hydra_audit_event
+    ADD CONSTRAINT hydra_audit_event_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.hydra_audit_lock
+    ADD CONSTRAINT hydra_audit_lock_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_client
     ADD CONSTRAINT hydra_client_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
 ALTER TABLE ONLY public.hydra_jwk
     ADD CONSTRAINT hydra_jwk_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_network
+    ADD CONSTRAINT hydra_network_id_fkey FOREIGN KEY (id) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_access
     ADD CONSTRAINT hydra_oauth2_access_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 
@@ -538,6 +751,9 @@ ALTER TABLE ONLY public.hydra_oauth2_code
 ALTER TABLE ONLY public.hydra_oauth2_code
     ADD CONSTRAINT hydra_oauth2_code_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_oauth2_consent_history
+    ADD CONSTRAINT hydra_oauth2_consent_history_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_device_auth_codes
     ADD CONSTRAINT hydra_oauth2_device_auth_codes_challenge_id_fkey FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 
@@ -550,12 +766,12 @@ ALTER TABLE ONLY public.hydra_oauth2_device_auth_codes
 ALTER TABLE ONLY public.hydra_oauth2_flow
     ADD CONSTRAINT hydra_oauth2_flow_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
 
-ALTER TABLE ONLY public.hydra_oauth2_flow
-    ADD CONSTRAINT hydra_oauth2_flow_login_session_id_fk FOREIGN KEY (login_session_id) REFERENCES public.hydra_oauth2_authentication_session(id) ON DELETE SET NULL;
-
 ALTER TABLE ONLY public.hydra_oauth2_flow
     ADD CONSTRAINT hydra_oauth2_flow_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_oauth2_initial_access_token
+    ADD CONSTRAINT hydra_oauth2_initial_access_token_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_jti_blacklist
     ADD CONSTRAINT hydra_oauth2_jti_blacklist_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
@@ -580,6 +796,12 @@ ALTER TABLE ONLY public.hydra_oauth2_oidc
 ALTER TABLE ONLY public.hydra_oauth2_oidc
     ADD CONSTRAINT hydra_oauth2_oidc_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_oauth2_par
+    ADD CONSTRAINT hydra_oauth2_par_client_id_nid_fkey FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.hydra_oauth2_par
+    ADD CONSTRAINT hydra_oauth2_par_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_pkce
     ADD CONSTRAINT hydra_oauth2_pkce_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 
@@ -595,6 +817,9 @@ ALTER TABLE ONLY public.hydra_oauth2_refresh
 ALTER TABLE ONLY public.hydra_oauth2_refresh
     ADD CONSTRAINT hydra_oauth2_refresh_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_oauth2_refresh_family
+    ADD CONSTRAINT hydra_oauth2_refresh_family_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_refresh
     ADD CONSTRAINT hydra_oauth2_refresh_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
@@ -604,4 +829,13 @@ ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
 ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
     ADD CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issuer_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_ssf_event
+    ADD CONSTRAINT hydra_ssf_event_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.hydra_ssf_event
+    ADD CONSTRAINT hydra_ssf_event_stream_id_fkey FOREIGN KEY (stream_id) REFERENCES public.hydra_ssf_stream(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.hydra_ssf_stream
+    ADD CONSTRAINT hydra_ssf_stream_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 SET search_path TO public;
@@ -1,5 +1,30 @@
--- migrations hash: 9ca90c1aaa8c09268d225b414e946af686058788bfe285c358b8daea9ce52149152e41ef2aeb3c1e836dd6f898a3e749fbbd495f31e51d1c40bce6dadd1d9b5d
+-- migrations hash: 5a05851f1bbfe822f62f306960bc7b50200d31293f22573bf2a0f6b16a919d133fd5e4e45c2bbd0bdc0a169436f44e5635ee9d80cbd3069e51fb22b77c723c24
 
+CREATE TABLE hydra_audit_event
+(
+  id            UUID          NOT NULL PRIMARY KEY,
+  nid           UUID          NOT NULL,
+  seq           BIGINT        NOT NULL,
+  created_at    TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  actor         VARCHAR(255)  NOT NULL DEFAULT '',
+  action        VARCHAR(255)  NOT NULL,
+  resource_id   VARCHAR(255)  NOT NULL DEFAULT '',
+  request_id    VARCHAR(255)  NOT NULL DEFAULT '',
+  diff          TEXT          NOT NULL,
+  previous_hash VARCHAR(64)   NOT NULL DEFAULT '',
+  hash          VARCHAR(64)   NOT NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE INDEX hydra_audit_event_nid_action_idx ON hydra_audit_event (nid, action);
+CREATE INDEX hydra_audit_event_nid_resource_id_idx ON hydra_audit_event (nid, resource_id);
+CREATE UNIQUE INDEX hydra_audit_event_nid_seq_idx ON hydra_audit_event (nid, seq);
+CREATE TABLE hydra_audit_lock
+(
+  nid UUID NOT NULL PRIMARY KEY,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
 CREATE TABLE "hydra_client"
 (
   id                                              VARCHAR(255) NOT NULL,
@@ -52,7 +77,7 @@ CREATE TABLE "hydra_client"
   refresh_token_grant_access_token_lifespan       BIGINT NULL DEFAULT NULL,
   refresh_token_grant_refresh_token_lifespan      BIGINT NULL DEFAULT NULL,
   skip_consent                                    BOOLEAN      NOT NULL DEFAULT false,
-  nid                                             CHAR(36)     NOT NULL, skip_logout_consent BOOLEAN NULL, device_authorization_grant_id_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_access_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_refresh_token_lifespan BIGINT NULL DEFAULT NULL,
+  nid                                             CHAR(36)     NOT NULL, skip_logout_consent BOOLEAN NULL, device_authorization_grant_id_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_access_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_refresh_token_lifespan BIGINT NULL DEFAULT NULL, refresh_token_reuse_policy VARCHAR(32) NOT NULL DEFAULT '', refresh_token_rotation_disabled BOOLEAN NOT NULL DEFAULT false, refresh_token_rotation_grace_period BIGINT NULL DEFAULT NULL, refresh_token_rotation_grace_reuse_count BIGINT NULL DEFAULT NULL, refresh_token_idle_lifespan BIGINT NULL DEFAULT NULL, refresh_token_max_lifespan BIGINT NULL DEFAULT NULL, id_token_signed_response_alg VARCHAR(10) NOT NULL DEFAULT '', initial_access_token_id UUID NULL, federation_expires_at TIMESTAMP NULL, security_profile VARCHAR(32) NOT NULL DEFAULT '', introspection_signed_response_alg VARCHAR(32) NOT NULL DEFAULT '', introspection_encrypted_response_alg VARCHAR(32) NOT NULL DEFAULT '', introspection_encrypted_response_enc VARCHAR(32) NOT NULL DEFAULT '', tls_client_auth_subject_dn VARCHAR(1024) NOT NULL DEFAULT '', application_type VARCHAR(10) NOT NULL DEFAULT '', client_secret_encrypted TEXT NULL,
   PRIMARY KEY (id, nid)
 );
 CREATE TABLE "hydra_jwk" (
@@ -69,6 +94,26 @@ CREATE TABLE "hydra_jwk" (
 CREATE INDEX hydra_jwk_nid_sid_created_at_idx ON hydra_jwk (nid, sid, created_at);
 CREATE INDEX hydra_jwk_nid_sid_kid_created_at_idx ON hydra_jwk (nid, sid, kid, created_at);
 CREATE UNIQUE INDEX hydra_jwk_sid_kid_nid_key ON hydra_jwk (sid, kid, nid);
+CREATE TABLE hydra_lease
+(
+  name       VARCHAR(64)  NOT NULL PRIMARY KEY,
+  holder     VARCHAR(255) NOT NULL,
+  expires_at TIMESTAMP    NOT NULL
+);
+CREATE TABLE hydra_network
+(
+  id          UUID          NOT NULL PRIMARY KEY,
+  name        VARCHAR(255)  NOT NULL,
+  host        VARCHAR(255)  NULL,
+  issuer_url  VARCHAR(2048) NOT NULL,
+  config      TEXT          NOT NULL,
+  created_at  TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at  TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (id) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX hydra_network_host_idx ON hydra_network (host);
+CREATE UNIQUE INDEX hydra_network_name_idx ON hydra_network (name);
 CREATE TABLE "hydra_oauth2_access" (
     signature          VARCHAR(255) NOT NULL PRIMARY KEY,
     request_id         VARCHAR(40)  NOT NULL,
@@ -119,6 +164,21 @@ CREATE TABLE "hydra_oauth2_code" (
 );
 CREATE INDEX hydra_oauth2_code_challenge_id_idx ON hydra_oauth2_code (challenge_id, nid);
 CREATE INDEX hydra_oauth2_code_client_id_idx ON hydra_oauth2_code (client_id, nid);
+CREATE TABLE hydra_oauth2_consent_history
+(
+  id                 UUID          NOT NULL PRIMARY KEY,
+  nid                UUID          NOT NULL,
+  subject            VARCHAR(255)  NOT NULL,
+  client_id          VARCHAR(255)  NOT NULL,
+  consent_request_id VARCHAR(40)   NOT NULL,
+  type               VARCHAR(32)   NOT NULL,
+  scope              TEXT          NOT NULL,
+  audience           TEXT          NOT NULL,
+  created_at         TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE INDEX hydra_oauth2_consent_history_nid_subject_idx ON hydra_oauth2_consent_history (nid, subject, id);
 CREATE TABLE hydra_oauth2_device_auth_codes
 (
   device_code_signature VARCHAR(255)  NOT NULL,
@@ -160,7 +220,7 @@ CREATE TABLE "hydra_oauth2_flow" (
   client_id                     VARCHAR(255)  NULL,
   requested_at                  TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
   oidc_context                  TEXT          NULL,
-  login_session_id              VARCHAR(40)   NULL REFERENCES hydra_oauth2_authentication_session (id) ON DELETE SET NULL,
+  login_session_id              VARCHAR(40)   NULL,
   requested_at_audience         TEXT          NULL,
   login_initialized_at          TIMESTAMP     NULL,
 
@@ -212,6 +272,21 @@ CREATE INDEX hydra_oauth2_flow_login_session_id_idx ON hydra_oauth2_flow (login_
 CREATE INDEX hydra_oauth2_flow_previous_consents_idx ON hydra_oauth2_flow (subject, client_id, nid, consent_skip,
                                                                            consent_error, consent_remember);
 CREATE INDEX hydra_oauth2_flow_subject_idx ON hydra_oauth2_flow (subject, nid);
+CREATE TABLE hydra_oauth2_initial_access_token
+(
+  id                         UUID          NOT NULL PRIMARY KEY,
+  nid                        UUID          NOT NULL,
+  signature                  VARCHAR(255)  NOT NULL,
+  description                TEXT          NOT NULL,
+  allowed_grant_types        TEXT          NOT NULL,
+  allowed_scope              TEXT          NOT NULL,
+  allowed_redirect_uri_hosts TEXT          NOT NULL,
+  created_at                 TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at                 TIMESTAMP     NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX hydra_oauth2_initial_access_token_signature_idx ON hydra_oauth2_initial_access_token (nid, signature);
 CREATE TABLE "hydra_oauth2_jti_blacklist" (
     signature  VARCHAR(64) NOT NULL,
     expires_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -265,6 +340,29 @@ CREATE TABLE "hydra_oauth2_oidc" (
 );
 CREATE INDEX hydra_oauth2_oidc_challenge_id_idx ON hydra_oauth2_oidc (challenge_id, nid);
 CREATE INDEX hydra_oauth2_oidc_client_id_idx ON hydra_oauth2_oidc (client_id, nid);
+CREATE TABLE hydra_oauth2_par
+(
+  signature          VARCHAR(255) NOT NULL PRIMARY KEY,
+  request_id         VARCHAR(40)  NOT NULL,
+  requested_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  client_id          VARCHAR(255) NOT NULL,
+  scope              TEXT         NOT NULL,
+  granted_scope      TEXT         NOT NULL,
+  form_data          TEXT         NOT NULL,
+  session_data       TEXT         NOT NULL,
+  subject            VARCHAR(255) NOT NULL,
+  active             BOOLEAN      NOT NULL DEFAULT true,
+  requested_audience TEXT         NOT NULL,
+  granted_audience   TEXT         NOT NULL,
+  challenge_id       VARCHAR(40)  NULL,
+  nid                UUID         NOT NULL,
+  expires_at         TIMESTAMP    NULL,
+
+  FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE,
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE INDEX hydra_oauth2_par_client_id_idx ON hydra_oauth2_par (client_id, nid);
+CREATE INDEX hydra_oauth2_par_expires_at_idx ON hydra_oauth2_par (expires_at);
 CREATE TABLE "hydra_oauth2_pkce" (
     signature          VARCHAR(255) NOT NULL PRIMARY KEY,
     request_id         VARCHAR(40)  NOT NULL,
@@ -303,24 +401,74 @@ CREATE TABLE "hydra_oauth2_refresh" (
 );
 CREATE INDEX hydra_oauth2_refresh_challenge_id_idx ON hydra_oauth2_refresh (challenge_id, nid);
 CREATE INDEX hydra_oauth2_refresh_client_id_idx ON hydra_oauth2_refresh (client_id, nid);
+CREATE TABLE hydra_oauth2_refresh_family
+(
+  request_id VARCHAR(40) NOT NULL,
+  nid        UUID        NOT NULL,
+  generation INTEGER     NOT NULL,
+  revoked    BOOLEAN     NOT NULL DEFAULT false,
+  expires_at TIMESTAMP   NULL,
+
+  PRIMARY KEY (request_id, nid),
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE INDEX hydra_oauth2_refresh_family_expires_at_idx ON hydra_oauth2_refresh_family (expires_at);
 CREATE INDEX hydra_oauth2_refresh_nid_subject_idx ON hydra_oauth2_refresh (nid ASC, subject ASC, client_id ASC);
 CREATE INDEX hydra_oauth2_refresh_request_id_idx ON hydra_oauth2_refresh (request_id, nid);
 CREATE INDEX hydra_oauth2_refresh_requested_at_idx ON hydra_oauth2_refresh (nid, requested_at);
-CREATE TABLE "hydra_oauth2_trusted_jwt_bearer_issuer" (
-    id         VARCHAR(36) PRIMARY KEY,
-    issuer     VARCHAR(255) NOT NULL,
-    subject    VARCHAR(255) NOT NULL,
-    scope      TEXT         NOT NULL,
-    key_set    varchar(255) NOT NULL,
-    key_id     varchar(255) NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    expires_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    nid        CHAR(36)     NOT NULL, allow_any_subject INTEGER NOT NULL DEFAULT FALSE,
+CREATE TABLE "hydra_oauth2_trusted_jwt_bearer_issuer"
+(
+    id                VARCHAR(36) PRIMARY KEY,
+    issuer            VARCHAR(255)  NOT NULL,
+    subject           VARCHAR(255)  NOT NULL,
+    scope             TEXT          NOT NULL,
+    key_set           VARCHAR(255)  NULL,
+    key_id            VARCHAR(255)  NULL,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    nid               CHAR(36)      NOT NULL,
+    allow_any_subject INTEGER       NOT NULL DEFAULT FALSE,
+    jwks_uri          VARCHAR(1024) NOT NULL DEFAULT '',
+    oidc_discovery    INTEGER       NOT NULL DEFAULT FALSE,
+    claim_conditions  TEXT          NULL,
+    claim_mappings    TEXT          NULL,
     UNIQUE (issuer, subject, key_id, nid),
     FOREIGN KEY (key_set, key_id, nid) REFERENCES hydra_jwk (sid, kid, nid) ON DELETE CASCADE
 );
 CREATE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (expires_at);
 CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid ASC, key_id ASC, issuer ASC, subject ASC);
+CREATE TABLE hydra_ssf_event
+(
+  id         UUID       NOT NULL PRIMARY KEY,
+  nid        UUID       NOT NULL,
+  stream_id  UUID       NOT NULL,
+  token      TEXT       NOT NULL,
+  created_at TIMESTAMP  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at TIMESTAMP  NOT NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE,
+  FOREIGN KEY (stream_id) REFERENCES hydra_ssf_stream (id) ON DELETE CASCADE
+);
+CREATE INDEX hydra_ssf_event_expires_at_idx ON hydra_ssf_event (expires_at);
+CREATE INDEX hydra_ssf_event_nid_stream_id_created_at_idx ON hydra_ssf_event (nid, stream_id, created_at);
+CREATE TABLE hydra_ssf_stream
+(
+  id                   UUID          NOT NULL PRIMARY KEY,
+  nid                  UUID          NOT NULL,
+  audience             TEXT          NOT NULL,
+  events_requested     TEXT          NOT NULL,
+  delivery_method      VARCHAR(64)   NOT NULL,
+  endpoint_url         TEXT          NOT NULL,
+  authorization_header TEXT          NOT NULL,
+  description          TEXT          NOT NULL,
+  status               VARCHAR(16)   NOT NULL,
+  status_reason        TEXT          NOT NULL,
+  created_at           TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at           TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE INDEX hydra_ssf_stream_nid_idx ON hydra_ssf_stream (nid);
 CREATE TABLE "networks" (
   "id" TEXT PRIMARY KEY,
   "created_at" DATETIME NOT NULL,
@@ -0,0 +1,81 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor
+
+import (
+	"context"
+	"time"
+)
+
+// Table is a table the janitor deletes expired rows from.
+type Table string
+
+const (
+	TableAccessTokens           Table = "hydra_oauth2_access"
+	TableRefreshTokens          Table = "hydra_oauth2_refresh"
+	TableAuthorizationCodes     Table = "hydra_oauth2_code"
+	TablePKCERequests           Table = "hydra_oauth2_pkce"
+	TablePushedAuthRequests     Table = "hydra_oauth2_par"
+	TableOpenIDConnectSessions  Table = "hydra_oauth2_oidc"
+	TableLoginConsentRequests   Table = "hydra_oauth2_flow"
+	TableBlacklistedJTIs        Table = "hydra_oauth2_jti_blacklist"
+	TableDeviceAuthCodes        Table = "hydra_oauth2_device_auth_codes"
+	TableTrustedJWTBearerGrants Table = "hydra_oauth2_trusted_jwt_bearer_issuer"
+	TableRefreshTokenFamilies   Table = "hydra_oauth2_refresh_family"
+	TableSecurityEvents         Table = "hydra_ssf_event"
+)
+
+// Tables are the tables the janitor deletes expired rows from, in the order they are flushed.
+//
+// OpenID Connect sessions hold the nonce of the authorization request, so flushing them also removes expired
+// nonces. The nonces of verifiable credentials are not stored.
+var Tables = []Table{
+	TableAccessTokens,
+	TableRefreshTokens,
+	TableAuthorizationCodes,
+	TablePKCERequests,
+	TablePushedAuthRequests,
+	TableOpenIDConnectSessions,
+	TableLoginConsentRequests,
+	TableBlacklistedJTIs,
+	TableDeviceAuthCodes,
+	TableTrustedJWTBearerGrants,
+	TableRefreshTokenFamilies,
+	TableSecurityEvents,
+}
+
+// Lease grants one process the exclusive right to run a background task until it expires.
+type Lease struct {
+	Name      string    `json:"name" db:"name"`
+	Holder    string    `json:"holder" db:"holder"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+}
+
+func (Lease) TableName() string {
+	return "hydra_lease"
+}
+
+type (
+	Manager interface {
+		// AcquireLease acquires or renews the lease with the given name for the holder. It returns false if the
+		// lease is held by another holder and has not expired yet.
+		AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+
+		// ReleaseLease releases the lease with the given name if it is held by the holder.
+		ReleaseLease(ctx context.Context, name, holder string) error
+
+		// FlushExpired deletes up to limit rows of the table which expired before notAfter in batches of batchSize
+		// and returns the number of deleted rows.
+		FlushExpired(ctx context.Context, table Table, notAfter time.Time, limit, batchSize int) (int, error)
+
+		// FlushExpiredPartitions creates the upcoming partitions of tables which are partitioned by expiry and drops
+		// the partitions whose rows all expired. It returns the number of dropped rows per table and does nothing if
+		// no table is partitioned.
+		FlushExpiredPartitions(ctx context.Context) (map[Table]int, error)
+	}
+
+	ManagerProvider interface {
+		JanitorManager() Manager
+	}
+)
@@ -0,0 +1,21 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor
+
+import (
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/network"
+	"github.com/ory/x/logrusx"
+)
+
+type InternalRegistry interface {
+	logrusx.Provider
+	config.Provider
+	network.Registry
+	Registry
+}
+
+type Registry interface {
+	ManagerProvider
+}
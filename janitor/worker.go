@@ -0,0 +1,138 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+// LeaseName is the name of the lease which ensures that only one process runs the janitor at a time.
+const LeaseName = "janitor"
+
+var (
+	deletedRows = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_janitor_deleted_rows_total",
+		Help: "Counts the number of expired rows deleted by the janitor",
+	}, []string{"table"})
+	DeletedRows prometheus.Collector = deletedRows
+)
+
+func init() {
+	for _, t := range Tables {
+		_ = deletedRows.WithLabelValues(string(t)) // make sure the metric is always present
+	}
+}
+
+// Worker periodically deletes expired rows from the tables in Tables. Every process running "hydra serve" starts
+// a worker if the janitor is enabled, but only the worker holding the lease deletes rows.
+type Worker struct {
+	r      InternalRegistry
+	holder string
+}
+
+func NewWorker(r InternalRegistry) *Worker {
+	if err := prometheus.Register(DeletedRows); err != nil && !errors.As(err, new(prometheus.AlreadyRegisteredError)) {
+		r.Logger().WithError(err).Warn("Unable to register the janitor metrics.")
+	}
+
+	host, _ := os.Hostname()
+	return &Worker{r: r, holder: host + "/" + uuid.Must(uuid.NewV4()).String()}
+}
+
+// Start runs the worker every janitor.interval until the context is canceled, and releases the lease when it
+// stops.
+func (w *Worker) Start(ctx context.Context) {
+	w.r.Logger().Info("Starting the janitor.")
+	for {
+		if err := w.Run(ctx); err != nil && ctx.Err() == nil {
+			w.r.Logger().WithError(err).Error("Unable to delete expired rows.")
+		}
+
+		select {
+		case <-ctx.Done():
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			defer cancel()
+			if err := w.r.JanitorManager().ReleaseLease(ctx, LeaseName, w.holder); err != nil {
+				w.r.Logger().WithError(err).Warn("Unable to release the janitor lease.")
+			}
+			return
+		case <-time.After(w.r.Config().JanitorInterval()):
+		}
+	}
+}
+
+// Run deletes expired rows of the default network and, if multi-tenancy is enabled, of all other networks. It
+// does nothing if another worker holds the lease.
+func (w *Worker) Run(ctx context.Context) error {
+	ok, err := w.r.JanitorManager().AcquireLease(ctx, LeaseName, w.holder, w.r.Config().JanitorInterval())
+	if err != nil {
+		return err
+	} else if !ok {
+		w.r.Logger().Debug("The janitor lease is held by another process, skipping this run.")
+		return nil
+	}
+
+	// Partitions are shared by all networks.
+	dropped, err := w.r.JanitorManager().FlushExpiredPartitions(ctx)
+	if err != nil {
+		w.r.Logger().WithError(err).Error("Unable to drop expired partitions.")
+	}
+	for t, n := range dropped {
+		deletedRows.WithLabelValues(string(t)).Add(float64(n))
+	}
+
+	w.flush(ctx)
+	if !w.r.Config().MultitenancyEnabled(ctx) {
+		return nil
+	}
+
+	opts := []keysetpagination.Option{keysetpagination.WithSize(keysetpagination.DefaultMaxSize)}
+	for {
+		ns, next, err := w.r.NetworkManager().GetNetworks(ctx, opts...)
+		if err != nil {
+			return err
+		}
+
+		for i := range ns {
+			ctx, err := w.r.NetworkResolver().Scope(ctx, &ns[i])
+			if err != nil {
+				w.r.Logger().WithError(err).WithField("network", ns[i].ID).Error("Unable to load the configuration of the network.")
+				continue
+			}
+			w.flush(ctx)
+		}
+
+		if next.IsLast() {
+			return nil
+		}
+		opts = next.ToOptions()
+	}
+}
+
+// flush deletes the expired rows of the network in the context. Errors are logged so that a failing table does
+// not prevent the other tables from being cleaned up.
+func (w *Worker) flush(ctx context.Context) {
+	var (
+		notAfter  = time.Now().UTC()
+		limit     = w.r.Config().JanitorLimit()
+		batchSize = w.r.Config().JanitorBatchSize()
+	)
+	for _, t := range Tables {
+		n, err := w.r.JanitorManager().FlushExpired(ctx, t, notAfter, limit, batchSize)
+		deletedRows.WithLabelValues(string(t)).Add(float64(n))
+		if err != nil {
+			w.r.Logger().WithError(err).WithField("table", t).Error("Unable to delete expired rows.")
+			continue
+		}
+		w.r.Logger().WithField("table", t).WithField("deleted_rows", n).Debug("Deleted expired rows.")
+	}
+}
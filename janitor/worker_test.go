@@ -0,0 +1,102 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/hydra/v2/network"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/sqlxx"
+)
+
+func TestWorker(t *testing.T) {
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyJanitorEnabled:      true,
+		config.KeyMultitenancyEnabled: true,
+	})))
+	ctx := t.Context()
+
+	acme := &network.Network{Name: "acme", IssuerURL: "https://acme.example.com/", Config: sqlxx.JSONRawMessage("{}")}
+	require.NoError(t, reg.NetworkManager().CreateNetwork(ctx, acme))
+	acmeCtx, err := reg.NetworkResolver().Scope(ctx, acme)
+	require.NoError(t, err)
+
+	cl := &client.Client{ID: "client"}
+	require.NoError(t, reg.ClientManager().CreateClient(ctx, cl))
+	require.NoError(t, reg.ClientManager().CreateClient(acmeCtx, cl))
+
+	createExpiredToken := func(t *testing.T, ctx context.Context) string {
+		sig := uuid.Must(uuid.NewV4()).String()
+		require.NoError(t, reg.OAuth2Storage().CreateAccessTokenSession(ctx, sig, &fosite.Request{
+			ID:          uuid.Must(uuid.NewV4()).String(),
+			Client:      cl,
+			RequestedAt: time.Now().UTC().Add(-24 * time.Hour),
+			Session:     &oauth2.Session{DefaultSession: openid.NewDefaultSession()},
+		}))
+		return sig
+	}
+	tokenExists := func(t *testing.T, ctx context.Context, sig string) bool {
+		_, err := reg.OAuth2Storage().GetAccessTokenSession(ctx, sig, &oauth2.Session{DefaultSession: openid.NewDefaultSession()})
+		if err == nil {
+			return true
+		}
+		require.ErrorIs(t, err, fosite.ErrNotFound)
+		return false
+	}
+	deleted := testutil.ToFloat64(janitor.DeletedRows.(*prometheus.CounterVec).WithLabelValues(string(janitor.TableAccessTokens)))
+
+	worker, other := janitor.NewWorker(reg), janitor.NewWorker(reg)
+
+	t.Run("case=deletes expired rows of all networks", func(t *testing.T) {
+		tokens := map[context.Context]string{ctx: createExpiredToken(t, ctx), acmeCtx: createExpiredToken(t, acmeCtx)}
+
+		require.NoError(t, worker.Run(ctx))
+		for ctx, sig := range tokens {
+			assert.False(t, tokenExists(t, ctx, sig))
+		}
+		assert.Equal(t, deleted+2, testutil.ToFloat64(janitor.DeletedRows.(*prometheus.CounterVec).WithLabelValues(string(janitor.TableAccessTokens))))
+	})
+
+	t.Run("case=only the lease holder deletes rows", func(t *testing.T) {
+		sig := createExpiredToken(t, ctx)
+
+		require.NoError(t, other.Run(ctx))
+		assert.True(t, tokenExists(t, ctx, sig))
+
+		require.NoError(t, worker.Run(ctx))
+		assert.False(t, tokenExists(t, ctx, sig))
+	})
+
+	t.Run("case=releases the lease when stopped", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			worker.Start(ctx)
+		}()
+		cancel()
+		<-done
+
+		sig := createExpiredToken(t, t.Context())
+		require.NoError(t, other.Run(t.Context()))
+		assert.False(t, tokenExists(t, t.Context(), sig))
+	})
+}
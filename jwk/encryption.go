@@ -0,0 +1,69 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package jwk
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"strings"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+)
+
+// EncryptionAlgorithms are the key management algorithms clients may request for encrypted responses.
+var EncryptionAlgorithms = []string{
+	string(jose.RSA_OAEP),
+	string(jose.RSA_OAEP_256),
+	string(jose.ECDH_ES),
+	string(jose.ECDH_ES_A128KW),
+	string(jose.ECDH_ES_A256KW),
+}
+
+// ContentEncryptionAlgorithms are the content encryption algorithms clients may request for encrypted responses.
+var ContentEncryptionAlgorithms = []string{
+	string(jose.A128CBC_HS256),
+	string(jose.A256CBC_HS512),
+	string(jose.A128GCM),
+	string(jose.A256GCM),
+}
+
+// FindEncryptionKey returns the first public key of the set which may be used with the key management algorithm.
+func FindEncryptionKey(set *jose.JSONWebKeySet, alg string) (*jose.JSONWebKey, error) {
+	for _, key := range set.Keys {
+		if (key.Use != "" && key.Use != "enc") || (key.Algorithm != "" && key.Algorithm != alg) {
+			continue
+		}
+		switch key.Key.(type) {
+		case *rsa.PublicKey:
+			if strings.HasPrefix(alg, "RSA-") {
+				return &key, nil
+			}
+		case *ecdsa.PublicKey:
+			if strings.HasPrefix(alg, "ECDH-ES") {
+				return &key, nil
+			}
+		}
+	}
+	return nil, errors.WithStack(fosite.ErrServerError.WithHintf("The JSON Web Key Set does not contain a public encryption key for algorithm '%s'.", alg))
+}
+
+// EncryptJWT encrypts the signed JSON Web Token for the key, resulting in a nested JSON Web Token.
+func EncryptJWT(token string, key *jose.JSONWebKey, alg, enc string) (string, error) {
+	encrypter, err := jose.NewEncrypter(
+		jose.ContentEncryption(enc),
+		jose.Recipient{Algorithm: jose.KeyAlgorithm(alg), Key: key.Key, KeyID: key.KeyID},
+		(&jose.EncrypterOptions{}).WithContentType("JWT"),
+	)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	encrypted, err := encrypter.Encrypt([]byte(token))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return encrypted.CompactSerialize()
+}
@@ -4,6 +4,7 @@
 package jwk
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -14,6 +15,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/httprouterx"
 	"github.com/ory/x/urlx"
@@ -95,11 +97,16 @@ func (h *Handler) discoverJsonWebKeys(w http.ResponseWriter, r *http.Request) {
 	eg, ctx := errgroup.WithContext(r.Context())
 	wellKnownKeys := h.r.Config().WellKnownKeys(ctx)
 
+	getKeySet := h.r.KeyManager().GetKeySet
+	if rr, ok := h.r.KeyManager().(ReplicaReader); ok {
+		getKeySet = rr.GetKeySetFromReplica
+	}
+
 	keys := make([]*jose.JSONWebKeySet, len(wellKnownKeys))
 	nTotalKeys := atomic.Int64{}
 	for i, set := range wellKnownKeys {
 		eg.Go(func() error {
-			k, err := h.r.KeyManager().GetKeySet(ctx, set)
+			k, err := getKeySet(ctx, set)
 			if errors.Is(err, x.ErrNotFound) {
 				h.r.Logger().Warnf("JSON Web Key Set %q does not exist yet, generating new key pair...", set)
 				k, err = h.r.KeyManager().GenerateAndPersistKeySet(ctx, set, "", string(jose.RS256), "sig")
@@ -298,12 +305,20 @@ func (h *Handler) createJsonWebKeySet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if keys, err := h.r.KeyManager().GenerateAndPersistKeySet(r.Context(), set, keyRequest.KeyID, keyRequest.Algorithm, keyRequest.Use); err == nil {
-		keys = ExcludeOpaquePrivateKeys(keys)
-		h.r.Writer().WriteCreated(w, r, urlx.AppendPaths(h.r.Config().IssuerURL(r.Context()), "keys", url.PathEscape(set)).String(), keys)
-	} else {
+	var keys *jose.JSONWebKeySet
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) (err error) {
+		before := h.auditKeySet(ctx, set)
+		if keys, err = h.r.KeyManager().GenerateAndPersistKeySet(ctx, set, keyRequest.KeyID, keyRequest.Algorithm, keyRequest.Use); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionJSONWebKeySetCreated, set, before, h.auditKeySet(ctx, set))
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
+		return
 	}
+
+	keys = ExcludeOpaquePrivateKeys(keys)
+	h.r.Writer().WriteCreated(w, r, urlx.AppendPaths(h.r.Config().IssuerURL(r.Context()), "keys", url.PathEscape(set)).String(), keys)
 }
 
 // Set JSON Web Key Set Request
@@ -351,7 +366,13 @@ func (h *Handler) setJsonWebKeySet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.r.KeyManager().UpdateKeySet(r.Context(), set, &keySet); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditKeySet(ctx, set)
+		if err := h.r.KeyManager().UpdateKeySet(ctx, set, &keySet); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionJSONWebKeySetUpdated, set, before, h.auditKeySet(ctx, set))
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -410,7 +431,13 @@ func (h *Handler) adminUpdateJsonWebKey(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := h.r.KeyManager().UpdateKey(r.Context(), set, &key); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditKey(ctx, set, key.KeyID)
+		if err := h.r.KeyManager().UpdateKey(ctx, set, &key); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionJSONWebKeyUpdated, set+"/"+key.KeyID, before, h.auditKey(ctx, set, key.KeyID))
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -453,7 +480,13 @@ type _ struct {
 func (h *Handler) adminDeleteJsonWebKeySet(w http.ResponseWriter, r *http.Request) {
 	var setName = r.PathValue("set")
 
-	if err := h.r.KeyManager().DeleteKeySet(r.Context(), setName); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditKeySet(ctx, setName)
+		if err := h.r.KeyManager().DeleteKeySet(ctx, setName); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionJSONWebKeySetDeleted, setName, before, nil)
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -505,7 +538,13 @@ type _ struct {
 func (h *Handler) deleteJsonWebKey(w http.ResponseWriter, r *http.Request) {
 	setName, keyName := r.PathValue("set"), r.PathValue("key")
 
-	if err := h.r.KeyManager().DeleteKey(r.Context(), setName, keyName); err != nil {
+	if err := h.r.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		before := h.auditKey(ctx, setName, keyName)
+		if err := h.r.KeyManager().DeleteKey(ctx, setName, keyName); err != nil {
+			return err
+		}
+		return h.r.AuditRecorder().Record(ctx, r, audit.ActionJSONWebKeyDeleted, setName+"/"+keyName, before, nil)
+	}); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -513,6 +552,30 @@ func (h *Handler) deleteJsonWebKey(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// auditKeySet returns the public keys of the set, or nil if the audit log is disabled or the set does not exist.
+func (h *Handler) auditKeySet(ctx context.Context, set string) *jose.JSONWebKeySet {
+	if !h.r.AuditRecorder().Enabled(ctx) {
+		return nil
+	}
+	keys, err := h.r.KeyManager().GetKeySet(ctx, set)
+	if err != nil {
+		return nil
+	}
+	return ExcludePrivateKeys(keys)
+}
+
+// auditKey returns the public keys with the key ID, or nil if the audit log is disabled or the key does not exist.
+func (h *Handler) auditKey(ctx context.Context, set, kid string) *jose.JSONWebKeySet {
+	if !h.r.AuditRecorder().Enabled(ctx) {
+		return nil
+	}
+	keys, err := h.r.KeyManager().GetKey(ctx, set, kid)
+	if err != nil {
+		return nil
+	}
+	return ExcludePrivateKeys(keys)
+}
+
 // This function will not be called, OPTIONS request will be handled by cors
 // this is just a placeholder.
 func (h *Handler) handleOptions(http.ResponseWriter, *http.Request) {}
@@ -6,6 +6,7 @@ package jwk
 import (
 	"context"
 	"net"
+	"slices"
 
 	"github.com/go-jose/go-jose/v3"
 	"github.com/pkg/errors"
@@ -19,6 +20,7 @@ type (
 	JWTSigner interface {
 		GetPublicKeyID(ctx context.Context) (string, error)
 		GetPublicKey(ctx context.Context) (jose.JSONWebKey, error)
+		GetSigningAlgorithms(ctx context.Context) ([]string, error)
 		jwt.Signer
 	}
 	DefaultJWTSigner struct {
@@ -32,8 +34,29 @@ type (
 	OpenIDSignerProvider interface {
 		OpenIDJWTSigner() JWTSigner
 	}
+	FederationSignerProvider interface {
+		FederationJWTSigner() JWTSigner
+	}
 )
 
+// SigningAlgorithms are the algorithms clients may request for their ID tokens and userinfo responses. A key set
+// holds at most one active key per algorithm, which is the most recent key of that algorithm.
+var SigningAlgorithms = []string{
+	string(jose.RS256),
+	string(jose.ES256),
+	string(jose.ES384),
+	string(jose.PS256),
+	string(jose.EdDSA),
+}
+
+type signingAlgorithmContextKey struct{}
+
+// WithSigningAlgorithm returns a context in which signers use the active key for the algorithm instead of their
+// default key, which is the most recent key of the key set. An empty algorithm selects the default key.
+func WithSigningAlgorithm(ctx context.Context, alg string) context.Context {
+	return context.WithValue(ctx, signingAlgorithmContextKey{}, alg)
+}
+
 func NewDefaultJWTSigner(r InternalRegistry, setID string) *DefaultJWTSigner {
 	j := &DefaultJWTSigner{r: r, setID: setID, DefaultSigner: &jwt.DefaultSigner{}}
 	j.DefaultSigner.GetPrivateKey = j.getPrivateKey
@@ -43,6 +66,9 @@ func NewDefaultJWTSigner(r InternalRegistry, setID string) *DefaultJWTSigner {
 func (j *DefaultJWTSigner) getKeys(ctx context.Context) (private *jose.JSONWebKey, err error) {
 	private, err = GetOrGenerateKeys(ctx, j.r, j.setID, string(jose.RS256))
 	if err == nil {
+		if alg, _ := ctx.Value(signingAlgorithmContextKey{}).(string); alg != "" && alg != private.Algorithm {
+			return j.getKeyForAlgorithm(ctx, alg)
+		}
 		return private, nil
 	}
 
@@ -57,6 +83,21 @@ func (j *DefaultJWTSigner) getKeys(ctx context.Context) (private *jose.JSONWebKe
 		WithHintf(`Could not ensure that signing keys for "%s" exists. If you are running against a persistent SQL database this is most likely because your "secrets.system" ("SECRETS_SYSTEM" environment variable) is not set or changed. When running with an SQL database backend you need to make sure that the secret is set and stays the same, unless when doing key rotation. This may also happen when you forget to run "hydra migrate sql up -e".`, j.setID))
 }
 
+// getKeyForAlgorithm returns the most recent private key of the key set which uses the algorithm.
+func (j *DefaultJWTSigner) getKeyForAlgorithm(ctx context.Context, alg string) (*jose.JSONWebKey, error) {
+	keys, err := j.r.KeyManager().GetKeySet(ctx, j.setID)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range ExcludePublicKeys(keys).Keys {
+		if k.Algorithm == alg {
+			return &k, nil
+		}
+	}
+	return nil, errors.WithStack(fosite.ErrServerError.
+		WithHintf(`JSON Web Key Set "%s" does not contain a key for algorithm "%s". Add one using "hydra create jwks %s --alg %s".`, j.setID, alg, j.setID, alg))
+}
+
 func (j *DefaultJWTSigner) GetPublicKeyID(ctx context.Context) (string, error) {
 	private, err := j.getKeys(ctx)
 	if err != nil {
@@ -76,3 +117,68 @@ func (j *DefaultJWTSigner) GetPublicKey(ctx context.Context) (jose.JSONWebKey, e
 func (j *DefaultJWTSigner) getPrivateKey(ctx context.Context) (any, error) {
 	return j.getKeys(ctx)
 }
+
+// GetSigningAlgorithms returns the algorithms of the keys in the key set, starting with the algorithm of the
+// default key.
+func (j *DefaultJWTSigner) GetSigningAlgorithms(ctx context.Context) ([]string, error) {
+	private, err := j.getKeys(WithSigningAlgorithm(ctx, ""))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	keys, err := j.r.KeyManager().GetKeySet(ctx, j.setID)
+	if err != nil {
+		return nil, err
+	}
+
+	algs := []string{private.Algorithm}
+	for _, k := range ExcludePublicKeys(keys).Keys {
+		if k.Algorithm != "" && !slices.Contains(algs, k.Algorithm) {
+			algs = append(algs, k.Algorithm)
+		}
+	}
+	return algs, nil
+}
+
+// Decode verifies the token with the key of the key set which signed it and decodes it.
+func (j *DefaultJWTSigner) Decode(ctx context.Context, token string) (*jwt.Token, error) {
+	key, err := j.getVerificationKey(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.ParseWithClaims(token, jwt.MapClaims{}, func(*jwt.Token) (any, error) { return key, nil })
+}
+
+// Validate verifies the token with the key of the key set which signed it and returns its signature.
+func (j *DefaultJWTSigner) Validate(ctx context.Context, token string) (string, error) {
+	if _, err := j.Decode(ctx, token); err != nil {
+		return "", err
+	}
+	return j.GetSignature(ctx, token)
+}
+
+// getVerificationKey returns the public key of the key set identified by the "kid" header of the token. Tokens
+// without a known key ID are verified with the default key.
+func (j *DefaultJWTSigner) getVerificationKey(ctx context.Context, token string) (*jose.JSONWebKey, error) {
+	private, err := j.getKeys(WithSigningAlgorithm(ctx, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed, err := jose.ParseSigned(token); err == nil && len(parsed.Signatures) == 1 {
+		if kid := parsed.Signatures[0].Header.KeyID; kid != "" && kid != private.KeyID {
+			keys, err := j.r.KeyManager().GetKeySet(ctx, j.setID)
+			if err != nil {
+				return nil, err
+			}
+			for _, k := range ExcludePublicKeys(keys).Keys {
+				if k.KeyID == kid {
+					public := josex.ToPublicKey(&k)
+					return &public, nil
+				}
+			}
+		}
+	}
+
+	public := josex.ToPublicKey(private)
+	return &public, nil
+}
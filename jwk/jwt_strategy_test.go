@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
+	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
 	"github.com/ory/hydra/v2/internal/testhelpers"
 	. "github.com/ory/hydra/v2/jwk"
@@ -66,3 +67,56 @@ func TestJWTStrategy(t *testing.T) {
 		})
 	}
 }
+
+func TestJWTStrategySigningAlgorithms(t *testing.T) {
+	reg := testhelpers.NewRegistryMemory(t)
+	m := reg.KeyManager()
+	ctx := context.Background()
+
+	_, err := m.GenerateAndPersistKeySet(ctx, "multi-set", "rsa", "RS256", "sig")
+	require.NoError(t, err)
+	_, err = m.GenerateAndPersistKeySet(ctx, "multi-set", "eddsa", "EdDSA", "sig")
+	require.NoError(t, err)
+	_, err = m.GenerateAndPersistKeySet(ctx, "multi-set", "ecdsa", "ES256", "sig")
+	require.NoError(t, err)
+
+	s := NewDefaultJWTSigner(reg, "multi-set")
+
+	algs, err := s.GetSigningAlgorithms(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ES256", "EdDSA", "RS256"}, algs, "the algorithm of the most recent key comes first")
+
+	for _, tc := range []struct{ alg, expectedAlg, expectedKID string }{
+		{alg: "", expectedAlg: "ES256", expectedKID: "ecdsa"},
+		{alg: "RS256", expectedAlg: "RS256", expectedKID: "rsa"},
+		{alg: "EdDSA", expectedAlg: "EdDSA", expectedKID: "eddsa"},
+	} {
+		t.Run("alg="+tc.alg, func(t *testing.T) {
+			ctx := WithSigningAlgorithm(ctx, tc.alg)
+
+			kid, err := s.GetPublicKeyID(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedKID, kid)
+
+			token, _, err := s.Generate(ctx, jwt.MapClaims{"foo": "bar"}, &jwt.Headers{Extra: map[string]any{"kid": kid}})
+			require.NoError(t, err)
+
+			header, err := base64.RawStdEncoding.DecodeString(strings.Split(token, ".")[0])
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAlg, gjson.GetBytes(header, "alg").String())
+
+			// Tokens are verified with the key they were signed with, regardless of the algorithm in the context.
+			decoded, err := s.Decode(context.Background(), token)
+			require.NoError(t, err)
+			assert.Equal(t, "bar", decoded.Claims["foo"])
+			_, err = s.Validate(context.Background(), token)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("case=fails if the key set has no key for the algorithm", func(t *testing.T) {
+		_, err := s.GetPublicKeyID(WithSigningAlgorithm(ctx, "PS256"))
+		require.Error(t, err)
+		assert.Contains(t, fosite.ErrorToRFC6749Error(err).HintField, `does not contain a key for algorithm "PS256"`)
+	})
+}
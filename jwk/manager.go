@@ -60,6 +60,12 @@ type (
 		KeyManager() Manager
 	}
 
+	// ReplicaReader is implemented by managers which can read key sets from a read replica. It must only be used
+	// by read-only endpoints which tolerate keys that have not been replicated yet.
+	ReplicaReader interface {
+		GetKeySetFromReplica(ctx context.Context, set string) (*jose.JSONWebKeySet, error)
+	}
+
 	SQLData struct {
 		ID        uuid.UUID `db:"pk"`
 		NID       uuid.UUID `json:"-" db:"nid"`
@@ -7,12 +7,14 @@ import (
 	"github.com/ory/x/httpx"
 	"github.com/ory/x/logrusx"
 
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/driver/config"
 )
 
 type InternalRegistry interface {
 	httpx.WriterProvider
 	logrusx.Provider
+	audit.RecorderProvider
 	Registry
 }
 
@@ -0,0 +1,39 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+)
+
+// Contextualizer scopes the network ID and configuration to the network the context was resolved to. Contexts
+// without a network are handled by the wrapped contextualizer.
+type Contextualizer struct {
+	contextx.Contextualizer
+}
+
+var _ contextx.Contextualizer = (*Contextualizer)(nil)
+
+func NewContextualizer(inner contextx.Contextualizer) *Contextualizer {
+	return &Contextualizer{Contextualizer: inner}
+}
+
+func (c *Contextualizer) Network(ctx context.Context, network uuid.UUID) uuid.UUID {
+	if r, ok := ctx.Value(resolvedContextKey).(*resolved); ok {
+		return r.network.ID
+	}
+	return c.Contextualizer.Network(ctx, network)
+}
+
+func (c *Contextualizer) Config(ctx context.Context, config *configx.Provider) *configx.Provider {
+	if r, ok := ctx.Value(resolvedContextKey).(*resolved); ok {
+		config = r.config
+	}
+	return c.Contextualizer.Config(ctx, config)
+}
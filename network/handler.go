@@ -0,0 +1,259 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/httprouterx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+	"github.com/ory/x/urlx"
+)
+
+const (
+	NetworksPath = "/networks"
+)
+
+type Handler struct {
+	r InternalRegistry
+}
+
+func NewHandler(r InternalRegistry) *Handler {
+	return &Handler{r: r}
+}
+
+func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
+	admin.GET(NetworksPath, h.listNetworks)
+	admin.POST(NetworksPath, h.createNetwork)
+	admin.GET(NetworksPath+"/{id}", h.getNetwork)
+	admin.DELETE(NetworksPath+"/{id}", h.deleteNetwork)
+}
+
+// Networks
+//
+// swagger:model networks
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type networks []Network
+
+// Create Network Request
+//
+// swagger:parameters createNetwork
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type createNetwork struct {
+	// in: body
+	// required: true
+	Body Network
+}
+
+// swagger:route POST /admin/networks network createNetwork
+//
+// # Create a Network
+//
+// Creates a new network (tenant). The network is resolved from the network header, the path prefix or the host
+// of a request, if multi-tenancy is enabled. The JSON Web Key Sets used to sign ID and access tokens are generated
+// when the network is created.
+//
+// The ID and timestamps are set by the server.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  201: network
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) createNetwork(w http.ResponseWriter, r *http.Request) {
+	var n Network
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	if err := validate(&n); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	n.ID = uuid.Must(uuid.NewV4())
+
+	ctx, err := h.r.NetworkResolver().Scope(r.Context(), &n)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field config is invalid: %s", err)))
+		return
+	}
+
+	if err := h.r.NetworkManager().CreateNetwork(r.Context(), &n); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	for _, set := range []string{x.OpenIDConnectKeyName, x.OAuth2JWTKeyName} {
+		if _, err := jwk.GetOrGenerateKeys(ctx, h.r, set, string(jose.RS256)); err != nil {
+			// The network is removed again, so that creating it can be retried.
+			if err := h.r.NetworkManager().DeleteNetwork(r.Context(), n.ID); err != nil {
+				h.r.Logger().WithError(err).WithField("network", n.ID).Error("Unable to delete the network after its keys could not be generated.")
+			}
+			h.r.NetworkResolver().Invalidate()
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+	}
+	h.r.NetworkResolver().Invalidate()
+
+	h.r.Writer().WriteCreated(w, r, urlx.MustJoin(NetworksPath, url.PathEscape(n.ID.String())), &n)
+}
+
+// Get Network Request
+//
+// swagger:parameters getNetwork
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type getNetwork struct {
+	// The ID of the network
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// swagger:route GET /admin/networks/{id} network getNetwork
+//
+// # Get a Network
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: network
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getNetwork(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	n, err := h.r.NetworkManager().GetNetwork(r.Context(), id)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, n)
+}
+
+// Delete Network Request
+//
+// swagger:parameters deleteNetwork
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type deleteNetwork struct {
+	// The ID of the network
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// swagger:route DELETE /admin/networks/{id} network deleteNetwork
+//
+// # Delete a Network
+//
+// Deletes the network and all of its data, including clients, keys, sessions and tokens. This can not be undone.
+// The default network can not be deleted.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  204: emptyResponse
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) deleteNetwork(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	if err := h.r.NetworkManager().DeleteNetwork(r.Context(), id); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	h.r.NetworkResolver().Invalidate()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List Networks Request
+//
+// swagger:parameters listNetworks
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type listNetworks struct {
+	keysetpagination.RequestParameters
+}
+
+// swagger:route GET /admin/networks network listNetworks
+//
+// # List Networks
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: networks
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) listNetworks(w http.ResponseWriter, r *http.Request) {
+	pageKeys := h.r.Config().GetPaginationEncryptionKeys(r.Context())
+	pageOpts, err := keysetpagination.ParseQueryParams(pageKeys, r.URL.Query())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse pagination parameters: %v", err)))
+		return
+	}
+
+	ns, nextPage, err := h.r.NetworkManager().GetNetworks(r.Context(), pageOpts...)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if ns == nil {
+		ns = []Network{}
+	}
+
+	keysetpagination.SetLinkHeader(w, pageKeys, r.URL, nextPage)
+	h.r.Writer().Write(w, r, ns)
+}
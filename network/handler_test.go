@@ -0,0 +1,257 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package network_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/network"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/httprouterx"
+	"github.com/ory/x/sqlcon"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyMultitenancyEnabled:    true,
+		config.KeyMultitenancyHeader:     "X-Hydra-Network",
+		config.KeyMultitenancyPathPrefix: "/tenants",
+		config.KeyAccessTokenLifespan:    "1h",
+	})))
+	ctx := t.Context()
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	network.NewHandler(reg).SetRoutes(router)
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	create := func(t *testing.T, body string) *http.Response {
+		res, err := ts.Client().Post(ts.URL+"/admin"+network.NetworksPath, "application/json", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+	do := func(t *testing.T, method, path string) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, method, ts.URL+"/admin"+path, nil)
+		require.NoError(t, err)
+		res, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+
+	t.Run("case=rejects invalid networks", func(t *testing.T) {
+		for _, body := range []string{
+			`{"name": "Acme", "issuer_url": "https://acme.example.com/"}`,
+			`{"name": "-acme", "issuer_url": "https://acme.example.com/"}`,
+			`{"name": "` + uuid.Must(uuid.NewV4()).String() + `", "issuer_url": "https://acme.example.com/"}`,
+			`{"name": "acme", "issuer_url": "/acme"}`,
+			`{"name": "acme", "host": "acme.example.com:443", "issuer_url": "https://acme.example.com/"}`,
+			`{"name": "acme", "issuer_url": "https://acme.example.com/", "config": []}`,
+			`{"name": "acme", "issuer_url": "https://acme.example.com/", "config": {"dsn": "memory"}}`,
+			`{"name": "acme", "issuer_url": "https://acme.example.com/", "config": {"ttl": {"access_token": "not-a-duration"}}}`,
+		} {
+			assert.Equal(t, http.StatusBadRequest, create(t, body).StatusCode, body)
+		}
+	})
+
+	res := create(t, `{"name": "acme", "host": "Acme.Example.com", "issuer_url": "https://acme.example.com/", "config": {"ttl": {"access_token": "5m"}}}`)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	var acme network.Network
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&acme))
+	assert.Equal(t, "acme.example.com", acme.Host.String())
+
+	t.Run("case=removes the network if its keys can not be generated", func(t *testing.T) {
+		router := httprouterx.NewRouterAdminWithPrefix()
+		network.NewHandler(&failingKeysRegistry{RegistrySQL: reg}).SetRoutes(router)
+		failing := httptest.NewServer(router)
+		t.Cleanup(failing.Close)
+
+		body := `{"name": "retry", "issuer_url": "https://retry.example.com/"}`
+		res, err := failing.Client().Post(failing.URL+"/admin"+network.NetworksPath, "application/json", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		_ = res.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+
+		_, err = reg.NetworkManager().FindNetworkByName(ctx, "retry")
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+
+		res = create(t, body)
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+		var retry network.Network
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&retry))
+		require.NoError(t, reg.NetworkManager().DeleteNetwork(ctx, retry.ID))
+		reg.NetworkResolver().Invalidate()
+	})
+
+	t.Run("case=rejects duplicate names", func(t *testing.T) {
+		assert.Equal(t, http.StatusConflict, create(t, `{"name": "acme", "issuer_url": "https://other.example.com/"}`).StatusCode)
+	})
+
+	t.Run("case=gets and lists networks", func(t *testing.T) {
+		res := do(t, http.MethodGet, network.NetworksPath+"/"+acme.ID.String())
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		var actual network.Network
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&actual))
+		assert.Equal(t, acme.Name, actual.Name)
+
+		res = do(t, http.MethodGet, network.NetworksPath)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		var all []network.Network
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&all))
+		require.Len(t, all, 1)
+		assert.Equal(t, acme.ID, all[0].ID)
+	})
+
+	t.Run("case=generates the signing keys of the network", func(t *testing.T) {
+		scoped, err := reg.NetworkResolver().Resolve(ctx, "acme")
+		require.NoError(t, err)
+		for _, set := range []string{x.OpenIDConnectKeyName, x.OAuth2JWTKeyName} {
+			keys, err := reg.KeyManager().GetKeySet(scoped, set)
+			require.NoError(t, err)
+			assert.NotEmpty(t, keys.Keys)
+		}
+	})
+
+	echo := func(t *testing.T, r *http.Request) (int, gjson.Result) {
+		rec := httptest.NewRecorder()
+		reg.NetworkResolver().Middleware(rec, r, func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"nid":    reg.Persister().NetworkID(r.Context()),
+				"issuer": reg.Config().IssuerURL(r.Context()).String(),
+				"ttl":    reg.Config().GetAccessTokenLifespan(r.Context()).String(),
+				"path":   r.URL.Path,
+			})
+		})
+		return rec.Code, gjson.Parse(rec.Body.String())
+	}
+	defaultNID := reg.Persister().NetworkID(ctx)
+
+	t.Run("case=resolves the network", func(t *testing.T) {
+		for name, r := range map[string]*http.Request{
+			"header name": func() *http.Request {
+				r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/foo", nil)
+				r.Header.Set("X-Hydra-Network", "acme")
+				return r
+			}(),
+			"header id": func() *http.Request {
+				r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/foo", nil)
+				r.Header.Set("X-Hydra-Network", acme.ID.String())
+				return r
+			}(),
+			"path prefix": httptest.NewRequestWithContext(ctx, http.MethodGet, "/tenants/acme/foo", nil),
+			"host":        httptest.NewRequestWithContext(ctx, http.MethodGet, "http://acme.example.com:4444/foo", nil),
+		} {
+			t.Run("by="+name, func(t *testing.T) {
+				code, body := echo(t, r)
+				require.Equal(t, http.StatusOK, code, body.Raw)
+				assert.Equal(t, acme.ID.String(), body.Get("nid").String())
+				assert.Equal(t, "https://acme.example.com/", body.Get("issuer").String())
+				assert.Equal(t, (5 * time.Minute).String(), body.Get("ttl").String())
+				assert.Equal(t, "/foo", body.Get("path").String())
+			})
+		}
+	})
+
+	t.Run("case=falls back to the default network", func(t *testing.T) {
+		code, body := echo(t, httptest.NewRequestWithContext(ctx, http.MethodGet, "http://other.example.com/foo", nil))
+		require.Equal(t, http.StatusOK, code, body.Raw)
+		assert.Equal(t, defaultNID.String(), body.Get("nid").String())
+		assert.Equal(t, time.Hour.String(), body.Get("ttl").String())
+	})
+
+	t.Run("case=rejects unknown networks", func(t *testing.T) {
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/foo", nil)
+		r.Header.Set("X-Hydra-Network", "unknown")
+		code, _ := echo(t, r)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, _ = echo(t, httptest.NewRequestWithContext(ctx, http.MethodGet, "/tenants/unknown/foo", nil))
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("case=caches unknown networks until a network is created", func(t *testing.T) {
+		_, err := reg.NetworkResolver().Resolve(ctx, "late")
+		require.ErrorIs(t, err, herodot.ErrNotFound())
+		_, ok, err := reg.NetworkResolver().ResolveHost(ctx, "late.example.com")
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		// The network is created without invalidating the cache.
+		require.NoError(t, reg.NetworkManager().CreateNetwork(ctx, &network.Network{Name: "late", IssuerURL: "https://late.example.com/"}))
+		_, err = reg.NetworkResolver().Resolve(ctx, "late")
+		require.ErrorIs(t, err, herodot.ErrNotFound())
+
+		// Creating a network through the API invalidates the cache.
+		res := create(t, `{"name": "late-host", "host": "late.example.com", "issuer_url": "https://late.example.com/"}`)
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+		scoped, err := reg.NetworkResolver().Resolve(ctx, "late")
+		require.NoError(t, err)
+		assert.Equal(t, "https://late.example.com/", reg.Config().IssuerURL(scoped).String())
+		_, ok, err = reg.NetworkResolver().ResolveHost(ctx, "late.example.com")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("case=ignores networks if multi-tenancy is disabled", func(t *testing.T) {
+		r := httptest.NewRequestWithContext(contextx.WithConfigValue(ctx, config.KeyMultitenancyEnabled, false), http.MethodGet, "/foo", nil)
+		r.Header.Set("X-Hydra-Network", "acme")
+		code, body := echo(t, r)
+		require.Equal(t, http.StatusOK, code, body.Raw)
+		assert.Equal(t, defaultNID.String(), body.Get("nid").String())
+	})
+
+	t.Run("case=deletes networks", func(t *testing.T) {
+		assert.Equal(t, http.StatusConflict, do(t, http.MethodDelete, network.NetworksPath+"/"+defaultNID.String()).StatusCode)
+		assert.Equal(t, http.StatusNoContent, do(t, http.MethodDelete, network.NetworksPath+"/"+acme.ID.String()).StatusCode)
+		assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, network.NetworksPath+"/"+acme.ID.String()).StatusCode)
+
+		r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/foo", nil)
+		r.Header.Set("X-Hydra-Network", "acme")
+		code, _ := echo(t, r)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		_, err := reg.KeyManager().GetKeySet(network.WithNetwork(ctx, &acme, reg.Config().Source(ctx)), x.OpenIDConnectKeyName)
+		assert.ErrorIs(t, err, x.ErrNotFound)
+	})
+}
+
+// failingKeysRegistry is a registry whose key manager fails to generate keys.
+type failingKeysRegistry struct {
+	*driver.RegistrySQL
+}
+
+func (r *failingKeysRegistry) KeyManager() jwk.Manager {
+	return &failingKeyManager{Manager: r.RegistrySQL.KeyManager()}
+}
+
+type failingKeyManager struct {
+	jwk.Manager
+}
+
+func (*failingKeyManager) GenerateAndPersistKeySet(context.Context, string, string, string, string) (*jose.JSONWebKeySet, error) {
+	return nil, errors.New("unable to generate keys")
+}
@@ -0,0 +1,30 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+)
+
+type (
+	// Manager manages networks. Contrary to other managers, it is not scoped to the network of the context.
+	Manager interface {
+		CreateNetwork(ctx context.Context, n *Network) error
+		GetNetwork(ctx context.Context, id uuid.UUID) (*Network, error)
+		FindNetworkByName(ctx context.Context, name string) (*Network, error)
+		FindNetworkByHost(ctx context.Context, host string) (*Network, error)
+		GetNetworks(ctx context.Context, pageOpts ...keysetpagination.Option) ([]Network, *keysetpagination.Paginator, error)
+
+		// DeleteNetwork deletes the network and all of its data. The default network can not be deleted.
+		DeleteNetwork(ctx context.Context, id uuid.UUID) error
+	}
+
+	ManagerProvider interface {
+		NetworkManager() Manager
+	}
+)
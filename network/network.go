@@ -0,0 +1,82 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+// Package network implements the management of networks (tenants) and the resolution of the network a request
+// belongs to.
+//
+// Every network has its own issuer URL, JSON Web Key Sets and configuration overrides which are layered on top
+// of the configuration of the process. All data is partitioned by the network ID (NID).
+package network
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/x/configx"
+	"github.com/ory/x/sqlxx"
+)
+
+// Network
+//
+// swagger:model network
+type Network struct {
+	// The ID of the network. It is the NID all data of the network is stored with.
+	//
+	// example: 9edc811f-4e28-453c-9b46-4de65f00217f
+	ID uuid.UUID `json:"id" db:"id"`
+
+	// The unique name of the network. It is used to resolve the network from the path prefix or the network
+	// header.
+	//
+	// example: acme
+	Name string `json:"name" db:"name"`
+
+	// The unique host name requests for this network are sent to.
+	//
+	// example: acme.auth.example.com
+	Host sqlxx.NullString `json:"host,omitempty" db:"host"`
+
+	// The issuer URL of the network.
+	//
+	// example: https://acme.auth.example.com/
+	IssuerURL string `json:"issuer_url" db:"issuer_url"`
+
+	// Configuration values which override the configuration of the process for this network.
+	//
+	// example: {"ttl": {"access_token": "5m"}}
+	Config sqlxx.JSONRawMessage `json:"config" db:"config"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (Network) TableName() string {
+	return "hydra_network"
+}
+
+type (
+	contextKey int
+
+	resolved struct {
+		network *Network
+		config  *configx.Provider
+	}
+)
+
+const resolvedContextKey contextKey = 1
+
+// WithNetwork returns a context in which all data and configuration is scoped to the given network.
+func WithNetwork(ctx context.Context, n *Network, config *configx.Provider) context.Context {
+	return context.WithValue(ctx, resolvedContextKey, &resolved{network: n, config: config})
+}
+
+// FromContext returns the network the context is scoped to, if any.
+func FromContext(ctx context.Context) (*Network, bool) {
+	r, ok := ctx.Value(resolvedContextKey).(*resolved)
+	if !ok {
+		return nil, false
+	}
+	return r.network, true
+}
@@ -0,0 +1,218 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/spec"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/sqlcon"
+)
+
+// notFoundCacheTTL is the maximum time names, IDs and hosts which belong to no network are cached for. It is short,
+// because networks created by other instances are only found once it passed.
+const notFoundCacheTTL = 5 * time.Second
+
+// immutableKeys are the top-level configuration keys which can not be overridden per network, because they
+// configure the process rather than the authorization server.
+var immutableKeys = []string{"dsn", "serve", "log", "profiling", "tracing", "sqa", "cgroups", "multitenancy", "db", "hsm", "janitor"}
+
+type (
+	// Resolver resolves the network of a request and caches the networks it resolved. Networks which do not
+	// exist are cached for at most notFoundCacheTTL, which bounds the database queries of requests for unknown
+	// networks.
+	Resolver struct {
+		r     InternalRegistry
+		cache *ristretto.Cache[string, *resolved]
+	}
+)
+
+func NewResolver(r InternalRegistry) *Resolver {
+	cache, err := ristretto.NewCache(&ristretto.Config[string, *resolved]{
+		NumCounters: 10000 * 10,
+		MaxCost:     10000,
+		BufferItems: 64,
+		Cost: func(*resolved) int64 {
+			return 1
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &Resolver{r: r, cache: cache}
+}
+
+// Scope returns a context which is scoped to the given network.
+func (r *Resolver) Scope(ctx context.Context, n *Network) (context.Context, error) {
+	c, err := r.newConfig(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	return WithNetwork(ctx, n, c), nil
+}
+
+// Resolve returns a context which is scoped to the network with the given name or ID.
+func (r *Resolver) Resolve(ctx context.Context, nameOrID string) (context.Context, error) {
+	var (
+		res *resolved
+		err error
+	)
+	if id, parseErr := uuid.FromString(nameOrID); parseErr == nil {
+		res, err = r.lookup(ctx, "id:"+id.String(), func() (*Network, error) {
+			return r.r.NetworkManager().GetNetwork(ctx, id)
+		})
+	} else {
+		res, err = r.lookup(ctx, "name:"+nameOrID, func() (*Network, error) {
+			return r.r.NetworkManager().FindNetworkByName(ctx, nameOrID)
+		})
+	}
+	if err != nil {
+		return nil, err
+	} else if res == nil {
+		return nil, errors.WithStack(herodot.ErrNotFound().WithReasonf("Network %q does not exist.", nameOrID))
+	}
+	return context.WithValue(ctx, resolvedContextKey, res), nil
+}
+
+// ResolveHost returns a context which is scoped to the network with the given host. The second return value is
+// false if no network uses the host.
+func (r *Resolver) ResolveHost(ctx context.Context, host string) (context.Context, bool, error) {
+	host = strings.ToLower(host)
+	res, err := r.lookup(ctx, "host:"+host, func() (*Network, error) {
+		return r.r.NetworkManager().FindNetworkByHost(ctx, host)
+	})
+	if err != nil {
+		return nil, false, err
+	} else if res == nil {
+		return ctx, false, nil
+	}
+	return context.WithValue(ctx, resolvedContextKey, res), true, nil
+}
+
+// Invalidate clears the cache. It is called whenever a network is created or deleted, which also forgets the
+// networks which did not exist.
+func (r *Resolver) Invalidate() {
+	r.cache.Clear()
+}
+
+// lookup returns the cached network for the key or finds and caches it. It returns nil if the network does not
+// exist.
+func (r *Resolver) lookup(ctx context.Context, key string, find func() (*Network, error)) (*resolved, error) {
+	if res, ok := r.cache.Get(key); ok {
+		return res, nil
+	}
+
+	n, err := find()
+	if errors.Is(err, sqlcon.ErrNoRows()) {
+		if ttl := min(notFoundCacheTTL, r.r.Config().MultitenancyCacheTTL(ctx)); ttl > 0 {
+			r.cache.SetWithTTL(key, nil, 1, ttl)
+			r.cache.Wait()
+		}
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	c, err := r.newConfig(ctx, n)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load the configuration of network %s", n.ID)
+	}
+	res := &resolved{network: n, config: c}
+	r.cache.SetWithTTL(key, res, 1, r.r.Config().MultitenancyCacheTTL(ctx))
+	return res, nil
+}
+
+// newConfig layers the configuration overrides of the network on top of the configuration of the process.
+func (r *Resolver) newConfig(ctx context.Context, n *Network) (*configx.Provider, error) {
+	overrides, err := decodeOverrides(n.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return configx.New(ctx, spec.ConfigValidationSchema,
+		configx.WithValues(r.r.Config().Source(contextx.RootContext).All()),
+		configx.WithValue(config.KeyIssuerURL, n.IssuerURL),
+		configx.WithValues(overrides),
+		configx.DisableEnvLoading(),
+	)
+}
+
+func decodeOverrides(raw []byte) (map[string]any, error) {
+	overrides := map[string]any{}
+	if len(raw) == 0 || string(raw) == "null" {
+		return overrides, nil
+	}
+	if err := json.Unmarshal(raw, &overrides); err != nil {
+		return nil, errors.New("the configuration overrides must be a JSON object")
+	}
+	for _, key := range immutableKeys {
+		if _, ok := overrides[key]; ok {
+			return nil, errors.Errorf("the configuration key %q can not be overridden per network", key)
+		}
+	}
+	return overrides, nil
+}
+
+// Middleware scopes the request to its network. The network is resolved from, in this order, the network
+// header, the path prefix and the host. Requests which match none of them are served by the default network.
+func (r *Resolver) Middleware(w http.ResponseWriter, req *http.Request, next http.HandlerFunc) {
+	ctx := req.Context()
+	conf := r.r.Config()
+	if !conf.MultitenancyEnabled(ctx) {
+		next(w, req)
+		return
+	}
+
+	if header := conf.MultitenancyHeader(ctx); header != "" {
+		if name := req.Header.Get(header); name != "" {
+			ctx, err := r.Resolve(ctx, name)
+			if err != nil {
+				r.r.Writer().WriteError(w, req, err)
+				return
+			}
+			next(w, req.WithContext(ctx))
+			return
+		}
+	}
+
+	if prefix := conf.MultitenancyPathPrefix(ctx); prefix != "" {
+		if rest, ok := strings.CutPrefix(req.URL.Path, prefix+"/"); ok {
+			name, path, _ := strings.Cut(rest, "/")
+			ctx, err := r.Resolve(ctx, name)
+			if err != nil {
+				r.r.Writer().WriteError(w, req, err)
+				return
+			}
+			req = req.Clone(ctx)
+			req.URL.Path = "/" + path
+			req.URL.RawPath = ""
+			next(w, req)
+			return
+		}
+	}
+
+	host := req.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	ctx, _, err := r.ResolveHost(ctx, host)
+	if err != nil {
+		r.r.Writer().WriteError(w, req, err)
+		return
+	}
+	next(w, req.WithContext(ctx))
+}
@@ -0,0 +1,45 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/sqlxx"
+)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+func validate(n *Network) error {
+	if !namePattern.MatchString(n.Name) {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field name must consist of 1 to 63 lowercase letters, digits and dashes and must not start with a dash but got %q.", n.Name))
+	}
+	if _, err := uuid.FromString(n.Name); err == nil {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field name must not be a UUID but got %q.", n.Name))
+	}
+
+	n.Host = sqlxx.NullString(strings.ToLower(string(n.Host)))
+	if n.Host != "" && strings.ContainsAny(string(n.Host), "/:?#@ ") {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field host must be a host name without scheme, port or path but got %q.", n.Host))
+	}
+
+	if u, err := url.Parse(n.IssuerURL); err != nil || !u.IsAbs() || u.Host == "" {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field issuer_url must be an absolute URL but got %q.", n.IssuerURL))
+	}
+
+	if len(n.Config) == 0 || string(n.Config) == "null" {
+		n.Config = sqlxx.JSONRawMessage("{}")
+	}
+	if _, err := decodeOverrides(n.Config); err != nil {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field config is invalid: %s", err))
+	}
+
+	return nil
+}
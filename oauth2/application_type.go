@@ -0,0 +1,25 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+)
+
+// validateAuthorizeApplicationType validates that native applications use PKCE in the authorization code flow, see
+// https://www.rfc-editor.org/rfc/rfc8252#section-8.1.
+func validateAuthorizeApplicationType(ar fosite.AuthorizeRequester) error {
+	c, ok := ar.GetClient().(*client.Client)
+	if !ok || c.ApplicationType != client.ApplicationTypeNative || !ar.GetResponseTypes().Has("code") {
+		return nil
+	}
+
+	if ar.GetRequestForm().Get("code_challenge") == "" {
+		return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Native applications must use PKCE and include a code_challenge in the authorization request."))
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+)
+
+// dpopProofLifespan is how far the issuance time of a DPoP proof may be in the past or in the future.
+const dpopProofLifespan = 5 * time.Minute
+
+// dpopSigningAlgorithms are the algorithms supported for DPoP proofs.
+var dpopSigningAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// ErrInvalidDPoPProof is returned if a DPoP proof is missing or invalid, see https://www.rfc-editor.org/rfc/rfc9449.
+var ErrInvalidDPoPProof = &fosite.RFC6749Error{
+	ErrorField:       "invalid_dpop_proof",
+	DescriptionField: "The DPoP proof is missing or invalid.",
+	CodeField:        http.StatusBadRequest,
+}
+
+type dpopProofClaims struct {
+	JTI string `json:"jti"`
+	HTM string `json:"htm"`
+	HTU string `json:"htu"`
+	IAT int64  `json:"iat"`
+	ATH string `json:"ath"`
+}
+
+// validateDPoPProof validates the DPoP proof of the request for the given endpoint and returns the base64url-encoded
+// SHA-256 thumbprint of its key. If an access token is given, the proof must contain its hash.
+func (h *Handler) validateDPoPProof(ctx context.Context, r *http.Request, endpoint *url.URL, accessToken string) (string, error) {
+	proofs := r.Header.Values("DPoP")
+	if len(proofs) != 1 {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("Exactly one DPoP header must be sent."))
+	}
+
+	proof := proofs[0]
+	if strings.Count(proof, ".") != 2 {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The DPoP proof must be a JWT in compact serialization."))
+	}
+	jws, err := jose.ParseSigned(proof)
+	if err != nil {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("Unable to parse the DPoP proof.").WithWrap(err).WithDebug(err.Error()))
+	}
+	if len(jws.Signatures) != 1 {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The DPoP proof must have exactly one signature."))
+	}
+
+	header := jws.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != "dpop+jwt" {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The DPoP proof must have the type 'dpop+jwt'."))
+	}
+	if !slices.Contains(dpopSigningAlgorithms, header.Algorithm) {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHintf("The DPoP proof uses unsupported signing algorithm '%s'.", header.Algorithm))
+	}
+	if header.JSONWebKey == nil || !header.JSONWebKey.IsPublic() || !header.JSONWebKey.Valid() {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The DPoP proof must contain a public JSON Web Key in its 'jwk' header."))
+	}
+
+	payload, err := jws.Verify(header.JSONWebKey)
+	if err != nil {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("Unable to verify the signature of the DPoP proof.").WithWrap(err).WithDebug(err.Error()))
+	}
+
+	var claims dpopProofClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("Unable to decode the claims of the DPoP proof.").WithWrap(err).WithDebug(err.Error()))
+	}
+
+	if claims.HTM != r.Method {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHintf("The DPoP proof must have the 'htm' claim '%s'.", r.Method))
+	}
+	htu, err := url.Parse(claims.HTU)
+	if err != nil {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("Unable to parse the 'htu' claim of the DPoP proof.").WithWrap(err).WithDebug(err.Error()))
+	}
+	htu.RawQuery, htu.Fragment = "", ""
+	if htu.String() != endpoint.String() {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHintf("The DPoP proof must have the 'htu' claim '%s'.", endpoint))
+	}
+
+	issuedAt := time.Unix(claims.IAT, 0)
+	if claims.IAT == 0 || time.Since(issuedAt) > dpopProofLifespan || time.Until(issuedAt) > dpopProofLifespan {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The 'iat' claim of the DPoP proof is missing or too far from the current time."))
+	}
+
+	if accessToken != "" {
+		hash := sha256.Sum256([]byte(accessToken))
+		if claims.ATH != base64.RawURLEncoding.EncodeToString(hash[:]) {
+			return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The 'ath' claim of the DPoP proof does not match the access token."))
+		}
+	}
+
+	if claims.JTI == "" {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The DPoP proof must have a 'jti' claim."))
+	}
+	jti := "dpop:" + claims.JTI
+	if err := h.r.OAuth2Storage().ClientAssertionJWTValid(ctx, jti); err != nil {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The DPoP proof was already used.").WithWrap(err).WithDebug(err.Error()))
+	}
+	if err := h.r.OAuth2Storage().SetClientAssertionJWT(ctx, jti, issuedAt.Add(dpopProofLifespan)); err != nil {
+		return "", err
+	}
+
+	thumbprint, err := header.JSONWebKey.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("Unable to compute the thumbprint of the DPoP proof key.").WithWrap(err).WithDebug(err.Error()))
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// bindDPoPProof binds the tokens issued for the access request to the key of its DPoP proof and returns the key's
+// thumbprint, or an empty string if the request has no DPoP proof. Clients with a security profile must send a DPoP
+// proof, and refresh tokens of public clients stay bound to the key they were issued for.
+func (h *Handler) bindDPoPProof(ctx context.Context, r *http.Request, ar fosite.AccessRequester) (string, error) {
+	session, ok := ar.GetSession().(*Session)
+	if !ok {
+		return "", errors.WithStack(fosite.ErrServerError.WithHint("Unable to type assert to *Session."))
+	}
+	bound := ar.GetGrantTypes().ExactOne("refresh_token") && ar.GetClient().IsPublic() && session.DPoPJKT != ""
+
+	if len(r.Header.Values("DPoP")) == 0 {
+		if c, ok := ar.GetClient().(*client.Client); ok && c.SecurityProfile != "" {
+			return "", errors.WithStack(ErrInvalidDPoPProof.WithHintf("Security profile %q requires a DPoP proof at the token endpoint.", c.SecurityProfile))
+		} else if bound {
+			return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The refresh token is bound to a DPoP key, but no DPoP proof was sent."))
+		}
+		session.DPoPJKT = ""
+		return "", nil
+	}
+
+	jkt, err := h.validateDPoPProof(ctx, r, h.c.OAuth2TokenURL(ctx), "")
+	if err != nil {
+		return "", err
+	} else if bound && jkt != session.DPoPJKT {
+		return "", errors.WithStack(ErrInvalidDPoPProof.WithHint("The refresh token is bound to a different DPoP key."))
+	}
+
+	session.DPoPJKT = jkt
+	return jkt, nil
+}
+
+// accessTokenFromDPoPRequest returns the access token of the request and whether it was sent with the DPoP
+// authorization scheme.
+func accessTokenFromDPoPRequest(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if found && strings.EqualFold(scheme, "DPoP") {
+		return token, true
+	}
+	return fosite.AccessTokenFromRequest(r), false
+}
+
+// verifyDPoPBinding verifies that an access token bound to a DPoP key is presented with the DPoP authorization scheme
+// and a DPoP proof of that key.
+func (h *Handler) verifyDPoPBinding(ctx context.Context, r *http.Request, endpoint *url.URL, accessToken string, isDPoP bool, ar fosite.Requester) error {
+	session, ok := ar.GetSession().(*Session)
+	if !ok {
+		return errors.WithStack(fosite.ErrServerError.WithHint("Unable to type assert to *Session."))
+	}
+
+	switch {
+	case session.DPoPJKT == "" && !isDPoP:
+		return nil
+	case session.DPoPJKT == "":
+		return errors.WithStack(ErrInvalidDPoPProof.WithHint("The access token is not bound to a DPoP key, but was sent with the DPoP authorization scheme."))
+	case !isDPoP:
+		return errors.WithStack(fosite.ErrRequestUnauthorized.WithHint("The access token is bound to a DPoP key and must be sent with the DPoP authorization scheme."))
+	}
+
+	jkt, err := h.validateDPoPProof(ctx, r, endpoint, accessToken)
+	if err != nil {
+		return err
+	} else if jkt != session.DPoPJKT {
+		return errors.WithStack(ErrInvalidDPoPProof.WithHint("The DPoP proof was not signed with the key the access token is bound to."))
+	}
+	return nil
+}
+
+// dpopAuthenticateHeader returns the WWW-Authenticate header for an error at a protected resource accepting DPoP.
+func dpopAuthenticateHeader(err *fosite.RFC6749Error) string {
+	return fmt.Sprintf(`DPoP algs="%s",error="%s",error_description="%s"`, strings.Join(dpopSigningAlgorithms, " "), err.ErrorField, err.GetDescription())
+}
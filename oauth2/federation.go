@@ -0,0 +1,130 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/federation"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/urlx"
+)
+
+// OpenID Federation Entity Statement
+//
+// swagger:response oidcFederationEntityStatement
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type oidcFederationEntityStatementResponse struct {
+	// The entity statement, signed with the federation keys of Ory Hydra.
+	//
+	// in:body
+	Body string
+}
+
+// swagger:route GET /.well-known/openid-federation oidc discoverOidcFederationEntity
+//
+// # OpenID Federation Entity Configuration
+//
+// Returns the entity configuration of Ory Hydra as OpenID Provider of an OpenID Federation, signed with its
+// federation keys. It contains the OpenID Connect Discovery metadata and the endpoints for automatic and explicit
+// registration of relying parties. This feature needs to be enabled in the configuration.
+//
+//	Produces:
+//	- application/entity-statement+jwt
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: oidcFederationEntityStatement
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-public-high
+func (h *Handler) discoverOidcFederationEntity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if !h.c.FederationEnabled(ctx) {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrNotFound().WithReason("OpenID Federation is disabled.")))
+		return
+	}
+
+	token, err := h.entityConfiguration(ctx)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", federation.ContentTypeEntityStatement)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(token))
+}
+
+func (h *Handler) entityConfiguration(ctx context.Context) (string, error) {
+	conf, err := h.oidcConfiguration(ctx)
+	if err != nil {
+		return "", err
+	}
+	raw, err := json.Marshal(conf)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	var provider map[string]any
+	if err := json.Unmarshal(raw, &provider); err != nil {
+		return "", errors.WithStack(err)
+	}
+	provider["client_registration_types_supported"] = []string{federation.RegistrationTypeAutomatic, federation.RegistrationTypeExplicit}
+	provider["federation_registration_endpoint"] = urlx.AppendPaths(h.c.IssuerURL(ctx), client.FederationRegistrationPath).String()
+
+	signer := h.r.FederationJWTSigner()
+	keyID, err := signer.GetPublicKeyID(ctx)
+	if err != nil {
+		return "", err
+	}
+	keys, err := h.r.KeyManager().GetKeySet(ctx, x.FederationKeyName)
+	if err != nil {
+		return "", err
+	}
+
+	issuer := h.c.IssuerURL(ctx).String()
+	now := time.Now()
+	statement := federation.EntityStatement{
+		Issuer:         issuer,
+		Subject:        issuer,
+		IssuedAt:       now.Unix(),
+		ExpiresAt:      now.Add(h.c.FederationEntityConfigurationLifespan(ctx)).Unix(),
+		JWKS:           jwk.ExcludePrivateKeys(keys),
+		AuthorityHints: h.c.FederationAuthorityHints(ctx),
+		Metadata:       map[string]map[string]any{federation.EntityTypeOpenIDProvider: provider},
+	}
+	claims, err := statement.Claims()
+	if err != nil {
+		return "", err
+	}
+
+	token, _, err := signer.Generate(ctx, claims, &jwt.Headers{
+		Extra: map[string]any{"kid": keyID, "typ": federation.TypeEntityStatement},
+	})
+	return token, err
+}
+
+// registerFederationClient registers the client of the authorization request using OpenID Federation automatic
+// registration, if the client ID is the entity identifier of a relying party.
+func (h *Handler) registerFederationClient(r *http.Request) error {
+	registrar := h.r.ClientFederationRegistrar()
+	clientID := r.FormValue("client_id")
+	if !registrar.IsEntityIdentifier(r.Context(), clientID) {
+		return nil
+	}
+	_, err := registrar.RegisterAutomatically(r.Context(), clientID, r.FormValue("request"))
+	return err
+}
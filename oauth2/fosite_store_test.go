@@ -69,3 +69,33 @@ func TestManagers(t *testing.T) {
 		})
 	}
 }
+
+func TestManagersShortLivedStorage(t *testing.T) {
+	t.Parallel()
+
+	storages := map[string]func(t *testing.T) string{
+		"memory": func(*testing.T) string { return "memory" },
+	}
+	if !testing.Short() {
+		storages["redis"] = func(t *testing.T) string { return testhelpers.ConnectToRedis(t) }
+	}
+
+	for name, dsn := range storages {
+		t.Run("storage="+name, func(t *testing.T) {
+			t.Parallel()
+
+			store := testhelpers.NewRegistryShortLived(t, dsn(t))
+
+			t.Run("testHelperCreateGetDeleteAuthorizeCodes", testHelperCreateGetDeleteAuthorizeCodes(store))
+			t.Run("testHelperCreateGetDeleteAccessTokenSession", testHelperCreateGetDeleteAccessTokenSession(store))
+			t.Run("testHelperCreateGetDeleteOpenIDConnectSession", testHelperCreateGetDeleteOpenIDConnectSession(store))
+			t.Run("testHelperCreateGetDeleteRefreshTokenSession", testHelperCreateGetDeleteRefreshTokenSession(store))
+			t.Run("testHelperRevokeRefreshToken", testHelperRevokeRefreshToken(store))
+			t.Run("testHelperCreateGetDeletePKCERequestSession", testHelperCreateGetDeletePKCERequestSession(store))
+			t.Run("testFositeStoreSetClientAssertionJWT", testFositeStoreSetClientAssertionJWT(store))
+			t.Run("testFositeStoreClientAssertionJWTValid", testFositeStoreClientAssertionJWTValid(store))
+			t.Run("testHelperRevokeAccessToken", testHelperRevokeAccessToken(store))
+			t.Run("testHelperRotateRefreshToken", testHelperRotateRefreshToken(store))
+		})
+	}
+}
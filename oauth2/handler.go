@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,13 +22,17 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 
+	"github.com/ory/herodot"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/federation"
 	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/hydra/v2/x/events"
 	"github.com/ory/x/httprouterx"
@@ -46,6 +51,7 @@ const (
 	DefaultErrorPath              = "/oauth2/fallbacks/error"
 	TokenPath                     = "/oauth2/token" // #nosec G101
 	AuthPath                      = "/oauth2/auth"
+	PushedAuthorizationPath       = "/oauth2/par"
 	LogoutPath                    = "/oauth2/sessions/logout"
 
 	VerifiableCredentialsPath    = "/credentials"
@@ -61,6 +67,9 @@ const (
 
 	DeviceAuthPath         = "/oauth2/device/auth"
 	DeviceVerificationPath = "/oauth2/device/verify"
+
+	// IntrospectScope must be allowed for OAuth 2.0 Clients to use the public introspection endpoint.
+	IntrospectScope = "introspect"
 )
 
 // Taken from https://github.com/ory/hydra/v2/fosite/blob/049ed1924cd0b41f12357b0fe617530c264421ac/handler/openid/flow_explicit_auth.go#L29
@@ -89,6 +98,7 @@ func (h *Handler) SetPublicRoutes(public *httprouterx.RouterPublic, corsMiddlewa
 	public.OPTIONS(TokenPath, corsMiddleware(http.HandlerFunc(h.handleOptions)).ServeHTTP)
 	public.POST(TokenPath, corsMiddleware(http.HandlerFunc(h.oauth2TokenExchange)).ServeHTTP)
 
+	public.POST(PushedAuthorizationPath, corsMiddleware(http.HandlerFunc(h.oAuth2PushedAuthorize)).ServeHTTP)
 	public.GET(AuthPath, h.oAuth2Authorize)
 	public.POST(AuthPath, h.oAuth2Authorize)
 	public.GET(LogoutPath, h.performOidcFrontOrBackChannelLogout)
@@ -117,7 +127,10 @@ func (h *Handler) SetPublicRoutes(public *httprouterx.RouterPublic, corsMiddlewa
 	public.OPTIONS(WellKnownPath, corsMiddleware(http.HandlerFunc(h.handleOptions)).ServeHTTP)
 	public.GET(WellKnownPath, corsMiddleware(http.HandlerFunc(h.discoverOidcConfiguration)).ServeHTTP)
 	public.OPTIONS(OauthAuthorizationServerPath, corsMiddleware(http.HandlerFunc(h.handleOptions)).ServeHTTP)
-	public.GET(OauthAuthorizationServerPath, corsMiddleware(http.HandlerFunc(h.discoverOidcConfiguration)).ServeHTTP)
+	public.GET(OauthAuthorizationServerPath, corsMiddleware(http.HandlerFunc(h.discoverOAuth2AuthorizationServer)).ServeHTTP)
+	public.OPTIONS(OauthAuthorizationServerPath+"/{path...}", corsMiddleware(http.HandlerFunc(h.handleOptions)).ServeHTTP)
+	public.GET(OauthAuthorizationServerPath+"/{path...}", corsMiddleware(http.HandlerFunc(h.discoverOAuth2AuthorizationServer)).ServeHTTP)
+	public.GET(federation.EntityConfigurationPath, h.discoverOidcFederationEntity)
 	public.OPTIONS(UserinfoPath, corsMiddleware(http.HandlerFunc(h.handleOptions)).ServeHTTP)
 	public.GET(UserinfoPath, corsMiddleware(http.HandlerFunc(h.getOidcUserInfo)).ServeHTTP)
 	public.POST(UserinfoPath, corsMiddleware(http.HandlerFunc(h.getOidcUserInfo)).ServeHTTP)
@@ -127,6 +140,7 @@ func (h *Handler) SetPublicRoutes(public *httprouterx.RouterPublic, corsMiddlewa
 
 	public.POST(DeviceAuthPath, h.oAuth2DeviceFlow)
 	public.GET(DeviceVerificationPath, h.performOAuth2DeviceVerificationFlow)
+	public.POST(IntrospectPath, h.introspectOAuth2TokenPublic)
 }
 
 func (h *Handler) SetAdminRoutes(admin *httprouterx.RouterAdmin) {
@@ -451,6 +465,79 @@ type oidcConfiguration struct {
 	// by this authorization server.
 	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported"`
 
+	// OAuth 2.0 Pushed Authorization Request Endpoint
+	//
+	// URL of the authorization server's pushed authorization request endpoint [RFC9126].
+	PushedAuthorizationRequestEndpoint string `json:"pushed_authorization_request_endpoint"`
+
+	// OAuth 2.0 Pushed Authorization Requests Required
+	//
+	// Boolean value indicating whether the authorization server accepts authorization request data only via
+	// the pushed authorization request endpoint.
+	RequirePushedAuthorizationRequests bool `json:"require_pushed_authorization_requests"`
+
+	// OAuth 2.0 DPoP Signing Algorithms Supported
+	//
+	// JSON array containing a list of the JWS alg values supported by the authorization server for DPoP proof
+	// JWTs [RFC9449].
+	DPoPSigningAlgValuesSupported []string `json:"dpop_signing_alg_values_supported"`
+
+	// OAuth 2.0 Authorization Response Issuer Parameter Supported
+	//
+	// Boolean value indicating whether the authorization server provides the iss parameter in the authorization
+	// response [RFC9207].
+	AuthorizationResponseIssParameterSupported bool `json:"authorization_response_iss_parameter_supported"`
+
+	// OAuth 2.0 Introspection Signing Algorithms Supported
+	//
+	// JSON array containing a list of the JWS alg values supported by the introspection endpoint to sign JWT
+	// introspection responses [RFC9701]. Only set if JWT introspection responses are enabled.
+	IntrospectionSigningAlgValuesSupported []string `json:"introspection_signing_alg_values_supported,omitempty"`
+
+	// OAuth 2.0 Introspection Encryption Algorithms Supported
+	//
+	// JSON array containing a list of the JWE alg values supported by the introspection endpoint to encrypt the
+	// content encryption key of JWT introspection responses [RFC9701]. Only set if JWT introspection responses are
+	// enabled.
+	IntrospectionEncryptionAlgValuesSupported []string `json:"introspection_encryption_alg_values_supported,omitempty"`
+
+	// OAuth 2.0 Introspection Encryption Encodings Supported
+	//
+	// JSON array containing a list of the JWE enc values supported by the introspection endpoint to encrypt JWT
+	// introspection responses [RFC9701]. Only set if JWT introspection responses are enabled.
+	IntrospectionEncryptionEncValuesSupported []string `json:"introspection_encryption_enc_values_supported,omitempty"`
+
+	// OAuth 2.0 Mutual TLS Endpoint Aliases
+	//
+	// JSON object containing the URLs of the endpoints which OAuth 2.0 Clients use for mutual TLS client
+	// authentication [RFC8705], keyed by the metadata name of the endpoint. Only set if mutual TLS client
+	// authentication is enabled.
+	MTLSEndpointAliases map[string]string `json:"mtls_endpoint_aliases,omitempty"`
+
+	// OAuth 2.0 Introspection Endpoint
+	//
+	// URL of the authorization server's OAuth 2.0 introspection endpoint [RFC8414]. Only set in the OAuth 2.0
+	// authorization server metadata if public introspection is enabled.
+	IntrospectionEndpoint string `json:"introspection_endpoint,omitempty"`
+
+	// OAuth 2.0 Introspection Endpoint Authentication Methods Supported
+	//
+	// JSON array containing a list of client authentication methods supported by the introspection endpoint
+	// [RFC8414]. Only set in the OAuth 2.0 authorization server metadata if public introspection is enabled.
+	IntrospectionEndpointAuthMethodsSupported []string `json:"introspection_endpoint_auth_methods_supported,omitempty"`
+
+	// OAuth 2.0 Revocation Endpoint Authentication Methods Supported
+	//
+	// JSON array containing a list of client authentication methods supported by the revocation endpoint
+	// [RFC8414]. Only set in the OAuth 2.0 authorization server metadata.
+	RevocationEndpointAuthMethodsSupported []string `json:"revocation_endpoint_auth_methods_supported,omitempty"`
+
+	// OAuth 2.0 Signed Metadata
+	//
+	// JSON Web Token containing the metadata as claims [RFC8414]. Only set in the OAuth 2.0 authorization server
+	// metadata if signed metadata is enabled.
+	SignedMetadata string `json:"signed_metadata,omitempty"`
+
 	// OpenID Connect Verifiable Credentials Endpoint
 	//
 	// Contains the URL of the Verifiable Credentials Endpoint.
@@ -510,43 +597,54 @@ type CredentialSupportedDraft00 struct {
 //	Extensions:
 //	  x-ory-ratelimit-bucket: hydra-public-high
 func (h *Handler) discoverOidcConfiguration(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	key, err := h.r.OpenIDJWTSigner().GetPublicKey(ctx)
+	conf, err := h.oidcConfiguration(r.Context())
 	if err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
-	h.r.Writer().Write(w, r, &oidcConfiguration{
-		Issuer:                                 h.c.IssuerURL(ctx).String(),
-		AuthURL:                                h.c.OAuth2AuthURL(ctx).String(),
-		DeviceAuthorizationURL:                 h.c.OAuth2DeviceAuthorisationURL(ctx).String(),
-		TokenURL:                               h.c.OAuth2TokenURL(ctx).String(),
-		JWKsURI:                                h.c.JWKSURL(ctx).String(),
-		RevocationEndpoint:                     urlx.AppendPaths(h.c.IssuerURL(ctx), RevocationPath).String(),
-		RegistrationEndpoint:                   h.c.OAuth2ClientRegistrationURL(ctx).String(),
-		SubjectTypes:                           h.c.SubjectTypesSupported(ctx),
-		ResponseTypes:                          []string{"code", "code id_token", "id_token", "token id_token", "token", "token id_token code"},
-		ClaimsSupported:                        h.c.OIDCDiscoverySupportedClaims(ctx),
-		ScopesSupported:                        h.c.OIDCDiscoverySupportedScope(ctx),
-		UserinfoEndpoint:                       h.c.OIDCDiscoveryUserinfoEndpoint(ctx).String(),
-		TokenEndpointAuthMethodsSupported:      []string{"client_secret_post", "client_secret_basic", "private_key_jwt", "none"},
-		IDTokenSigningAlgValuesSupported:       []string{key.Algorithm},
-		IDTokenSignedResponseAlg:               []string{key.Algorithm},
-		UserinfoSignedResponseAlg:              []string{key.Algorithm},
-		GrantTypesSupported:                    []string{"authorization_code", "implicit", "client_credentials", "refresh_token", "urn:ietf:params:oauth:grant-type:device_code"},
-		ResponseModesSupported:                 []string{"query", "fragment", "form_post"},
-		UserinfoSigningAlgValuesSupported:      []string{"none", key.Algorithm},
-		RequestParameterSupported:              true,
-		RequestURIParameterSupported:           true,
-		RequireRequestURIRegistration:          true,
-		BackChannelLogoutSupported:             true,
-		BackChannelLogoutSessionSupported:      true,
-		FrontChannelLogoutSupported:            true,
-		FrontChannelLogoutSessionSupported:     true,
-		EndSessionEndpoint:                     urlx.AppendPaths(h.c.IssuerURL(ctx), LogoutPath).String(),
-		RequestObjectSigningAlgValuesSupported: []string{"none", "RS256", "ES256"},
-		CodeChallengeMethodsSupported:          []string{"plain", "S256"},
-		CredentialsEndpointDraft00:             h.c.CredentialsEndpointURL(ctx).String(),
+	h.r.Writer().Write(w, r, conf)
+}
+
+func (h *Handler) oidcConfiguration(ctx context.Context) (*oidcConfiguration, error) {
+	algs, err := h.r.OpenIDJWTSigner().GetSigningAlgorithms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conf := &oidcConfiguration{
+		Issuer:                                     h.c.IssuerURL(ctx).String(),
+		AuthURL:                                    h.c.OAuth2AuthURL(ctx).String(),
+		DeviceAuthorizationURL:                     h.c.OAuth2DeviceAuthorisationURL(ctx).String(),
+		TokenURL:                                   h.c.OAuth2TokenURL(ctx).String(),
+		JWKsURI:                                    h.c.JWKSURL(ctx).String(),
+		RevocationEndpoint:                         urlx.AppendPaths(h.c.IssuerURL(ctx), RevocationPath).String(),
+		RegistrationEndpoint:                       h.c.OAuth2ClientRegistrationURL(ctx).String(),
+		SubjectTypes:                               h.c.SubjectTypesSupported(ctx),
+		ResponseTypes:                              []string{"code", "code id_token", "id_token", "token id_token", "token", "token id_token code"},
+		ClaimsSupported:                            h.c.OIDCDiscoverySupportedClaims(ctx),
+		ScopesSupported:                            h.c.OIDCDiscoverySupportedScope(ctx),
+		UserinfoEndpoint:                           h.c.OIDCDiscoveryUserinfoEndpoint(ctx).String(),
+		TokenEndpointAuthMethodsSupported:          []string{"client_secret_post", "client_secret_basic", "client_secret_jwt", "private_key_jwt", "none"},
+		IDTokenSigningAlgValuesSupported:           algs,
+		IDTokenSignedResponseAlg:                   algs[:1],
+		UserinfoSignedResponseAlg:                  algs[:1],
+		GrantTypesSupported:                        []string{"authorization_code", "implicit", "client_credentials", "refresh_token", "urn:ietf:params:oauth:grant-type:device_code"},
+		ResponseModesSupported:                     []string{"query", "fragment", "form_post"},
+		UserinfoSigningAlgValuesSupported:          append([]string{"none"}, algs...),
+		RequestParameterSupported:                  true,
+		RequestURIParameterSupported:               true,
+		RequireRequestURIRegistration:              true,
+		BackChannelLogoutSupported:                 true,
+		BackChannelLogoutSessionSupported:          true,
+		FrontChannelLogoutSupported:                true,
+		FrontChannelLogoutSessionSupported:         true,
+		EndSessionEndpoint:                         urlx.AppendPaths(h.c.IssuerURL(ctx), LogoutPath).String(),
+		RequestObjectSigningAlgValuesSupported:     []string{"none", "RS256", "ES256"},
+		CodeChallengeMethodsSupported:              []string{"plain", "S256"},
+		PushedAuthorizationRequestEndpoint:         urlx.AppendPaths(h.c.IssuerURL(ctx), PushedAuthorizationPath).String(),
+		RequirePushedAuthorizationRequests:         h.c.EnforcePushedAuthorize(ctx),
+		DPoPSigningAlgValuesSupported:              dpopSigningAlgorithms,
+		AuthorizationResponseIssParameterSupported: true,
+		CredentialsEndpointDraft00:                 h.c.CredentialsEndpointURL(ctx).String(),
 		CredentialsSupportedDraft00: []CredentialSupportedDraft00{{
 			Format:                               "jwt_vc_json",
 			Types:                                []string{"VerifiableCredential", "UserInfoCredential"},
@@ -558,7 +656,32 @@ func (h *Handler) discoverOidcConfiguration(w http.ResponseWriter, r *http.Reque
 				"EdDSA",
 			},
 		}},
-	})
+	}
+
+	if h.c.IntrospectionJWTResponseEnabled(ctx) {
+		if conf.IntrospectionSigningAlgValuesSupported, err = h.r.IntrospectionJWTSigner().GetSigningAlgorithms(ctx); err != nil {
+			return nil, err
+		}
+		conf.IntrospectionEncryptionAlgValuesSupported = jwk.EncryptionAlgorithms
+		conf.IntrospectionEncryptionEncValuesSupported = jwk.ContentEncryptionAlgorithms
+	}
+	if h.c.MTLSEnabled(ctx) {
+		conf.TokenEndpointAuthMethodsSupported = append(conf.TokenEndpointAuthMethodsSupported, fosite.ClientAuthMethodTLSClientAuth, fosite.ClientAuthMethodSelfSignedTLSClientAuth)
+
+		// Client certificates are only requested on the mtls interface, see
+		// https://www.rfc-editor.org/rfc/rfc8705#section-5.
+		mtlsURL := h.c.MTLSURL(ctx)
+		conf.MTLSEndpointAliases = map[string]string{
+			"token_endpoint":                        urlx.AppendPaths(mtlsURL, TokenPath).String(),
+			"revocation_endpoint":                   urlx.AppendPaths(mtlsURL, RevocationPath).String(),
+			"pushed_authorization_request_endpoint": urlx.AppendPaths(mtlsURL, PushedAuthorizationPath).String(),
+			"device_authorization_endpoint":         urlx.AppendPaths(mtlsURL, DeviceAuthPath).String(),
+		}
+		if h.c.PublicIntrospectionEnabled(ctx) {
+			conf.MTLSEndpointAliases["introspection_endpoint"] = urlx.AppendPaths(mtlsURL, IntrospectPath).String()
+		}
+	}
+	return conf, nil
 }
 
 // OpenID Connect Userinfo
@@ -651,7 +774,8 @@ type _ struct {
 func (h *Handler) getOidcUserInfo(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	session := NewSessionWithCustomClaims(ctx, h.c, "")
-	tokenType, ar, err := h.r.OAuth2Provider().IntrospectToken(ctx, fosite.AccessTokenFromRequest(r), fosite.AccessToken, session)
+	accessToken, isDPoP := accessTokenFromDPoPRequest(r)
+	tokenType, ar, err := h.r.OAuth2Provider().IntrospectToken(ctx, accessToken, fosite.AccessToken, session)
 	if err != nil {
 		rfcerr := fosite.ErrorToRFC6749Error(err)
 		if rfcerr.StatusCode() == http.StatusUnauthorized {
@@ -668,6 +792,12 @@ func (h *Handler) getOidcUserInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.verifyDPoPBinding(ctx, r, h.c.OIDCDiscoveryUserinfoEndpoint(ctx), accessToken, isDPoP, ar); err != nil {
+		w.Header().Set("WWW-Authenticate", dpopAuthenticateHeader(fosite.ErrorToRFC6749Error(err)))
+		h.r.Writer().WriteErrorCode(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
 	c, ok := ar.GetClient().(*client.Client)
 	if !ok {
 		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrServerError.WithHint("Unable to type assert to *client.Client.")))
@@ -699,7 +829,23 @@ func (h *Handler) getOidcUserInfo(w http.ResponseWriter, r *http.Request) {
 	}
 	interim["aud"] = aud
 
-	if c.UserinfoSignedResponseAlg == "RS256" {
+	if c.UserinfoSignedResponseAlg == "" || c.UserinfoSignedResponseAlg == "none" {
+		h.r.Writer().Write(w, r, interim)
+	} else {
+		alg := c.UserinfoSignedResponseAlg
+		if alg == "RS256" {
+			// Before other algorithms were supported, "RS256" requested a response signed with the default key,
+			// regardless of its algorithm.
+			algs, err := h.r.OpenIDJWTSigner().GetSigningAlgorithms(ctx)
+			if err != nil {
+				h.r.Writer().WriteError(w, r, err)
+				return
+			} else if !slices.Contains(algs, alg) {
+				alg = ""
+			}
+		}
+
+		ctx := jwk.WithSigningAlgorithm(ctx, alg)
 		interim["jti"] = uuid.New()
 		interim["iat"] = time.Now().Unix()
 
@@ -719,11 +865,6 @@ func (h *Handler) getOidcUserInfo(w http.ResponseWriter, r *http.Request) {
 
 		w.Header().Set("Content-Type", "application/jwt")
 		_, _ = w.Write([]byte(token))
-	} else if c.UserinfoSignedResponseAlg == "" || c.UserinfoSignedResponseAlg == "none" {
-		h.r.Writer().Write(w, r, interim)
-	} else {
-		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrServerError.WithHintf("Unsupported userinfo signing algorithm '%s'.", c.UserinfoSignedResponseAlg)))
-		return
 	}
 }
 
@@ -899,6 +1040,7 @@ func (h *Handler) oAuth2DeviceFlow(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	events.Trace(ctx, events.DeviceCodeIssued, events.WithClientID(request.GetClient().GetID()))
 	h.r.OAuth2Provider().WriteDeviceResponse(ctx, w, request, resp)
 }
 
@@ -942,6 +1084,15 @@ type _ struct {
 func (h *Handler) revokeOAuth2Token(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// The token is introspected before it is revoked, because the security event contains its subject and client.
+	var (
+		tokenUse fosite.TokenUse
+		revoked  fosite.AccessRequester
+	)
+	if h.c.SSFEnabled(ctx) && r.ParseForm() == nil {
+		tokenUse, revoked, _ = h.r.OAuth2Provider().IntrospectToken(ctx, r.PostForm.Get("token"), fosite.TokenType(r.PostForm.Get("token_type_hint")), NewSessionWithCustomClaims(ctx, h.c, ""))
+	}
+
 	err := h.r.Transaction(ctx, func(ctx context.Context) error {
 		return h.r.OAuth2Provider().NewRevocationRequest(ctx, r)
 	})
@@ -949,6 +1100,11 @@ func (h *Handler) revokeOAuth2Token(w http.ResponseWriter, r *http.Request) {
 		x.LogError(r, err, h.r.Logger())
 	} else {
 		events.Trace(ctx, events.AccessTokenRevoked)
+		if revoked != nil {
+			h.r.SSFTransmitter().Transmit(ctx, ssf.TokenRevoked(
+				h.c.IssuerURL(ctx).String(), revoked.GetSession().GetSubject(), revoked.GetClient().GetID(), string(tokenUse),
+			))
+		}
 	}
 
 	h.r.OAuth2Provider().WriteRevocationResponse(ctx, w, err)
@@ -972,6 +1128,13 @@ type _ struct {
 	//
 	// in: formData
 	Scope string `json:"scope"`
+
+	// The ID of the OAuth 2.0 Client of the resource server. If a JWT introspection response is requested with the
+	// Accept header application/token-introspection+jwt, the token is issued for this client and signed and encrypted
+	// using the algorithms of its metadata.
+	//
+	// in: formData
+	ClientID string `json:"client_id"`
 }
 
 // swagger:route POST /admin/oauth2/introspect oAuth2 introspectOAuth2Token
@@ -982,6 +1145,9 @@ type _ struct {
 // is neither expired nor revoked. If a token is active, additional information on the token will be included. You can
 // set additional data for a token by setting `session.access_token` during the consent flow.
 //
+// If enabled in the configuration, the response is a JSON Web Token as defined in RFC 9701 when requested with the
+// Accept header application/token-introspection+jwt.
+//
 //	Consumes:
 //	- application/x-www-form-urlencoded
 //
@@ -997,6 +1163,62 @@ type _ struct {
 //	Extensions:
 //	  x-ory-ratelimit-bucket: hydra-admin-high
 func (h *Handler) introspectOAuth2Token(w http.ResponseWriter, r *http.Request) {
+	h.introspect(w, r, false)
+}
+
+// swagger:route POST /oauth2/introspect oAuth2 introspectOAuth2TokenPublic
+//
+// # Introspect OAuth2 Access and Refresh Tokens as a Resource Server
+//
+// The public introspection endpoint allows resource servers to check if a token (both refresh and access) is active
+// without access to the admin API. It is only available if enabled in the configuration.
+//
+// The resource server authenticates as an OAuth 2.0 Client using its token endpoint authentication method, and the
+// client must be allowed the `introspect` scope. Tokens whose audience does not include the client are reported as
+// inactive.
+//
+//	Consumes:
+//	- application/x-www-form-urlencoded
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Security:
+//	  basic:
+//
+//	Responses:
+//	  200: introspectedOAuth2Token
+//	  default: errorOAuth2
+func (h *Handler) introspectOAuth2TokenPublic(w http.ResponseWriter, r *http.Request) {
+	if !h.c.PublicIntrospectionEnabled(r.Context()) {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrNotFound().WithReason("The public introspection endpoint is disabled.")))
+		return
+	}
+	h.introspect(w, r, true)
+}
+
+// authenticateResourceServer authenticates the OAuth 2.0 Client calling the public introspection endpoint and checks
+// that it is allowed to introspect tokens.
+func (h *Handler) authenticateResourceServer(ctx context.Context, r *http.Request) (*client.Client, error) {
+	c, err := h.r.OAuth2Provider().AuthenticateClient(ctx, r, r.PostForm)
+	if err != nil {
+		return nil, errors.WithStack(fosite.ErrRequestUnauthorized.WithHint("Client authentication failed.").WithWrap(err).WithDebug(err.Error()))
+	} else if c.IsPublic() {
+		return nil, errors.WithStack(fosite.ErrRequestUnauthorized.WithHint("Public OAuth 2.0 Clients are not allowed to introspect tokens."))
+	} else if !h.r.OAuth2ProviderConfig().GetScopeStrategy(ctx)(c.GetScopes(), IntrospectScope) {
+		return nil, errors.WithStack(fosite.ErrRequestUnauthorized.WithHintf("The OAuth 2.0 Client is not allowed to introspect tokens, because it is not allowed to request scope '%s'.", IntrospectScope))
+	}
+
+	rs, ok := c.(*client.Client)
+	if !ok {
+		return nil, errors.WithStack(fosite.ErrServerError.WithHint("Expected client to be of type *client.Client, but got another type.").WithDebug(fmt.Sprintf("Got type %s", reflect.TypeOf(c))))
+	}
+	return rs, nil
+}
+
+func (h *Handler) introspect(w http.ResponseWriter, r *http.Request, public bool) {
 	ctx := r.Context()
 	session := NewSessionWithCustomClaims(ctx, h.c, "")
 
@@ -1016,11 +1238,44 @@ func (h *Handler) introspectOAuth2Token(w http.ResponseWriter, r *http.Request)
 	tokenType := r.PostForm.Get("token_type_hint")
 	scope := r.PostForm.Get("scope")
 
+	var rs *client.Client
+	if public {
+		c, err := h.authenticateResourceServer(ctx, r)
+		if err != nil {
+			x.LogError(r, err, h.r.Logger())
+			h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+			return
+		}
+		rs = c
+	} else if id := r.PostForm.Get("client_id"); id != "" && h.wantsIntrospectionJWT(r) {
+		c, err := h.r.ClientManager().GetConcreteClient(ctx, id)
+		if err != nil {
+			err := errors.WithStack(fosite.ErrInvalidRequest.WithHintf("The resource server client '%s' does not exist.", id).WithWrap(err).WithDebug(err.Error()))
+			x.LogError(r, err, h.r.Logger())
+			h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+			return
+		}
+		rs = c
+	}
+
 	tt, ar, err := h.r.OAuth2Provider().IntrospectToken(ctx, token, fosite.TokenType(tokenType), session, strings.Split(scope, " ")...)
+	if err == nil && public && !ar.GetGrantedAudience().Has(rs.GetID()) {
+		// Resource servers introspecting through the public endpoint may only see tokens issued for them.
+		err = errors.WithStack(fosite.ErrRequestForbidden.WithHintf("The token was not issued for audience '%s'.", rs.GetID()))
+	}
 	if err != nil {
 		x.LogError(r, err, h.r.Logger())
-		err := errors.WithStack(fosite.ErrInactiveToken.WithHint("An introspection strategy indicated that the token is inactive.").WithDebug(err.Error()))
-		h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+		if h.wantsIntrospectionJWT(r) {
+			if err := h.writeIntrospectionJWT(w, r, rs, &Introspection{Active: false}); err != nil {
+				x.LogError(r, err, h.r.Logger())
+				h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+				return
+			}
+		} else {
+			err := errors.WithStack(fosite.ErrInactiveToken.WithHint("An introspection strategy indicated that the token is inactive.").WithDebug(err.Error()))
+			h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+		}
+		events.Trace(ctx, events.AccessTokenInspected, events.WithTokenActive(false))
 		return
 	}
 
@@ -1059,8 +1314,13 @@ func (h *Handler) introspectOAuth2Token(w http.ResponseWriter, r *http.Request)
 		audience = fosite.Arguments{}
 	}
 
-	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
-	if err = json.NewEncoder(w).Encode(&Introspection{
+	var confirmation map[string]string
+	if tt == fosite.AccessToken && session.DPoPJKT != "" {
+		resp.AccessTokenType = "DPoP"
+		confirmation = map[string]string{"jkt": session.DPoPJKT}
+	}
+
+	introspection := &Introspection{
 		Active:            resp.IsActive(),
 		ClientID:          resp.GetAccessRequester().GetClient().GetID(),
 		Scope:             strings.Join(resp.GetAccessRequester().GetGrantedScopes(), " "),
@@ -1073,16 +1333,29 @@ func (h *Handler) introspectOAuth2Token(w http.ResponseWriter, r *http.Request)
 		Issuer:            h.c.IssuerURL(ctx).String(),
 		ObfuscatedSubject: obfuscated,
 		TokenType:         resp.GetAccessTokenType(),
+		Confirmation:      confirmation,
 		TokenUse:          string(resp.GetTokenUse()),
 		NotBefore:         resp.GetAccessRequester().GetRequestedAt().Unix(),
-	}); err != nil {
-		x.LogError(r, errors.WithStack(err), h.r.Logger())
+	}
+
+	if h.wantsIntrospectionJWT(r) {
+		if err := h.writeIntrospectionJWT(w, r, rs, introspection); err != nil {
+			x.LogError(r, err, h.r.Logger())
+			h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+			return
+		}
+	} else {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		if err = json.NewEncoder(w).Encode(introspection); err != nil {
+			x.LogError(r, errors.WithStack(err), h.r.Logger())
+		}
 	}
 
 	events.Trace(ctx,
 		events.AccessTokenInspected,
 		events.WithSubject(session.GetSubject()),
 		events.WithClientID(resp.GetAccessRequester().GetClient().GetID()),
+		events.WithTokenActive(true),
 	)
 }
 
@@ -1236,6 +1509,14 @@ func (h *Handler) oauth2TokenExchange(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	jkt, err := h.bindDPoPProof(ctx, r, accessRequest)
+	if err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.r.OAuth2Provider().WriteAccessError(ctx, w, accessRequest, err)
+		events.Trace(ctx, events.TokenExchangeError, events.WithRequest(accessRequest), events.WithError(err))
+		return
+	}
+
 	for _, hook := range h.r.AccessRequestHooks() {
 		if err := hook(ctx, accessRequest); err != nil {
 			x.LogError(r, err, h.r.Logger())
@@ -1256,6 +1537,10 @@ func (h *Handler) oauth2TokenExchange(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if jkt != "" {
+		accessResponse.SetTokenType("DPoP")
+	}
+
 	h.r.OAuth2Provider().WriteAccessResponse(ctx, w, accessRequest, accessResponse)
 }
 
@@ -1284,6 +1569,12 @@ func (h *Handler) oauth2TokenExchange(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) oAuth2Authorize(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	if err := h.registerFederationClient(r); err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.forwardError(w, r, err)
+		return
+	}
+
 	authorizeRequest, err := h.r.OAuth2Provider().NewAuthorizeRequest(ctx, r)
 	if err != nil {
 		x.LogError(r, err, h.r.Logger())
@@ -1291,6 +1582,18 @@ func (h *Handler) oAuth2Authorize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := validateAuthorizeSecurityProfile(authorizeRequest); err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.writeAuthorizeError(w, r, authorizeRequest, err)
+		return
+	}
+
+	if err := validateAuthorizeApplicationType(authorizeRequest); err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.writeAuthorizeError(w, r, authorizeRequest, err)
+		return
+	}
+
 	fl, err := h.r.ConsentStrategy().HandleOAuth2AuthorizationRequest(ctx, w, r, authorizeRequest)
 	if errors.Is(err, consent.ErrUserRedirected) {
 		return
@@ -1398,7 +1701,11 @@ func (h *Handler) updateSessionWithRequest(
 		request.GrantAudience(audience)
 	}
 
-	openIDKeyID, err := h.r.OpenIDJWTSigner().GetPublicKeyID(ctx)
+	var idTokenAlg string
+	if c, ok := request.GetClient().(*client.Client); ok {
+		idTokenAlg = c.IDTokenSignedResponseAlg
+	}
+	openIDKeyID, err := h.r.OpenIDJWTSigner().GetPublicKeyID(jwk.WithSigningAlgorithm(ctx, idTokenAlg))
 	if err != nil {
 		x.LogError(r, err, h.r.Logger())
 		return nil, err
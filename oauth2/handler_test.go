@@ -25,6 +25,7 @@ import (
 	hydra "github.com/ory/hydra-client-go/v2"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/federation"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
@@ -310,6 +311,49 @@ func TestUserinfo(t *testing.T) {
 				assert.NotEmpty(t, claims.Claims["jti"])
 			},
 		},
+		{
+			setup: func(t *testing.T) {
+				_, err := reg.KeyManager().GenerateAndPersistKeySet(t.Context(), x.OpenIDConnectKeyName, "userinfo-es256", "ES256", "sig")
+				require.NoError(t, err)
+
+				op.EXPECT().
+					IntrospectToken(gomock.Any(), gomock.Eq("access-token"), gomock.Eq(fosite.AccessToken), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ fosite.TokenType, _ fosite.Session, _ ...string) (fosite.TokenType, fosite.AccessRequester, error) {
+						session := &oauth2.Session{
+							DefaultSession: &openid.DefaultSession{
+								Claims: &jwt.IDTokenClaims{
+									Subject: "alice",
+								},
+								Headers: new(jwt.Headers),
+								Subject: "alice",
+							},
+							Extra: map[string]interface{}{},
+						}
+
+						return fosite.AccessToken, &fosite.AccessRequest{
+							Request: fosite.Request{
+								Client: &client.Client{
+									ID:                        "foobar-client",
+									UserinfoSignedResponseAlg: "ES256",
+								},
+								Session: session,
+							},
+						}, nil
+					})
+			},
+			expectStatusCode: http.StatusOK,
+			checkForSuccess: func(t *testing.T, body []byte) {
+				claims, err := jwt.Parse(string(body), func(token *jwt.Token) (interface{}, error) {
+					assert.Equal(t, "ES256", token.Header["alg"])
+					assert.Equal(t, "userinfo-es256", token.Header["kid"])
+					key, err := reg.KeyManager().GetKey(t.Context(), x.OpenIDConnectKeyName, "userinfo-es256")
+					require.NoError(t, err)
+					return jwk.ExcludePrivateKeys(key).Keys[0].Key, nil
+				})
+				require.NoError(t, err)
+				assert.EqualValues(t, "alice", claims.Claims["sub"])
+			},
+		},
 	} {
 		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
 			tc.setup(t)
@@ -374,6 +418,23 @@ func TestHandlerWellKnown(t *testing.T) {
 			))
 		}
 		snapshotx.SnapshotT(t, wellKnownResp, snapshotOpts...)
+
+		if !reg.Config().HSMEnabled() {
+			t.Run("case=lists the algorithms of all keys", func(t *testing.T) {
+				_, err := reg.KeyManager().GenerateAndPersistKeySet(t.Context(), x.OpenIDConnectKeyName, "", "RS256", "sig")
+				require.NoError(t, err)
+
+				res, err := http.Get(ts.URL + "/.well-known/openid-configuration")
+				require.NoError(t, err)
+				defer func() { _ = res.Body.Close() }()
+
+				var wellKnownResp hydra.OidcConfiguration
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&wellKnownResp))
+				assert.Equal(t, []string{"RS256", "ES256"}, wellKnownResp.IdTokenSigningAlgValuesSupported)
+				assert.Equal(t, []string{"RS256"}, wellKnownResp.IdTokenSignedResponseAlg)
+				assert.Equal(t, []string{"none", "RS256", "ES256"}, wellKnownResp.UserinfoSigningAlgValuesSupported)
+			})
+		}
 	})
 }
 
@@ -383,6 +444,7 @@ func TestHandlerOauthAuthorizationServer(t *testing.T) {
 	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
 		config.KeyScopeStrategy:                 "DEPRECATED_HIERARCHICAL_SCOPE_STRATEGY",
 		config.KeyIssuerURL:                     "http://hydra.localhost",
+		config.KeyAdminURL:                      "http://admin.hydra.localhost",
 		config.KeySubjectTypesSupported:         []string{"pairwise", "public"},
 		config.KeyOIDCDiscoverySupportedClaims:  []string{"sub"},
 		config.KeyOAuth2ClientRegistrationURL:   "http://client-register/registration",
@@ -403,7 +465,7 @@ func TestHandlerOauthAuthorizationServer(t *testing.T) {
 		require.NoError(t, err)
 		defer func() { _ = res.Body.Close() }()
 
-		var wellKnownResp hydra.OidcConfiguration
+		var wellKnownResp map[string]any
 		err = json.NewDecoder(res.Body).Decode(&wellKnownResp)
 		require.NoError(t, err, "problem decoding wellknown json response: %+v", err)
 		snapshotOpts := []snapshotx.Opt{}
@@ -420,3 +482,172 @@ func TestHandlerOauthAuthorizationServer(t *testing.T) {
 		snapshotx.SnapshotT(t, wellKnownResp, snapshotOpts...)
 	})
 }
+
+func TestHandlerOauthAuthorizationServerMetadata(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, values map[string]any) (*httptest.Server, *driver.RegistrySQL) {
+		values[config.KeyIssuerURL] = "https://hydra.example.com/tenant"
+		values[config.KeyAdminURL] = "https://admin.hydra.example.com/"
+		reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(values)))
+
+		r := httprouterx.NewRouterAdminWithPrefix()
+		oauth2.NewHandler(reg).SetPublicRoutes(r.ToPublic(), func(h http.Handler) http.Handler { return h })
+		ts := httptest.NewServer(r)
+		t.Cleanup(ts.Close)
+		return ts, reg
+	}
+
+	get := func(t *testing.T, ts *httptest.Server, path string) (int, map[string]any) {
+		res, err := ts.Client().Get(ts.URL + path)
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+		return res.StatusCode, body
+	}
+
+	t.Run("case=serves the metadata at the path inserted well-known URL", func(t *testing.T) {
+		ts, _ := newServer(t, map[string]any{})
+
+		for _, path := range []string{"/.well-known/oauth-authorization-server", "/.well-known/oauth-authorization-server/tenant"} {
+			code, metadata := get(t, ts, path)
+			require.Equal(t, http.StatusOK, code, "%s: %+v", path, metadata)
+			assert.Equal(t, "https://hydra.example.com/tenant", metadata["issuer"])
+			assert.NotContains(t, metadata, "introspection_endpoint")
+			assert.NotContains(t, metadata, "introspection_endpoint_auth_methods_supported")
+			assert.Equal(t, []any{"client_secret_post", "client_secret_basic", "client_secret_jwt", "private_key_jwt", "none"}, metadata["revocation_endpoint_auth_methods_supported"])
+			assert.NotContains(t, metadata, "signed_metadata")
+		}
+
+		code, _ := get(t, ts, "/.well-known/oauth-authorization-server/other-tenant")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("case=advertises the public introspection endpoint", func(t *testing.T) {
+		ts, _ := newServer(t, map[string]any{config.KeyIntrospectionPublicEnabled: true})
+
+		code, metadata := get(t, ts, "/.well-known/oauth-authorization-server")
+		require.Equal(t, http.StatusOK, code, "%+v", metadata)
+		assert.Equal(t, "https://hydra.example.com/tenant/oauth2/introspect", metadata["introspection_endpoint"])
+		assert.Equal(t, []any{"client_secret_post", "client_secret_basic", "client_secret_jwt", "private_key_jwt"}, metadata["introspection_endpoint_auth_methods_supported"])
+	})
+
+	t.Run("case=advertises the mutual TLS endpoint aliases", func(t *testing.T) {
+		ts, _ := newServer(t, map[string]any{
+			config.KeyMTLSEnabled:                true,
+			config.KeyMTLSURL:                    "https://mtls.hydra.example.com/tenant",
+			config.KeyIntrospectionPublicEnabled: true,
+		})
+
+		code, metadata := get(t, ts, "/.well-known/oauth-authorization-server")
+		require.Equal(t, http.StatusOK, code, "%+v", metadata)
+		assert.Contains(t, metadata["token_endpoint_auth_methods_supported"], "tls_client_auth")
+		assert.Equal(t, map[string]any{
+			"token_endpoint":                        "https://mtls.hydra.example.com/tenant/oauth2/token",
+			"revocation_endpoint":                   "https://mtls.hydra.example.com/tenant/oauth2/revoke",
+			"introspection_endpoint":                "https://mtls.hydra.example.com/tenant/oauth2/introspect",
+			"pushed_authorization_request_endpoint": "https://mtls.hydra.example.com/tenant/oauth2/par",
+			"device_authorization_endpoint":         "https://mtls.hydra.example.com/tenant/oauth2/device/auth",
+		}, metadata["mtls_endpoint_aliases"])
+
+		ts, _ = newServer(t, map[string]any{})
+		_, metadata = get(t, ts, "/.well-known/oauth-authorization-server")
+		assert.NotContains(t, metadata, "mtls_endpoint_aliases")
+	})
+
+	t.Run("case=serves signed metadata", func(t *testing.T) {
+		ts, reg := newServer(t, map[string]any{config.KeySignedMetadataEnabled: true})
+		ctx := context.Background()
+
+		code, metadata := get(t, ts, "/.well-known/oauth-authorization-server/tenant")
+		require.Equal(t, http.StatusOK, code, "%+v", metadata)
+		signed, ok := metadata["signed_metadata"].(string)
+		require.True(t, ok, "%+v", metadata)
+
+		assert.Contains(t, reg.Config().WellKnownKeys(ctx), x.MetadataKeyName)
+		keys, err := reg.KeyManager().GetKeySet(ctx, x.MetadataKeyName)
+		require.NoError(t, err)
+
+		token, err := jwt.Parse(signed, func(token *jwt.Token) (any, error) {
+			keys := jwk.ExcludePrivateKeys(keys).Key(token.Header["kid"].(string))
+			require.Len(t, keys, 1)
+			return keys[0].Key, nil
+		})
+		require.NoError(t, err)
+		claims := token.Claims
+		assert.Equal(t, "https://hydra.example.com/tenant", claims["iss"])
+		assert.Equal(t, metadata["issuer"], claims["issuer"])
+		assert.Equal(t, metadata["token_endpoint"], claims["token_endpoint"])
+		assert.Equal(t, metadata["revocation_endpoint"], claims["revocation_endpoint"])
+		assert.NotContains(t, claims, "signed_metadata")
+	})
+
+	t.Run("case=is decoded by the SDK", func(t *testing.T) {
+		ts, _ := newServer(t, map[string]any{config.KeyIntrospectionPublicEnabled: true, config.KeySignedMetadataEnabled: true})
+
+		c := hydra.NewAPIClient(hydra.NewConfiguration())
+		c.GetConfig().Servers = hydra.ServerConfigurations{{URL: ts.URL}}
+		metadata, _, err := c.OidcAPI.DiscoverOAuth2AuthorizationServer(context.Background()).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, "https://hydra.example.com/tenant/oauth2/introspect", metadata.GetIntrospectionEndpoint())
+		assert.NotEmpty(t, metadata.GetRevocationEndpointAuthMethodsSupported())
+		assert.NotEmpty(t, metadata.GetSignedMetadata())
+	})
+}
+
+func TestHandlerFederationEntityConfiguration(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, values map[string]any) *httptest.Server {
+		values[config.KeyIssuerURL] = "https://hydra.example.com/"
+		reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(values)))
+
+		r := httprouterx.NewRouterAdminWithPrefix()
+		oauth2.NewHandler(reg).SetPublicRoutes(r.ToPublic(), func(h http.Handler) http.Handler { return h })
+		ts := httptest.NewServer(r)
+		t.Cleanup(ts.Close)
+		return ts
+	}
+
+	t.Run("case=federation is disabled", func(t *testing.T) {
+		ts := newServer(t, map[string]any{})
+		res, err := ts.Client().Get(ts.URL + federation.EntityConfigurationPath)
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("case=federation is enabled", func(t *testing.T) {
+		anchor := testhelpers.NewFederation(t).Entity(t, "anchor")
+		values := testhelpers.FederationConfig(t, anchor)
+		values[config.KeyFederationAuthorityHints] = []string{anchor.ID}
+		ts := newServer(t, values)
+
+		res, err := ts.Client().Get(ts.URL + federation.EntityConfigurationPath)
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+		assert.Equal(t, federation.ContentTypeEntityStatement, res.Header.Get("Content-Type"))
+
+		ec, err := federation.ParseEntityStatement(string(body))
+		require.NoError(t, err)
+		require.NoError(t, ec.Verify(ec.JWKS, time.Now()), "the entity configuration is signed with the published keys")
+		for _, key := range ec.JWKS.Keys {
+			assert.True(t, key.IsPublic())
+		}
+
+		assert.Equal(t, "https://hydra.example.com/", ec.Issuer)
+		assert.True(t, ec.IsEntityConfiguration())
+		assert.Equal(t, []string{anchor.ID}, ec.AuthorityHints)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), time.Unix(ec.ExpiresAt, 0), time.Minute)
+
+		provider := ec.Metadata[federation.EntityTypeOpenIDProvider]
+		assert.Equal(t, "https://hydra.example.com/", provider["issuer"])
+		assert.Equal(t, "https://hydra.example.com/oauth2/federation/register", provider["federation_registration_endpoint"])
+		assert.Equal(t, []any{"automatic", "explicit"}, provider["client_registration_types_supported"])
+		assert.NotEmpty(t, provider["authorization_endpoint"])
+	})
+}
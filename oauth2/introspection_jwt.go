@@ -0,0 +1,91 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/hydra/v2/jwk"
+)
+
+// IntrospectionJWTMediaType is the media type of JWT introspection responses, see
+// https://www.rfc-editor.org/rfc/rfc9701.
+const IntrospectionJWTMediaType = "application/token-introspection+jwt"
+
+// wantsIntrospectionJWT returns whether the resource server requested a JWT introspection response.
+func (h *Handler) wantsIntrospectionJWT(r *http.Request) bool {
+	return h.c.IntrospectionJWTResponseEnabled(r.Context()) && strings.Contains(r.Header.Get("Accept"), IntrospectionJWTMediaType)
+}
+
+// writeIntrospectionJWT writes the introspection response as a signed and optionally encrypted JSON Web Token. The
+// client metadata of the resource server, if known, determines the audience and the algorithms of the token.
+func (h *Handler) writeIntrospectionJWT(w http.ResponseWriter, r *http.Request, rs *client.Client, introspection *Introspection) error {
+	ctx := r.Context()
+
+	// https://www.rfc-editor.org/rfc/rfc9701#section-5
+	tokenIntrospection := map[string]any{"active": false}
+	if introspection.Active {
+		raw, err := json.Marshal(introspection)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := json.Unmarshal(raw, &tokenIntrospection); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	claims := jwt.MapClaims{
+		"iss":                 h.c.IssuerURL(ctx).String(),
+		"iat":                 time.Now().Unix(),
+		"token_introspection": tokenIntrospection,
+	}
+	if rs != nil {
+		claims["aud"] = rs.GetID()
+		ctx = jwk.WithSigningAlgorithm(ctx, rs.IntrospectionSignedResponseAlg)
+	}
+
+	signer := h.r.IntrospectionJWTSigner()
+	keyID, err := signer.GetPublicKeyID(ctx)
+	if err != nil {
+		return err
+	}
+	token, _, err := signer.Generate(ctx, claims, &jwt.Headers{
+		Extra: map[string]any{"kid": keyID, "typ": "token-introspection+jwt"},
+	})
+	if err != nil {
+		return err
+	}
+
+	if rs != nil && rs.IntrospectionEncryptedResponseAlg != "" {
+		keys := rs.GetJSONWebKeys()
+		if keys == nil {
+			if keys, err = h.r.OAuth2ProviderConfig().GetJWKSFetcherStrategy(ctx).Resolve(ctx, rs.JSONWebKeysURI, false); err != nil {
+				return err
+			}
+		}
+		key, err := jwk.FindEncryptionKey(keys, rs.IntrospectionEncryptedResponseAlg)
+		if err != nil {
+			return err
+		}
+		enc := rs.IntrospectionEncryptedResponseEnc
+		if enc == "" {
+			enc = string(jose.A128CBC_HS256)
+		}
+		if token, err = jwk.EncryptJWT(token, key, rs.IntrospectionEncryptedResponseAlg, enc); err != nil {
+			return err
+		}
+	}
+
+	w.Header().Set("Content-Type", IntrospectionJWTMediaType)
+	_, _ = w.Write([]byte(token))
+	return nil
+}
@@ -70,4 +70,8 @@ type Introspection struct {
 
 	// Extra is arbitrary data set by the session.
 	Extra map[string]interface{} `json:"ext,omitempty"`
+
+	// Confirmation contains the thumbprint of the DPoP key the access token is bound to, see
+	// https://www.rfc-editor.org/rfc/rfc9449#section-6.2.
+	Confirmation map[string]string `json:"cnf,omitempty"`
 }
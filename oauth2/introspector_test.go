@@ -5,21 +5,31 @@ package oauth2_test
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"io"
+	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-jose/go-jose/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 
 	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/driver"
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
 	"github.com/ory/hydra/v2/internal"
 	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/configx"
@@ -158,3 +168,218 @@ func TestIntrospectorSDK(t *testing.T) {
 		}
 	})
 }
+
+func TestIntrospectorJWTResponse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyIssuerURL:                       "https://foobariss",
+		config.KeyIntrospectionJWTResponseEnabled: true,
+	})))
+	internal.AddFositeExamples(t, reg)
+
+	tokens := Tokens(reg.OAuth2ProviderConfig(), 1)
+	now := time.Now().UTC().Round(time.Minute)
+	createAccessTokenSession(t, "alice", "my-client", tokens[0].sig, now.Add(time.Hour), reg.OAuth2Storage(), fosite.Arguments{"core"})
+
+	encryptionKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	require.NoError(t, reg.ClientManager().CreateClient(ctx, &client.Client{
+		ID: "resource-server",
+		JSONWebKeys: &x.JoseJSONWebKeySet{JSONWebKeySet: &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &encryptionKey.PublicKey, KeyID: "enc", Use: "enc", Algorithm: string(jose.RSA_OAEP_256)},
+		}}},
+		IntrospectionEncryptedResponseAlg: string(jose.RSA_OAEP_256),
+		IntrospectionEncryptedResponseEnc: string(jose.A256GCM),
+	}))
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	oauth2.NewHandler(reg).SetAdminRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	introspect := func(t *testing.T, form url.Values, accept string) (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/admin"+oauth2.IntrospectPath, strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", accept)
+		res, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, string(body)
+	}
+
+	verify := func(t *testing.T, token string) jwt.MapClaims {
+		keys, err := reg.KeyManager().GetKeySet(ctx, x.IntrospectionKeyName)
+		require.NoError(t, err)
+		parsed, err := jwt.Parse(token, func(token *jwt.Token) (any, error) {
+			assert.Equal(t, "token-introspection+jwt", token.Header["typ"])
+			keys := jwk.ExcludePrivateKeys(keys).Key(token.Header["kid"].(string))
+			require.Len(t, keys, 1)
+			return keys[0].Key, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "https://foobariss", parsed.Claims["iss"])
+		return parsed.Claims
+	}
+
+	t.Run("case=responds with a signed token for an active token", func(t *testing.T) {
+		res, body := introspect(t, url.Values{"token": {tokens[0].tok}}, oauth2.IntrospectionJWTMediaType)
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+		assert.Equal(t, oauth2.IntrospectionJWTMediaType, res.Header.Get("Content-Type"))
+
+		claims := verify(t, body)
+		assert.NotContains(t, claims, "aud")
+		introspection, ok := claims["token_introspection"].(map[string]any)
+		require.True(t, ok, "%+v", claims)
+		assert.Equal(t, true, introspection["active"])
+		assert.Equal(t, "alice", introspection["sub"])
+		assert.Equal(t, "core", introspection["scope"])
+	})
+
+	t.Run("case=responds with a signed token for an inactive token", func(t *testing.T) {
+		res, body := introspect(t, url.Values{"token": {"invalid"}}, oauth2.IntrospectionJWTMediaType)
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+		claims := verify(t, body)
+		assert.Equal(t, map[string]any{"active": false}, claims["token_introspection"])
+	})
+
+	t.Run("case=responds with an encrypted token for the resource server", func(t *testing.T) {
+		res, body := introspect(t, url.Values{"token": {tokens[0].tok}, "client_id": {"resource-server"}}, oauth2.IntrospectionJWTMediaType)
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+
+		encrypted, err := jose.ParseEncrypted(body)
+		require.NoError(t, err)
+		assert.Equal(t, "JWT", encrypted.Header.ExtraHeaders[jose.HeaderContentType])
+		assert.Equal(t, "enc", encrypted.Header.KeyID)
+		signed, err := encrypted.Decrypt(encryptionKey)
+		require.NoError(t, err)
+
+		claims := verify(t, string(signed))
+		assert.Equal(t, "resource-server", claims["aud"])
+		assert.Equal(t, true, claims["token_introspection"].(map[string]any)["active"])
+	})
+
+	t.Run("case=rejects an unknown resource server", func(t *testing.T) {
+		res, body := introspect(t, url.Values{"token": {tokens[0].tok}, "client_id": {"unknown"}}, oauth2.IntrospectionJWTMediaType)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+	})
+
+	t.Run("case=responds with JSON if not requested", func(t *testing.T) {
+		res, body := introspect(t, url.Values{"token": {tokens[0].tok}}, "application/json")
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+		assert.Contains(t, res.Header.Get("Content-Type"), "application/json")
+		assert.Equal(t, true, gjson.Get(body, "active").Bool())
+	})
+}
+
+func TestIntrospectorPublic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyIssuerURL:                       "https://foobariss",
+		config.KeyIntrospectionPublicEnabled:      true,
+		config.KeyIntrospectionJWTResponseEnabled: true,
+	})))
+
+	for _, c := range []*client.Client{
+		{ID: "resource-server", Secret: "secret", Scope: oauth2.IntrospectScope, TokenEndpointAuthMethod: "client_secret_basic"},
+		{ID: "not-allowed", Secret: "secret", Scope: "core", TokenEndpointAuthMethod: "client_secret_basic"},
+		{ID: "public", Scope: oauth2.IntrospectScope, TokenEndpointAuthMethod: "none"},
+	} {
+		require.NoError(t, reg.ClientManager().CreateClient(ctx, c))
+	}
+
+	tokens := Tokens(reg.OAuth2ProviderConfig(), 2)
+	for i, audience := range []fosite.Arguments{{"resource-server"}, {"other-resource-server"}} {
+		ar := fosite.NewAccessRequest(oauth2.NewTestSession(t, "alice"))
+		ar.GrantedScope = fosite.Arguments{"core"}
+		ar.GrantedAudience = audience
+		ar.RequestedAt = time.Now().UTC().Round(time.Minute)
+		ar.Client = &fosite.DefaultClient{ID: "not-allowed"}
+		ar.Session.SetExpiresAt(fosite.AccessToken, time.Now().Add(time.Hour))
+		require.NoError(t, reg.OAuth2Storage().CreateAccessTokenSession(ctx, tokens[i].sig, ar))
+	}
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	oauth2.NewHandler(reg).SetPublicRoutes(router.ToPublic(), func(h http.Handler) http.Handler { return h })
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	introspect := func(t *testing.T, id, secret string, form url.Values, accept string) (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodPost, server.URL+oauth2.IntrospectPath, strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", accept)
+		if secret != "" {
+			req.SetBasicAuth(id, secret)
+		}
+		res, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, string(body)
+	}
+
+	t.Run("case=responds with the token if the audience includes the resource server", func(t *testing.T) {
+		res, body := introspect(t, "resource-server", "secret", url.Values{"token": {tokens[0].tok}}, "application/json")
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+		assert.True(t, gjson.Get(body, "active").Bool(), body)
+		assert.Equal(t, "alice", gjson.Get(body, "sub").String(), body)
+	})
+
+	t.Run("case=responds with an inactive token if the audience does not include the resource server", func(t *testing.T) {
+		res, body := introspect(t, "resource-server", "secret", url.Values{"token": {tokens[1].tok}}, "application/json")
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+		assert.JSONEq(t, `{"active":false}`, body)
+	})
+
+	t.Run("case=responds with a signed token for the resource server", func(t *testing.T) {
+		res, body := introspect(t, "resource-server", "secret", url.Values{"token": {tokens[0].tok}}, oauth2.IntrospectionJWTMediaType)
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+		claims, err := jwt.Parse(body, func(token *jwt.Token) (any, error) {
+			keys, err := reg.KeyManager().GetKeySet(ctx, x.IntrospectionKeyName)
+			require.NoError(t, err)
+			return jwk.ExcludePrivateKeys(keys).Key(token.Header["kid"].(string))[0].Key, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "resource-server", claims.Claims["aud"])
+	})
+
+	for _, tc := range []struct {
+		d, id, secret string
+	}{
+		{d: "an unauthenticated client", id: "resource-server"},
+		{d: "a wrong client secret", id: "resource-server", secret: "wrong"},
+		{d: "a client without the introspect scope", id: "not-allowed", secret: "secret"},
+	} {
+		t.Run("case=rejects "+tc.d, func(t *testing.T) {
+			res, body := introspect(t, tc.id, tc.secret, url.Values{"token": {tokens[0].tok}}, "application/json")
+			assert.Equal(t, http.StatusUnauthorized, res.StatusCode, body)
+		})
+	}
+
+	t.Run("case=rejects a public client", func(t *testing.T) {
+		res, body := introspect(t, "public", "", url.Values{"token": {tokens[0].tok}, "client_id": {"public"}}, "application/json")
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode, body)
+	})
+
+	t.Run("case=is not found if disabled", func(t *testing.T) {
+		reg := testhelpers.NewRegistryMemory(t)
+		router := httprouterx.NewRouterAdminWithPrefix()
+		oauth2.NewHandler(reg).SetPublicRoutes(router.ToPublic(), func(h http.Handler) http.Handler { return h })
+		server := httptest.NewServer(router)
+		t.Cleanup(server.Close)
+
+		res, err := server.Client().PostForm(server.URL+oauth2.IntrospectPath, url.Values{"token": {tokens[0].tok}})
+		require.NoError(t, err)
+		_ = res.Body.Close()
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/x/urlx"
+)
+
+// swagger:route GET /.well-known/oauth-authorization-server oidc discoverOAuth2AuthorizationServer
+//
+// # OAuth 2.0 Authorization Server Metadata
+//
+// Returns the OAuth 2.0 authorization server metadata as defined in RFC 8414. It contains the OpenID Connect
+// Discovery metadata and the metadata of the revocation endpoint, and of the introspection endpoint if public
+// introspection is enabled. If the issuer has a path, the metadata is also served at the well-known URL with the
+// path of the issuer appended.
+//
+// If enabled in the configuration, the metadata contains signed_metadata, a JSON Web Token with the metadata as its
+// claims, signed with the hydra.oauth2.metadata JSON Web Key Set.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: oidcConfiguration
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-public-high
+func (h *Handler) discoverOAuth2AuthorizationServer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// https://www.rfc-editor.org/rfc/rfc8414#section-3.1
+	if path := r.PathValue("path"); path != "" && strings.Trim(path, "/") != strings.Trim(h.c.IssuerURL(ctx).Path, "/") {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrNotFound().WithReasonf("The issuer does not have the path %q.", "/"+path)))
+		return
+	}
+
+	metadata, err := h.authorizationServerMetadata(ctx)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	h.r.Writer().Write(w, r, metadata)
+}
+
+func (h *Handler) authorizationServerMetadata(ctx context.Context) (map[string]any, error) {
+	conf, err := h.oidcConfiguration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The introspection endpoint of the admin API is not advertised, because it is not reachable by clients.
+	if h.c.PublicIntrospectionEnabled(ctx) {
+		conf.IntrospectionEndpoint = urlx.AppendPaths(h.c.IssuerURL(ctx), IntrospectPath).String()
+		// Public clients can not introspect tokens.
+		for _, m := range conf.TokenEndpointAuthMethodsSupported {
+			if m != "none" {
+				conf.IntrospectionEndpointAuthMethodsSupported = append(conf.IntrospectionEndpointAuthMethodsSupported, m)
+			}
+		}
+	}
+	conf.RevocationEndpointAuthMethodsSupported = conf.TokenEndpointAuthMethodsSupported
+
+	raw, err := json.Marshal(conf)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var metadata map[string]any
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if !h.c.SignedMetadataEnabled(ctx) {
+		return metadata, nil
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc8414#section-2.1
+	claims := jwt.MapClaims{"iss": conf.Issuer, "iat": time.Now().Unix()}
+	for k, v := range metadata {
+		claims[k] = v
+	}
+
+	signer := h.r.MetadataJWTSigner()
+	keyID, err := signer.GetPublicKeyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metadata["signed_metadata"], _, err = signer.Generate(ctx, claims, &jwt.Headers{
+		Extra: map[string]any{"kid": keyID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
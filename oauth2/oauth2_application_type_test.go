@@ -0,0 +1,64 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+)
+
+func TestNativeApplicationType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reg := testhelpers.NewRegistryMemory(t)
+	_, adminTS := testhelpers.NewOAuth2Server(ctx, t, reg)
+	adminClient := hydra.NewAPIClient(hydra.NewConfiguration())
+	adminClient.GetConfig().Servers = hydra.ServerConfigurations{{URL: adminTS.URL}}
+	subject := "aeneas-rekkas"
+
+	c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler), func(c *client.Client) {
+		c.ApplicationType = client.ApplicationTypeNative
+		c.Secret = ""
+		c.TokenEndpointAuthMethod = "none"
+		c.ResponseTypes = []string{"code"}
+		c.GrantTypes = []string{"authorization_code", "refresh_token"}
+	})
+	conf.ClientSecret = ""
+	conf.Endpoint.AuthStyle = oauth2.AuthStyleInParams
+
+	testhelpers.NewLoginConsentUI(t, reg.Config(),
+		acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+		acceptConsentHandler(t, c, adminClient, reg, subject, nil),
+	)
+
+	t.Run("case=rejects authorization requests without PKCE", func(t *testing.T) {
+		res, err := testhelpers.NewEmptyJarClient(t).Get(conf.AuthCodeURL(uuid.New()))
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		assert.Equal(t, "invalid_request", res.Request.URL.Query().Get("error"), "%s", res.Request.URL)
+	})
+
+	t.Run("case=performs the authorization code flow with PKCE", func(t *testing.T) {
+		verifier := oauth2.GenerateVerifier()
+		res, err := testhelpers.NewEmptyJarClient(t).Get(conf.AuthCodeURL(uuid.New(), oauth2.S256ChallengeOption(verifier)))
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		code := res.Request.URL.Query().Get("code")
+		require.NotEmpty(t, code, "%s", res.Request.URL)
+
+		token, err := conf.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+		require.NoError(t, err)
+		assert.NotEmpty(t, token.AccessToken)
+	})
+}
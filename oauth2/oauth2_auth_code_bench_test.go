@@ -47,6 +47,7 @@ var (
 	prof    = flag.String("profile", "", "write a CPU profile to this filename")
 	conc    = flag.Int("conc", 100, "dispatch this many requests concurrently")
 	tracing = flag.Bool("tracing", false, "send OpenTelemetry traces to localhost:4318")
+	refresh = flag.Int("refreshes", 5, "refresh the tokens of every authorization this many times in the refresh token benchmarks")
 )
 
 func BenchmarkAuthCode(b *testing.B) {
@@ -115,6 +116,7 @@ func BenchmarkAuthCode(b *testing.B) {
 		return c, &oauth2.Config{
 			ClientID:     c.GetID(),
 			ClientSecret: secret,
+			RedirectURL:  cb,
 			Endpoint: oauth2.Endpoint{
 				AuthURL:   authURL,
 				TokenURL:  tokenURL,
@@ -222,8 +224,9 @@ func BenchmarkAuthCode(b *testing.B) {
 		}), "acceptConsentHandler").ServeHTTP
 	}
 
-	run := func(b *testing.B, strategy string) func(*testing.B) {
+	run := func(b *testing.B, strategy, refreshStrategy string, refreshes int) func(*testing.B) {
 		reg.Config().MustSet(ctx, config.KeyAccessTokenStrategy, strategy)
+		reg.Config().MustSet(ctx, config.KeyRefreshTokenStrategy, refreshStrategy)
 		c, conf := newOAuth2Client(b, testhelpers.NewCallbackURL(b, "callback", testhelpers.HTTPServerNotImplementedHandler))
 		testhelpers.NewLoginConsentUI(b, reg.Config(),
 			acceptLoginHandler(b, c, nil),
@@ -234,18 +237,20 @@ func BenchmarkAuthCode(b *testing.B) {
 			code, _ := getAuthorizeCode(ctx, b, conf, nil, oauth2.SetAuthURLParam("nonce", nonce))
 			require.NotEmpty(b, code)
 
-			_, err := conf.Exchange(ctx, code)
+			token, err := conf.Exchange(ctx, code)
 			require.NoError(b, err)
+
+			for range refreshes {
+				// Force the token source to refresh the token.
+				token.Expiry = time.Now().Add(-time.Hour)
+				token, err = conf.TokenSource(ctx, token).Token()
+				require.NoError(b, err)
+			}
 		}
 	}
 
-	b.ResetTimer()
-
-	b.SetParallelism(*conc / runtime.GOMAXPROCS(0))
-
-	b.Run("strategy=jwt", func(b *testing.B) {
+	measure := func(b *testing.B, B func(*testing.B)) {
 		initialDBSpans := dbSpans(spans)
-		B := run(b, "jwt")
 
 		stop := profile(b)
 		defer stop()
@@ -264,29 +269,28 @@ func BenchmarkAuthCode(b *testing.B) {
 		b.ReportMetric(float64(atomic.LoadInt64(&totalMS))/float64(b.N), "ms/op")
 		b.ReportMetric((float64(dbSpans(spans)-initialDBSpans))/float64(b.N), "queries/op")
 		b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "ops/s")
+	}
+
+	b.ResetTimer()
+
+	b.SetParallelism(*conc / runtime.GOMAXPROCS(0))
+
+	b.Run("strategy=jwt", func(b *testing.B) {
+		measure(b, run(b, "jwt", config.RefreshTokenOpaqueStrategy, 0))
 	})
 
 	b.Run("strategy=opaque", func(b *testing.B) {
-		initialDBSpans := dbSpans(spans)
-		B := run(b, "opaque")
-
-		stop := profile(b)
-		defer stop()
+		measure(b, run(b, "opaque", config.RefreshTokenOpaqueStrategy, 0))
+	})
 
-		var totalMS int64 = 0
-		b.RunParallel(func(p *testing.PB) {
-			defer func(t0 time.Time) {
-				atomic.AddInt64(&totalMS, int64(time.Since(t0).Milliseconds()))
-			}(time.Now())
-			for p.Next() {
-				B(b)
-			}
-		})
+	// The refresh token benchmarks refresh the tokens of every authorization, so their difference to
+	// strategy=opaque is the cost of the refreshes.
+	b.Run("strategy=opaque/refresh_token=opaque", func(b *testing.B) {
+		measure(b, run(b, "opaque", config.RefreshTokenOpaqueStrategy, *refresh))
+	})
 
-		b.ReportMetric(0, "ns/op")
-		b.ReportMetric(float64(atomic.LoadInt64(&totalMS))/float64(b.N), "ms/op")
-		b.ReportMetric((float64(dbSpans(spans)-initialDBSpans))/float64(b.N), "queries/op")
-		b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "ops/s")
+	b.Run("strategy=opaque/refresh_token=stateless", func(b *testing.B) {
+		measure(b, run(b, "opaque", config.RefreshTokenStatelessStrategy, *refresh))
 	})
 }
 
@@ -50,6 +50,7 @@ import (
 	"github.com/ory/x/josex"
 	"github.com/ory/x/pointerx"
 	"github.com/ory/x/snapshotx"
+	"github.com/ory/x/sqlxx"
 )
 
 func noopHandler(*testing.T) http.HandlerFunc {
@@ -1326,6 +1327,207 @@ func TestAuthCodeWithDefaultStrategy(t *testing.T) {
 				assert.True(t, rt2.Get("active").Bool(), "%s", rt2)
 			})
 
+			t.Run("case=stateless refresh tokens", func(t *testing.T) {
+				reg.Config().MustSet(ctx, config.KeyRefreshTokenStrategy, config.RefreshTokenStatelessStrategy)
+				t.Cleanup(func() { reg.Config().MustSet(ctx, config.KeyRefreshTokenStrategy, config.RefreshTokenOpaqueStrategy) })
+
+				c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler))
+				testhelpers.NewLoginConsentUI(t, reg.Config(),
+					acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+					acceptConsentHandler(t, c, adminClient, reg, subject, nil),
+				)
+
+				issue := func(t *testing.T) *oauth2.Token {
+					code, _ := getAuthorizeCode(t, conf, nil)
+					require.NotEmpty(t, code)
+					token, err := conf.Exchange(context.Background(), code)
+					require.NoError(t, err)
+					require.True(t, hydraoauth2.IsStatelessRefreshToken(token.RefreshToken), "%s", token.RefreshToken)
+					return token
+				}
+
+				refresh := func(t *testing.T, token *oauth2.Token) (*oauth2.Token, error) {
+					token.Expiry = token.Expiry.Add(-time.Hour * 24)
+					return conf.TokenSource(context.Background(), token).Token()
+				}
+
+				t.Run("case=rotates and detects reuse", func(t *testing.T) {
+					token := issue(t)
+					iat := time.Now()
+					assertRefreshToken(t, token, conf, iat.Add(reg.Config().GetRefreshTokenLifespan(ctx)))
+
+					refreshed, err := refresh(t, token)
+					require.NoError(t, err)
+					require.True(t, hydraoauth2.IsStatelessRefreshToken(refreshed.RefreshToken))
+					require.NotEqual(t, token.RefreshToken, refreshed.RefreshToken)
+					introspectAccessToken(t, conf, refreshed, subject)
+
+					i := testhelpers.IntrospectToken(t, refreshed.RefreshToken, adminTS)
+					assert.True(t, i.Get("active").Bool(), "%s", i)
+					i = testhelpers.IntrospectToken(t, token.RefreshToken, adminTS)
+					assert.False(t, i.Get("active").Bool(), "%s", i)
+					i = testhelpers.IntrospectToken(t, token.AccessToken, adminTS)
+					assert.False(t, i.Get("active").Bool(), "%s", i)
+
+					_, err = refresh(t, &oauth2.Token{RefreshToken: token.RefreshToken})
+					require.Error(t, err)
+
+					// Reusing the old token revoked the whole family.
+					_, err = refresh(t, refreshed)
+					require.Error(t, err)
+					i = testhelpers.IntrospectToken(t, refreshed.RefreshToken, adminTS)
+					assert.False(t, i.Get("active").Bool(), "%s", i)
+					i = testhelpers.IntrospectToken(t, refreshed.AccessToken, adminTS)
+					assert.False(t, i.Get("active").Bool(), "%s", i)
+				})
+
+				t.Run("case=revoking the consent revokes the family", func(t *testing.T) {
+					token := issue(t)
+					refreshed, err := refresh(t, token)
+					require.NoError(t, err)
+
+					_, err = adminClient.OAuth2API.RevokeOAuth2ConsentSessions(context.Background()).Subject(subject).Client(c.GetID()).Execute()
+					require.NoError(t, err)
+
+					i := testhelpers.IntrospectToken(t, refreshed.RefreshToken, adminTS)
+					assert.False(t, i.Get("active").Bool(), "%s", i)
+					_, err = refresh(t, refreshed)
+					require.Error(t, err)
+				})
+
+				t.Run("case=opaque refresh tokens remain valid", func(t *testing.T) {
+					reg.Config().MustSet(ctx, config.KeyRefreshTokenStrategy, config.RefreshTokenOpaqueStrategy)
+					code, _ := getAuthorizeCode(t, conf, nil)
+					require.NotEmpty(t, code)
+					token, err := conf.Exchange(context.Background(), code)
+					require.NoError(t, err)
+					require.False(t, hydraoauth2.IsStatelessRefreshToken(token.RefreshToken))
+
+					reg.Config().MustSet(ctx, config.KeyRefreshTokenStrategy, config.RefreshTokenStatelessStrategy)
+					refreshed, err := refresh(t, token)
+					require.NoError(t, err)
+					require.True(t, hydraoauth2.IsStatelessRefreshToken(refreshed.RefreshToken))
+
+					refreshed, err = refresh(t, refreshed)
+					require.NoError(t, err)
+					i := testhelpers.IntrospectToken(t, refreshed.RefreshToken, adminTS)
+					assert.True(t, i.Get("active").Bool(), "%s", i)
+				})
+			})
+
+			t.Run("case=per-client refresh token policy", func(t *testing.T) {
+				run := func(t *testing.T, opts ...func(*client.Client)) (issue func(t *testing.T) *oauth2.Token, refresh func(t *testing.T, token *oauth2.Token) (*oauth2.Token, error)) {
+					c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler), opts...)
+					testhelpers.NewLoginConsentUI(t, reg.Config(),
+						acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+						acceptConsentHandler(t, c, adminClient, reg, subject, nil),
+					)
+
+					return func(t *testing.T) *oauth2.Token {
+							code, _ := getAuthorizeCode(t, conf, nil)
+							require.NotEmpty(t, code)
+							token, err := conf.Exchange(context.Background(), code)
+							require.NoError(t, err)
+							return token
+						}, func(t *testing.T, token *oauth2.Token) (*oauth2.Token, error) {
+							token = &oauth2.Token{RefreshToken: token.RefreshToken, Expiry: time.Now().Add(-time.Hour)}
+							return conf.TokenSource(context.Background(), token).Token()
+						}
+				}
+
+				t.Run("case=rotation disabled", func(t *testing.T) {
+					issue, refresh := run(t, func(c *client.Client) { c.RefreshTokenRotationDisabled = true })
+					token := issue(t)
+
+					for range 2 {
+						refreshed, err := refresh(t, token)
+						require.NoError(t, err)
+						assert.Equal(t, token.RefreshToken, refreshed.RefreshToken)
+						assert.NotEqual(t, token.AccessToken, refreshed.AccessToken)
+						i := testhelpers.IntrospectToken(t, refreshed.AccessToken, adminTS)
+						assert.True(t, i.Get("active").Bool(), "%s", i)
+					}
+
+					i := testhelpers.IntrospectToken(t, token.RefreshToken, adminTS)
+					assert.True(t, i.Get("active").Bool(), "%s", i)
+				})
+
+				t.Run("case=rotation grace period", func(t *testing.T) {
+					issue, refresh := run(t, func(c *client.Client) {
+						c.RefreshTokenRotationGracePeriod = x.NullDuration{Duration: time.Minute, Valid: true}
+						c.RefreshTokenRotationGraceReuseCount = sqlxx.NullInt64{Int: 2, Valid: true}
+					})
+					token := issue(t)
+
+					refreshed, err := refresh(t, token)
+					require.NoError(t, err)
+					require.NotEqual(t, token.RefreshToken, refreshed.RefreshToken)
+
+					// The rotated token can be used twice in total within the grace period.
+					_, err = refresh(t, token)
+					require.NoError(t, err)
+					_, err = refresh(t, token)
+					require.Error(t, err)
+				})
+
+				t.Run("case=maximum lifespan exceeded", func(t *testing.T) {
+					issue, refresh := run(t, func(c *client.Client) {
+						c.RefreshTokenMaxLifespan = x.NullDuration{Duration: time.Nanosecond, Valid: true}
+					})
+					token := issue(t)
+
+					_, err := refresh(t, token)
+					require.ErrorContains(t, err, "invalid_grant")
+				})
+			})
+
+			t.Run("case=per-client ID token signing algorithm", func(t *testing.T) {
+				// The newest key of the set is the default key, so the ES256 key is only used if requested by the client.
+				ks, err := reg.KeyManager().GenerateAndPersistKeySet(ctx, x.OpenIDConnectKeyName, "id-token-eddsa", string(jose.EdDSA), "sig")
+				require.NoError(t, err)
+				t.Cleanup(func() {
+					require.NoError(t, reg.KeyManager().DeleteKey(context.Background(), x.OpenIDConnectKeyName, ks.Keys[0].KeyID))
+				})
+
+				run := func(t *testing.T, alg string) *jwt.Token {
+					c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler), func(c *client.Client) {
+						c.IDTokenSignedResponseAlg = alg
+					})
+					testhelpers.NewLoginConsentUI(t, reg.Config(),
+						acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+						acceptConsentHandler(t, c, adminClient, reg, subject, nil),
+					)
+
+					code, _ := getAuthorizeCode(t, conf, nil)
+					require.NotEmpty(t, code)
+					token, err := conf.Exchange(context.Background(), code)
+					require.NoError(t, err)
+					idt, ok := token.Extra("id_token").(string)
+					require.True(t, ok)
+
+					parsed, err := jwt.Parse(idt, func(token *jwt.Token) (interface{}, error) {
+						kid, _ := token.Header["kid"].(string)
+						key, err := reg.KeyManager().GetKey(ctx, x.OpenIDConnectKeyName, kid)
+						require.NoError(t, err)
+						return jwk.ExcludePrivateKeys(key).Keys[0].Key, nil
+					})
+					require.NoError(t, err)
+					return parsed
+				}
+
+				t.Run("case=default key", func(t *testing.T) {
+					token := run(t, "")
+					assert.Equal(t, string(jose.EdDSA), token.Header["alg"])
+					assert.Equal(t, "id-token-eddsa", token.Header["kid"])
+				})
+
+				t.Run("case=client algorithm", func(t *testing.T) {
+					token := run(t, string(jose.ES256))
+					assert.Equal(t, string(jose.ES256), token.Header["alg"])
+					assert.NotEqual(t, "id-token-eddsa", token.Header["kid"])
+				})
+			})
+
 			t.Run("case=graceful token rotation", func(t *testing.T) {
 				reg.Config().MustSet(ctx, config.KeyRefreshTokenRotationGracePeriod, "2s")
 				reg.Config().Delete(ctx, config.KeyTokenHook)
@@ -2029,6 +2231,7 @@ func TestAuthCodeWithMockStrategy(t *testing.T) {
 						return func(w http.ResponseWriter, r *http.Request) {
 							require.Empty(t, r.URL.Query().Get("code"))
 							assert.Equal(t, fosite.ErrRequestForbidden.Error(), r.URL.Query().Get("error"))
+							assert.Equal(t, reg.Config().IssuerURL(ctx).String(), r.URL.Query().Get("iss"))
 						}
 					},
 				},
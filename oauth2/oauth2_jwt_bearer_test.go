@@ -261,6 +261,114 @@ func TestJWTBearer(t *testing.T) {
 		t.Run("strategy=jwt", run("jwt"))
 	})
 
+	t.Run("case=federated issuer", func(t *testing.T) {
+		fedKid := uuid.Must(uuid.NewV4()).String()
+		fedKeys, err := jwk.GenerateJWK(jose.RS256, fedKid, "sig")
+		require.NoError(t, err)
+
+		var issuer string
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/jwks.json"})
+		})
+		mux.HandleFunc("/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{fedKeys.Keys[0].Public()}})
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		issuer = server.URL
+
+		fedSigner := jwk.NewDefaultJWTSigner(reg, set)
+		fedSigner.GetPrivateKey = func(ctx context.Context) (interface{}, error) {
+			return fedKeys.Keys[0], nil
+		}
+
+		fedClient := &hc.Client{
+			Secret:     secret,
+			GrantTypes: []string{"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+			Scope:      "offline_access deploy",
+		}
+		require.NoError(t, reg.ClientManager().CreateClient(ctx, fedClient))
+
+		exchange := func(t *testing.T, claims jwt.MapClaims, scopes ...string) (*goauth2.Token, error) {
+			claims["jti"] = uuid.Must(uuid.NewV4()).String()
+			claims["iss"] = issuer
+			claims["aud"] = reg.Config().OAuth2TokenURL(ctx).String()
+			claims["exp"] = time.Now().Add(time.Hour).Unix()
+			claims["iat"] = time.Now().Add(-time.Minute).Unix()
+			token, _, err := fedSigner.Generate(ctx, claims, &jwt.Headers{Extra: map[string]interface{}{"kid": fedKid}})
+			require.NoError(t, err)
+
+			conf := newConf(fedClient)
+			conf.Scopes = scopes
+			conf.EndpointParams = url.Values{"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"}, "assertion": {token}}
+			return getToken(t, conf)
+		}
+
+		t.Run("jwks_uri", func(t *testing.T) {
+			grant := trust.Grant{
+				ID:              uuid.Must(uuid.NewV4()),
+				Issuer:          issuer,
+				AllowAnySubject: true,
+				Scope:           []string{"offline_access"},
+				ExpiresAt:       time.Now().Add(time.Hour),
+				JWKSURI:         issuer + "/jwks.json",
+				ClaimConditions: trust.ClaimConditions{"repository": "ory/*"},
+				ClaimMappings: trust.ClaimMappings{{
+					Claim:    "ref",
+					Value:    "refs/heads/main",
+					Scope:    []string{"deploy"},
+					Audience: []string{"https://api.example.com"},
+				}},
+			}
+			require.NoError(t, reg.GrantManager().CreateGrant(ctx, grant, jose.JSONWebKey{}))
+			t.Cleanup(func() { _ = reg.GrantManager().DeleteGrant(ctx, grant.ID) })
+
+			t.Run("case=claim condition not met", func(t *testing.T) {
+				_, err := exchange(t, jwt.MapClaims{"sub": "repo:other/hydra", "repository": "other/hydra"}, "offline_access")
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "public key is required to check signature of JWT")
+			})
+
+			t.Run("case=mapped scope not granted", func(t *testing.T) {
+				_, err := exchange(t, jwt.MapClaims{"sub": "repo:ory/hydra", "repository": "ory/hydra", "ref": "refs/heads/feature"}, "deploy")
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "deploy")
+			})
+
+			t.Run("case=mapped scope and audience granted", func(t *testing.T) {
+				token, err := exchange(t, jwt.MapClaims{"sub": "repo:ory/hydra", "repository": "ory/hydra", "ref": "refs/heads/main"}, "offline_access", "deploy")
+				require.NoError(t, err)
+
+				introspection := testhelpers.IntrospectToken(t, token.AccessToken, admin)
+				assert.True(t, introspection.Get("active").Bool(), "%s", introspection.Raw)
+				assert.EqualValues(t, "repo:ory/hydra", introspection.Get("sub").String(), "%s", introspection.Raw)
+				assert.EqualValues(t, "offline_access deploy", introspection.Get("scope").String(), "%s", introspection.Raw)
+				assert.Contains(t, introspection.Get("aud").Raw, "https://api.example.com", "%s", introspection.Raw)
+			})
+		})
+
+		t.Run("oidc_discovery", func(t *testing.T) {
+			grant := trust.Grant{
+				ID:            uuid.Must(uuid.NewV4()),
+				Issuer:        issuer,
+				Subject:       "workload",
+				Scope:         []string{"offline_access"},
+				ExpiresAt:     time.Now().Add(time.Hour),
+				OIDCDiscovery: true,
+			}
+			require.NoError(t, reg.GrantManager().CreateGrant(ctx, grant, jose.JSONWebKey{}))
+			t.Cleanup(func() { _ = reg.GrantManager().DeleteGrant(ctx, grant.ID) })
+
+			token, err := exchange(t, jwt.MapClaims{"sub": "workload"}, "offline_access")
+			require.NoError(t, err)
+
+			introspection := testhelpers.IntrospectToken(t, token.AccessToken, admin)
+			assert.True(t, introspection.Get("active").Bool(), "%s", introspection.Raw)
+			assert.EqualValues(t, "workload", introspection.Get("sub").String(), "%s", introspection.Raw)
+		})
+	})
+
 	t.Run("case=should accept issuer-derived audience when issuer differs from public URL", func(t *testing.T) {
 		run := func(strategy string) func(t *testing.T) {
 			return func(t *testing.T) {
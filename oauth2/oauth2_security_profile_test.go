@@ -0,0 +1,323 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"golang.org/x/oauth2"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	hydraoauth2 "github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/configx"
+)
+
+func signJWT(t *testing.T, key jose.SigningKey, opts *jose.SignerOptions, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(key, opts)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	token, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestPushedAuthorizationAndSecurityProfile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for dbName, reg := range testhelpers.ConnectDatabases(t, true, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyAccessTokenStrategy: "opaque",
+	}))) {
+		t.Run("registry="+dbName, func(t *testing.T) {
+			t.Parallel()
+
+			publicTS, adminTS := testhelpers.NewOAuth2Server(ctx, t, reg)
+			adminClient := hydra.NewAPIClient(hydra.NewConfiguration())
+			adminClient.GetConfig().Servers = hydra.ServerConfigurations{{URL: adminTS.URL}}
+			subject := "aeneas-rekkas"
+
+			verifier := "security-profile-code-verifier-" + uuid.New()
+			challenge := sha256.Sum256([]byte(verifier))
+			codeChallenge := base64.RawURLEncoding.EncodeToString(challenge[:])
+
+			post := func(t *testing.T, u string, params url.Values, header http.Header) (*http.Response, gjson.Result) {
+				req, err := http.NewRequest(http.MethodPost, u, strings.NewReader(params.Encode()))
+				require.NoError(t, err)
+				for k, v := range header {
+					req.Header[k] = v
+				}
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				res, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				defer res.Body.Close() //nolint:errcheck
+				body, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+				return res, gjson.ParseBytes(body)
+			}
+
+			authorize := func(t *testing.T, conf *oauth2.Config, clientID, requestURI string) *http.Response {
+				u := publicTS.URL + "/oauth2/auth?" + url.Values{"client_id": {clientID}, "request_uri": {requestURI}}.Encode()
+				if requestURI == "" {
+					u = conf.AuthCodeURL(uuid.New())
+				}
+				res, err := testhelpers.NewEmptyJarClient(t).Get(u)
+				require.NoError(t, err)
+				require.NoError(t, res.Body.Close())
+				return res
+			}
+
+			t.Run("case=client without security profile", func(t *testing.T) {
+				c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler))
+				testhelpers.NewLoginConsentUI(t, reg.Config(),
+					acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+					acceptConsentHandler(t, c, adminClient, reg, subject, nil),
+				)
+
+				push := func(t *testing.T) string {
+					state := uuid.New()
+					res, body := post(t, publicTS.URL+"/oauth2/par", url.Values{
+						"response_type": {"code"},
+						"scope":         {"hydra offline openid"},
+						"redirect_uri":  {conf.RedirectURL},
+						"state":         {state},
+					}, http.Header{"Authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte(c.GetID()+":"+conf.ClientSecret))}})
+					require.Equal(t, http.StatusCreated, res.StatusCode, "%s", body)
+					assert.True(t, strings.HasPrefix(body.Get("request_uri").String(), hydraoauth2.PushedAuthorizeRequestURIPrefix), "%s", body)
+					assert.EqualValues(t, 90, body.Get("expires_in").Int(), "%s", body)
+					return body.Get("request_uri").String()
+				}
+
+				t.Run("case=authorization code flow with pushed authorization request", func(t *testing.T) {
+					res := authorize(t, conf, c.GetID(), push(t))
+					code := res.Request.URL.Query().Get("code")
+					require.NotEmpty(t, code, "%s", res.Request.URL)
+					assert.Equal(t, reg.Config().IssuerURL(ctx).String(), res.Request.URL.Query().Get("iss"))
+
+					token, err := conf.Exchange(ctx, code)
+					require.NoError(t, err)
+					assert.Equal(t, "bearer", token.TokenType)
+				})
+
+				t.Run("case=rejects unknown request URI", func(t *testing.T) {
+					res := authorize(t, conf, c.GetID(), hydraoauth2.PushedAuthorizeRequestURIPrefix+"unknown")
+					assert.Equal(t, "invalid_request_uri", res.Request.URL.Query().Get("error"), "%s", res.Request.URL)
+				})
+
+				t.Run("case=rejects authorization requests which were not pushed if enforced", func(t *testing.T) {
+					reg.Config().MustSet(ctx, config.KeyPushedAuthorizeRequestsEnforced, true)
+					t.Cleanup(func() { reg.Config().MustSet(ctx, config.KeyPushedAuthorizeRequestsEnforced, false) })
+
+					res := authorize(t, conf, c.GetID(), "")
+					assert.Equal(t, "invalid_request", res.Request.URL.Query().Get("error"), "%s", res.Request.URL)
+				})
+			})
+
+			t.Run("case=client with security profile", func(t *testing.T) {
+				clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				require.NoError(t, err)
+				dpopKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				require.NoError(t, err)
+				thumbprint, err := (&jose.JSONWebKey{Key: &dpopKey.PublicKey}).Thumbprint(crypto.SHA256)
+				require.NoError(t, err)
+				jkt := base64.RawURLEncoding.EncodeToString(thumbprint)
+
+				c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler), func(c *client.Client) {
+					c.SecurityProfile = client.SecurityProfileFAPI2Security
+					c.TokenEndpointAuthMethod = "private_key_jwt"
+					c.TokenEndpointAuthSigningAlgorithm = "ES256"
+					c.JSONWebKeys = &x.JoseJSONWebKeySet{JSONWebKeySet: &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+						{Key: &clientKey.PublicKey, KeyID: "client", Algorithm: "ES256", Use: "sig"},
+					}}}
+					c.ResponseTypes = []string{"code"}
+					c.GrantTypes = []string{"authorization_code", "refresh_token"}
+				})
+				testhelpers.NewLoginConsentUI(t, reg.Config(),
+					acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+					acceptConsentHandler(t, c, adminClient, reg, subject, nil),
+				)
+
+				withClientAssertion := func(t *testing.T, params url.Values) url.Values {
+					params.Set("client_id", c.GetID())
+					params.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+					params.Set("client_assertion", signJWT(t,
+						jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: clientKey, KeyID: "client"}},
+						nil,
+						map[string]interface{}{
+							"iss": c.GetID(),
+							"sub": c.GetID(),
+							"aud": reg.Config().OAuth2TokenURL(ctx).String(),
+							"jti": uuid.New(),
+							"iat": time.Now().Unix(),
+							"exp": time.Now().Add(time.Minute).Unix(),
+						}))
+					return params
+				}
+
+				dpopProof := func(t *testing.T, method, u, accessToken string) http.Header {
+					claims := map[string]interface{}{"jti": uuid.New(), "htm": method, "htu": u, "iat": time.Now().Unix()}
+					if accessToken != "" {
+						hash := sha256.Sum256([]byte(accessToken))
+						claims["ath"] = base64.RawURLEncoding.EncodeToString(hash[:])
+					}
+					return http.Header{"Dpop": {signJWT(t,
+						jose.SigningKey{Algorithm: jose.ES256, Key: dpopKey},
+						(&jose.SignerOptions{EmbedJWK: true}).WithType("dpop+jwt"),
+						claims)}}
+				}
+
+				push := func(t *testing.T, params url.Values) (*http.Response, gjson.Result) {
+					return post(t, publicTS.URL+"/oauth2/par", withClientAssertion(t, params), nil)
+				}
+
+				authorizationCode := func(t *testing.T) string {
+					state := uuid.New()
+					res, body := push(t, url.Values{
+						"response_type":         {"code"},
+						"scope":                 {"hydra offline openid"},
+						"redirect_uri":          {conf.RedirectURL},
+						"state":                 {state},
+						"code_challenge":        {codeChallenge},
+						"code_challenge_method": {"S256"},
+					})
+					require.Equal(t, http.StatusCreated, res.StatusCode, "%s", body)
+
+					res = authorize(t, conf, c.GetID(), body.Get("request_uri").String())
+					q := res.Request.URL.Query()
+					require.NotEmpty(t, q.Get("code"), "%s", res.Request.URL)
+					assert.Equal(t, state, q.Get("state"))
+					assert.Equal(t, reg.Config().IssuerURL(ctx).String(), q.Get("iss"))
+					return q.Get("code")
+				}
+
+				exchange := func(t *testing.T, params url.Values, header http.Header) (*http.Response, gjson.Result) {
+					return post(t, reg.Config().OAuth2TokenURL(ctx).String(), withClientAssertion(t, params), header)
+				}
+
+				exchangeCode := func(t *testing.T, code string, header http.Header) (*http.Response, gjson.Result) {
+					return exchange(t, url.Values{
+						"grant_type":    {"authorization_code"},
+						"code":          {code},
+						"redirect_uri":  {conf.RedirectURL},
+						"code_verifier": {verifier},
+					}, header)
+				}
+
+				t.Run("case=rejects authorization requests which were not pushed", func(t *testing.T) {
+					res := authorize(t, conf, c.GetID(), "")
+					assert.Equal(t, "invalid_request", res.Request.URL.Query().Get("error"), "%s", res.Request.URL)
+				})
+
+				t.Run("case=rejects pushed authorization requests without S256 PKCE", func(t *testing.T) {
+					res, body := push(t, url.Values{
+						"response_type": {"code"},
+						"scope":         {"hydra offline openid"},
+						"redirect_uri":  {conf.RedirectURL},
+						"state":         {uuid.New()},
+					})
+					assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+					assert.Equal(t, "invalid_request", body.Get("error").String(), "%s", body)
+					assert.Contains(t, body.Get("error_description").String(), "S256", "%s", body)
+				})
+
+				t.Run("case=caps the authorization code lifespan", func(t *testing.T) {
+					code := authorizationCode(t)
+					signature := reg.OAuth2HMACStrategy().AuthorizeCodeSignature(ctx, code)
+					ar, err := reg.OAuth2Storage().GetAuthorizeCodeSession(ctx, code, signature, hydraoauth2.NewTestSession(t, ""))
+					require.NoError(t, err)
+					assert.WithinDuration(t, time.Now().Add(time.Minute), ar.GetSession().GetExpiresAt(fosite.AuthorizeCode), 5*time.Second)
+				})
+
+				t.Run("case=rejects token requests without DPoP proof", func(t *testing.T) {
+					res, body := exchangeCode(t, authorizationCode(t), nil)
+					assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+					assert.Equal(t, "invalid_dpop_proof", body.Get("error").String(), "%s", body)
+				})
+
+				t.Run("case=rejects DPoP proofs for another endpoint", func(t *testing.T) {
+					res, body := exchangeCode(t, authorizationCode(t), dpopProof(t, http.MethodPost, publicTS.URL+"/oauth2/revoke", ""))
+					assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+					assert.Equal(t, "invalid_dpop_proof", body.Get("error").String(), "%s", body)
+				})
+
+				t.Run("case=issues DPoP-bound tokens", func(t *testing.T) {
+					res, token := exchangeCode(t, authorizationCode(t), dpopProof(t, http.MethodPost, reg.Config().OAuth2TokenURL(ctx).String(), ""))
+					require.Equal(t, http.StatusOK, res.StatusCode, "%s", token)
+					assert.Equal(t, "DPoP", token.Get("token_type").String(), "%s", token)
+					accessToken := token.Get("access_token").String()
+
+					i := testhelpers.IntrospectToken(t, accessToken, adminTS)
+					assert.Equal(t, jkt, i.Get("cnf.jkt").String(), "%s", i)
+					assert.Equal(t, "DPoP", i.Get("token_type").String(), "%s", i)
+
+					userinfo := func(t *testing.T, scheme string, header http.Header) (*http.Response, gjson.Result) {
+						req, err := http.NewRequest(http.MethodGet, publicTS.URL+"/userinfo", nil)
+						require.NoError(t, err)
+						for k, v := range header {
+							req.Header[k] = v
+						}
+						req.Header.Set("Authorization", scheme+" "+accessToken)
+						res, err := http.DefaultClient.Do(req)
+						require.NoError(t, err)
+						defer res.Body.Close() //nolint:errcheck
+						body, err := io.ReadAll(res.Body)
+						require.NoError(t, err)
+						return res, gjson.ParseBytes(body)
+					}
+
+					t.Run("case=rejects bearer scheme", func(t *testing.T) {
+						res, body := userinfo(t, "Bearer", nil)
+						assert.Equal(t, http.StatusUnauthorized, res.StatusCode, "%s", body)
+					})
+
+					t.Run("case=accepts DPoP scheme", func(t *testing.T) {
+						proof := dpopProof(t, http.MethodGet, reg.Config().OIDCDiscoveryUserinfoEndpoint(ctx).String(), accessToken)
+						res, body := userinfo(t, "DPoP", proof)
+						require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+						assert.Equal(t, subject, body.Get("sub").String(), "%s", body)
+
+						res, body = userinfo(t, "DPoP", proof)
+						assert.Equal(t, http.StatusUnauthorized, res.StatusCode, "%s", body)
+						assert.Contains(t, res.Header.Get("WWW-Authenticate"), `error="invalid_dpop_proof"`)
+					})
+
+					t.Run("case=refreshes with DPoP proof", func(t *testing.T) {
+						res, body := exchange(t, url.Values{
+							"grant_type":    {"refresh_token"},
+							"refresh_token": {token.Get("refresh_token").String()},
+						}, dpopProof(t, http.MethodPost, reg.Config().OAuth2TokenURL(ctx).String(), ""))
+						require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+						assert.Equal(t, "DPoP", body.Get("token_type").String(), "%s", body)
+					})
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/x"
+)
+
+// PushedAuthorizeRequestURIPrefix is the prefix of the request URIs returned by the pushed authorization request
+// endpoint.
+const PushedAuthorizeRequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+
+// pushedAuthorizeRequestCredentials are the form parameters authenticating the client at the pushed authorization
+// request endpoint. They are not stored with the pushed authorization request.
+var pushedAuthorizeRequestCredentials = []string{"client_secret", "client_assertion", "client_assertion_type"}
+
+// PushedAuthorizeRequestToStore returns the request to store for a pushed authorization request. The client
+// credentials are removed from its form, and the redirect URI and response mode resolved by the pushed authorization
+// request endpoint are added to it, so that RestorePushedAuthorizeRequest can restore them.
+func PushedAuthorizeRequestToStore(ar fosite.AuthorizeRequester) fosite.Requester {
+	allowed := make([]string, 0, len(ar.GetRequestForm()))
+	for k := range ar.GetRequestForm() {
+		if !slices.Contains(pushedAuthorizeRequestCredentials, k) {
+			allowed = append(allowed, k)
+		}
+	}
+
+	r := ar.Sanitize(allowed)
+	if ar.GetRedirectURI() != nil {
+		r.GetRequestForm().Set("redirect_uri", ar.GetRedirectURI().String())
+	}
+	if ar.GetResponseMode() != fosite.ResponseModeDefault {
+		r.GetRequestForm().Set("response_mode", string(ar.GetResponseMode()))
+	}
+	return r
+}
+
+// RestorePushedAuthorizeRequest restores a pushed authorization request stored with PushedAuthorizeRequestToStore.
+func RestorePushedAuthorizeRequest(r *fosite.Request) (*fosite.AuthorizeRequest, error) {
+	ar := fosite.NewAuthorizeRequest()
+	ar.Request = *r
+
+	form := r.GetRequestForm()
+	ar.ResponseTypes = fosite.RemoveEmpty(strings.Split(form.Get("response_type"), " "))
+	ar.State = form.Get("state")
+	ar.ResponseMode = fosite.ResponseModeType(form.Get("response_mode"))
+	if raw := form.Get("redirect_uri"); raw != "" {
+		redirectURI, err := url.Parse(raw)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ar.RedirectURI = redirectURI
+	}
+	return ar, nil
+}
+
+// OAuth 2.0 Pushed Authorization Request Response
+//
+// swagger:model pushedAuthorizationResponse
+type _ struct {
+	// The request URI to use at the authorization endpoint instead of the parameters of the authorization request.
+	//
+	// example: urn:ietf:params:oauth:request_uri:6esc_11ACC5bwc014ltc14eY22c
+	RequestURI string `json:"request_uri"`
+
+	// The lifetime of the request URI in seconds.
+	//
+	// example: 90
+	ExpiresIn int `json:"expires_in"`
+}
+
+// swagger:route POST /oauth2/par oAuth2 oAuth2PushedAuthorize
+//
+// # OAuth 2.0 Pushed Authorization Request Endpoint
+//
+// Use open source libraries to perform OAuth 2.0 and OpenID Connect
+// available for any programming language. You can find a list of libraries here https://oauth.net/code/
+//
+// The pushed authorization request endpoint accepts the parameters of an authorization request from an
+// authenticated client, and returns the request URI to use at the authorization endpoint instead. To learn more
+// please refer to the specification: https://www.rfc-editor.org/rfc/rfc9126
+//
+//	Consumes:
+//	- application/x-www-form-urlencoded
+//
+//	Schemes: http, https
+//
+//	Security:
+//	  basic:
+//
+//	Responses:
+//	  201: pushedAuthorizationResponse
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-public-medium
+func (h *Handler) oAuth2PushedAuthorize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ar, err := h.r.OAuth2Provider().NewPushedAuthorizeRequest(ctx, r)
+	if err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.r.OAuth2Provider().WritePushedAuthorizeError(ctx, w, ar, err)
+		return
+	}
+
+	if err := validatePushedAuthorizeSecurityProfile(ar); err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.r.OAuth2Provider().WritePushedAuthorizeError(ctx, w, ar, err)
+		return
+	}
+
+	resp, err := h.r.OAuth2Provider().NewPushedAuthorizeResponse(ctx, ar, NewSessionWithCustomClaims(ctx, h.c, ""))
+	if err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.r.OAuth2Provider().WritePushedAuthorizeError(ctx, w, ar, err)
+		return
+	}
+
+	h.r.OAuth2Provider().WritePushedAuthorizeResponse(ctx, w, ar, resp)
+}
@@ -0,0 +1,85 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+	foauth2 "github.com/ory/hydra/v2/fosite/handler/oauth2"
+	"github.com/ory/hydra/v2/ssf"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/hydra/v2/x/events"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ foauth2.RefreshTokenReuseHandler = (*RefreshTokenReuseHandler)(nil)
+
+// RefreshTokenReuseHandler applies the refresh token reuse policy of the client after fosite revoked the tokens of
+// a reused refresh token, and emits the RefreshTokenReused event.
+type RefreshTokenReuseHandler struct {
+	r InternalRegistry
+}
+
+func NewRefreshTokenReuseHandler(r InternalRegistry) *RefreshTokenReuseHandler {
+	return &RefreshTokenReuseHandler{r: r}
+}
+
+// HandleRefreshTokenReuse implements foauth2.RefreshTokenReuseHandler
+func (h *RefreshTokenReuseHandler) HandleRefreshTokenReuse(ctx context.Context, request fosite.Requester) (err error) {
+	ctx, span := h.r.Tracer(ctx).Tracer().Start(ctx, "oauth2.HandleRefreshTokenReuse")
+	defer otelx.End(span, &err)
+
+	policy := client.RefreshTokenReusePolicyRevokeTokens
+	if c, ok := request.GetClient().(*client.Client); ok && c.RefreshTokenReusePolicy != "" {
+		policy = c.RefreshTokenReusePolicy
+	}
+
+	events.Trace(ctx, events.RefreshTokenReused, events.WithRequest(request), events.WithConsentRequestID(request.GetID()))
+	h.r.SSFTransmitter().Transmit(ctx, ssf.CredentialCompromised(
+		h.r.Config().IssuerURL(ctx).String(), request.GetSession().GetSubject(), request.GetClient().GetID(), string(fosite.RefreshToken),
+		"The refresh token was reused, which indicates that it was stolen.",
+	))
+	h.r.Logger().
+		WithField("client_id", request.GetClient().GetID()).
+		WithField("subject", request.GetSession().GetSubject()).
+		WithField("request_id", request.GetID()).
+		WithField("refresh_token_reuse_policy", policy).
+		Warn("A refresh token was reused, all tokens issued with the same authorization have been revoked.")
+
+	switch policy {
+	case client.RefreshTokenReusePolicyRevokeConsent:
+		// The consent request ID is the request ID of the tokens.
+		if err := h.r.ConsentManager().RevokeConsentSessionByID(ctx, request.GetID()); err != nil && !isNotFound(err) {
+			return err
+		}
+		fallthrough
+	case client.RefreshTokenReusePolicyRevokeLoginSession:
+		if sid := loginSessionID(request); sid != "" {
+			if _, err := h.r.LoginManager().DeleteLoginSession(ctx, sid); err != nil && !isNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loginSessionID returns the ID of the login session the tokens were issued in, which is the "sid" claim of the
+// ID token.
+func loginSessionID(request fosite.Requester) string {
+	s, ok := request.GetSession().(*Session)
+	if !ok || s.DefaultSession == nil || s.DefaultSession.Claims == nil {
+		return ""
+	}
+	sid, _ := s.DefaultSession.Claims.Extra["sid"].(string)
+	return sid
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, sqlcon.ErrNoRows()) || errors.Is(err, x.ErrNotFound) || errors.Is(err, fosite.ErrNotFound)
+}
@@ -0,0 +1,79 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/consent/test"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/x/sqlxx"
+)
+
+func TestRefreshTokenReuseHandler(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t)
+	h := oauth2.NewRefreshTokenReuseHandler(reg)
+
+	for _, tc := range []struct {
+		policy                              string
+		revokesLoginSession, revokesConsent bool
+	}{
+		{policy: ""},
+		{policy: client.RefreshTokenReusePolicyRevokeTokens},
+		{policy: client.RefreshTokenReusePolicyRevokeLoginSession, revokesLoginSession: true},
+		{policy: client.RefreshTokenReusePolicyRevokeConsent, revokesLoginSession: true, revokesConsent: true},
+	} {
+		t.Run("policy="+tc.policy, func(t *testing.T) {
+			ctx := t.Context()
+
+			cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String(), RefreshTokenReusePolicy: tc.policy}
+			require.NoError(t, reg.ClientManager().CreateClient(ctx, cl))
+
+			ls := &flow.LoginSession{ID: uuid.Must(uuid.NewV4()).String(), Subject: "subject", Remember: true, AuthenticatedAt: sqlxx.NullTime(time.Now())}
+			require.NoError(t, reg.LoginManager().ConfirmLoginSession(ctx, ls))
+
+			f := test.MockConsentFlow(true, 0, false)
+			f.Client, f.Subject, f.SessionID = cl, ls.Subject, sqlxx.NullString(ls.ID)
+			f.NID = reg.Networker().NetworkID(ctx)
+			require.NoError(t, reg.ConsentManager().CreateConsentSession(ctx, f))
+
+			require.NoError(t, h.HandleRefreshTokenReuse(ctx, &fosite.Request{
+				ID:     f.ConsentRequestID.String(),
+				Client: cl,
+				Session: &oauth2.Session{DefaultSession: &openid.DefaultSession{
+					Subject: ls.Subject,
+					Claims:  &jwt.IDTokenClaims{Extra: map[string]any{"sid": ls.ID}},
+				}},
+			}))
+
+			_, err := reg.LoginManager().GetRememberedLoginSession(ctx, ls.ID)
+			if tc.revokesLoginSession {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			_, err = reg.ConsentManager().FindGrantedAndRememberedConsentRequest(ctx, cl.ID, f.Subject)
+			if tc.revokesConsent {
+				assert.ErrorIs(t, err, consent.ErrNoPreviousConsentFound)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
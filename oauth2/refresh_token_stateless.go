@@ -0,0 +1,121 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/aead"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	foauth2 "github.com/ory/hydra/v2/fosite/handler/oauth2"
+)
+
+// StatelessRefreshTokenPrefix is the prefix of refresh tokens issued by the StatelessRefreshTokenStrategy.
+const StatelessRefreshTokenPrefix = "ory_rts_" // nolint:gosec
+
+var _ foauth2.RefreshTokenStrategy = (*StatelessRefreshTokenStrategy)(nil)
+
+type (
+	// StatelessRefreshToken is the encrypted payload of a stateless refresh token. It carries everything required to
+	// restore the request the token was issued for, so that the store only needs to keep track of the family
+	// (the request ID shared by all rotated tokens) and its current generation.
+	StatelessRefreshToken struct {
+		Family            string          `json:"fam"`
+		Generation        int             `json:"gen"`
+		RequestedAt       time.Time       `json:"rat"`
+		ClientID          string          `json:"cid"`
+		RequestedScope    []string        `json:"scp,omitempty"`
+		GrantedScope      []string        `json:"gsc,omitempty"`
+		RequestedAudience []string        `json:"aud,omitempty"`
+		GrantedAudience   []string        `json:"gau,omitempty"`
+		Session           json.RawMessage `json:"ses"`
+	}
+
+	// StatelessRefreshTokenStrategy issues refresh tokens which are encrypted with the flow cipher instead of being
+	// stored in the database. The signature of a stateless refresh token is the token itself.
+	StatelessRefreshTokenStrategy struct {
+		d statelessRefreshTokenStrategyDependencies
+	}
+	statelessRefreshTokenStrategyDependencies interface {
+		FlowCipher() *aead.XChaCha20Poly1305
+	}
+)
+
+func NewStatelessRefreshTokenStrategy(d statelessRefreshTokenStrategyDependencies) *StatelessRefreshTokenStrategy {
+	return &StatelessRefreshTokenStrategy{d: d}
+}
+
+// IsStatelessRefreshToken returns true if the token was issued by the StatelessRefreshTokenStrategy.
+func IsStatelessRefreshToken(token string) bool {
+	return strings.HasPrefix(token, StatelessRefreshTokenPrefix)
+}
+
+// DecodeStatelessRefreshToken decrypts a stateless refresh token. It returns fosite.ErrNotFound if the token
+// is not a stateless refresh token or can not be decrypted.
+func DecodeStatelessRefreshToken(ctx context.Context, cipher aead.Cipher, token string) (*StatelessRefreshToken, error) {
+	if !IsStatelessRefreshToken(token) {
+		return nil, errors.WithStack(fosite.ErrNotFound)
+	}
+	t, err := flow.Decode[StatelessRefreshToken](ctx, cipher, strings.TrimPrefix(token, StatelessRefreshTokenPrefix), flow.AsRefreshToken)
+	if err != nil {
+		return nil, errors.WithStack(fosite.ErrNotFound.WithWrap(err).WithDebug(err.Error()))
+	}
+	return t, nil
+}
+
+// RefreshTokenSignature implements foauth2.RefreshTokenStrategy
+func (s *StatelessRefreshTokenStrategy) RefreshTokenSignature(_ context.Context, token string) string {
+	return token
+}
+
+// GenerateRefreshToken implements foauth2.RefreshTokenStrategy
+//
+// Tokens issued by the refresh token grant for a stateless refresh token continue its family with the next
+// generation, all other tokens start a new family.
+func (s *StatelessRefreshTokenStrategy) GenerateRefreshToken(ctx context.Context, requester fosite.Requester) (token string, signature string, err error) {
+	session, err := json.Marshal(requester.GetSession())
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	t := StatelessRefreshToken{
+		Family:            requester.GetID(),
+		RequestedAt:       requester.GetRequestedAt().UTC(),
+		ClientID:          requester.GetClient().GetID(),
+		RequestedScope:    requester.GetRequestedScopes(),
+		GrantedScope:      requester.GetGrantedScopes(),
+		RequestedAudience: requester.GetRequestedAudience(),
+		GrantedAudience:   requester.GetGrantedAudience(),
+		Session:           session,
+	}
+	if form := requester.GetRequestForm(); form.Get("grant_type") == string(fosite.GrantTypeRefreshToken) {
+		if previous, err := DecodeStatelessRefreshToken(ctx, s.d.FlowCipher(), form.Get("refresh_token")); err == nil && previous.Family == t.Family {
+			t.Generation = previous.Generation + 1
+		}
+	}
+
+	encoded, err := flow.Encode(ctx, s.d.FlowCipher(), t, flow.AsRefreshToken)
+	if err != nil {
+		return "", "", err
+	}
+
+	token = StatelessRefreshTokenPrefix + encoded
+	return token, token, nil
+}
+
+// ValidateRefreshToken implements foauth2.RefreshTokenStrategy
+//
+// The integrity of the token is verified when it is decrypted by the store, so only the expiry is checked here.
+func (s *StatelessRefreshTokenStrategy) ValidateRefreshToken(_ context.Context, requester fosite.Requester, _ string) error {
+	if exp := requester.GetSession().GetExpiresAt(fosite.RefreshToken); !exp.IsZero() && exp.Before(time.Now().UTC()) {
+		return errors.WithStack(fosite.ErrTokenExpired.WithHintf("Refresh token expired at '%s'.", exp))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2"
+)
+
+func TestStatelessRefreshTokenStrategy(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	reg := testhelpers.NewRegistryMemory(t)
+	s := oauth2.NewStatelessRefreshTokenStrategy(reg)
+
+	newRequest := func(form url.Values) *fosite.Request {
+		r := fosite.NewRequest()
+		r.SetID("request-id")
+		r.Client = &client.Client{ID: "client-id"}
+		r.GrantedScope = fosite.Arguments{"offline", "openid"}
+		r.Form = form
+		r.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "subject"}}
+		r.Session.SetExpiresAt(fosite.RefreshToken, time.Now().Add(time.Hour).UTC().Round(time.Second))
+		return r
+	}
+
+	first, signature, err := s.GenerateRefreshToken(ctx, newRequest(url.Values{"grant_type": {"authorization_code"}}))
+	require.NoError(t, err)
+	assert.Equal(t, first, signature)
+	assert.Equal(t, first, s.RefreshTokenSignature(ctx, first))
+	require.True(t, oauth2.IsStatelessRefreshToken(first))
+
+	t.Run("case=carries the request", func(t *testing.T) {
+		decoded, err := oauth2.DecodeStatelessRefreshToken(ctx, reg.FlowCipher(), first)
+		require.NoError(t, err)
+		assert.Equal(t, "request-id", decoded.Family)
+		assert.Zero(t, decoded.Generation)
+		assert.Equal(t, "client-id", decoded.ClientID)
+		assert.Equal(t, []string{"offline", "openid"}, decoded.GrantedScope)
+		assert.Contains(t, string(decoded.Session), `"subject":"subject"`)
+	})
+
+	t.Run("case=refreshing continues the family", func(t *testing.T) {
+		second, _, err := s.GenerateRefreshToken(ctx, newRequest(url.Values{"grant_type": {"refresh_token"}, "refresh_token": {first}}))
+		require.NoError(t, err)
+		decoded, err := oauth2.DecodeStatelessRefreshToken(ctx, reg.FlowCipher(), second)
+		require.NoError(t, err)
+		assert.Equal(t, 1, decoded.Generation)
+
+		third, _, err := s.GenerateRefreshToken(ctx, newRequest(url.Values{"grant_type": {"refresh_token"}, "refresh_token": {second}}))
+		require.NoError(t, err)
+		decoded, err = oauth2.DecodeStatelessRefreshToken(ctx, reg.FlowCipher(), third)
+		require.NoError(t, err)
+		assert.Equal(t, 2, decoded.Generation)
+	})
+
+	t.Run("case=refreshing an opaque token starts a new family", func(t *testing.T) {
+		token, _, err := s.GenerateRefreshToken(ctx, newRequest(url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"ory_rt_foo.bar"}}))
+		require.NoError(t, err)
+		decoded, err := oauth2.DecodeStatelessRefreshToken(ctx, reg.FlowCipher(), token)
+		require.NoError(t, err)
+		assert.Zero(t, decoded.Generation)
+	})
+
+	t.Run("case=rejects tampered and foreign tokens", func(t *testing.T) {
+		for _, token := range []string{
+			"",
+			"ory_rt_foo.bar",
+			oauth2.StatelessRefreshTokenPrefix + "foo",
+			first[:len(first)-4] + "AAAA",
+		} {
+			_, err := oauth2.DecodeStatelessRefreshToken(ctx, reg.FlowCipher(), token)
+			assert.ErrorIs(t, err, fosite.ErrNotFound, "%s", token)
+		}
+
+		other := testhelpers.NewRegistryMemory(t)
+		other.Config().MustSet(ctx, config.KeyGetSystemSecret, []string{"another-system-secret-which-is-long-enough"})
+		_, err := oauth2.DecodeStatelessRefreshToken(ctx, other.FlowCipher(), first)
+		assert.ErrorIs(t, err, fosite.ErrNotFound)
+	})
+
+	t.Run("case=validates the expiry", func(t *testing.T) {
+		r := newRequest(nil)
+		require.NoError(t, s.ValidateRefreshToken(ctx, r, first))
+
+		r.Session.SetExpiresAt(fosite.RefreshToken, time.Now().Add(-time.Minute))
+		assert.ErrorIs(t, s.ValidateRefreshToken(ctx, r, first), fosite.ErrTokenExpired)
+
+		r.Session.SetExpiresAt(fosite.RefreshToken, time.Time{})
+		assert.NoError(t, s.ValidateRefreshToken(ctx, r, first), "refresh tokens without expiry never expire")
+	})
+}
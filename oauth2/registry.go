@@ -12,6 +12,7 @@ import (
 	"github.com/ory/hydra/v2/fosite/handler/rfc8628"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/httpx"
 	"github.com/ory/x/logrusx"
@@ -27,6 +28,7 @@ type InternalRegistry interface {
 	otelx.Provider
 	x.Transactor
 	consent.Registry
+	ssf.TransmitterProvider
 	Registry
 	FlowCipher() *aead.XChaCha20Poly1305
 }
@@ -35,6 +37,8 @@ type Registry interface {
 	OAuth2Storage() x.FositeStorer
 	OAuth2Provider() fosite.OAuth2Provider
 	AccessTokenJWTSigner() jwk.JWTSigner
+	MetadataJWTSigner() jwk.JWTSigner
+	IntrospectionJWTSigner() jwk.JWTSigner
 	OpenIDConnectRequestValidator() *openid.OpenIDConnectRequestValidator
 	AccessRequestHooks() []AccessRequestHook
 	OAuth2ProviderConfig() fosite.Configurator
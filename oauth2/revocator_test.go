@@ -9,13 +9,17 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/go-jose/go-jose/v3"
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/internal"
 	"github.com/ory/hydra/v2/internal/testhelpers"
@@ -136,3 +140,65 @@ func TestRevoke(t *testing.T) {
 		})
 	}
 }
+
+func TestRevokeWithClientSecretJWT(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reg := testhelpers.NewRegistryMemory(t)
+	testhelpers.MustEnsureRegistryKeys(t, reg, x.OpenIDConnectKeyName)
+
+	c := &client.Client{
+		ID:                      "client-secret-jwt",
+		Secret:                  "client-secret-jwt-0123456789-0123456789",
+		TokenEndpointAuthMethod: "client_secret_jwt",
+		GrantTypes:              []string{"client_credentials"},
+	}
+	require.NoError(t, reg.ClientValidator().Validate(ctx, c))
+	require.NoError(t, reg.ClientManager().CreateClient(ctx, c))
+
+	tokens := Tokens(reg.OAuth2ProviderConfig(), 1)
+	createAccessTokenSession(t, "alice", c.GetID(), tokens[0].sig, time.Now().UTC().Add(time.Hour), reg.OAuth2Storage(), nil)
+	require.Equal(t, 1, countAccessTokens(t, reg.Persister().Connection(ctx)))
+
+	handler := oauth2.NewHandler(reg)
+	router := httprouterx.NewRouterAdminWithPrefix()
+	handler.SetPublicRoutes(router.ToPublic(), func(h http.Handler) http.Handler { return h })
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	revoke := func(t *testing.T, secret string) *http.Response {
+		res, err := http.PostForm(server.URL+"/oauth2/revoke", url.Values{
+			"token":                 {tokens[0].tok},
+			"client_id":             {c.GetID()},
+			"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+			"client_assertion": {signJWT(t,
+				jose.SigningKey{Algorithm: jose.HS256, Key: []byte(secret)},
+				nil,
+				map[string]interface{}{
+					"iss": c.GetID(),
+					"sub": c.GetID(),
+					"aud": reg.Config().OAuth2TokenURL(ctx).String(),
+					"jti": uuid.New(),
+					"iat": time.Now().Unix(),
+					"exp": time.Now().Add(time.Minute).Unix(),
+				})},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+
+	t.Run("case=rejects an assertion signed with the wrong secret", func(t *testing.T) {
+		res := revoke(t, "wrong-secret-0123456789-0123456789-012345")
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		assert.Equal(t, 1, countAccessTokens(t, reg.Persister().Connection(ctx)))
+	})
+
+	t.Run("case=revokes the token with an assertion signed with the client secret", func(t *testing.T) {
+		res := revoke(t, "client-secret-jwt-0123456789-0123456789")
+		body, _ := io.ReadAll(res.Body)
+		require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+		assert.Equal(t, 0, countAccessTokens(t, reg.Persister().Connection(ctx)))
+	})
+}
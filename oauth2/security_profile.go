@@ -0,0 +1,57 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+)
+
+// securityProfile returns the security profile of the client of the request, or an empty string if it has none.
+func securityProfile(ar fosite.Requester) string {
+	if c, ok := ar.GetClient().(*client.Client); ok {
+		return c.SecurityProfile
+	}
+	return ""
+}
+
+// validatePushedAuthorizeSecurityProfile validates a pushed authorization request against the security profile of
+// its client. Clients with a security profile must use the authorization code flow with S256 PKCE, and clients with
+// the message signing profile must push a signed request object.
+func validatePushedAuthorizeSecurityProfile(ar fosite.AuthorizeRequester) error {
+	profile := securityProfile(ar)
+	if profile == "" {
+		return nil
+	}
+
+	form := ar.GetRequestForm()
+	if !ar.GetResponseTypes().ExactOne("code") {
+		return errors.WithStack(fosite.ErrUnsupportedResponseType.WithHintf("Security profile %q only allows response type 'code'.", profile))
+	}
+	if form.Get("code_challenge") == "" || form.Get("code_challenge_method") != "S256" {
+		return errors.WithStack(fosite.ErrInvalidRequest.WithHintf("Security profile %q requires PKCE with code challenge method 'S256'.", profile))
+	}
+	if profile == client.SecurityProfileFAPI2MessageSigning && (form.Get("request") == "" || !ar.GetRequestedScopes().Has("openid")) {
+		return errors.WithStack(fosite.ErrInvalidRequest.WithHintf("Security profile %q requires a signed request object and the 'openid' scope.", profile))
+	}
+	return nil
+}
+
+// validateAuthorizeSecurityProfile validates that an authorization request of a client with a security profile was
+// pushed to the pushed authorization request endpoint.
+func validateAuthorizeSecurityProfile(ar fosite.AuthorizeRequester) error {
+	profile := securityProfile(ar)
+	if profile == "" {
+		return nil
+	}
+
+	if !strings.HasPrefix(ar.GetRequestForm().Get("request_uri"), PushedAuthorizeRequestURIPrefix) {
+		return errors.WithStack(fosite.ErrInvalidRequest.WithHintf("Security profile %q requires authorization requests to be pushed to the pushed authorization request endpoint.", profile))
+	}
+	return nil
+}
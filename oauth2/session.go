@@ -34,6 +34,7 @@ type Session struct {
 	AllowedTopLevelClaims  []string               `json:"allowed_top_level_claims"`
 	MirrorTopLevelClaims   bool                   `json:"mirror_top_level_claims"`
 	PreserveExtClaims      bool                   `json:"preserve_ext_claims"`
+	DPoPJKT                string                 `json:"dpop_jkt,omitempty"`
 }
 
 func NewTestSession(t testing.TB, subject string) *Session {
@@ -62,7 +63,7 @@ func (s *Session) GetJWTClaims() jwt.JWTClaimsContainer {
 	allowedClaimsFromConfigWithoutReserved := slices.DeleteFunc(s.AllowedTopLevelClaims, func(s string) bool {
 		switch s {
 		// these claims are reserved and should not be overridden
-		case "iss", "sub", "aud", "exp", "nbf", "iat", "jti", "client_id", "scp", "ext":
+		case "iss", "sub", "aud", "exp", "nbf", "iat", "jti", "client_id", "scp", "ext", "cnf":
 			return true
 		}
 		return false
@@ -71,6 +72,9 @@ func (s *Session) GetJWTClaims() jwt.JWTClaimsContainer {
 	// our new extra map which will be added to the jwt
 	topLevelExtraWithMirrorExt := make(map[string]interface{}, len(allowedClaimsFromConfigWithoutReserved)+2)
 	topLevelExtraWithMirrorExt["client_id"] = s.ClientID
+	if s.DPoPJKT != "" {
+		topLevelExtraWithMirrorExt["cnf"] = map[string]interface{}{"jkt": s.DPoPJKT}
+	}
 
 	// setting every allowed claim top level in jwt with respective value
 	for _, allowedClaim := range allowedClaimsFromConfigWithoutReserved {
@@ -45,6 +45,20 @@ type trustedOAuth2JwtGrantIssuer struct {
 	// The "public_key" contains information about public key issued by "issuer", that will be used to check JWT assertion signature.
 	PublicKey trustedOAuth2JwtGrantJsonWebKey `json:"public_key"`
 
+	// The "jwks_uri" is the URL of the JSON Web Key Set of "issuer", that will be used to check JWT assertion signature.
+	// example: https://token.actions.githubusercontent.com/.well-known/jwks
+	JWKSURI string `json:"jwks_uri,omitempty"`
+
+	// The "oidc_discovery" indicates that the JSON Web Key Set of "issuer" is fetched from its OpenID Connect discovery document.
+	OIDCDiscovery bool `json:"oidc_discovery,omitempty"`
+
+	// The "claim_conditions" contains glob patterns which the claims of the JWT assertion must match.
+	// example: {"repository": "octo-org/*", "ref": "refs/heads/main"}
+	ClaimConditions map[string]string `json:"claim_conditions,omitempty"`
+
+	// The "claim_mappings" grant additional scope and audience to JWT assertions with matching claims.
+	ClaimMappings []trustedOAuth2JwtGrantClaimMapping `json:"claim_mappings,omitempty"`
+
 	// The "created_at" indicates, when grant was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -66,3 +80,30 @@ type trustedOAuth2JwtGrantJsonWebKey struct {
 	// example: 123e4567-e89b-12d3-a456-426655440000
 	KeyID string `json:"kid"`
 }
+
+// OAuth2 JWT Bearer Grant Type Issuer Trust Relationship Claim Mapping
+//
+// swagger:model trustedOAuth2JwtGrantClaimMapping
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type trustedOAuth2JwtGrantClaimMapping struct {
+	// The "claim" is the name of the claim of the JWT assertion.
+	//
+	// required: true
+	// example: namespace
+	Claim string `json:"claim"`
+
+	// The "value" is the glob pattern the claim must match.
+	//
+	// required: true
+	// example: production
+	Value string `json:"value"`
+
+	// The "scope" contains scope values the JWT assertion is additionally allowed to request.
+	// example: ["deploy"]
+	Scope []string `json:"scope,omitempty"`
+
+	// The "audience" contains the audience which is granted to the JWT assertion.
+	// example: ["https://api.example.com"]
+	Audience []string `json:"audience,omitempty"`
+}
@@ -0,0 +1,224 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package trust
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/rfc7523"
+	"github.com/ory/x/fetcher"
+)
+
+const (
+	// maxFederationDocumentSize is the maximum size of fetched discovery documents and JSON Web Key Sets.
+	maxFederationDocumentSize = 1 << 20
+
+	// defaultJWKSRefreshInterval is the minimum time between two fetches of the same JSON Web Key Set which are
+	// caused by assertions signed with an unknown key.
+	defaultJWKSRefreshInterval = 30 * time.Second
+)
+
+var _ rfc7523.RFC7523FederatedKeyStorage = (*KeyStorage)(nil)
+
+// KeyStorage extends an rfc7523.RFC7523KeyStorage with the grants whose issuer publishes its JSON Web Key Set,
+// either at a jwks_uri or in its OpenID Connect discovery document.
+type KeyStorage struct {
+	rfc7523.RFC7523KeyStorage
+
+	r               InternalRegistry
+	keys            *ristretto.Cache[string, *cachedKeySet]
+	documents       *ristretto.Cache[[]byte, []byte]
+	refreshInterval time.Duration
+}
+
+type cachedKeySet struct {
+	keys      *jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+// NewKeyStorage returns a KeyStorage which looks up uploaded keys in s.
+func NewKeyStorage(s rfc7523.RFC7523KeyStorage, r InternalRegistry) *KeyStorage {
+	keys, err := ristretto.NewCache(&ristretto.Config[string, *cachedKeySet]{
+		NumCounters: 10000,
+		MaxCost:     1000,
+		BufferItems: 64,
+	})
+	if err != nil {
+		panic(err)
+	}
+	documents, err := ristretto.NewCache(&ristretto.Config[[]byte, []byte]{
+		NumCounters: 10000,
+		MaxCost:     32 << 20,
+		BufferItems: 64,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &KeyStorage{
+		RFC7523KeyStorage: s,
+		r:                 r,
+		keys:              keys,
+		documents:         documents,
+		refreshInterval:   defaultJWKSRefreshInterval,
+	}
+}
+
+// GetFederatedTrusts implements rfc7523.RFC7523FederatedKeyStorage
+func (s *KeyStorage) GetFederatedTrusts(ctx context.Context, claims map[string]any, keyID string) ([]rfc7523.FederatedTrust, error) {
+	issuer, _ := claims["iss"].(string)
+	grants, err := s.r.GrantManager().GetFederatedGrants(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		trusts   []rfc7523.FederatedTrust
+		fetchErr error
+	)
+	for _, g := range grants {
+		scope, audience, ok := g.authorize(claims)
+		if !ok {
+			continue
+		}
+
+		keys, err := s.getKeys(ctx, g, keyID)
+		if err != nil {
+			s.r.Logger().WithError(err).WithField("grant", g.ID).Warn("Unable to fetch the JSON Web Key Set of a trusted JWT bearer grant issuer.")
+			fetchErr = err
+			continue
+		}
+
+		trusts = append(trusts, rfc7523.FederatedTrust{Keys: keys, Scopes: scope, Audience: audience})
+	}
+
+	if len(trusts) == 0 && fetchErr != nil {
+		return nil, fetchErr
+	}
+	return trusts, nil
+}
+
+// authorize returns the scope and audience the grant allows for a JWT assertion with the given claims, and whether
+// the grant applies to the JWT assertion at all.
+func (g Grant) authorize(claims map[string]any) (scope, audience []string, ok bool) {
+	if subject, _ := claims["sub"].(string); !g.AllowAnySubject && subject != g.Subject {
+		return nil, nil, false
+	}
+
+	for claim, pattern := range g.ClaimConditions {
+		if !claimMatches(claims[claim], pattern) {
+			return nil, nil, false
+		}
+	}
+
+	scope = slices.Clone(g.Scope)
+	for _, m := range g.ClaimMappings {
+		if claimMatches(claims[m.Claim], m.Value) {
+			scope = append(scope, m.Scope...)
+			audience = append(audience, m.Audience...)
+		}
+	}
+	return scope, audience, true
+}
+
+func claimMatches(value any, pattern string) bool {
+	g, err := glob.Compile(pattern)
+	if err != nil {
+		return false
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+	for _, v := range values {
+		switch v := v.(type) {
+		case nil, map[string]any, []any:
+			continue
+		case string:
+			if g.Match(v) {
+				return true
+			}
+		default:
+			if g.Match(fmt.Sprint(v)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getKeys returns the JSON Web Key Set of the grant's issuer. A cached JSON Web Key Set is fetched again if it does
+// not contain the key, because the issuer may have rotated its keys.
+func (s *KeyStorage) getKeys(ctx context.Context, g Grant, keyID string) (*jose.JSONWebKeySet, error) {
+	location := g.JWKSURI
+	if g.OIDCDiscovery {
+		var err error
+		if location, err = s.discoverJWKSURI(ctx, g.Issuer); err != nil {
+			return nil, err
+		}
+	}
+
+	if cached, ok := s.keys.Get(location); ok {
+		if keyID == "" || len(cached.keys.Key(keyID)) > 0 || time.Since(cached.fetchedAt) < s.refreshInterval {
+			return cached.keys, nil
+		}
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := s.fetch(ctx, location, &keys); err != nil {
+		return nil, err
+	}
+
+	s.keys.SetWithTTL(location, &cachedKeySet{keys: &keys, fetchedAt: time.Now()}, 1, s.r.Config().GetJWTBearerJWKSCacheTTL(ctx))
+	s.keys.Wait()
+	return &keys, nil
+}
+
+// discoverJWKSURI returns the jwks_uri from the OpenID Connect discovery document of the issuer.
+func (s *KeyStorage) discoverJWKSURI(ctx context.Context, issuer string) (string, error) {
+	location := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := s.fetch(ctx, location, &discovery, fetcher.WithCache(s.documents, s.r.Config().GetJWTBearerJWKSCacheTTL(ctx))); err != nil {
+		return "", err
+	}
+
+	if discovery.Issuer != issuer {
+		return "", errors.WithStack(fosite.ErrServerError.WithHintf("The OpenID Connect discovery document at '%s' belongs to issuer '%s' instead of '%s'.", location, discovery.Issuer, issuer))
+	} else if discovery.JWKSURI == "" {
+		return "", errors.WithStack(fosite.ErrServerError.WithHintf("The OpenID Connect discovery document at '%s' does not contain a 'jwks_uri'.", location))
+	}
+	return discovery.JWKSURI, nil
+}
+
+func (s *KeyStorage) fetch(ctx context.Context, location string, v any, opts ...fetcher.Modifier) error {
+	f := fetcher.NewFetcher(append([]fetcher.Modifier{
+		fetcher.WithClient(s.r.HTTPClient(ctx)),
+		fetcher.WithAllowedSchemes("http", "https"),
+		fetcher.WithMaxHTTPMaxBytes(maxFederationDocumentSize),
+	}, opts...)...)
+
+	b, err := f.FetchBytes(ctx, location)
+	if err != nil {
+		return errors.WithStack(fosite.ErrServerError.WithHintf("Unable to fetch '%s' from the trusted JWT bearer grant issuer.", location).WithWrap(err).WithDebug(err.Error()))
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errors.WithStack(fosite.ErrServerError.WithHintf("Unable to decode '%s' from the trusted JWT bearer grant issuer.", location).WithWrap(err).WithDebug(err.Error()))
+	}
+	return nil
+}
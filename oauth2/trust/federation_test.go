@@ -0,0 +1,70 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package trust
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantAuthorize(t *testing.T) {
+	g := Grant{
+		Subject:         "repo:ory/hydra",
+		Scope:           []string{"openid"},
+		ClaimConditions: ClaimConditions{"repository": "ory/*", "groups": "admins"},
+		ClaimMappings: ClaimMappings{
+			{Claim: "ref", Value: "refs/heads/*", Scope: []string{"deploy"}, Audience: []string{"https://api.example.com"}},
+			{Claim: "run_attempt", Value: "1", Scope: []string{"first"}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		claims   map[string]any
+		ok       bool
+		scope    []string
+		audience []string
+	}{
+		{
+			name:   "case=subject mismatch",
+			claims: map[string]any{"sub": "repo:ory/kratos", "repository": "ory/kratos", "groups": []any{"admins"}},
+		},
+		{
+			name:   "case=condition not met",
+			claims: map[string]any{"sub": "repo:ory/hydra", "repository": "other/hydra", "groups": []any{"admins"}},
+		},
+		{
+			name:   "case=missing claim",
+			claims: map[string]any{"sub": "repo:ory/hydra", "repository": "ory/hydra"},
+		},
+		{
+			name:   "case=no mappings",
+			claims: map[string]any{"sub": "repo:ory/hydra", "repository": "ory/hydra", "groups": []any{"users", "admins"}},
+			ok:     true,
+			scope:  []string{"openid"},
+		},
+		{
+			name:     "case=mappings",
+			claims:   map[string]any{"sub": "repo:ory/hydra", "repository": "ory/hydra", "groups": "admins", "ref": "refs/heads/main", "run_attempt": float64(1)},
+			ok:       true,
+			scope:    []string{"openid", "deploy", "first"},
+			audience: []string{"https://api.example.com"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			scope, audience, ok := g.authorize(tc.claims)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.scope, scope)
+			assert.Equal(t, tc.audience, audience)
+		})
+	}
+
+	t.Run("case=scope is not shared", func(t *testing.T) {
+		scope, _, ok := g.authorize(map[string]any{"sub": "repo:ory/hydra", "repository": "ory/hydra", "groups": "admins", "ref": "refs/heads/main"})
+		assert.True(t, ok)
+		assert.Equal(t, []string{"openid", "deploy"}, scope)
+		assert.Equal(t, []string{"openid"}, g.Scope)
+	})
+}
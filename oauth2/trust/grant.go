@@ -4,9 +4,13 @@
 package trust
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"time"
 
 	"github.com/gofrs/uuid"
+
+	"github.com/ory/x/sqlxx"
 )
 
 type Grant struct {
@@ -27,6 +31,20 @@ type Grant struct {
 	// PublicKeys contains information about public key issued by Issuer, that will be used to check JWT assertion signature.
 	PublicKey PublicKey `json:"public_key"`
 
+	// JWKSURI is the URL of the JSON Web Key Set of Issuer. If set, the keys are fetched from this URL instead of
+	// being uploaded.
+	JWKSURI string `json:"jwks_uri,omitempty"`
+
+	// OIDCDiscovery indicates that the JSON Web Key Set of Issuer is fetched from the jwks_uri of its OpenID Connect
+	// discovery document.
+	OIDCDiscovery bool `json:"oidc_discovery,omitempty"`
+
+	// ClaimConditions contains glob patterns which the claims of the JWT assertion must match.
+	ClaimConditions ClaimConditions `json:"claim_conditions,omitempty"`
+
+	// ClaimMappings grant additional scope and audience to JWT assertions with matching claims.
+	ClaimMappings ClaimMappings `json:"claim_mappings,omitempty"`
+
 	// CreatedAt indicates, when grant was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -41,3 +59,53 @@ type PublicKey struct {
 	// KeyID is key unique identifier (same as kid header in jws/jwt).
 	KeyID string `json:"kid"`
 }
+
+// IsFederated returns true if the keys of Issuer are fetched instead of being uploaded.
+func (g Grant) IsFederated() bool {
+	return g.JWKSURI != "" || g.OIDCDiscovery
+}
+
+// ClaimConditions maps claims of the JWT assertion to glob patterns, for example {"repository": "octo-org/*"}.
+// Claims with array values match if one of the values matches.
+type ClaimConditions map[string]string
+
+func (c *ClaimConditions) Scan(value any) error {
+	return sqlxx.JSONScan(c, value)
+}
+
+func (c ClaimConditions) Value() (driver.Value, error) {
+	if c == nil {
+		c = ClaimConditions{}
+	}
+	v, err := json.Marshal(c)
+	return string(v), err
+}
+
+type ClaimMappings []ClaimMapping
+
+func (m *ClaimMappings) Scan(value any) error {
+	return sqlxx.JSONScan(m, value)
+}
+
+func (m ClaimMappings) Value() (driver.Value, error) {
+	if m == nil {
+		m = ClaimMappings{}
+	}
+	v, err := json.Marshal(m)
+	return string(v), err
+}
+
+// ClaimMapping grants scope and audience to JWT assertions whose claim matches a glob pattern.
+type ClaimMapping struct {
+	// Claim is the name of the claim, for example "namespace".
+	Claim string `json:"claim"`
+
+	// Value is the glob pattern the claim must match.
+	Value string `json:"value"`
+
+	// Scope contains the scope values which the JWT assertion is additionally allowed to request.
+	Scope []string `json:"scope,omitempty"`
+
+	// Audience contains the audience which is granted to the JWT assertion.
+	Audience []string `json:"audience,omitempty"`
+}
@@ -4,6 +4,7 @@
 package trust
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -13,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/httprouterx"
@@ -66,10 +68,29 @@ type trustOAuth2JwtGrantIssuerBody struct {
 	Scope []string `json:"scope"`
 
 	// The "jwk" contains public key in JWK format issued by "issuer", that will be used to check JWT assertion signature.
-	//
-	// required:true
+	// Exactly one of "jwk", "jwks_uri" and "oidc_discovery" must be set.
 	JWK x.JSONWebKey `json:"jwk"`
 
+	// The "jwks_uri" is the URL of the JSON Web Key Set of "issuer", that will be used to check JWT assertion signature.
+	// The keys are cached and fetched again if an assertion is signed with an unknown key.
+	//
+	// example: https://token.actions.githubusercontent.com/.well-known/jwks
+	JWKSURI string `json:"jwks_uri"`
+
+	// The "oidc_discovery" indicates that the JSON Web Key Set of "issuer" is fetched from the "jwks_uri" of its
+	// OpenID Connect discovery document at "<issuer>/.well-known/openid-configuration".
+	OIDCDiscovery bool `json:"oidc_discovery"`
+
+	// The "claim_conditions" contains glob patterns which the claims of the JWT assertion must match. Claims with
+	// array values match if one of the values matches. Can only be set together with "jwks_uri" or "oidc_discovery".
+	//
+	// example: {"repository": "octo-org/*", "ref": "refs/heads/main"}
+	ClaimConditions map[string]string `json:"claim_conditions"`
+
+	// The "claim_mappings" grant additional scope and audience to JWT assertions with matching claims. Can only be set
+	// together with "jwks_uri" or "oidc_discovery".
+	ClaimMappings []trustedOAuth2JwtGrantClaimMapping `json:"claim_mappings"`
+
 	// The "expires_at" indicates, when grant will expire, so we will reject assertion from "issuer" targeting "subject".
 	//
 	// required:true
@@ -132,15 +153,26 @@ func (h *Handler) trustOAuth2JwtGrantIssuer(w http.ResponseWriter, r *http.Reque
 		Subject:         grantRequest.Subject,
 		AllowAnySubject: grantRequest.AllowAnySubject,
 		Scope:           grantRequest.Scope,
-		PublicKey: PublicKey{
+		JWKSURI:         grantRequest.JWKSURI,
+		OIDCDiscovery:   grantRequest.OIDCDiscovery,
+		ClaimConditions: grantRequest.ClaimConditions,
+		ClaimMappings:   grantRequest.ClaimMappings,
+		CreatedAt:       time.Now().UTC().Round(time.Second),
+		ExpiresAt:       grantRequest.ExpiresAt.UTC().Round(time.Second),
+	}
+	if !grant.IsFederated() {
+		grant.PublicKey = PublicKey{
 			Set:   grantRequest.Issuer, // group all keys by issuer, so set=issuer
 			KeyID: grantRequest.PublicKeyJWK.KeyID,
-		},
-		CreatedAt: time.Now().UTC().Round(time.Second),
-		ExpiresAt: grantRequest.ExpiresAt.UTC().Round(time.Second),
+		}
 	}
 
-	if err := h.registry.GrantManager().CreateGrant(r.Context(), grant, grantRequest.PublicKeyJWK); err != nil {
+	if err := h.registry.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.registry.GrantManager().CreateGrant(ctx, grant, grantRequest.PublicKeyJWK); err != nil {
+			return err
+		}
+		return h.registry.AuditRecorder().Record(ctx, r, audit.ActionTrustGrantCreated, grant.ID.String(), nil, &grant)
+	}); err != nil {
 		h.registry.Writer().WriteError(w, r, err)
 		return
 	}
@@ -245,7 +277,19 @@ func (h *Handler) deleteTrustedOAuth2JwtGrantIssuer(w http.ResponseWriter, r *ht
 		return
 	}
 
-	if err := h.registry.GrantManager().DeleteGrant(r.Context(), id); err != nil {
+	var before *Grant
+	if h.registry.AuditRecorder().Enabled(r.Context()) {
+		if grant, err := h.registry.GrantManager().GetConcreteGrant(r.Context(), id); err == nil {
+			before = &grant
+		}
+	}
+
+	if err := h.registry.AuditRecorder().Transaction(r.Context(), func(ctx context.Context) error {
+		if err := h.registry.GrantManager().DeleteGrant(ctx, id); err != nil {
+			return err
+		}
+		return h.registry.AuditRecorder().Record(ctx, r, audit.ActionTrustGrantDeleted, id.String(), before, nil)
+	}); err != nil {
 		h.registry.Writer().WriteError(w, r, err)
 		return
 	}
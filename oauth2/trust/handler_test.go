@@ -109,6 +109,51 @@ func (s *HandlerTestSuite) TestGrantCanBeCreatedAndFetched() {
 	s.Equal(model.ExpiresAt.Round(time.Second).UTC().String(), getResult.ExpiresAt.Round(time.Second).UTC().String(), "expiration date must match")
 }
 
+func (s *HandlerTestSuite) TestFederatedGrantCanBeCreatedAndFetched() {
+	createRequestParams := s.newCreateJwtBearerGrantParams(
+		"https://token.actions.githubusercontent.com",
+		"",
+		true,
+		[]string{"openid"},
+		time.Now().Add(time.Hour),
+	)
+	createRequestParams.Jwk = nil
+	createRequestParams.JwksUri = new("https://token.actions.githubusercontent.com/.well-known/jwks")
+	createRequestParams.ClaimConditions = map[string]string{"repository": "ory/*"}
+	createRequestParams.ClaimMappings = []hydra.TrustedOAuth2JwtGrantClaimMapping{
+		{Claim: "ref", Value: "refs/heads/main", Scope: []string{"deploy"}},
+	}
+
+	ctx := context.Background()
+	createResult, _, err := s.hydraClient.OAuth2API.TrustOAuth2JwtGrantIssuer(ctx).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Require().NoError(err, "no errors expected on grant creation")
+	s.Equal(*createRequestParams.JwksUri, createResult.GetJwksUri(), "jwks_uri must match")
+	s.Empty(createResult.PublicKey.GetKid(), "public key id must be empty")
+
+	getResult, _, err := s.hydraClient.OAuth2API.GetTrustedOAuth2JwtGrantIssuer(ctx, *createResult.Id).Execute()
+	s.Require().NoError(err, "no errors expected on grant fetching")
+	s.Equal(*createRequestParams.JwksUri, getResult.GetJwksUri(), "jwks_uri must match")
+	s.Equal(createRequestParams.ClaimConditions, getResult.ClaimConditions, "claim conditions must match")
+	s.Equal(createRequestParams.ClaimMappings, getResult.ClaimMappings, "claim mappings must match")
+
+	_, err = s.hydraClient.OAuth2API.DeleteTrustedOAuth2JwtGrantIssuer(ctx, *createResult.Id).Execute()
+	s.Require().NoError(err, "no errors expected on grant deletion")
+}
+
+func (s *HandlerTestSuite) TestFederatedGrantCanNotBeCreatedWithJWK() {
+	createRequestParams := s.newCreateJwtBearerGrantParams(
+		"https://token.actions.githubusercontent.com",
+		"",
+		true,
+		[]string{"openid"},
+		time.Now().Add(time.Hour),
+	)
+	createRequestParams.OidcDiscovery = new(true)
+
+	_, _, err := s.hydraClient.OAuth2API.TrustOAuth2JwtGrantIssuer(context.Background()).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Require().Error(err, "expected error, because a grant cannot have a jwk and oidc_discovery")
+}
+
 func (s *HandlerTestSuite) TestGrantCanNotBeCreatedWithSameIssuerSubjectKey() {
 	createRequestParams := s.newCreateJwtBearerGrantParams(
 		"ory",
@@ -148,7 +193,7 @@ func (s *HandlerTestSuite) TestGrantCanNotBeCreatedWithUnknownJWK() {
 		AllowAnySubject: new(true),
 		ExpiresAt:       time.Now().Add(1 * time.Hour),
 		Issuer:          "ory",
-		Jwk: hydra.JsonWebKey{
+		Jwk: &hydra.JsonWebKey{
 			Alg: "unknown",
 		},
 		Scope: []string{"openid", "offline", "profile"},
@@ -283,7 +328,7 @@ func (s *HandlerTestSuite) TestGrantCanBeDeleted() {
 	s.Error(err, "expected error, because grant has been already deleted")
 }
 
-func (s *HandlerTestSuite) generateJWK(publicKey *rsa.PublicKey) hydra.JsonWebKey {
+func (s *HandlerTestSuite) generateJWK(publicKey *rsa.PublicKey) *hydra.JsonWebKey {
 	var b bytes.Buffer
 	s.Require().NoError(json.NewEncoder(&b).Encode(&jose.JSONWebKey{
 		Key:       publicKey,
@@ -294,7 +339,7 @@ func (s *HandlerTestSuite) generateJWK(publicKey *rsa.PublicKey) hydra.JsonWebKe
 
 	var mJWK hydra.JsonWebKey
 	s.Require().NoError(json.NewDecoder(&b).Decode(&mJWK))
-	return mJWK
+	return &mJWK
 }
 
 func (s *HandlerTestSuite) newCreateJwtBearerGrantParams(
@@ -18,5 +18,7 @@ type GrantManager interface {
 	GetConcreteGrant(ctx context.Context, id uuid.UUID) (Grant, error)
 	DeleteGrant(ctx context.Context, id uuid.UUID) error
 	GetGrants(ctx context.Context, optionalIssuer string, pageOpts ...keysetpagination.Option) ([]Grant, *keysetpagination.Paginator, error)
+	// GetFederatedGrants returns the unexpired grants of the issuer whose keys are fetched instead of being uploaded.
+	GetFederatedGrants(ctx context.Context, issuer string) ([]Grant, error)
 	FlushInactiveGrants(ctx context.Context, notAfter time.Time, limit int, batchSize int) error
 }
@@ -188,3 +188,66 @@ func TestHelperGrantManagerErrors(m GrantManager, km jwk.Manager) func(t *testin
 		require.Error(t, err, "expect error, when fetching non-existing grant")
 	}
 }
+
+func TestHelperGrantManagerFederatedGrants(m GrantManager, parallel bool) func(t *testing.T) {
+	return func(t *testing.T) {
+		if parallel {
+			t.Parallel()
+		}
+		issuer := "https://" + uuid.Must(uuid.NewV4()).String() + ".example.com"
+
+		createdAt := time.Now().UTC().Round(time.Second)
+		federated := Grant{
+			ID:              uuid.Must(uuid.NewV4()),
+			Issuer:          issuer,
+			AllowAnySubject: true,
+			Scope:           []string{"openid"},
+			JWKSURI:         issuer + "/jwks.json",
+			ClaimConditions: ClaimConditions{"repository": "ory/*"},
+			ClaimMappings:   ClaimMappings{{Claim: "ref", Value: "refs/heads/main", Scope: []string{"deploy"}, Audience: []string{"https://api.example.com"}}},
+			CreatedAt:       createdAt,
+			ExpiresAt:       createdAt.AddDate(1, 0, 0),
+		}
+		require.NoError(t, m.CreateGrant(t.Context(), federated, jose.JSONWebKey{}))
+
+		discovered := Grant{
+			ID:        uuid.Must(uuid.NewV4()),
+			Issuer:    issuer,
+			Subject:   "bob@example.com",
+			Scope:     []string{"offline"},
+			CreatedAt: createdAt.Add(time.Second),
+			ExpiresAt: createdAt.AddDate(1, 0, 0),
+
+			OIDCDiscovery: true,
+		}
+		require.NoError(t, m.CreateGrant(t.Context(), discovered, jose.JSONWebKey{}))
+
+		expired := discovered
+		expired.ID = uuid.Must(uuid.NewV4())
+		expired.ExpiresAt = createdAt.Add(-time.Hour)
+		require.NoError(t, m.CreateGrant(t.Context(), expired, jose.JSONWebKey{}))
+
+		storedGrant, err := m.GetConcreteGrant(t.Context(), federated.ID)
+		require.NoError(t, err)
+		assert.True(t, storedGrant.IsFederated())
+		assert.Equal(t, federated.JWKSURI, storedGrant.JWKSURI)
+		assert.Equal(t, federated.ClaimConditions, storedGrant.ClaimConditions)
+		assert.Equal(t, federated.ClaimMappings, storedGrant.ClaimMappings)
+		assert.Empty(t, storedGrant.PublicKey)
+
+		grants, err := m.GetFederatedGrants(t.Context(), issuer)
+		require.NoError(t, err)
+		require.Len(t, grants, 2)
+		assert.Equal(t, discovered.ID, grants[0].ID)
+		assert.True(t, grants[0].OIDCDiscovery)
+		assert.Equal(t, federated.ID, grants[1].ID)
+
+		grants, err = m.GetFederatedGrants(t.Context(), "https://unknown.example.com")
+		require.NoError(t, err)
+		assert.Len(t, grants, 0)
+
+		require.NoError(t, m.DeleteGrant(t.Context(), federated.ID))
+		_, err = m.GetConcreteGrant(t.Context(), federated.ID)
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+	}
+}
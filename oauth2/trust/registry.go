@@ -4,6 +4,7 @@
 package trust
 
 import (
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/x/httpx"
@@ -12,10 +13,12 @@ import (
 
 type InternalRegistry interface {
 	httpx.WriterProvider
+	httpx.ClientProvider
 	logrusx.Provider
 	Registry
 	config.Provider
 	jwk.ManagerProvider
+	audit.RecorderProvider
 }
 
 type Registry interface {
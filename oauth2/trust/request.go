@@ -25,6 +25,18 @@ type createGrantRequest struct {
 	// PublicKeyJWK contains public key in JWK format issued by Issuer, that will be used to check JWT assertion signature.
 	PublicKeyJWK jose.JSONWebKey `json:"jwk"`
 
+	// JWKSURI is the URL of the JSON Web Key Set of Issuer, that will be used to check JWT assertion signature.
+	JWKSURI string `json:"jwks_uri"`
+
+	// OIDCDiscovery indicates that the JSON Web Key Set of Issuer is fetched from its OpenID Connect discovery document.
+	OIDCDiscovery bool `json:"oidc_discovery"`
+
+	// ClaimConditions contains glob patterns which the claims of the JWT assertion must match.
+	ClaimConditions ClaimConditions `json:"claim_conditions"`
+
+	// ClaimMappings grant additional scope and audience to JWT assertions with matching claims.
+	ClaimMappings ClaimMappings `json:"claim_mappings"`
+
 	// ExpiresAt indicates, when grant will expire, so we will reject assertion from Issuer targeting Subject.
 	ExpiresAt time.Time `json:"expires_at"`
 }
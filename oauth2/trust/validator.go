@@ -3,7 +3,14 @@
 
 package trust
 
-import "github.com/pkg/errors"
+import (
+	"net/url"
+
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+)
 
 func validateGrant(request createGrantRequest) error {
 	if request.Issuer == "" {
@@ -22,9 +29,48 @@ func validateGrant(request createGrantRequest) error {
 		return errors.WithStack(ErrMissingRequiredParameter.WithHint("Field 'expires_at' is required."))
 	}
 
-	if request.PublicKeyJWK.KeyID == "" {
-		return errors.WithStack(ErrMissingRequiredParameter.WithHint("Field 'jwk' must contain JWK with kid header."))
+	hasJWK := request.PublicKeyJWK.KeyID != "" || request.PublicKeyJWK.Key != nil
+	if hasJWK && (request.JWKSURI != "" || request.OIDCDiscovery) || request.JWKSURI != "" && request.OIDCDiscovery {
+		return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Only one of 'jwk', 'jwks_uri' and 'oidc_discovery' fields can be set."))
+	}
+
+	switch {
+	case request.JWKSURI != "":
+		if !isHTTPURL(request.JWKSURI) {
+			return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Field 'jwks_uri' must be an absolute HTTP(S) URL."))
+		}
+	case request.OIDCDiscovery:
+		if !isHTTPURL(request.Issuer) {
+			return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Field 'issuer' must be an absolute HTTP(S) URL if 'oidc_discovery' is set."))
+		}
+	default:
+		if request.PublicKeyJWK.KeyID == "" {
+			return errors.WithStack(ErrMissingRequiredParameter.WithHint("Field 'jwk' must contain JWK with kid header."))
+		}
+		if len(request.ClaimConditions) > 0 || len(request.ClaimMappings) > 0 {
+			return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Fields 'claim_conditions' and 'claim_mappings' can only be set together with 'jwks_uri' or 'oidc_discovery'."))
+		}
+	}
+
+	for claim, pattern := range request.ClaimConditions {
+		if _, err := glob.Compile(pattern); err != nil {
+			return errors.WithStack(fosite.ErrInvalidRequest.WithHintf("Field 'claim_conditions' contains an invalid pattern for claim '%s': %s", claim, err))
+		}
+	}
+
+	for _, m := range request.ClaimMappings {
+		if m.Claim == "" || m.Value == "" {
+			return errors.WithStack(ErrMissingRequiredParameter.WithHint("Each entry of field 'claim_mappings' must contain a 'claim' and a 'value'."))
+		}
+		if _, err := glob.Compile(m.Value); err != nil {
+			return errors.WithStack(fosite.ErrInvalidRequest.WithHintf("Field 'claim_mappings' contains an invalid pattern for claim '%s': %s", m.Claim, err))
+		}
 	}
 
 	return nil
 }
+
+func isHTTPURL(raw string) bool {
+	u, err := url.ParseRequestURI(raw)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
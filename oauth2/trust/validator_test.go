@@ -121,3 +121,116 @@ func TestIsValid(t *testing.T) {
 
 	assert.NoError(t, validateGrant(r))
 }
+
+func TestJWKAndJWKSURIIsInvalid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "https://valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		PublicKeyJWK: jose.JSONWebKey{
+			KeyID: "valid-key-id",
+		},
+		JWKSURI: "https://valid-issuer/jwks.json",
+	}
+
+	err := &fosite.RFC6749Error{}
+	require.ErrorAs(t, validateGrant(r), &err)
+	assert.Equal(t, "Only one of 'jwk', 'jwks_uri' and 'oidc_discovery' fields can be set.", err.HintField)
+}
+
+func TestJWKSURIAndOIDCDiscoveryIsInvalid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "https://valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		JWKSURI:         "https://valid-issuer/jwks.json",
+		OIDCDiscovery:   true,
+	}
+
+	err := &fosite.RFC6749Error{}
+	require.ErrorAs(t, validateGrant(r), &err)
+	assert.Equal(t, "Only one of 'jwk', 'jwks_uri' and 'oidc_discovery' fields can be set.", err.HintField)
+}
+
+func TestRelativeJWKSURIIsInvalid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "https://valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		JWKSURI:         "/jwks.json",
+	}
+
+	err := &fosite.RFC6749Error{}
+	require.ErrorAs(t, validateGrant(r), &err)
+	assert.Equal(t, "Field 'jwks_uri' must be an absolute HTTP(S) URL.", err.HintField)
+}
+
+func TestOIDCDiscoveryWithoutURLIssuerIsInvalid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		OIDCDiscovery:   true,
+	}
+
+	err := &fosite.RFC6749Error{}
+	require.ErrorAs(t, validateGrant(r), &err)
+	assert.Equal(t, "Field 'issuer' must be an absolute HTTP(S) URL if 'oidc_discovery' is set.", err.HintField)
+}
+
+func TestClaimConditionsWithJWKIsInvalid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		PublicKeyJWK: jose.JSONWebKey{
+			KeyID: "valid-key-id",
+		},
+		ClaimConditions: ClaimConditions{"repository": "ory/*"},
+	}
+
+	err := &fosite.RFC6749Error{}
+	require.ErrorAs(t, validateGrant(r), &err)
+	assert.Equal(t, "Fields 'claim_conditions' and 'claim_mappings' can only be set together with 'jwks_uri' or 'oidc_discovery'.", err.HintField)
+}
+
+func TestInvalidClaimConditionPatternIsInvalid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "https://valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		OIDCDiscovery:   true,
+		ClaimConditions: ClaimConditions{"repository": "ory/[*"},
+	}
+
+	err := &fosite.RFC6749Error{}
+	require.ErrorAs(t, validateGrant(r), &err)
+	assert.Contains(t, err.HintField, "Field 'claim_conditions' contains an invalid pattern for claim 'repository'")
+}
+
+func TestClaimMappingWithoutValueIsInvalid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "https://valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		OIDCDiscovery:   true,
+		ClaimMappings:   ClaimMappings{{Claim: "ref", Scope: []string{"deploy"}}},
+	}
+
+	err := &fosite.RFC6749Error{}
+	require.ErrorAs(t, validateGrant(r), &err)
+	assert.Equal(t, "Each entry of field 'claim_mappings' must contain a 'claim' and a 'value'.", err.HintField)
+}
+
+func TestFederatedIsValid(t *testing.T) {
+	r := createGrantRequest{
+		Issuer:          "https://valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		JWKSURI:         "https://valid-issuer/jwks.json",
+		ClaimConditions: ClaimConditions{"repository": "ory/*"},
+		ClaimMappings:   ClaimMappings{{Claim: "ref", Value: "refs/heads/main", Scope: []string{"deploy"}}},
+	}
+
+	assert.NoError(t, validateGrant(r))
+}
@@ -6,9 +6,13 @@ package persistence
 import (
 	"context"
 
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/hydra/v2/network"
 	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/pop/v6"
 	"github.com/ory/x/networkx"
@@ -20,8 +24,14 @@ type (
 		consent.LoginManager
 		consent.LogoutManager
 		client.Manager
+		client.InitialAccessTokenManager
 		x.FositeStorer
 		trust.GrantManager
+		NetworkArchiver
+		network.Manager
+		audit.Manager
+		ssf.Manager
+		janitor.Manager
 
 		Connection(context.Context) *pop.Connection
 		Transaction(context.Context, func(ctx context.Context, c *pop.Connection) error) error
@@ -0,0 +1,47 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotFound is returned by a Client if the key does not exist or has expired.
+var ErrNotFound = errors.New("key not found")
+
+// Client is a key-value store which removes keys once their time to live has passed.
+type Client interface {
+	// Get returns the value of the key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// GetDel returns the value of the key and deletes it.
+	GetDel(ctx context.Context, key string) ([]byte, error)
+	// Set sets the value of the key, replacing any previous value and time to live.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// SetNX sets the value of the key only if it does not exist yet, and reports whether it was set.
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// Del deletes the keys. Keys which do not exist are ignored.
+	Del(ctx context.Context, keys ...string) error
+	// SAdd adds the members to the set stored at the key and replaces the time to live of the set.
+	SAdd(ctx context.Context, key string, ttl time.Duration, members ...string) error
+	// SMembers returns the members of the set stored at the key. It returns no members if the key does not exist.
+	SMembers(ctx context.Context, key string) ([]string, error)
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// NewClient returns the Client for the data source name. "memory" returns a client which keeps all values in the
+// memory of the process, "redis://" and "rediss://" URLs return a client for Redis.
+func NewClient(dsn string) (Client, error) {
+	switch {
+	case dsn == "memory":
+		return NewMemory(), nil
+	case strings.HasPrefix(dsn, "redis://"), strings.HasPrefix(dsn, "rediss://"):
+		return NewRedis(dsn)
+	}
+	return nil, errors.Errorf(`unsupported short-lived storage, expected "memory" or a "redis://" or "rediss://" URL`)
+}
@@ -0,0 +1,150 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/persistence/kv"
+)
+
+func TestClients(t *testing.T) {
+	t.Parallel()
+
+	// Every client is returned with a function which lets the given time pass for the client.
+	clients := map[string]func(t *testing.T) (kv.Client, func(time.Duration)){
+		"memory": func(*testing.T) (kv.Client, func(time.Duration)) { return kv.NewMemory(), time.Sleep },
+		"redis": func(t *testing.T) (kv.Client, func(time.Duration)) {
+			s := miniredis.RunT(t)
+			s.RequireUserAuth("user", "secret")
+			c, err := kv.NewRedis("redis://user:secret@" + s.Addr() + "/3")
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = c.Close() })
+			// miniredis only expires keys when time is fast-forwarded.
+			return c, s.FastForward
+		},
+	}
+	if !testing.Short() {
+		clients["redis-server"] = func(t *testing.T) (kv.Client, func(time.Duration)) {
+			c, err := kv.NewRedis(testhelpers.ConnectToRedis(t))
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = c.Close() })
+			return c, time.Sleep
+		}
+	}
+
+	for name, newClient := range clients {
+		t.Run("client="+name, func(t *testing.T) {
+			t.Parallel()
+
+			c, wait := newClient(t)
+			ctx := t.Context()
+
+			require.NoError(t, c.Ping(ctx))
+
+			t.Run("case=get unknown key", func(t *testing.T) {
+				_, err := c.Get(ctx, "unknown")
+				assert.ErrorIs(t, err, kv.ErrNotFound)
+				_, err = c.GetDel(ctx, "unknown")
+				assert.ErrorIs(t, err, kv.ErrNotFound)
+			})
+
+			t.Run("case=set get delete", func(t *testing.T) {
+				require.NoError(t, c.Set(ctx, "key", []byte("value\r\n with a line break"), time.Minute))
+				v, err := c.Get(ctx, "key")
+				require.NoError(t, err)
+				assert.Equal(t, "value\r\n with a line break", string(v))
+
+				require.NoError(t, c.Set(ctx, "key", []byte{}, time.Minute))
+				v, err = c.Get(ctx, "key")
+				require.NoError(t, err)
+				assert.Empty(t, v)
+
+				require.NoError(t, c.Del(ctx, "key", "unknown"))
+				_, err = c.Get(ctx, "key")
+				assert.ErrorIs(t, err, kv.ErrNotFound)
+			})
+
+			t.Run("case=set if not exists", func(t *testing.T) {
+				ok, err := c.SetNX(ctx, "nx", []byte("first"), time.Minute)
+				require.NoError(t, err)
+				assert.True(t, ok)
+
+				ok, err = c.SetNX(ctx, "nx", []byte("second"), time.Minute)
+				require.NoError(t, err)
+				assert.False(t, ok)
+
+				v, err := c.GetDel(ctx, "nx")
+				require.NoError(t, err)
+				assert.Equal(t, "first", string(v))
+				_, err = c.Get(ctx, "nx")
+				assert.ErrorIs(t, err, kv.ErrNotFound)
+			})
+
+			t.Run("case=sets", func(t *testing.T) {
+				members, err := c.SMembers(ctx, "unknown-set")
+				require.NoError(t, err)
+				assert.Empty(t, members)
+
+				require.NoError(t, c.SAdd(ctx, "set", time.Minute, "a", "b"))
+				require.NoError(t, c.SAdd(ctx, "set", time.Minute, "b", "c"))
+				require.NoError(t, c.SAdd(ctx, "set", time.Minute))
+				members, err = c.SMembers(ctx, "set")
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []string{"a", "b", "c"}, members)
+
+				require.NoError(t, c.Del(ctx, "set"))
+				members, err = c.SMembers(ctx, "set")
+				require.NoError(t, err)
+				assert.Empty(t, members)
+			})
+
+			t.Run("case=keys expire", func(t *testing.T) {
+				require.NoError(t, c.Set(ctx, "expires", []byte("value"), 10*time.Millisecond))
+				ok, err := c.SetNX(ctx, "expires-nx", []byte("value"), 10*time.Millisecond)
+				require.NoError(t, err)
+				require.True(t, ok)
+
+				wait(20 * time.Millisecond)
+
+				_, err = c.Get(ctx, "expires")
+				assert.ErrorIs(t, err, kv.ErrNotFound)
+				ok, err = c.SetNX(ctx, "expires-nx", []byte("value"), time.Minute)
+				require.NoError(t, err)
+				assert.True(t, ok)
+
+				require.NoError(t, c.SAdd(ctx, "expires-set", 10*time.Millisecond, "a"))
+				wait(20 * time.Millisecond)
+				members, err := c.SMembers(ctx, "expires-set")
+				require.NoError(t, err)
+				assert.Empty(t, members)
+			})
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	c, err := kv.NewClient("memory")
+	require.NoError(t, err)
+	assert.IsType(t, &kv.Memory{}, c)
+
+	for _, dsn := range []string{"redis://localhost", "rediss://:password@localhost:6380/1"} {
+		c, err = kv.NewClient(dsn)
+		require.NoError(t, err, dsn)
+		assert.IsType(t, &kv.Redis{}, c)
+	}
+
+	for _, dsn := range []string{"", "postgres://localhost", "redis://", "redis://localhost/db"} {
+		_, err = kv.NewClient(dsn)
+		assert.Error(t, err, dsn)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var _ Client = (*Memory)(nil)
+
+// sweepInterval is the number of writes after which expired keys are removed from a Memory client.
+const sweepInterval = 1024
+
+type (
+	// Memory is a Client which keeps all values in the memory of the process. It is not shared between instances
+	// and must only be used with a single Hydra instance.
+	Memory struct {
+		mu      sync.Mutex
+		entries map[string]memoryEntry
+		writes  int
+	}
+	memoryEntry struct {
+		value     []byte
+		members   map[string]struct{}
+		expiresAt time.Time
+	}
+)
+
+func NewMemory() *Memory {
+	return &Memory{entries: make(map[string]memoryEntry)}
+}
+
+func (e memoryEntry) expired(now time.Time) bool {
+	return !e.expiresAt.After(now)
+}
+
+// get must be called with the lock held.
+func (m *Memory) get(key string) ([]byte, error) {
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, errors.WithStack(ErrNotFound)
+	} else if e.expired(time.Now()) {
+		delete(m.entries, key)
+		return nil, errors.WithStack(ErrNotFound)
+	}
+	return slices.Clone(e.value), nil
+}
+
+// set must be called with the lock held.
+func (m *Memory) set(key string, value []byte, ttl time.Duration) {
+	m.entries[key] = memoryEntry{value: slices.Clone(value), expiresAt: time.Now().Add(ttl)}
+	m.written()
+}
+
+// written counts a write and removes expired keys every sweepInterval writes. It must be called with the lock held.
+func (m *Memory) written() {
+	if m.writes++; m.writes%sweepInterval == 0 {
+		now := time.Now()
+		for k, e := range m.entries {
+			if e.expired(now) {
+				delete(m.entries, k)
+			}
+		}
+	}
+}
+
+func (m *Memory) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.get(key)
+}
+
+func (m *Memory) GetDel(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, err := m.get(key)
+	delete(m.entries, key)
+	return v, err
+}
+
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.set(key, value, ttl)
+	return nil
+}
+
+func (m *Memory) SetNX(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, err := m.get(key); err == nil {
+		return false, nil
+	}
+	m.set(key, value, ttl)
+	return true, nil
+}
+
+func (m *Memory) Del(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, k := range keys {
+		delete(m.entries, k)
+	}
+	return nil
+}
+
+func (m *Memory) SAdd(_ context.Context, key string, ttl time.Duration, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok || e.expired(time.Now()) {
+		e = memoryEntry{members: make(map[string]struct{}, len(members))}
+	}
+	for _, member := range members {
+		e.members[member] = struct{}{}
+	}
+	e.expiresAt = time.Now().Add(ttl)
+	m.entries[key] = e
+	m.written()
+	return nil
+}
+
+func (m *Memory) SMembers(_ context.Context, key string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok || e.expired(time.Now()) {
+		return []string{}, nil
+	}
+	return slices.Collect(maps.Keys(e.members)), nil
+}
+
+func (m *Memory) Ping(context.Context) error { return nil }
+
+func (m *Memory) Close() error { return nil }
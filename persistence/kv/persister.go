@@ -0,0 +1,195 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/aead"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/persistence"
+	"github.com/ory/x/otelx"
+)
+
+var (
+	_ persistence.Persister     = (*Persister)(nil)
+	_ oauth2.AssertionJWTReader = (*Persister)(nil)
+)
+
+// retention is how long records are kept after they expired or were used. It allows telling expired and reused
+// artifacts apart from unknown ones, and matches how long the SQL persister keeps used authorization codes.
+const retention = 30 * time.Minute
+
+type (
+	// Persister stores short-lived artifacts in a key-value store which removes them once they expire. These are
+	// authorization codes, PKCE and OpenID Connect requests, device and user codes, the JTIs of client assertions,
+	// logout requests and login sessions. Everything else, including clients, keys, tokens and consent sessions, is
+	// stored by the wrapped persister.
+	//
+	// Writes to the key-value store are not part of database transactions and are not rolled back with them.
+	Persister struct {
+		persistence.Persister
+		c Client
+		d Dependencies
+	}
+	Dependencies interface {
+		KeyCipher() *aead.AESGCM
+		otelx.Provider
+		config.Provider
+	}
+
+	// request is the record of a fosite request.
+	request struct {
+		ID                string               `json:"id"`
+		RequestedAt       time.Time            `json:"requested_at"`
+		ClientID          string               `json:"client_id"`
+		RequestedScope    []string             `json:"requested_scope,omitempty"`
+		GrantedScope      []string             `json:"granted_scope,omitempty"`
+		RequestedAudience []string             `json:"requested_audience,omitempty"`
+		GrantedAudience   []string             `json:"granted_audience,omitempty"`
+		Form              string               `json:"form,omitempty"`
+		Session           []byte               `json:"session"`
+		Encrypted         bool                 `json:"encrypted,omitempty"`
+		Active            bool                 `json:"active"`
+		ExpiresAt         time.Time            `json:"expires_at"`
+		UserCodeSignature string               `json:"user_code_signature,omitempty"`
+		UserCodeState     fosite.UserCodeState `json:"user_code_state,omitempty"`
+	}
+)
+
+func NewPersister(p persistence.Persister, c Client, d Dependencies) *Persister {
+	return &Persister{Persister: p, c: c, d: d}
+}
+
+// key returns the key of a record of the given kind in the network of the context.
+func (p *Persister) key(ctx context.Context, kind, id string) string {
+	return "hydra:" + p.NetworkID(ctx).String() + ":" + kind + ":" + id
+}
+
+// ttl returns the time to live of a record which expires at the given time.
+func ttl(expiresAt time.Time) time.Duration {
+	if expiresAt.IsZero() {
+		return retention
+	}
+	return max(time.Until(expiresAt), 0) + retention
+}
+
+func (p *Persister) getJSON(ctx context.Context, key string, v any) error {
+	b, err := p.c.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(json.Unmarshal(b, v))
+}
+
+func (p *Persister) setJSON(ctx context.Context, key string, v any, ttl time.Duration) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return p.c.Set(ctx, key, b, ttl)
+}
+
+func (p *Persister) newRequest(ctx context.Context, r fosite.Requester, expiresAt time.Time) (*request, error) {
+	if _, ok := r.GetSession().(*oauth2.Session); !ok && r.GetSession() != nil {
+		return nil, errors.Errorf("Expected request to be of type *Session, but got: %T", r.GetSession())
+	}
+
+	session, err := json.Marshal(r.GetSession())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	encrypted := p.d.Config().EncryptSessionData(ctx)
+	if encrypted {
+		ciphertext, err := p.d.KeyCipher().Encrypt(ctx, session, nil)
+		if err != nil {
+			return nil, err
+		}
+		session = []byte(ciphertext)
+	}
+
+	return &request{
+		ID:                r.GetID(),
+		RequestedAt:       r.GetRequestedAt().UTC(),
+		ClientID:          r.GetClient().GetID(),
+		RequestedScope:    r.GetRequestedScopes(),
+		GrantedScope:      r.GetGrantedScopes(),
+		RequestedAudience: r.GetRequestedAudience(),
+		GrantedAudience:   r.GetGrantedAudience(),
+		Form:              r.GetRequestForm().Encode(),
+		Session:           session,
+		Encrypted:         encrypted,
+		Active:            true,
+		ExpiresAt:         expiresAt.UTC(),
+	}, nil
+}
+
+func (r *request) setSession(ctx context.Context, p *Persister, session fosite.Session) error {
+	b, err := json.Marshal(session)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if r.Encrypted {
+		ciphertext, err := p.d.KeyCipher().Encrypt(ctx, b, nil)
+		if err != nil {
+			return err
+		}
+		b = []byte(ciphertext)
+	}
+	r.Session = b
+	return nil
+}
+
+func (r *request) toRequest(ctx context.Context, p *Persister, session fosite.Session) (*fosite.Request, error) {
+	sess := r.Session
+	if r.Encrypted {
+		var err error
+		if sess, err = p.d.KeyCipher().Decrypt(ctx, string(sess), nil); err != nil {
+			return nil, err
+		}
+	}
+	if session != nil {
+		if err := json.Unmarshal(sess, session); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	c, err := p.GetClient(ctx, r.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := url.ParseQuery(r.Form)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &fosite.Request{
+		ID:                r.ID,
+		RequestedAt:       r.RequestedAt,
+		Client:            c,
+		RequestedScope:    r.RequestedScope,
+		GrantedScope:      r.GrantedScope,
+		RequestedAudience: r.RequestedAudience,
+		GrantedAudience:   r.GrantedAudience,
+		Form:              form,
+		Session:           session,
+	}, nil
+}
+
+// notFound converts ErrNotFound of the Client into fosite.ErrNotFound.
+func notFound(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return errors.WithStack(fosite.ErrNotFound)
+	}
+	return err
+}
@@ -0,0 +1,166 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/x/otelx"
+)
+
+const (
+	kindDeviceCode    = "device_code"
+	kindUserCode      = "user_code"
+	kindDeviceRequest = "device_request"
+)
+
+// findDeviceSession returns the record of the device code with the given signature. The user code and the request ID
+// of a device code refer to the signature of the device code.
+func (p *Persister) findDeviceSession(ctx context.Context, signature string) (*request, error) {
+	var r request
+	if err := p.getJSON(ctx, p.key(ctx, kindDeviceCode, signature), &r); err != nil {
+		return nil, notFound(err)
+	}
+	return &r, nil
+}
+
+func (p *Persister) toDeviceRequest(ctx context.Context, r *request, session fosite.Session) (*fosite.DeviceRequest, error) {
+	fr, err := r.toRequest(ctx, p, session)
+	if err != nil {
+		return nil, err
+	}
+	return &fosite.DeviceRequest{Request: *fr, UserCodeState: r.UserCodeState}, nil
+}
+
+// CreateDeviceAuthSession implements DeviceAuthStorage
+func (p *Persister) CreateDeviceAuthSession(ctx context.Context, deviceCodeSignature, userCodeSignature string, requester fosite.DeviceRequester) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.CreateDeviceAuthSession")
+	defer otelx.End(span, &err)
+
+	r, err := p.newRequest(ctx, requester, requester.GetSession().GetExpiresAt(fosite.DeviceCode))
+	if err != nil {
+		return err
+	}
+	r.UserCodeSignature = userCodeSignature
+	r.UserCodeState = requester.GetUserCodeState()
+
+	if ok, err := p.c.SetNX(ctx, p.key(ctx, kindUserCode, userCodeSignature), []byte(deviceCodeSignature), ttl(r.ExpiresAt)); err != nil {
+		return err
+	} else if !ok {
+		return errors.WithStack(fosite.ErrExistingUserCodeSignature)
+	}
+	if err := p.c.Set(ctx, p.key(ctx, kindDeviceRequest, r.ID), []byte(deviceCodeSignature), ttl(r.ExpiresAt)); err != nil {
+		return err
+	}
+	return p.setJSON(ctx, p.key(ctx, kindDeviceCode, deviceCodeSignature), r, ttl(r.ExpiresAt))
+}
+
+// GetDeviceCodeSession implements DeviceAuthStorage
+func (p *Persister) GetDeviceCodeSession(ctx context.Context, signature string, session fosite.Session) (_ fosite.DeviceRequester, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetDeviceCodeSession")
+	defer otelx.End(span, &err)
+
+	r, err := p.findDeviceSession(ctx, signature)
+	if err != nil {
+		return nil, err
+	}
+	fr, err := p.toDeviceRequest(ctx, r, session)
+	if err != nil {
+		return nil, err
+	}
+	if !r.Active {
+		return fr, errors.WithStack(fosite.ErrInactiveToken)
+	}
+	return fr, nil
+}
+
+// InvalidateDeviceCodeSession implements DeviceAuthStorage
+func (p *Persister) InvalidateDeviceCodeSession(ctx context.Context, signature string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.InvalidateDeviceCodeSession")
+	defer otelx.End(span, &err)
+
+	r, err := p.findDeviceSession(ctx, signature)
+	if errors.Is(err, fosite.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return p.c.Del(ctx,
+		p.key(ctx, kindDeviceCode, signature),
+		p.key(ctx, kindUserCode, r.UserCodeSignature),
+		p.key(ctx, kindDeviceRequest, r.ID),
+	)
+}
+
+// GetUserCodeSession implements FositeStorer
+func (p *Persister) GetUserCodeSession(ctx context.Context, signature string, session fosite.Session) (_ fosite.DeviceRequester, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetUserCodeSession")
+	defer otelx.End(span, &err)
+
+	if session == nil {
+		session = oauth2.NewSessionWithCustomClaims(ctx, p.d.Config(), "")
+	}
+
+	deviceCodeSignature, err := p.c.Get(ctx, p.key(ctx, kindUserCode, signature))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	r, err := p.findDeviceSession(ctx, string(deviceCodeSignature))
+	if err != nil {
+		return nil, err
+	}
+	fr, err := p.toDeviceRequest(ctx, r, session)
+	if err != nil {
+		return nil, err
+	}
+	if r.UserCodeState != fosite.UserCodeUnused {
+		return fr, errors.WithStack(fosite.ErrInactiveToken)
+	}
+	return fr, nil
+}
+
+// GetDeviceCodeSessionByRequestID implements FositeStorer
+func (p *Persister) GetDeviceCodeSessionByRequestID(ctx context.Context, requestID string, session fosite.Session) (_ fosite.DeviceRequester, deviceCodeSignature string, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetDeviceCodeSessionByRequestID")
+	defer otelx.End(span, &err)
+
+	signature, err := p.c.Get(ctx, p.key(ctx, kindDeviceRequest, requestID))
+	if err != nil {
+		return nil, "", notFound(err)
+	}
+	r, err := p.findDeviceSession(ctx, string(signature))
+	if err != nil {
+		return nil, "", err
+	}
+	fr, err := p.toDeviceRequest(ctx, r, session)
+	if err != nil {
+		return nil, "", err
+	}
+	if !r.Active {
+		return fr, string(signature), errors.WithStack(fosite.ErrInactiveToken)
+	}
+	return fr, string(signature), nil
+}
+
+// UpdateDeviceCodeSessionBySignature implements FositeStorer
+func (p *Persister) UpdateDeviceCodeSessionBySignature(ctx context.Context, signature string, requester fosite.DeviceRequester) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.UpdateDeviceCodeSessionBySignature")
+	defer otelx.End(span, &err)
+
+	r, err := p.findDeviceSession(ctx, signature)
+	if err != nil {
+		return err
+	}
+	if err := r.setSession(ctx, p, requester.GetSession()); err != nil {
+		return err
+	}
+	r.GrantedScope = requester.GetGrantedScopes()
+	r.GrantedAudience = requester.GetGrantedAudience()
+	r.UserCodeState = requester.GetUserCodeState()
+	return p.setJSON(ctx, p.key(ctx, kindDeviceCode, signature), r, ttl(r.ExpiresAt))
+}
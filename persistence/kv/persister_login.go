@@ -0,0 +1,143 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+)
+
+var _ consent.LoginManager = (*Persister)(nil)
+
+const (
+	kindLoginSession         = "login_session"
+	kindSubjectLoginSessions = "subject_login_sessions"
+)
+
+// loginSession is the record of a flow.LoginSession, which does not serialize its fields to JSON.
+type loginSession struct {
+	ID                        string           `json:"id"`
+	AuthenticatedAt           sqlxx.NullTime   `json:"authenticated_at"`
+	Subject                   string           `json:"subject"`
+	IdentityProviderSessionID sqlxx.NullString `json:"identity_provider_session_id,omitempty"`
+	Remember                  bool             `json:"remember"`
+	ExpiresAt                 sqlxx.NullTime   `json:"expires_at"`
+}
+
+func (p *Persister) getLoginSession(ctx context.Context, id string) (*flow.LoginSession, error) {
+	var s loginSession
+	if err := p.getJSON(ctx, p.key(ctx, kindLoginSession, id), &s); err != nil {
+		return nil, err
+	}
+	return p.toLoginSession(ctx, &s), nil
+}
+
+func (p *Persister) toLoginSession(ctx context.Context, s *loginSession) *flow.LoginSession {
+	return &flow.LoginSession{
+		ID:                        s.ID,
+		NID:                       p.NetworkID(ctx),
+		AuthenticatedAt:           s.AuthenticatedAt,
+		Subject:                   s.Subject,
+		IdentityProviderSessionID: s.IdentityProviderSessionID,
+		Remember:                  s.Remember,
+		ExpiresAt:                 s.ExpiresAt,
+	}
+}
+
+// GetRememberedLoginSession implements consent.LoginManager.
+func (p *Persister) GetRememberedLoginSession(ctx context.Context, id string) (_ *flow.LoginSession, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetRememberedLoginSession")
+	defer otelx.End(span, &err)
+
+	s, err := p.getLoginSession(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, errors.WithStack(x.ErrNotFound)
+	} else if err != nil {
+		return nil, err
+	} else if !s.Remember {
+		return nil, errors.WithStack(x.ErrNotFound)
+	}
+	return s, nil
+}
+
+// ConfirmLoginSession implements consent.LoginManager. The login session is removed once it expires. Consent
+// sessions keep referencing it by its ID, which is why flows have no foreign key to login sessions.
+func (p *Persister) ConfirmLoginSession(ctx context.Context, session *flow.LoginSession) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.ConfirmLoginSession")
+	defer otelx.End(span, &err)
+
+	lifespan := p.d.Config().GetAuthenticationSessionLifespan(ctx)
+	session.NID = p.NetworkID(ctx)
+	session.AuthenticatedAt = sqlxx.NullTime(time.Time(session.AuthenticatedAt).Truncate(time.Second))
+	session.ExpiresAt = sqlxx.NullTime(time.Now().Truncate(time.Second).Add(lifespan).UTC())
+
+	if err := p.setJSON(ctx, p.key(ctx, kindLoginSession, session.ID), &loginSession{
+		ID:                        session.ID,
+		AuthenticatedAt:           session.AuthenticatedAt,
+		Subject:                   session.Subject,
+		IdentityProviderSessionID: session.IdentityProviderSessionID,
+		Remember:                  session.Remember,
+		ExpiresAt:                 session.ExpiresAt,
+	}, lifespan); err != nil {
+		return err
+	}
+
+	// The index of the sessions of the subject lives as long as its newest session.
+	return p.c.SAdd(ctx, p.key(ctx, kindSubjectLoginSessions, session.Subject), lifespan, session.ID)
+}
+
+// DeleteLoginSession implements consent.LoginManager.
+func (p *Persister) DeleteLoginSession(ctx context.Context, id string) (_ *flow.LoginSession, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.DeleteLoginSession")
+	defer otelx.End(span, &err)
+
+	b, err := p.c.GetDel(ctx, p.key(ctx, kindLoginSession, id))
+	if errors.Is(err, ErrNotFound) {
+		return nil, errors.WithStack(sqlcon.ErrNoRows())
+	} else if err != nil {
+		return nil, err
+	}
+
+	var s loginSession
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return p.toLoginSession(ctx, &s), nil
+}
+
+// RevokeSubjectLoginSession implements consent.LoginManager.
+func (p *Persister) RevokeSubjectLoginSession(ctx context.Context, subject string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.RevokeSubjectLoginSession")
+	defer otelx.End(span, &err)
+
+	index := p.key(ctx, kindSubjectLoginSessions, subject)
+	ids, err := p.c.SMembers(ctx, index)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{index}
+	for _, id := range ids {
+		// The subject of a session can change when it is confirmed again, so the index may be outdated.
+		s, err := p.getLoginSession(ctx, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		} else if s.Subject == subject {
+			keys = append(keys, p.key(ctx, kindLoginSession, id))
+		}
+	}
+	return p.c.Del(ctx, keys...)
+}
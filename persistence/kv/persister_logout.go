@@ -0,0 +1,193 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+)
+
+const (
+	kindLogout         = "logout"
+	kindLogoutVerifier = "logout_verifier"
+)
+
+// logoutRequest is the record of a flow.LogoutRequest, which does not serialize all of its fields to JSON.
+type logoutRequest struct {
+	ID                    string         `json:"challenge"`
+	Subject               string         `json:"subject"`
+	SessionID             string         `json:"sid,omitempty"`
+	RequestURL            string         `json:"request_url"`
+	RPInitiated           bool           `json:"rp_initiated"`
+	WasHandled            bool           `json:"was_used"`
+	Verifier              string         `json:"verifier"`
+	PostLogoutRedirectURI string         `json:"redir_url"`
+	Accepted              bool           `json:"accepted"`
+	Rejected              bool           `json:"rejected"`
+	ClientID              string         `json:"client_id,omitempty"`
+	ExpiresAt             sqlxx.NullTime `json:"expires_at"`
+	RequestedAt           sqlxx.NullTime `json:"requested_at"`
+}
+
+func (p *Persister) getLogoutRequest(ctx context.Context, challenge string) (*logoutRequest, error) {
+	var r logoutRequest
+	if err := p.getJSON(ctx, p.key(ctx, kindLogout, challenge), &r); errors.Is(err, ErrNotFound) {
+		return nil, errors.WithStack(sqlcon.ErrNoRows())
+	} else if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (p *Persister) setLogoutRequest(ctx context.Context, r *logoutRequest) error {
+	return p.setJSON(ctx, p.key(ctx, kindLogout, r.ID), r, ttl(time.Time(r.ExpiresAt)))
+}
+
+func (p *Persister) toLogoutRequest(ctx context.Context, r *logoutRequest) (*flow.LogoutRequest, error) {
+	lr := &flow.LogoutRequest{
+		ID:                    r.ID,
+		NID:                   p.NetworkID(ctx),
+		Subject:               r.Subject,
+		SessionID:             r.SessionID,
+		RequestURL:            r.RequestURL,
+		RPInitiated:           r.RPInitiated,
+		WasHandled:            r.WasHandled,
+		Verifier:              r.Verifier,
+		PostLogoutRedirectURI: r.PostLogoutRedirectURI,
+		Accepted:              r.Accepted,
+		Rejected:              r.Rejected,
+		ExpiresAt:             r.ExpiresAt,
+		RequestedAt:           r.RequestedAt,
+	}
+	if r.ClientID != "" {
+		c, err := p.GetConcreteClient(ctx, r.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		lr.ClientID = sql.NullString{Valid: true, String: r.ClientID}
+		lr.Client = c
+	}
+	return lr, nil
+}
+
+// CreateLogoutRequest implements consent.LogoutManager
+func (p *Persister) CreateLogoutRequest(ctx context.Context, request *flow.LogoutRequest) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.CreateLogoutRequest")
+	defer otelx.End(span, &err)
+
+	r := &logoutRequest{
+		ID:                    request.ID,
+		Subject:               request.Subject,
+		SessionID:             request.SessionID,
+		RequestURL:            request.RequestURL,
+		RPInitiated:           request.RPInitiated,
+		WasHandled:            request.WasHandled,
+		Verifier:              request.Verifier,
+		PostLogoutRedirectURI: request.PostLogoutRedirectURI,
+		Accepted:              request.Accepted,
+		Rejected:              request.Rejected,
+		ExpiresAt:             request.ExpiresAt,
+		RequestedAt:           request.RequestedAt,
+	}
+	if request.Client != nil {
+		r.ClientID = request.Client.GetID()
+	} else if request.ClientID.Valid {
+		r.ClientID = request.ClientID.String
+	}
+	request.NID = p.NetworkID(ctx)
+
+	if err := p.c.Set(ctx, p.key(ctx, kindLogoutVerifier, r.Verifier), []byte(r.ID), ttl(time.Time(r.ExpiresAt))); err != nil {
+		return err
+	}
+	return p.setLogoutRequest(ctx, r)
+}
+
+// GetLogoutRequest implements consent.LogoutManager
+func (p *Persister) GetLogoutRequest(ctx context.Context, challenge string) (_ *flow.LogoutRequest, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetLogoutRequest")
+	defer otelx.End(span, &err)
+
+	r, err := p.getLogoutRequest(ctx, challenge)
+	if err != nil {
+		return nil, err
+	} else if r.Rejected {
+		return nil, errors.WithStack(sqlcon.ErrNoRows())
+	}
+	return p.toLogoutRequest(ctx, r)
+}
+
+// AcceptLogoutRequest implements consent.LogoutManager
+func (p *Persister) AcceptLogoutRequest(ctx context.Context, challenge string) (_ *flow.LogoutRequest, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.AcceptLogoutRequest")
+	defer otelx.End(span, &err)
+
+	r, err := p.getLogoutRequest(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+	r.Accepted, r.Rejected = true, false
+	if err := p.setLogoutRequest(ctx, r); err != nil {
+		return nil, err
+	}
+	return p.toLogoutRequest(ctx, r)
+}
+
+// RejectLogoutRequest implements consent.LogoutManager
+func (p *Persister) RejectLogoutRequest(ctx context.Context, challenge string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.RejectLogoutRequest")
+	defer otelx.End(span, &err)
+
+	r, err := p.getLogoutRequest(ctx, challenge)
+	if errors.Is(err, sqlcon.ErrNoRows()) {
+		return errors.WithStack(x.ErrNotFound)
+	} else if err != nil {
+		return err
+	}
+	r.Accepted, r.Rejected = false, true
+	return p.setLogoutRequest(ctx, r)
+}
+
+// VerifyAndInvalidateLogoutRequest implements consent.LogoutManager
+func (p *Persister) VerifyAndInvalidateLogoutRequest(ctx context.Context, verifier string) (_ *flow.LogoutRequest, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.VerifyAndInvalidateLogoutRequest")
+	defer otelx.End(span, &err)
+
+	challenge, err := p.c.Get(ctx, p.key(ctx, kindLogoutVerifier, verifier))
+	if errors.Is(err, ErrNotFound) {
+		return nil, errors.WithStack(x.ErrNotFound)
+	} else if err != nil {
+		return nil, err
+	}
+
+	r, err := p.getLogoutRequest(ctx, string(challenge))
+	if errors.Is(err, sqlcon.ErrNoRows()) {
+		return nil, errors.WithStack(x.ErrNotFound)
+	} else if err != nil {
+		return nil, err
+	} else if !r.Accepted || r.Rejected {
+		return nil, errors.WithStack(x.ErrNotFound)
+	}
+
+	r.WasHandled = true
+	if err := p.setLogoutRequest(ctx, r); err != nil {
+		return nil, err
+	}
+
+	if expiry := time.Time(r.ExpiresAt);
+	// If the expiry is unset, we are in a legacy use case (allow logout).
+	!expiry.IsZero() && expiry.Before(time.Now().UTC()) {
+		return nil, errors.WithStack(flow.ErrorLogoutFlowExpired)
+	}
+
+	return p.toLogoutRequest(ctx, r)
+}
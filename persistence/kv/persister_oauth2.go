@@ -0,0 +1,281 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/hydra/v2/x/events"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+const (
+	kindCode     = "code"
+	kindCodeUsed = "code_used"
+	kindPKCE     = "pkce"
+	kindPAR      = "par"
+	kindOpenID   = "oidc"
+	kindJTI      = "jti"
+)
+
+func (p *Persister) createSession(ctx context.Context, kind, signature string, requester fosite.Requester) error {
+	// The expiry of PKCE and OpenID Connect requests is equal to the expiry of the authorization code.
+	r, err := p.newRequest(ctx, requester, requester.GetSession().GetExpiresAt(fosite.AuthorizeCode))
+	if err != nil {
+		return err
+	}
+	return p.setJSON(ctx, p.key(ctx, kind, signature), r, ttl(r.ExpiresAt))
+}
+
+func (p *Persister) findSession(ctx context.Context, kind, signature string, session fosite.Session) (fosite.Requester, error) {
+	var r request
+	if err := p.getJSON(ctx, p.key(ctx, kind, signature), &r); err != nil {
+		return nil, notFound(err)
+	}
+
+	fr, err := r.toRequest(ctx, p, session)
+	if err != nil {
+		return nil, err
+	}
+	if !r.Active {
+		if kind == kindCode {
+			return fr, errors.WithStack(fosite.ErrInvalidatedAuthorizeCode)
+		}
+		return fr, errors.WithStack(fosite.ErrInactiveToken)
+	}
+	return fr, nil
+}
+
+func (p *Persister) deleteSession(ctx context.Context, kind, signature string) error {
+	return p.c.Del(ctx, p.key(ctx, kind, signature))
+}
+
+// CreateAuthorizeCodeSession implements AuthorizeCodeStorage
+func (p *Persister) CreateAuthorizeCodeSession(ctx context.Context, signature string, requester fosite.Requester) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.CreateAuthorizeCodeSession")
+	defer otelx.End(span, &err)
+
+	return p.createSession(ctx, kindCode, signature, requester)
+}
+
+// GetAuthorizeCodeSession implements AuthorizeCodeStorage
+func (p *Persister) GetAuthorizeCodeSession(ctx context.Context, _, signature string, session fosite.Session) (_ fosite.Requester, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetAuthorizeCodeSession")
+	defer otelx.End(span, &err)
+
+	return p.findSession(ctx, kindCode, signature, session)
+}
+
+// InvalidateAuthorizeCodeSession implements AuthorizeCodeStorage
+//
+// Only the first invalidation of an authorization code succeeds, so that concurrent token requests can not both
+// exchange the same code.
+func (p *Persister) InvalidateAuthorizeCodeSession(ctx context.Context, _, signature string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.InvalidateAuthorizeCodeSession")
+	defer otelx.End(span, &err)
+
+	if ok, err := p.c.SetNX(ctx, p.key(ctx, kindCodeUsed, signature), []byte{1}, retention); err != nil {
+		return err
+	} else if !ok {
+		return errors.WithStack(fosite.ErrInvalidatedAuthorizeCode)
+	}
+
+	key := p.key(ctx, kindCode, signature)
+	var r request
+	if err := p.getJSON(ctx, key, &r); err != nil {
+		return notFound(err)
+	}
+	r.Active = false
+	// The code is kept for reuse detection.
+	return p.setJSON(ctx, key, &r, retention)
+}
+
+// CreatePKCERequestSession implements PKCERequestStorage
+func (p *Persister) CreatePKCERequestSession(ctx context.Context, signature string, requester fosite.Requester) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.CreatePKCERequestSession")
+	defer otelx.End(span, &err)
+
+	return p.createSession(ctx, kindPKCE, signature, requester)
+}
+
+// GetPKCERequestSession implements PKCERequestStorage
+func (p *Persister) GetPKCERequestSession(ctx context.Context, _, signature string, session fosite.Session) (_ fosite.Requester, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetPKCERequestSession")
+	defer otelx.End(span, &err)
+
+	return p.findSession(ctx, kindPKCE, signature, session)
+}
+
+// DeletePKCERequestSession implements PKCERequestStorage
+func (p *Persister) DeletePKCERequestSession(ctx context.Context, _, signature string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.DeletePKCERequestSession")
+	defer otelx.End(span, &err)
+
+	return p.deleteSession(ctx, kindPKCE, signature)
+}
+
+// CreatePARSession implements fosite.PARStorage
+func (p *Persister) CreatePARSession(ctx context.Context, requestURI string, request fosite.AuthorizeRequester) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.CreatePARSession")
+	defer otelx.End(span, &err)
+
+	r, err := p.newRequest(ctx, oauth2.PushedAuthorizeRequestToStore(request), request.GetSession().GetExpiresAt(fosite.PushedAuthorizeRequestContext))
+	if err != nil {
+		return err
+	}
+	return p.setJSON(ctx, p.key(ctx, kindPAR, x.SignatureHash(requestURI)), r, ttl(r.ExpiresAt))
+}
+
+// GetPARSession implements fosite.PARStorage
+//
+// Pushed authorization requests which were already used are returned as well, because the authorization endpoint is
+// requested with the same request_uri again once the user logged in and consented.
+func (p *Persister) GetPARSession(ctx context.Context, requestURI string) (_ fosite.AuthorizeRequester, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetPARSession")
+	defer otelx.End(span, &err)
+
+	var r request
+	if err := p.getJSON(ctx, p.key(ctx, kindPAR, x.SignatureHash(requestURI)), &r); err != nil {
+		return nil, notFound(err)
+	}
+	if time.Now().After(r.ExpiresAt) {
+		return nil, errors.WithStack(fosite.ErrNotFound)
+	}
+
+	fr, err := r.toRequest(ctx, p, oauth2.NewSessionWithCustomClaims(ctx, p.d.Config(), ""))
+	if err != nil {
+		return nil, err
+	}
+	return oauth2.RestorePushedAuthorizeRequest(fr)
+}
+
+// DeletePARSession implements fosite.PARStorage
+//
+// The pushed authorization request is marked as used instead, and expires once the login and consent flow started
+// with it can no longer be completed.
+func (p *Persister) DeletePARSession(ctx context.Context, requestURI string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.DeletePARSession")
+	defer otelx.End(span, &err)
+
+	key := p.key(ctx, kindPAR, x.SignatureHash(requestURI))
+	var r request
+	if err := p.getJSON(ctx, key, &r); err != nil {
+		return notFound(err)
+	}
+	if !r.Active {
+		return nil
+	}
+	r.Active = false
+	r.ExpiresAt = time.Now().UTC().Add(retention)
+	return p.setJSON(ctx, key, &r, retention)
+}
+
+// CreateOpenIDConnectSession implements OpenIDConnectRequestStorage
+func (p *Persister) CreateOpenIDConnectSession(ctx context.Context, signature string, requester fosite.Requester) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.CreateOpenIDConnectSession")
+	defer otelx.End(span, &err)
+
+	events.Trace(ctx, events.IdentityTokenIssued,
+		events.WithGrantType(requester.GetRequestForm().Get("grant_type")),
+		events.WithRequest(requester),
+	)
+	return p.createSession(ctx, kindOpenID, signature, requester)
+}
+
+// GetOpenIDConnectSession implements OpenIDConnectRequestStorage
+func (p *Persister) GetOpenIDConnectSession(ctx context.Context, signature string, requester fosite.Requester) (_ fosite.Requester, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetOpenIDConnectSession")
+	defer otelx.End(span, &err)
+
+	return p.findSession(ctx, kindOpenID, signature, requester.GetSession())
+}
+
+// DeleteOpenIDConnectSession implements OpenIDConnectRequestStorage
+func (p *Persister) DeleteOpenIDConnectSession(ctx context.Context, signature string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.DeleteOpenIDConnectSession")
+	defer otelx.End(span, &err)
+
+	return p.deleteSession(ctx, kindOpenID, signature)
+}
+
+// ClientAssertionJWTValid implements fosite.ClientManager
+func (p *Persister) ClientAssertionJWTValid(ctx context.Context, jti string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.ClientAssertionJWTValid")
+	defer otelx.End(span, &err)
+
+	j, err := p.GetClientAssertionJWT(ctx, jti)
+	if errors.Is(err, sqlcon.ErrNoRows()) {
+		// the jti is not known => valid
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if j.Expiry.After(time.Now()) {
+		// the jti is not expired yet => invalid
+		return errors.WithStack(fosite.ErrJTIKnown)
+	}
+	// the jti is expired => valid
+	return nil
+}
+
+// SetClientAssertionJWT implements fosite.ClientManager
+func (p *Persister) SetClientAssertionJWT(ctx context.Context, jti string, exp time.Time) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.SetClientAssertionJWT")
+	defer otelx.End(span, &err)
+
+	j := oauth2.NewBlacklistedJTI(jti, exp)
+	j.NID = p.NetworkID(ctx)
+	if time.Until(j.Expiry) <= 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(j.Expiry)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if ok, err := p.c.SetNX(ctx, p.key(ctx, kindJTI, j.ID), b, time.Until(j.Expiry)); err != nil {
+		return err
+	} else if !ok {
+		return errors.WithStack(fosite.ErrJTIKnown)
+	}
+	return nil
+}
+
+// GetClientAssertionJWT implements AssertionJWTReader
+func (p *Persister) GetClientAssertionJWT(ctx context.Context, jti string) (_ *oauth2.BlacklistedJTI, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.GetClientAssertionJWT")
+	defer otelx.End(span, &err)
+
+	j := oauth2.NewBlacklistedJTI(jti, time.Time{})
+	if err := p.getJSON(ctx, p.key(ctx, kindJTI, j.ID), &j.Expiry); errors.Is(err, ErrNotFound) {
+		return nil, errors.WithStack(sqlcon.ErrNoRows())
+	} else if err != nil {
+		return nil, err
+	}
+	j.Expiry = j.Expiry.UTC()
+	j.NID = p.NetworkID(ctx)
+	return j, nil
+}
+
+// SetClientAssertionJWTRaw implements AssertionJWTReader
+//
+// JTIs which already expired are not stored.
+func (p *Persister) SetClientAssertionJWTRaw(ctx context.Context, jti *oauth2.BlacklistedJTI) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.kv.SetClientAssertionJWTRaw")
+	defer otelx.End(span, &err)
+
+	jti.NID = p.NetworkID(ctx)
+	if time.Until(jti.Expiry) <= 0 {
+		return nil
+	}
+	return p.setJSON(ctx, p.key(ctx, kindJTI, jti.ID), jti.Expiry, time.Until(jti.Expiry))
+}
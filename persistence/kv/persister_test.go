@@ -0,0 +1,141 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent/test"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/persistence/kv"
+)
+
+func TestPersister(t *testing.T) {
+	t.Parallel()
+
+	storages := map[string]func(t *testing.T) string{
+		"memory": func(*testing.T) string { return "memory" },
+	}
+	if !testing.Short() {
+		storages["redis"] = func(t *testing.T) string { return testhelpers.ConnectToRedis(t) }
+	}
+
+	for name, dsn := range storages {
+		t.Run("storage="+name, func(t *testing.T) {
+			t.Parallel()
+
+			reg := testhelpers.NewRegistryShortLived(t, dsn(t))
+			require.IsType(t, &kv.Persister{}, reg.Persister())
+			testPersister(t, reg)
+		})
+	}
+}
+
+func testPersister(t *testing.T, reg *driver.RegistrySQL) {
+	ctx := t.Context()
+
+	cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String()}
+	require.NoError(t, reg.ClientManager().CreateClient(ctx, cl))
+
+	newRequest := func() *fosite.DeviceRequest {
+		r := fosite.NewDeviceRequest()
+		r.ID = uuid.Must(uuid.NewV4()).String()
+		r.Client = cl
+		r.RequestedScope = fosite.Arguments{"openid", "offline"}
+		r.Session = oauth2.NewTestSession(t, "subject")
+		r.Session.SetExpiresAt(fosite.DeviceCode, time.Now().Add(time.Hour))
+		r.Session.SetExpiresAt(fosite.AuthorizeCode, time.Now().Add(time.Hour))
+		return r
+	}
+
+	t.Run("consent", func(t *testing.T) {
+		test.ConsentManagerTests(t, reg, reg.ConsentManager(), reg.LoginManager(), reg.ClientManager(), reg.OAuth2Storage())
+	})
+
+	t.Run("login", func(t *testing.T) {
+		test.LoginManagerTest(t, reg, reg.LoginManager())
+	})
+
+	t.Run("logout", func(t *testing.T) {
+		test.LogoutManagerTest(t, reg.LogoutManager(), reg.ClientManager())
+	})
+
+	t.Run("case=short-lived artifacts are not stored in the database", func(t *testing.T) {
+		r := newRequest()
+		signature := uuid.Must(uuid.NewV4()).String()
+		require.NoError(t, reg.OAuth2Storage().CreateAuthorizeCodeSession(ctx, signature, r))
+		require.NoError(t, reg.OAuth2Storage().CreatePKCERequestSession(ctx, signature, r))
+		require.NoError(t, reg.OAuth2Storage().CreateOpenIDConnectSession(ctx, signature, r))
+		require.NoError(t, reg.OAuth2Storage().CreateDeviceAuthSession(ctx, signature, signature, r))
+
+		require.NoError(t, reg.LoginManager().ConfirmLoginSession(ctx, &flow.LoginSession{ID: signature, Subject: "subject", Remember: true}))
+
+		for _, table := range []string{"hydra_oauth2_code", "hydra_oauth2_pkce", "hydra_oauth2_oidc", "hydra_oauth2_device_auth_codes", "hydra_oauth2_authentication_session"} {
+			count, err := reg.Persister().Connection(ctx).RawQuery("SELECT * FROM " + table).Count(&struct{}{})
+			require.NoError(t, err)
+			assert.Zero(t, count, table)
+		}
+	})
+
+	t.Run("case=authorization codes can only be invalidated once", func(t *testing.T) {
+		signature := uuid.Must(uuid.NewV4()).String()
+		require.NoError(t, reg.OAuth2Storage().CreateAuthorizeCodeSession(ctx, signature, newRequest()))
+
+		require.NoError(t, reg.OAuth2Storage().InvalidateAuthorizeCodeSession(ctx, "", signature))
+		assert.ErrorIs(t, reg.OAuth2Storage().InvalidateAuthorizeCodeSession(ctx, "", signature), fosite.ErrInvalidatedAuthorizeCode)
+
+		r, err := reg.OAuth2Storage().GetAuthorizeCodeSession(ctx, "", signature, oauth2.NewTestSession(t, ""))
+		assert.ErrorIs(t, err, fosite.ErrInvalidatedAuthorizeCode)
+		require.NotNil(t, r)
+		assert.Equal(t, "subject", r.GetSession().GetSubject())
+	})
+
+	t.Run("case=device codes", func(t *testing.T) {
+		s := reg.OAuth2Storage()
+		r := newRequest()
+		deviceCode, userCode := uuid.Must(uuid.NewV4()).String(), uuid.Must(uuid.NewV4()).String()
+
+		require.NoError(t, s.CreateDeviceAuthSession(ctx, deviceCode, userCode, r))
+		assert.ErrorIs(t, s.CreateDeviceAuthSession(ctx, uuid.Must(uuid.NewV4()).String(), userCode, newRequest()), fosite.ErrExistingUserCodeSignature)
+
+		actual, err := s.GetUserCodeSession(ctx, userCode, nil)
+		require.NoError(t, err)
+		assert.Equal(t, r.ID, actual.GetID())
+		assert.Equal(t, fosite.UserCodeUnused, actual.GetUserCodeState())
+
+		actual.SetUserCodeState(fosite.UserCodeAccepted)
+		actual.GrantScope("openid")
+		require.NoError(t, s.UpdateDeviceCodeSessionBySignature(ctx, deviceCode, actual))
+
+		_, err = s.GetUserCodeSession(ctx, userCode, nil)
+		assert.ErrorIs(t, err, fosite.ErrInactiveToken)
+
+		actual, signature, err := s.GetDeviceCodeSessionByRequestID(ctx, r.ID, oauth2.NewTestSession(t, ""))
+		require.NoError(t, err)
+		assert.Equal(t, deviceCode, signature)
+		assert.Equal(t, fosite.Arguments{"openid"}, actual.GetGrantedScopes())
+
+		actual, err = s.GetDeviceCodeSession(ctx, deviceCode, oauth2.NewTestSession(t, ""))
+		require.NoError(t, err)
+		assert.Equal(t, "subject", actual.GetSession().GetSubject())
+
+		require.NoError(t, s.InvalidateDeviceCodeSession(ctx, deviceCode))
+		_, err = s.GetDeviceCodeSession(ctx, deviceCode, oauth2.NewTestSession(t, ""))
+		assert.ErrorIs(t, err, fosite.ErrNotFound)
+		_, err = s.GetUserCodeSession(ctx, userCode, nil)
+		assert.ErrorIs(t, err, fosite.ErrNotFound)
+		_, _, err = s.GetDeviceCodeSessionByRequestID(ctx, r.ID, oauth2.NewTestSession(t, ""))
+		assert.ErrorIs(t, err, fosite.ErrNotFound)
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package kv
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ Client = (*Redis)(nil)
+
+// Redis is a Client for Redis 6.2 or later.
+type Redis struct {
+	c *redis.Client
+}
+
+// NewRedis returns a Redis client for a URL of the form redis://[[username]:password@]host[:port][/db]. The scheme
+// rediss:// connects using TLS. Connection pool and timeout options are set as query parameters, see
+// redis.ParseURL.
+func NewRedis(dsn string) (*Redis, error) {
+	// redis.ParseURL defaults to localhost if the host is missing, which hides configuration mistakes.
+	if u, err := url.Parse(dsn); err != nil {
+		return nil, errors.WithStack(err)
+	} else if u.Hostname() == "" {
+		return nil, errors.New("the Redis URL is missing the host")
+	}
+
+	opts, err := redis.ParseURL(dsn)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Redis{c: redis.NewClient(opts)}, nil
+}
+
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, error) {
+	return bulk(r.c.Get(ctx, key).Bytes())
+}
+
+func (r *Redis) GetDel(ctx context.Context, key string) ([]byte, error) {
+	return bulk(r.c.GetDel(ctx, key).Bytes())
+}
+
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return errors.WithStack(r.c.Set(ctx, key, value, expiration(ttl)).Err())
+}
+
+func (r *Redis) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	ok, err := r.c.SetNX(ctx, key, value, expiration(ttl)).Result()
+	return ok, errors.WithStack(err)
+}
+
+func (r *Redis) Del(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return errors.WithStack(r.c.Del(ctx, keys...).Err())
+}
+
+func (r *Redis) SAdd(ctx context.Context, key string, ttl time.Duration, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	_, err := r.c.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.SAdd(ctx, key, members)
+		p.PExpire(ctx, key, expiration(ttl))
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (r *Redis) SMembers(ctx context.Context, key string) ([]string, error) {
+	members, err := r.c.SMembers(ctx, key).Result()
+	return members, errors.WithStack(err)
+}
+
+func (r *Redis) Ping(ctx context.Context) error {
+	return errors.WithStack(r.c.Ping(ctx).Err())
+}
+
+func (r *Redis) Close() error {
+	return errors.WithStack(r.c.Close())
+}
+
+// bulk converts the reply of a command returning a bulk string.
+func bulk(v []byte, err error) ([]byte, error) {
+	if errors.Is(err, redis.Nil) {
+		return nil, errors.WithStack(ErrNotFound)
+	}
+	return v, errors.WithStack(err)
+}
+
+// expiration returns the time to live passed to Redis. A time to live of zero means that the key does not expire
+// in Redis, so it is raised to the smallest one Redis accepts.
+func expiration(ttl time.Duration) time.Duration {
+	return max(ttl, time.Millisecond)
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/aead"
+	"github.com/ory/hydra/v2/x"
+)
+
+// NetworkArchiveVersion is the version of the archive format written by NetworkArchiver.ExportNetwork. Archives
+// of other versions are rejected on import.
+const NetworkArchiveVersion = 1
+
+type (
+	// NetworkArchive contains all data of a single network which is required to restore it into another database.
+	//
+	// Rows are stored by their column names. Secrets which are encrypted using the system secret in the database
+	// (JSON Web Keys and token session data) are re-encrypted using a transport key.
+	NetworkArchive struct {
+		// Version is the version of the archive format.
+		Version int `json:"version"`
+
+		// Migration is the latest migration which was applied to the database the archive was exported from.
+		Migration string `json:"migration"`
+
+		// NID is the ID of the exported network.
+		NID uuid.UUID `json:"nid"`
+
+		// ExportedAt is the time the archive was created.
+		ExportedAt time.Time `json:"exported_at"`
+
+		// Tables contains the exported rows, in the order they have to be imported in.
+		Tables []NetworkArchiveTable `json:"tables"`
+	}
+
+	NetworkArchiveTable struct {
+		Name string                       `json:"name"`
+		Rows []map[string]json.RawMessage `json:"rows"`
+	}
+
+	NetworkExportOptions struct {
+		// IncludeRefreshTokens exports active refresh tokens. They can only be used after the import if the
+		// system secret of the target is the same as the source's.
+		IncludeRefreshTokens bool
+	}
+
+	NetworkArchiver interface {
+		// ExportNetwork exports the current network.
+		ExportNetwork(ctx context.Context, transport aead.Cipher, opts NetworkExportOptions) (*NetworkArchive, error)
+
+		// ImportNetwork imports all rows of the archive into the current network while preserving their IDs.
+		ImportNetwork(ctx context.Context, archive *NetworkArchive, transport aead.Cipher) error
+	}
+)
+
+type transportSecret []byte
+
+func (s transportSecret) GetGlobalSecret(context.Context) ([]byte, error) { return s, nil }
+
+func (transportSecret) GetRotatedGlobalSecrets(context.Context) ([][]byte, error) { return nil, nil }
+
+// NewTransportCipher returns the cipher used to protect secrets in a NetworkArchive.
+func NewTransportCipher(key string) (aead.Cipher, error) {
+	if len(key) < 16 {
+		return nil, errors.Errorf("the transport key must have at least 16 characters but only has %d characters", len(key))
+	}
+	return aead.NewAESGCM(transportSecret(x.HashStringSecret(key))), nil
+}
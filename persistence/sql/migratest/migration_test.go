@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -21,13 +22,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/flow"
 	testhelpersuuid "github.com/ory/hydra/v2/internal/testhelpers/uuid"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/network"
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/persistence/sql"
+	"github.com/ory/hydra/v2/ssf"
 	"github.com/ory/pop/v6"
 	"github.com/ory/x/dbal"
 	"github.com/ory/x/logrusx"
@@ -118,7 +123,7 @@ func TestMigrations(t *testing.T) {
 				t.Run("case=hydra_client", func(t *testing.T) {
 					cs := []client.Client{}
 					require.NoError(t, c.All(&cs))
-					require.Len(t, cs, 20)
+					require.Len(t, cs, 29)
 					for _, c := range cs {
 						if s := time.Since(c.CreatedAt); s > 0 && s < 10*time.Minute {
 							// Some are backfilled with the current time
@@ -257,6 +262,16 @@ func TestMigrations(t *testing.T) {
 					}
 				})
 
+				t.Run("case=hydra_oauth2_par", func(t *testing.T) {
+					ps := []sql.OAuth2RequestSQL{}
+					require.NoError(t, c.RawQuery("SELECT * FROM hydra_oauth2_par").All(&ps))
+					require.Len(t, ps, 1)
+
+					for _, p := range ps {
+						compareWithFixture(t, p, "hydra_oauth2_par", p.ID)
+					}
+				})
+
 				t.Run("case=hydra_oauth2_device_auth_codes", func(t *testing.T) {
 					rs := []sql.DeviceRequestSQL{}
 					require.NoError(t, c.All(&rs))
@@ -267,6 +282,116 @@ func TestMigrations(t *testing.T) {
 					}
 				})
 
+				t.Run("case=hydra_network", func(t *testing.T) {
+					ns := []network.Network{}
+					require.NoError(t, c.All(&ns))
+					require.Len(t, ns, 1)
+
+					for _, n := range ns {
+						compareWithFixture(t, n, "hydra_network", n.Name)
+					}
+				})
+
+				t.Run("case=hydra_audit_event", func(t *testing.T) {
+					es := []audit.Event{}
+					require.NoError(t, c.All(&es))
+					require.Len(t, es, 1)
+
+					for _, e := range es {
+						// The fixture is hashed with the system secret "migratest-system-secret".
+						assert.Equal(t, e.ComputeHash([]byte("migratest-system-secret")), e.Hash)
+						compareWithFixture(t, e, "hydra_audit_event", e.ResourceID)
+					}
+				})
+
+				t.Run("case=hydra_oauth2_consent_history", func(t *testing.T) {
+					es := []consent.HistoryEvent{}
+					require.NoError(t, c.All(&es))
+					require.Len(t, es, 1)
+
+					for _, e := range es {
+						compareWithFixture(t, e, "hydra_oauth2_consent_history", e.ConsentRequestID)
+					}
+				})
+
+				t.Run("case=hydra_lease", func(t *testing.T) {
+					ls := []janitor.Lease{}
+					require.NoError(t, c.All(&ls))
+					require.Len(t, ls, 1)
+
+					for _, l := range ls {
+						compareWithFixture(t, l, "hydra_lease", l.Name)
+					}
+				})
+
+				t.Run("case=hydra_oauth2_refresh_family", func(t *testing.T) {
+					fs := []sql.OAuth2RefreshFamily{}
+					require.NoError(t, c.All(&fs))
+					require.Len(t, fs, 1)
+
+					for _, f := range fs {
+						compareWithFixture(t, f, "hydra_oauth2_refresh_family", f.ID)
+					}
+				})
+
+				t.Run("case=hydra_ssf_stream", func(t *testing.T) {
+					ss := []sql.SSFStream{}
+					require.NoError(t, c.All(&ss))
+					require.Len(t, ss, 1)
+
+					for _, s := range ss {
+						compareWithFixture(t, s, "hydra_ssf_stream", s.ID.String())
+					}
+				})
+
+				t.Run("case=hydra_ssf_event", func(t *testing.T) {
+					es := []ssf.PendingEvent{}
+					require.NoError(t, c.All(&es))
+					require.Len(t, es, 1)
+
+					for _, e := range es {
+						compareWithFixture(t, e, "hydra_ssf_event", e.ID.String())
+					}
+				})
+
+				t.Run("case=hydra_oauth2_initial_access_token", func(t *testing.T) {
+					ts := []client.InitialAccessToken{}
+					require.NoError(t, c.All(&ts))
+					require.Len(t, ts, 1)
+
+					for _, tok := range ts {
+						compareWithFixture(t, tok, "hydra_oauth2_initial_access_token", tok.ID.String())
+					}
+				})
+
+				t.Run("case=network archive columns", func(t *testing.T) {
+					// Network archives must contain every column of the migrated schema, except for these deprecated
+					// or generated columns.
+					omitted := map[string][]string{
+						"hydra_client": {"pk", "pk_deprecated"},
+						"hydra_jwk":    {"pk_deprecated"},
+						"hydra_oauth2_flow": {
+							"login_verifier", "login_csrf", "login_skip", "login_initialized_at", "state", "login_remember",
+							"login_remember_for", "login_error", "login_authenticated_at", "login_was_used",
+							"forced_subject_identifier", "consent_verifier", "consent_csrf", "consent_was_used", "consent_error",
+							"login_extend_session_lifespan", "identity_provider_session_id", "device_verifier", "device_csrf",
+							"device_was_used", "device_handled_at", "device_error", "expires_at",
+						},
+					}
+					for table, columns := range sql.NetworkArchiveColumns() {
+						rows, err := c.Store.SQLDB().QueryContext(t.Context(), fmt.Sprintf("SELECT * FROM %s LIMIT 1", c.Dialect.Quote(table)))
+						require.NoError(t, err)
+						actual, err := rows.Columns()
+						require.NoError(t, err)
+						require.NoError(t, rows.Close())
+
+						assert.Subset(t, actual, columns, "table %s", table)
+						assert.ElementsMatch(t, omitted[table], slices.DeleteFunc(actual, func(column string) bool {
+							return slices.Contains(columns, column)
+						}), "table %s", table)
+					}
+				})
+
 				t.Run("case=networks", func(t *testing.T) {
 					ns := []networkx.Network{}
 					require.NoError(t, c.RawQuery("SELECT * FROM networks").All(&ns))
@@ -279,6 +404,36 @@ func TestMigrations(t *testing.T) {
 				})
 			})
 
+			t.Run("suite=token expiry", func(t *testing.T) {
+				if db != "postgres" && db != "cockroach" {
+					t.Skip("native token expiry is only supported on PostgreSQL and CockroachDB")
+				}
+
+				em, err := popx.NewMigrationBox(sql.TokenExpiryMigrations, c, l, popx.WithoutGoldenDatabase())
+				require.NoError(t, err)
+				require.NoError(t, em.Up(t.Context()))
+
+				for _, table := range []string{"hydra_oauth2_access", "hydra_oauth2_refresh"} {
+					switch db {
+					case "postgres":
+						var n int
+						require.NoError(t, c.RawQuery(fmt.Sprintf("SELECT count(*) FROM %s WHERE expires_at IS NULL OR expires_at < CURRENT_DATE", table)).First(&n))
+						assert.Zero(t, n, "table %s", table)
+
+						require.NoError(t, c.RawQuery("SELECT hydra_maintain_token_partitions(?, ?)", table, 2).First(&n))
+						assert.Zero(t, n, "table %s", table)
+						require.NoError(t, c.RawQuery("SELECT count(*) FROM pg_inherits WHERE inhparent = ?::REGCLASS", table).First(&n))
+						assert.Equal(t, 33, n, "table %s has a default and 32 daily partitions", table)
+					case "cockroach":
+						var stmt string
+						require.NoError(t, c.RawQuery(fmt.Sprintf("SELECT create_statement FROM [SHOW CREATE TABLE %s]", table)).First(&stmt))
+						assert.Contains(t, stmt, "ttl_expiration_expression")
+					}
+				}
+
+				require.NoError(t, em.Down(t.Context(), 1))
+			})
+
 			t.Run("down", func(t *testing.T) {
 				status, err := tm.Status(t.Context())
 				require.NoError(t, err)
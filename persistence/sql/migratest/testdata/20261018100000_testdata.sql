@@ -0,0 +1,2 @@
+INSERT INTO hydra_network (id, name, host, issuer_url, config, created_at, updated_at)
+VALUES ('24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'network-0001', 'network-0001.example.com', 'https://network-0001.example.com/', '{"ttl":{"access_token":"5m"}}', '2026-10-18 10:00:00', '2026-10-18 10:00:00');
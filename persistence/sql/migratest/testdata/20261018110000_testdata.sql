@@ -0,0 +1,2 @@
+INSERT INTO hydra_audit_event (id, nid, seq, created_at, actor, action, resource_id, request_id, diff, previous_hash, hash)
+VALUES ('a1b2c3d4-0000-4000-8000-000000000001', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 1, '2026-10-18 11:00:00', 'alice', 'client.created', 'client-0001', 'request-0001', '{"client_id":{"after":"client-0001"}}', '', 'aee194fc127c2d90b9fc558a1e8f9eb9924354404a0a7b0c9c14f41d26dff398');
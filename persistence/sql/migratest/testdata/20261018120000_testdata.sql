@@ -0,0 +1,2 @@
+INSERT INTO hydra_oauth2_consent_history (id, nid, subject, client_id, consent_request_id, type, scope, audience, created_at)
+VALUES ('0199f7a2-6a00-7000-8000-000000000001', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'subject-0001', 'client-0001', 'challenge-0001', 'granted', '["openid","offline_access"]', '["https://api.example.com"]', '2026-10-18 12:00:00');
@@ -0,0 +1,2 @@
+INSERT INTO hydra_lease (name, holder, expires_at)
+VALUES ('janitor', 'hydra-0001', '2026-10-18 13:00:00');
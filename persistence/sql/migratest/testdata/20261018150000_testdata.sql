@@ -0,0 +1,45 @@
+INSERT INTO hydra_client (id,
+                          nid,
+                          client_name,
+                          client_secret,
+                          redirect_uris,
+                          grant_types,
+                          response_types,
+                          scope,
+                          owner,
+                          policy_uri,
+                          tos_uri,
+                          client_uri,
+                          logo_uri,
+                          contacts,
+                          client_secret_expires_at,
+                          sector_identifier_uri,
+                          jwks,
+                          jwks_uri,
+                          request_uris,
+                          token_endpoint_auth_method,
+                          request_object_signing_alg,
+                          userinfo_signed_response_alg,
+                          subject_type,
+                          allowed_cors_origins,
+                          pk_deprecated,
+                          audience,
+                          created_at,
+                          updated_at,
+                          frontchannel_logout_uri,
+                          frontchannel_logout_session_required,
+                          post_logout_redirect_uris,
+                          backchannel_logout_uri,
+                          backchannel_logout_session_required,
+                          metadata,
+                          token_endpoint_auth_signing_alg,
+                          pk,
+                          registration_access_token_signature,
+                          skip_consent,
+                          skip_logout_consent,
+                          device_authorization_grant_id_token_lifespan,
+                          device_authorization_grant_access_token_lifespan,
+                          device_authorization_grant_refresh_token_lifespan,
+                          refresh_token_reuse_policy)
+VALUES ('client-24',
+        '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'Client 24', 'secret-24', '["http://redirect/24_1","http://redirect/24_2"]', '["grant-24_1","grant-24_2"]', '["response-24_1","response-24_2"]', 'scope-24', 'owner-24', 'http://policy/24', 'http://tos/24', 'http://client/24', 'http://logo/24', '["contact-24_1","contact-24_2"]', 0, 'http://sector_id/24', '', 'http://jwks/24', '["http://request/24_1","http://request/24_2"]', 'token_auth-24', 'r_alg-24', 'u_alg-24', 'subject-24', '["http://cors/24_1","http://cors/24_2"]', 0, '["autdience-24_1","autdience-24_2"]', '2026-10-18 15:00:00', '2026-10-18 15:00:00', 'http://front_logout/24', true, '["http://post_redirect/24_1","http://post_redirect/24_2"]', 'http://back_logout/24', true, '{"migration": "24"}', '', '4b0d4a1e-2c5f-4d4e-9e5b-2f5f1a0c7e24', '', TRUE, TRUE, 3600, 3600, 3600, 'revoke_consent');
@@ -0,0 +1,2 @@
+INSERT INTO hydra_oauth2_refresh_family (request_id, nid, generation, revoked, expires_at)
+VALUES ('request-0001', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 3, false, '2026-10-18 16:00:00');
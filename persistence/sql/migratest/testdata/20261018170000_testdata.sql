@@ -0,0 +1,50 @@
+INSERT INTO hydra_client (id,
+                          nid,
+                          client_name,
+                          client_secret,
+                          redirect_uris,
+                          grant_types,
+                          response_types,
+                          scope,
+                          owner,
+                          policy_uri,
+                          tos_uri,
+                          client_uri,
+                          logo_uri,
+                          contacts,
+                          client_secret_expires_at,
+                          sector_identifier_uri,
+                          jwks,
+                          jwks_uri,
+                          request_uris,
+                          token_endpoint_auth_method,
+                          request_object_signing_alg,
+                          userinfo_signed_response_alg,
+                          subject_type,
+                          allowed_cors_origins,
+                          pk_deprecated,
+                          audience,
+                          created_at,
+                          updated_at,
+                          frontchannel_logout_uri,
+                          frontchannel_logout_session_required,
+                          post_logout_redirect_uris,
+                          backchannel_logout_uri,
+                          backchannel_logout_session_required,
+                          metadata,
+                          token_endpoint_auth_signing_alg,
+                          pk,
+                          registration_access_token_signature,
+                          skip_consent,
+                          skip_logout_consent,
+                          device_authorization_grant_id_token_lifespan,
+                          device_authorization_grant_access_token_lifespan,
+                          device_authorization_grant_refresh_token_lifespan,
+                          refresh_token_reuse_policy,
+                          refresh_token_rotation_disabled,
+                          refresh_token_rotation_grace_period,
+                          refresh_token_rotation_grace_reuse_count,
+                          refresh_token_idle_lifespan,
+                          refresh_token_max_lifespan)
+VALUES ('client-25',
+        '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'Client 25', 'secret-25', '["http://redirect/25_1","http://redirect/25_2"]', '["grant-25_1","grant-25_2"]', '["response-25_1","response-25_2"]', 'scope-25', 'owner-25', 'http://policy/25', 'http://tos/25', 'http://client/25', 'http://logo/25', '["contact-25_1","contact-25_2"]', 0, 'http://sector_id/25', '', 'http://jwks/25', '["http://request/25_1","http://request/25_2"]', 'token_auth-25', 'r_alg-25', 'u_alg-25', 'subject-25', '["http://cors/25_1","http://cors/25_2"]', 0, '["autdience-25_1","autdience-25_2"]', '2026-10-18 17:00:00', '2026-10-18 17:00:00', 'http://front_logout/25', true, '["http://post_redirect/25_1","http://post_redirect/25_2"]', 'http://back_logout/25', true, '{"migration": "25"}', '', '4b0d4a1e-2c5f-4d4e-9e5b-2f5f1a0c7e25', '', TRUE, TRUE, 3600, 3600, 3600, 'revoke_consent', FALSE, 60000000000, 2, 2592000000000000, 7776000000000000);
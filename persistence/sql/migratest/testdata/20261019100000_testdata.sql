@@ -0,0 +1,5 @@
+INSERT INTO hydra_ssf_stream (id, nid, audience, events_requested, delivery_method, endpoint_url, authorization_header, description, status, status_reason, created_at, updated_at)
+VALUES ('7d3c3b7a-52d4-4c9c-9d3e-2f3a1c5b0001', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', '["receiver-0001"]', '["https://schemas.openid.net/secevent/caep/event-type/session-revoked"]', 'urn:ietf:rfc:8936', 'http://poll/0001', '', 'stream-0001', 'paused', 'reason-0001', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+
+INSERT INTO hydra_ssf_event (id, nid, stream_id, token, created_at, expires_at)
+VALUES ('7d3c3b7a-52d4-4c9c-9d3e-2f3a1c5b1001', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', '7d3c3b7a-52d4-4c9c-9d3e-2f3a1c5b0001', 'token-0001', '2026-10-19 10:00:00', '2026-10-20 10:00:00');
@@ -0,0 +1,51 @@
+INSERT INTO hydra_client (id,
+                          nid,
+                          client_name,
+                          client_secret,
+                          redirect_uris,
+                          grant_types,
+                          response_types,
+                          scope,
+                          owner,
+                          policy_uri,
+                          tos_uri,
+                          client_uri,
+                          logo_uri,
+                          contacts,
+                          client_secret_expires_at,
+                          sector_identifier_uri,
+                          jwks,
+                          jwks_uri,
+                          request_uris,
+                          token_endpoint_auth_method,
+                          request_object_signing_alg,
+                          userinfo_signed_response_alg,
+                          subject_type,
+                          allowed_cors_origins,
+                          pk_deprecated,
+                          audience,
+                          created_at,
+                          updated_at,
+                          frontchannel_logout_uri,
+                          frontchannel_logout_session_required,
+                          post_logout_redirect_uris,
+                          backchannel_logout_uri,
+                          backchannel_logout_session_required,
+                          metadata,
+                          token_endpoint_auth_signing_alg,
+                          pk,
+                          registration_access_token_signature,
+                          skip_consent,
+                          skip_logout_consent,
+                          device_authorization_grant_id_token_lifespan,
+                          device_authorization_grant_access_token_lifespan,
+                          device_authorization_grant_refresh_token_lifespan,
+                          refresh_token_reuse_policy,
+                          refresh_token_rotation_disabled,
+                          refresh_token_rotation_grace_period,
+                          refresh_token_rotation_grace_reuse_count,
+                          refresh_token_idle_lifespan,
+                          refresh_token_max_lifespan,
+                          id_token_signed_response_alg)
+VALUES ('client-26',
+        '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'Client 26', 'secret-26', '["http://redirect/26_1","http://redirect/26_2"]', '["grant-26_1","grant-26_2"]', '["response-26_1","response-26_2"]', 'scope-26', 'owner-26', 'http://policy/26', 'http://tos/26', 'http://client/26', 'http://logo/26', '["contact-26_1","contact-26_2"]', 0, 'http://sector_id/26', '', 'http://jwks/26', '["http://request/26_1","http://request/26_2"]', 'token_auth-26', 'r_alg-26', 'u_alg-26', 'subject-26', '["http://cors/26_1","http://cors/26_2"]', 0, '["autdience-26_1","autdience-26_2"]', '2026-10-19 11:00:00', '2026-10-19 11:00:00', 'http://front_logout/26', true, '["http://post_redirect/26_1","http://post_redirect/26_2"]', 'http://back_logout/26', true, '{"migration": "26"}', '', '4b0d4a1e-2c5f-4d4e-9e5b-2f5f1a0c7e26', '', TRUE, TRUE, 3600, 3600, 3600, 'revoke_consent', FALSE, 60000000000, 2, 2592000000000000, 7776000000000000, 'ES256');
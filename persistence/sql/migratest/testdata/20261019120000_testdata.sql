@@ -0,0 +1,5 @@
+INSERT INTO hydra_oauth2_trusted_jwt_bearer_issuer (id, nid, issuer, subject, allow_any_subject, scope, key_set, key_id, jwks_uri, oidc_discovery, claim_conditions, claim_mappings)
+VALUES ('30e51720-4a88-48ca-8243-de7d8f461677', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'https://federated-issuer.example.com', '', true, 'some-scope', NULL, NULL, 'https://federated-issuer.example.com/jwks.json', false, '{"repository":"ory/*"}', '[{"claim":"ref","value":"refs/heads/main","scope":["deploy"]}]');
+
+INSERT INTO hydra_oauth2_trusted_jwt_bearer_issuer (id, nid, issuer, subject, allow_any_subject, scope, key_set, key_id, jwks_uri, oidc_discovery, claim_conditions, claim_mappings)
+VALUES ('30e51720-4a88-48ca-8243-de7d8f461678', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'https://discovery-issuer.example.com', 'some-subject', false, 'some-scope', NULL, NULL, '', true, '{}', '[]');
@@ -0,0 +1,55 @@
+INSERT INTO hydra_oauth2_initial_access_token (id, nid, signature, description, allowed_grant_types, allowed_scope, allowed_redirect_uri_hosts, created_at, expires_at)
+VALUES ('5e0b7f6c-3a1d-4c2e-8f4b-9a6d2c1e0001', '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'signature-0001', 'partner-0001', '["authorization_code","refresh_token"]', '["openid","offline_access"]', '["redirect"]', '2026-10-19 13:00:00', '2027-10-19 13:00:00');
+
+INSERT INTO hydra_client (id,
+                          nid,
+                          client_name,
+                          client_secret,
+                          redirect_uris,
+                          grant_types,
+                          response_types,
+                          scope,
+                          owner,
+                          policy_uri,
+                          tos_uri,
+                          client_uri,
+                          logo_uri,
+                          contacts,
+                          client_secret_expires_at,
+                          sector_identifier_uri,
+                          jwks,
+                          jwks_uri,
+                          request_uris,
+                          token_endpoint_auth_method,
+                          request_object_signing_alg,
+                          userinfo_signed_response_alg,
+                          subject_type,
+                          allowed_cors_origins,
+                          pk_deprecated,
+                          audience,
+                          created_at,
+                          updated_at,
+                          frontchannel_logout_uri,
+                          frontchannel_logout_session_required,
+                          post_logout_redirect_uris,
+                          backchannel_logout_uri,
+                          backchannel_logout_session_required,
+                          metadata,
+                          token_endpoint_auth_signing_alg,
+                          pk,
+                          registration_access_token_signature,
+                          skip_consent,
+                          skip_logout_consent,
+                          device_authorization_grant_id_token_lifespan,
+                          device_authorization_grant_access_token_lifespan,
+                          device_authorization_grant_refresh_token_lifespan,
+                          refresh_token_reuse_policy,
+                          refresh_token_rotation_disabled,
+                          refresh_token_rotation_grace_period,
+                          refresh_token_rotation_grace_reuse_count,
+                          refresh_token_idle_lifespan,
+                          refresh_token_max_lifespan,
+                          id_token_signed_response_alg,
+                          initial_access_token_id)
+VALUES ('client-27',
+        '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'Client 27', 'secret-27', '["http://redirect/27_1","http://redirect/27_2"]', '["grant-27_1","grant-27_2"]', '["response-27_1","response-27_2"]', 'scope-27', 'owner-27', 'http://policy/27', 'http://tos/27', 'http://client/27', 'http://logo/27', '["contact-27_1","contact-27_2"]', 0, 'http://sector_id/27', '', 'http://jwks/27', '["http://request/27_1","http://request/27_2"]', 'token_auth-27', 'r_alg-27', 'u_alg-27', 'subject-27', '["http://cors/27_1","http://cors/27_2"]', 0, '["autdience-27_1","autdience-27_2"]', '2026-10-19 13:00:00', '2026-10-19 13:00:00', 'http://front_logout/27', true, '["http://post_redirect/27_1","http://post_redirect/27_2"]', 'http://back_logout/27', true, '{"migration": "27"}', '', '4b0d4a1e-2c5f-4d4e-9e5b-2f5f1a0c7e27', '', TRUE, TRUE, 3600, 3600, 3600, 'revoke_consent', FALSE, 60000000000, 2, 2592000000000000, 7776000000000000, 'ES256', '5e0b7f6c-3a1d-4c2e-8f4b-9a6d2c1e0001');
@@ -0,0 +1,2 @@
+INSERT INTO hydra_oauth2_par (signature, request_id, requested_at, client_id, scope, granted_scope, form_data, session_data, subject, active, requested_audience, granted_audience, challenge_id, nid, expires_at)
+VALUES ('sig-20261019150000', 'req-20261019150000', '2026-10-19 15:00:00', 'client-28', 'scope-20261019150000', 'granted_scope-20261019150000', 'response_type=code&state=state-20261019150000', '{}', 'subject-20261019150000', true, 'requested_audience-20261019150000', 'granted_audience-20261019150000', NULL, '24704dcb-0ab9-4bfa-a84c-405932ae53fe', '2026-10-19 15:01:30');
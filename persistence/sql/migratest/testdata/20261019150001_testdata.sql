@@ -0,0 +1,54 @@
+INSERT INTO hydra_client (id,
+                          nid,
+                          client_name,
+                          client_secret,
+                          redirect_uris,
+                          grant_types,
+                          response_types,
+                          scope,
+                          owner,
+                          policy_uri,
+                          tos_uri,
+                          client_uri,
+                          logo_uri,
+                          contacts,
+                          client_secret_expires_at,
+                          sector_identifier_uri,
+                          jwks,
+                          jwks_uri,
+                          request_uris,
+                          token_endpoint_auth_method,
+                          request_object_signing_alg,
+                          userinfo_signed_response_alg,
+                          subject_type,
+                          allowed_cors_origins,
+                          pk_deprecated,
+                          audience,
+                          created_at,
+                          updated_at,
+                          frontchannel_logout_uri,
+                          frontchannel_logout_session_required,
+                          post_logout_redirect_uris,
+                          backchannel_logout_uri,
+                          backchannel_logout_session_required,
+                          metadata,
+                          token_endpoint_auth_signing_alg,
+                          pk,
+                          registration_access_token_signature,
+                          skip_consent,
+                          skip_logout_consent,
+                          device_authorization_grant_id_token_lifespan,
+                          device_authorization_grant_access_token_lifespan,
+                          device_authorization_grant_refresh_token_lifespan,
+                          refresh_token_reuse_policy,
+                          refresh_token_rotation_disabled,
+                          refresh_token_rotation_grace_period,
+                          refresh_token_rotation_grace_reuse_count,
+                          refresh_token_idle_lifespan,
+                          refresh_token_max_lifespan,
+                          id_token_signed_response_alg,
+                          initial_access_token_id,
+                          federation_expires_at,
+                          security_profile)
+VALUES ('client-29',
+        '24704dcb-0ab9-4bfa-a84c-405932ae53fe', 'Client 29', 'secret-29', '["http://redirect/29_1","http://redirect/29_2"]', '["grant-29_1","grant-29_2"]', '["response-29_1","response-29_2"]', 'scope-29', 'owner-29', 'http://policy/29', 'http://tos/29', 'http://client/29', 'http://logo/29', '["contact-29_1","contact-29_2"]', 0, 'http://sector_id/29', '', 'http://jwks/29', '["http://request/29_1","http://request/29_2"]', 'token_auth-29', 'r_alg-29', 'u_alg-29', 'subject-29', '["http://cors/29_1","http://cors/29_2"]', 0, '["autdience-29_1","autdience-29_2"]', '2026-10-19 15:00:01', '2026-10-19 15:00:01', 'http://front_logout/29', true, '["http://post_redirect/29_1","http://post_redirect/29_2"]', 'http://back_logout/29', true, '{"migration": "29"}', '', '4b0d4a1e-2c5f-4d4e-9e5b-2f5f1a0c7e29', '', TRUE, TRUE, 3600, 3600, 3600, 'revoke_consent', FALSE, 60000000000, 2, 2592000000000000, 7776000000000000, 'ES256', NULL, '2026-10-20 15:00:01', 'fapi2_security');
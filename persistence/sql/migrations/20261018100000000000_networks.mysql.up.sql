@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS hydra_network
+(
+  id          CHAR(36)      NOT NULL PRIMARY KEY,
+  name        VARCHAR(255)  NOT NULL,
+  host        VARCHAR(255)  NULL,
+  issuer_url  VARCHAR(2048) NOT NULL,
+  config      TEXT          NOT NULL,
+  created_at  TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at  TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (id) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX hydra_network_name_idx ON hydra_network (name);
+CREATE UNIQUE INDEX hydra_network_host_idx ON hydra_network (host);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS hydra_audit_event
+(
+  id            CHAR(36)      NOT NULL PRIMARY KEY,
+  nid           CHAR(36)      NOT NULL,
+  seq           BIGINT        NOT NULL,
+  created_at    TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  actor         VARCHAR(255)  NOT NULL DEFAULT '',
+  action        VARCHAR(255)  NOT NULL,
+  resource_id   VARCHAR(255)  NOT NULL DEFAULT '',
+  request_id    VARCHAR(255)  NOT NULL DEFAULT '',
+  diff          MEDIUMTEXT    NOT NULL,
+  previous_hash VARCHAR(64)   NOT NULL DEFAULT '',
+  hash          VARCHAR(64)   NOT NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX hydra_audit_event_nid_seq_idx ON hydra_audit_event (nid, seq);
+CREATE INDEX hydra_audit_event_nid_action_idx ON hydra_audit_event (nid, action);
+CREATE INDEX hydra_audit_event_nid_resource_id_idx ON hydra_audit_event (nid, resource_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS hydra_oauth2_consent_history
+(
+  id                 CHAR(36)      NOT NULL PRIMARY KEY,
+  nid                CHAR(36)      NOT NULL,
+  subject            VARCHAR(255)  NOT NULL,
+  client_id          VARCHAR(255)  NOT NULL,
+  consent_request_id VARCHAR(40)   NOT NULL,
+  type               VARCHAR(32)   NOT NULL,
+  scope              MEDIUMTEXT    NOT NULL,
+  audience           MEDIUMTEXT    NOT NULL,
+  created_at         TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE INDEX hydra_oauth2_consent_history_nid_subject_idx ON hydra_oauth2_consent_history (nid, subject, id);
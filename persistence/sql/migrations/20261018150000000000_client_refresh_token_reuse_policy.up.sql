@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN refresh_token_reuse_policy VARCHAR(32) NOT NULL DEFAULT '';
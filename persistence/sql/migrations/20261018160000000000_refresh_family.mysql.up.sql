@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS hydra_oauth2_refresh_family
+(
+  request_id VARCHAR(40) NOT NULL,
+  nid        CHAR(36)    NOT NULL,
+  generation INTEGER     NOT NULL,
+  revoked    BOOLEAN     NOT NULL DEFAULT false,
+  expires_at TIMESTAMP   NULL,
+
+  PRIMARY KEY (request_id, nid),
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE INDEX hydra_oauth2_refresh_family_expires_at_idx ON hydra_oauth2_refresh_family (expires_at);
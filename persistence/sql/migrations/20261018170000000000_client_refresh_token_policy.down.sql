@@ -0,0 +1,5 @@
+ALTER TABLE hydra_client DROP COLUMN refresh_token_max_lifespan;
+ALTER TABLE hydra_client DROP COLUMN refresh_token_idle_lifespan;
+ALTER TABLE hydra_client DROP COLUMN refresh_token_rotation_grace_reuse_count;
+ALTER TABLE hydra_client DROP COLUMN refresh_token_rotation_grace_period;
+ALTER TABLE hydra_client DROP COLUMN refresh_token_rotation_disabled;
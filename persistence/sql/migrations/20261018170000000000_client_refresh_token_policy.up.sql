@@ -0,0 +1,5 @@
+ALTER TABLE hydra_client ADD COLUMN refresh_token_rotation_disabled BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE hydra_client ADD COLUMN refresh_token_rotation_grace_period BIGINT NULL DEFAULT NULL;
+ALTER TABLE hydra_client ADD COLUMN refresh_token_rotation_grace_reuse_count BIGINT NULL DEFAULT NULL;
+ALTER TABLE hydra_client ADD COLUMN refresh_token_idle_lifespan BIGINT NULL DEFAULT NULL;
+ALTER TABLE hydra_client ADD COLUMN refresh_token_max_lifespan BIGINT NULL DEFAULT NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS hydra_ssf_event;
+DROP TABLE IF EXISTS hydra_ssf_stream;
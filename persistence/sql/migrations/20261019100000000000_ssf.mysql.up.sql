@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS hydra_ssf_stream
+(
+  id                   CHAR(36)      NOT NULL PRIMARY KEY,
+  nid                  CHAR(36)      NOT NULL,
+  audience             TEXT          NOT NULL,
+  events_requested     TEXT          NOT NULL,
+  delivery_method      VARCHAR(64)   NOT NULL,
+  endpoint_url         TEXT          NOT NULL,
+  authorization_header TEXT          NOT NULL,
+  description          TEXT          NOT NULL,
+  status               VARCHAR(16)   NOT NULL,
+  status_reason        TEXT          NOT NULL,
+  created_at           TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at           TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE INDEX hydra_ssf_stream_nid_idx ON hydra_ssf_stream (nid);
+
+CREATE TABLE IF NOT EXISTS hydra_ssf_event
+(
+  id         CHAR(36)   NOT NULL PRIMARY KEY,
+  nid        CHAR(36)   NOT NULL,
+  stream_id  CHAR(36)   NOT NULL,
+  token      TEXT       NOT NULL,
+  created_at TIMESTAMP  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at TIMESTAMP  NOT NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE,
+  FOREIGN KEY (stream_id) REFERENCES hydra_ssf_stream (id) ON DELETE CASCADE
+);
+
+CREATE INDEX hydra_ssf_event_nid_stream_id_created_at_idx ON hydra_ssf_event (nid, stream_id, created_at);
+CREATE INDEX hydra_ssf_event_expires_at_idx ON hydra_ssf_event (expires_at);
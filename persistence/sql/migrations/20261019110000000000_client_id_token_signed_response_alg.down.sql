@@ -0,0 +1 @@
+ALTER TABLE hydra_client DROP COLUMN id_token_signed_response_alg;
@@ -0,0 +1,7 @@
+DELETE FROM hydra_oauth2_trusted_jwt_bearer_issuer WHERE key_id IS NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN claim_mappings;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN claim_conditions;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN oidc_discovery;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN jwks_uri;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ALTER COLUMN key_id SET NOT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ALTER COLUMN key_set SET NOT NULL;
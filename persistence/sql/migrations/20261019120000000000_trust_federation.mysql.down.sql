@@ -0,0 +1,7 @@
+DELETE FROM hydra_oauth2_trusted_jwt_bearer_issuer WHERE key_id IS NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN claim_mappings;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN claim_conditions;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN oidc_discovery;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN jwks_uri;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer MODIFY key_id VARCHAR(255) CHARACTER SET ascii COLLATE ascii_general_ci NOT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer MODIFY key_set VARCHAR(255) NOT NULL;
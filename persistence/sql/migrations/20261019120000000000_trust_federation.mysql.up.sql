@@ -0,0 +1,6 @@
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer MODIFY key_set VARCHAR(255) NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer MODIFY key_id VARCHAR(255) CHARACTER SET ascii COLLATE ascii_general_ci NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD COLUMN jwks_uri VARCHAR(1024) NOT NULL DEFAULT '';
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD COLUMN oidc_discovery BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD COLUMN claim_conditions TEXT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD COLUMN claim_mappings TEXT NULL;
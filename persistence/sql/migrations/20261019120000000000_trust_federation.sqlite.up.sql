@@ -0,0 +1,31 @@
+CREATE TABLE "_hydra_oauth2_trusted_jwt_bearer_issuer"
+(
+    id                VARCHAR(36) PRIMARY KEY,
+    issuer            VARCHAR(255)  NOT NULL,
+    subject           VARCHAR(255)  NOT NULL,
+    scope             TEXT          NOT NULL,
+    key_set           VARCHAR(255)  NULL,
+    key_id            VARCHAR(255)  NULL,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    nid               CHAR(36)      NOT NULL,
+    allow_any_subject INTEGER       NOT NULL DEFAULT FALSE,
+    jwks_uri          VARCHAR(1024) NOT NULL DEFAULT '',
+    oidc_discovery    INTEGER       NOT NULL DEFAULT FALSE,
+    claim_conditions  TEXT          NULL,
+    claim_mappings    TEXT          NULL,
+    UNIQUE (issuer, subject, key_id, nid),
+    FOREIGN KEY (key_set, key_id, nid) REFERENCES hydra_jwk (sid, kid, nid) ON DELETE CASCADE
+);
+
+INSERT INTO "_hydra_oauth2_trusted_jwt_bearer_issuer" (
+    id, issuer, subject, scope, key_set, key_id, created_at, expires_at, nid, allow_any_subject
+) SELECT id, issuer, subject, scope, key_set, key_id, created_at, expires_at, nid, allow_any_subject FROM "hydra_oauth2_trusted_jwt_bearer_issuer";
+
+DROP INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx;
+DROP INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx;
+DROP TABLE "hydra_oauth2_trusted_jwt_bearer_issuer";
+
+ALTER TABLE "_hydra_oauth2_trusted_jwt_bearer_issuer" RENAME TO "hydra_oauth2_trusted_jwt_bearer_issuer";
+CREATE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (expires_at);
+CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid ASC, key_id ASC, issuer ASC, subject ASC);
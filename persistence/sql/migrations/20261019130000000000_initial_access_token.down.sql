@@ -0,0 +1 @@
+DROP TABLE IF EXISTS hydra_oauth2_initial_access_token;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS hydra_oauth2_initial_access_token
+(
+  id                         CHAR(36)      NOT NULL PRIMARY KEY,
+  nid                        CHAR(36)      NOT NULL,
+  signature                  VARCHAR(255)  NOT NULL,
+  description                TEXT          NOT NULL,
+  allowed_grant_types        TEXT          NOT NULL,
+  allowed_scope              TEXT          NOT NULL,
+  allowed_redirect_uri_hosts TEXT          NOT NULL,
+  created_at                 TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at                 TIMESTAMP     NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX hydra_oauth2_initial_access_token_signature_idx ON hydra_oauth2_initial_access_token (nid, signature);
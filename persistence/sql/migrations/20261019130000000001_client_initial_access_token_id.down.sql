@@ -0,0 +1 @@
+ALTER TABLE hydra_client DROP COLUMN initial_access_token_id;
@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN initial_access_token_id UUID NULL;
@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN federation_expires_at TIMESTAMP NULL;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS hydra_oauth2_par
+(
+  signature          VARCHAR(255) NOT NULL PRIMARY KEY,
+  request_id         VARCHAR(40)  NOT NULL,
+  requested_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  client_id          VARCHAR(255) NOT NULL,
+  scope              TEXT         NOT NULL,
+  granted_scope      TEXT         NOT NULL,
+  form_data          TEXT         NOT NULL,
+  session_data       TEXT         NOT NULL,
+  subject            VARCHAR(255) NOT NULL,
+  active             BOOLEAN      NOT NULL DEFAULT true,
+  requested_audience TEXT         NOT NULL,
+  granted_audience   TEXT         NOT NULL,
+  challenge_id       VARCHAR(40)  NULL,
+  nid                UUID         NOT NULL,
+  expires_at         TIMESTAMP    NULL,
+
+  FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE,
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE INDEX hydra_oauth2_par_client_id_idx ON hydra_oauth2_par (client_id, nid);
+CREATE INDEX hydra_oauth2_par_expires_at_idx ON hydra_oauth2_par (expires_at);
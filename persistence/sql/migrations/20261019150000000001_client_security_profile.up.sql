@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN security_profile VARCHAR(32) NOT NULL DEFAULT '';
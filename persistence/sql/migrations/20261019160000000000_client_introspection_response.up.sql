@@ -0,0 +1,3 @@
+ALTER TABLE hydra_client ADD COLUMN introspection_signed_response_alg VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE hydra_client ADD COLUMN introspection_encrypted_response_alg VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE hydra_client ADD COLUMN introspection_encrypted_response_enc VARCHAR(32) NOT NULL DEFAULT '';
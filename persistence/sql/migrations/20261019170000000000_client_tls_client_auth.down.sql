@@ -0,0 +1,3 @@
+UPDATE hydra_client SET token_endpoint_auth_method = 'client_secret_basic' WHERE token_endpoint_auth_method IN ('tls_client_auth', 'self_signed_tls_client_auth');
+ALTER TABLE hydra_client DROP COLUMN tls_client_auth_subject_dn;
+ALTER TABLE hydra_client ALTER COLUMN token_endpoint_auth_method TYPE VARCHAR(25);
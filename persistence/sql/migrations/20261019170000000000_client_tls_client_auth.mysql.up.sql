@@ -0,0 +1,2 @@
+ALTER TABLE hydra_client MODIFY token_endpoint_auth_method VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE hydra_client ADD COLUMN tls_client_auth_subject_dn VARCHAR(1024) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN tls_client_auth_subject_dn VARCHAR(1024) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE hydra_client ALTER COLUMN token_endpoint_auth_method TYPE VARCHAR(32);
+ALTER TABLE hydra_client ADD COLUMN tls_client_auth_subject_dn VARCHAR(1024) NOT NULL DEFAULT '';
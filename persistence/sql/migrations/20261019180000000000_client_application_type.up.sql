@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN application_type VARCHAR(10) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE hydra_oauth2_flow DROP FOREIGN KEY hydra_oauth2_flow_login_session_id_fk;
@@ -0,0 +1,3 @@
+UPDATE hydra_oauth2_flow SET login_session_id = NULL WHERE login_session_id NOT IN (SELECT id FROM hydra_oauth2_authentication_session);
+
+ALTER TABLE hydra_oauth2_flow ADD CONSTRAINT hydra_oauth2_flow_login_session_id_fk FOREIGN KEY (login_session_id) REFERENCES hydra_oauth2_authentication_session (id) ON DELETE SET NULL;
@@ -0,0 +1 @@
+ALTER TABLE hydra_client DROP COLUMN client_secret_encrypted;
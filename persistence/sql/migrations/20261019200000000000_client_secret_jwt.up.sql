@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN client_secret_encrypted TEXT NULL;
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS hydra_audit_lock
+(
+  nid CHAR(36) NOT NULL PRIMARY KEY,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
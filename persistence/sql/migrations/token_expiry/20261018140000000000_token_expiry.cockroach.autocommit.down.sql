@@ -0,0 +1,2 @@
+ALTER TABLE hydra_oauth2_access RESET (ttl);
+ALTER TABLE hydra_oauth2_refresh RESET (ttl);
@@ -0,0 +1,2 @@
+ALTER TABLE hydra_oauth2_access SET (ttl_expiration_expression = '(expires_at AT TIME ZONE ''UTC'')', ttl_job_cron = '@hourly');
+ALTER TABLE hydra_oauth2_refresh SET (ttl_expiration_expression = '(expires_at AT TIME ZONE ''UTC'')', ttl_job_cron = '@hourly');
@@ -0,0 +1,35 @@
+ALTER TABLE hydra_oauth2_access RENAME TO hydra_oauth2_access_partitioned;
+CREATE TABLE hydra_oauth2_access (LIKE hydra_oauth2_access_partitioned INCLUDING DEFAULTS);
+ALTER TABLE hydra_oauth2_access ALTER COLUMN expires_at DROP NOT NULL;
+INSERT INTO hydra_oauth2_access SELECT * FROM hydra_oauth2_access_partitioned;
+UPDATE hydra_oauth2_access SET expires_at = NULL WHERE expires_at = '9999-12-31';
+DROP TABLE hydra_oauth2_access_partitioned;
+
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_pkey PRIMARY KEY (signature);
+CREATE INDEX hydra_oauth2_access_challenge_id_idx ON hydra_oauth2_access (challenge_id);
+CREATE INDEX hydra_oauth2_access_client_id_idx ON hydra_oauth2_access (client_id, nid);
+CREATE INDEX hydra_oauth2_access_nid_subject_idx ON hydra_oauth2_access (nid, subject, client_id);
+CREATE INDEX hydra_oauth2_access_request_id_idx ON hydra_oauth2_access (request_id, nid);
+CREATE INDEX hydra_oauth2_access_requested_at_idx ON hydra_oauth2_access (requested_at, nid);
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES hydra_oauth2_flow (consent_challenge_id) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_nid_fk_idx FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
+ALTER TABLE hydra_oauth2_refresh RENAME TO hydra_oauth2_refresh_partitioned;
+CREATE TABLE hydra_oauth2_refresh (LIKE hydra_oauth2_refresh_partitioned INCLUDING DEFAULTS);
+ALTER TABLE hydra_oauth2_refresh ALTER COLUMN expires_at DROP NOT NULL;
+INSERT INTO hydra_oauth2_refresh SELECT * FROM hydra_oauth2_refresh_partitioned;
+UPDATE hydra_oauth2_refresh SET expires_at = NULL WHERE expires_at = '9999-12-31';
+DROP TABLE hydra_oauth2_refresh_partitioned;
+
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_pkey PRIMARY KEY (signature);
+CREATE INDEX hydra_oauth2_refresh_challenge_id_idx ON hydra_oauth2_refresh (challenge_id);
+CREATE INDEX hydra_oauth2_refresh_client_id_idx ON hydra_oauth2_refresh (client_id, nid);
+CREATE INDEX hydra_oauth2_refresh_nid_subject_idx ON hydra_oauth2_refresh (nid, subject, client_id);
+CREATE INDEX hydra_oauth2_refresh_request_id_idx ON hydra_oauth2_refresh (request_id);
+CREATE INDEX hydra_oauth2_refresh_requested_at_idx ON hydra_oauth2_refresh (nid, requested_at);
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES hydra_oauth2_flow (consent_challenge_id) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_nid_fk_idx FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
+DROP FUNCTION IF EXISTS hydra_maintain_token_partitions(TEXT, INTEGER);
@@ -0,0 +1,82 @@
+-- hydra_maintain_token_partitions creates the daily partitions of the token table for today and the next days_ahead
+-- days, and drops the partitions whose rows all expired before today. Rows which were stored in the default
+-- partition because no partition existed for their expiry yet are moved to the new partitions. Returns the number
+-- of dropped rows.
+CREATE OR REPLACE FUNCTION hydra_maintain_token_partitions(parent TEXT, days_ahead INTEGER) RETURNS BIGINT AS $$
+DECLARE
+  today   DATE := (now() AT TIME ZONE 'UTC')::DATE;
+  day     DATE;
+  part    TEXT;
+  dropped BIGINT := 0;
+  n       BIGINT;
+BEGIN
+  FOR i IN 0..days_ahead LOOP
+    day := today + i;
+    part := parent || '_p' || to_char(day, 'YYYYMMDD');
+    IF to_regclass(part) IS NULL THEN
+      EXECUTE format('CREATE TABLE %I (LIKE %I INCLUDING DEFAULTS)', part, parent);
+      EXECUTE format('WITH moved AS (DELETE FROM %I WHERE expires_at >= %L AND expires_at < %L RETURNING *) INSERT INTO %I SELECT * FROM moved',
+        parent || '_default', day, day + 1, part);
+      EXECUTE format('ALTER TABLE %I ATTACH PARTITION %I FOR VALUES FROM (%L) TO (%L)', parent, part, day, day + 1);
+    END IF;
+  END LOOP;
+
+  FOR part IN
+    SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+    WHERE i.inhparent = parent::REGCLASS AND c.relname ~ ('^' || parent || '_p[0-9]{8}$')
+      AND to_date(right(c.relname, 8), 'YYYYMMDD') < today
+  LOOP
+    EXECUTE format('SELECT count(*) FROM %I', part) INTO n;
+    dropped := dropped + n;
+    EXECUTE format('ALTER TABLE %I DETACH PARTITION %I', parent, part);
+    EXECUTE format('DROP TABLE %I', part);
+  END LOOP;
+
+  EXECUTE format('DELETE FROM %I WHERE expires_at < %L', parent || '_default', today);
+  GET DIAGNOSTICS n = ROW_COUNT;
+  RETURN dropped + n;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Partitioned tables can not have a primary key which does not contain the partition key, so the primary key is
+-- (signature, expires_at). Tokens which never expire are stored with an expiry of 9999-12-31 instead of NULL.
+
+ALTER TABLE hydra_oauth2_access RENAME TO hydra_oauth2_access_unpartitioned;
+UPDATE hydra_oauth2_access_unpartitioned SET expires_at = '9999-12-31' WHERE expires_at IS NULL;
+ALTER TABLE hydra_oauth2_access_unpartitioned ALTER COLUMN expires_at SET NOT NULL;
+CREATE TABLE hydra_oauth2_access (LIKE hydra_oauth2_access_unpartitioned INCLUDING DEFAULTS) PARTITION BY RANGE (expires_at);
+CREATE TABLE hydra_oauth2_access_default PARTITION OF hydra_oauth2_access DEFAULT;
+SELECT hydra_maintain_token_partitions('hydra_oauth2_access', 31);
+INSERT INTO hydra_oauth2_access SELECT * FROM hydra_oauth2_access_unpartitioned
+  WHERE expires_at >= (now() AT TIME ZONE 'UTC')::DATE;
+DROP TABLE hydra_oauth2_access_unpartitioned;
+
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_pkey PRIMARY KEY (signature, expires_at);
+CREATE INDEX hydra_oauth2_access_challenge_id_idx ON hydra_oauth2_access (challenge_id);
+CREATE INDEX hydra_oauth2_access_client_id_idx ON hydra_oauth2_access (client_id, nid);
+CREATE INDEX hydra_oauth2_access_nid_subject_idx ON hydra_oauth2_access (nid, subject, client_id);
+CREATE INDEX hydra_oauth2_access_request_id_idx ON hydra_oauth2_access (request_id, nid);
+CREATE INDEX hydra_oauth2_access_requested_at_idx ON hydra_oauth2_access (requested_at, nid);
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES hydra_oauth2_flow (consent_challenge_id) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_nid_fk_idx FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
+ALTER TABLE hydra_oauth2_refresh RENAME TO hydra_oauth2_refresh_unpartitioned;
+UPDATE hydra_oauth2_refresh_unpartitioned SET expires_at = '9999-12-31' WHERE expires_at IS NULL;
+ALTER TABLE hydra_oauth2_refresh_unpartitioned ALTER COLUMN expires_at SET NOT NULL;
+CREATE TABLE hydra_oauth2_refresh (LIKE hydra_oauth2_refresh_unpartitioned INCLUDING DEFAULTS) PARTITION BY RANGE (expires_at);
+CREATE TABLE hydra_oauth2_refresh_default PARTITION OF hydra_oauth2_refresh DEFAULT;
+SELECT hydra_maintain_token_partitions('hydra_oauth2_refresh', 31);
+INSERT INTO hydra_oauth2_refresh SELECT * FROM hydra_oauth2_refresh_unpartitioned
+  WHERE expires_at >= (now() AT TIME ZONE 'UTC')::DATE;
+DROP TABLE hydra_oauth2_refresh_unpartitioned;
+
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_pkey PRIMARY KEY (signature, expires_at);
+CREATE INDEX hydra_oauth2_refresh_challenge_id_idx ON hydra_oauth2_refresh (challenge_id);
+CREATE INDEX hydra_oauth2_refresh_client_id_idx ON hydra_oauth2_refresh (client_id, nid);
+CREATE INDEX hydra_oauth2_refresh_nid_subject_idx ON hydra_oauth2_refresh (nid, subject, client_id);
+CREATE INDEX hydra_oauth2_refresh_request_id_idx ON hydra_oauth2_refresh (request_id);
+CREATE INDEX hydra_oauth2_refresh_requested_at_idx ON hydra_oauth2_refresh (nid, requested_at);
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES hydra_oauth2_flow (consent_challenge_id) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE;
+ALTER TABLE hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_nid_fk_idx FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE;
@@ -6,6 +6,8 @@ package sql
 import (
 	"context"
 	"reflect"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gofrs/uuid"
 
@@ -37,6 +39,9 @@ type (
 		*BasePersister
 		r Dependencies
 		l *logrusx.Logger
+
+		// nativeExpiry caches nativeTokenExpiry by table name.
+		nativeExpiry *sync.Map
 	}
 	Dependencies interface {
 		ClientHasher() fosite.Hasher
@@ -50,6 +55,8 @@ type (
 	}
 	BasePersister struct {
 		c           *pop.Connection
+		replicas    []*pop.Connection
+		next        *atomic.Uint64
 		fallbackNID uuid.UUID
 		d           baseDependencies
 	}
@@ -70,11 +77,19 @@ func NewPersister(base *BasePersister, r Dependencies) *Persister {
 		BasePersister: base,
 		r:             r,
 		l:             r.Logger(),
+		nativeExpiry:  new(sync.Map),
 	}
 }
 
 func NewBasePersister(c *pop.Connection, d baseDependencies) *BasePersister {
-	return &BasePersister{c: c, d: d}
+	return &BasePersister{c: c, d: d, next: new(atomic.Uint64)}
+}
+
+// WithReadReplicas returns a copy of the persister which sends queries that tolerate replication lag to the given
+// read replicas.
+func (p BasePersister) WithReadReplicas(replicas ...*pop.Connection) *BasePersister {
+	p.replicas = replicas
+	return &p
 }
 
 func (p *BasePersister) DetermineNetwork(ctx context.Context) (*networkx.Network, error) {
@@ -116,6 +131,21 @@ func (p *BasePersister) Connection(ctx context.Context) *pop.Connection {
 	return popx.GetConnection(ctx, p.c)
 }
 
+// ReplicaConnection returns a connection to one of the read replicas, chosen round-robin. Use it only for read-only
+// queries which tolerate replication lag. The primary connection is returned if no read replicas are configured or
+// if the context carries a transaction, so that reads inside of a transaction observe its writes.
+func (p *BasePersister) ReplicaConnection(ctx context.Context) *pop.Connection {
+	if len(p.replicas) == 0 || popx.InTransaction(ctx) {
+		return p.Connection(ctx)
+	}
+	return p.replicas[p.next.Add(1)%uint64(len(p.replicas))].WithContext(ctx)
+}
+
+// ReplicaQueryWithNetwork is like QueryWithNetwork, but uses ReplicaConnection.
+func (p *BasePersister) ReplicaQueryWithNetwork(ctx context.Context) *pop.Query {
+	return p.ReplicaConnection(ctx).Where("nid = ?", p.NetworkID(ctx))
+}
+
 func (p *BasePersister) Ping(ctx context.Context) error { return p.c.Store.SQLDB().PingContext(ctx) }
 
 func (p *BasePersister) mustSetNetwork(ctx context.Context, v interface{}) {
@@ -5,8 +5,11 @@ package sql
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ory/hydra/v2/client"
@@ -16,6 +19,7 @@ import (
 	"github.com/ory/x/otelx"
 	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
 	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
 )
 
 // AuthenticateClient implements client.Manager.
@@ -42,6 +46,9 @@ func (p *Persister) CreateClient(ctx context.Context, c *client.Client) (err err
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateClient")
 	defer otelx.End(span, &err)
 
+	if err := p.sealClientSecret(ctx, c); err != nil {
+		return err
+	}
 	h, err := p.r.ClientHasher().Hash(ctx, []byte(c.Secret))
 	if err != nil {
 		return err
@@ -77,7 +84,17 @@ func (p *Persister) UpdateClient(ctx context.Context, cl *client.Client) (err er
 
 		if cl.Secret == "" {
 			cl.Secret = string(o.GetHashedSecret())
+			cl.EncryptedSecret = ""
+			if cl.GetTokenEndpointAuthMethod() == "client_secret_jwt" {
+				if o.EncryptedSecret == "" {
+					return errors.WithStack(client.ErrInvalidClientMetadata.WithHint("The client_secret must be set when token_endpoint_auth_method is changed to 'client_secret_jwt', because the stored client secret is hashed."))
+				}
+				cl.EncryptedSecret = o.EncryptedSecret
+			}
 		} else {
+			if err := p.sealClientSecret(ctx, cl); err != nil {
+				return err
+			}
 			h, err := p.r.ClientHasher().Hash(ctx, []byte(cl.Secret))
 			if err != nil {
 				return err
@@ -87,6 +104,12 @@ func (p *Persister) UpdateClient(ctx context.Context, cl *client.Client) (err er
 
 		// Ensure ID is the same
 		cl.ID = o.ID
+		// The registration policy of the client can not be changed.
+		cl.InitialAccessTokenID = o.InitialAccessTokenID
+		// Clients registered using OpenID Federation remain bound to their trust chain, unless it is renewed.
+		if time.Time(cl.FederationExpiresAt).IsZero() {
+			cl.FederationExpiresAt = o.FederationExpiresAt
+		}
 
 		if err = cl.BeforeSave(c); err != nil {
 			return sqlcon.HandleError(err)
@@ -107,6 +130,33 @@ func (p *Persister) UpdateClient(ctx context.Context, cl *client.Client) (err er
 	})
 }
 
+// sealClientSecret encrypts the client secret of clients using the client_secret_jwt client authentication method,
+// because the HMAC of their client assertions can not be verified with the hashed secret.
+func (p *Persister) sealClientSecret(ctx context.Context, c *client.Client) error {
+	c.EncryptedSecret = ""
+	if c.GetTokenEndpointAuthMethod() != "client_secret_jwt" || c.Secret == "" {
+		return nil
+	}
+
+	ciphertext, err := p.r.KeyCipher().Encrypt(ctx, []byte(c.Secret), nil)
+	if err != nil {
+		return err
+	}
+	c.EncryptedSecret = sqlxx.NullString(ciphertext)
+	return nil
+}
+
+// GetClientSecret implements fosite.ClientSecretStorage.
+func (p *Persister) GetClientSecret(ctx context.Context, c fosite.Client) ([]byte, error) {
+	cl, ok := c.(*client.Client)
+	if !ok {
+		return nil, errors.Errorf("unable to recover the client secret of a client of type %T", c)
+	} else if cl.EncryptedSecret == "" {
+		return nil, nil
+	}
+	return p.r.KeyCipher().Decrypt(ctx, string(cl.EncryptedSecret), nil)
+}
+
 // DeleteClient implements client.Storage.
 func (p *Persister) DeleteClient(ctx context.Context, id string) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteClient",
@@ -142,7 +192,7 @@ func (p *Persister) GetClients(ctx context.Context, filters client.Filter) (cs [
 		return nil, nil, err
 	}
 
-	query := p.QueryWithNetwork(ctx).Scope(
+	query := p.ReplicaQueryWithNetwork(ctx).Scope(
 		keysetpagination.Paginate[client.Client](paginator))
 
 	if filters.Name != "" {
@@ -176,7 +226,90 @@ func (p *Persister) GetConcreteClient(ctx context.Context, id string) (c *client
 	return &cl, nil
 }
 
-// GetClient implements fosite.ClientManager.
-func (p *Persister) GetClient(ctx context.Context, id string) (fosite.Client, error) {
-	return p.GetConcreteClient(ctx, id)
+// GetClient implements fosite.ClientManager. The OAuth 2.0 flows only read the client, so it is read from a read
+// replica if one is configured. Clients which have not been replicated yet are read from the primary. Use
+// GetConcreteClient to read a client which is about to be changed.
+func (p *Persister) GetClient(ctx context.Context, id string) (_ fosite.Client, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetClient",
+		trace.WithAttributes(events.ClientID(id)),
+	)
+	defer otelx.End(span, &err)
+
+	var cl client.Client
+	if err := p.ReplicaQueryWithNetwork(ctx).Where("id = ?", id).First(&cl); errors.Is(err, sql.ErrNoRows) {
+		return p.GetConcreteClient(ctx, id)
+	} else if err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &cl, nil
+}
+
+// CreateInitialAccessToken implements client.InitialAccessTokenManager.
+func (p *Persister) CreateInitialAccessToken(ctx context.Context, t *client.InitialAccessToken) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateInitialAccessToken")
+	defer otelx.End(span, &err)
+
+	if t.ID == uuid.Nil {
+		t.ID = uuid.Must(uuid.NewV4())
+	}
+	t.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	return sqlcon.HandleError(p.CreateWithNetwork(ctx, t))
+}
+
+// GetInitialAccessToken implements client.InitialAccessTokenManager.
+func (p *Persister) GetInitialAccessToken(ctx context.Context, id uuid.UUID) (_ *client.InitialAccessToken, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetInitialAccessToken")
+	defer otelx.End(span, &err)
+
+	var t client.InitialAccessToken
+	if err := p.QueryWithNetwork(ctx).Where("id = ?", id).First(&t); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &t, nil
+}
+
+// GetInitialAccessTokenBySignature implements client.InitialAccessTokenManager.
+func (p *Persister) GetInitialAccessTokenBySignature(ctx context.Context, signature string) (_ *client.InitialAccessToken, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetInitialAccessTokenBySignature")
+	defer otelx.End(span, &err)
+
+	var t client.InitialAccessToken
+	if err := p.QueryWithNetwork(ctx).Where("signature = ?", signature).First(&t); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &t, nil
+}
+
+// GetInitialAccessTokens implements client.InitialAccessTokenManager.
+func (p *Persister) GetInitialAccessTokens(ctx context.Context, pageOpts ...keysetpagination.Option) (_ []client.InitialAccessToken, _ *keysetpagination.Paginator, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetInitialAccessTokens")
+	defer otelx.End(span, &err)
+
+	paginator, err := keysetpagination.NewPaginator(append(pageOpts,
+		keysetpagination.WithDefaultToken(keysetpagination.NewPageToken(keysetpagination.Column{Name: "id", Value: uuid.Nil})),
+	)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ts []client.InitialAccessToken
+	if err := p.QueryWithNetwork(ctx).Scope(keysetpagination.Paginate[client.InitialAccessToken](paginator)).All(&ts); err != nil {
+		return nil, nil, sqlcon.HandleError(err)
+	}
+	ts, nextPage := keysetpagination.Result(ts, paginator)
+	return ts, nextPage, nil
+}
+
+// DeleteInitialAccessToken implements client.InitialAccessTokenManager.
+func (p *Persister) DeleteInitialAccessToken(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteInitialAccessToken")
+	defer otelx.End(span, &err)
+
+	n, err := p.Connection(ctx).RawQuery("DELETE FROM hydra_oauth2_initial_access_token WHERE id = ? AND nid = ?", id, p.NetworkID(ctx)).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	} else if n == 0 {
+		return errors.WithStack(sqlcon.ErrNoRows())
+	}
+	return nil
 }
@@ -7,8 +7,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -24,6 +27,7 @@ import (
 	"github.com/ory/x/popx"
 	"github.com/ory/x/sqlcon"
 	"github.com/ory/x/sqlxx"
+	"github.com/ory/x/stringsx"
 )
 
 var (
@@ -59,22 +63,161 @@ func (p *ConsentPersister) RevokeConsentSessionByID(ctx context.Context, consent
 	return p.Transaction(ctx, p.revokeConsentSession("consent_challenge_id = ?", consentRequestID))
 }
 
+func (p *ConsentPersister) RevokeSubjectClientConsentScopes(ctx context.Context, user, clientID string, scope, audience []string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RevokeSubjectClientConsentScopes", trace.WithAttributes(attribute.String("client.id", clientID)))
+	defer otelx.End(span, &err)
+
+	invalidate := p.d.Config().InvalidateRefreshTokensOnPartialRevocation(ctx)
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		var grants []consentGrant
+		if err := p.QueryWithNetwork(ctx).
+			Where("consent_challenge_id IS NOT NULL AND subject = ? AND client_id = ?", user, clientID).
+			All(&grants); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		nid := p.NetworkID(ctx)
+		for _, g := range grants {
+			remainingScope, revokedScope := partitionGrants(g.GrantedScope, scope)
+			remainingAudience, revokedAudience := partitionGrants(g.GrantedAudience, audience)
+			if len(revokedScope) == 0 && len(revokedAudience) == 0 {
+				continue
+			}
+
+			if err := c.RawQuery(
+				"UPDATE hydra_oauth2_flow SET granted_scope = ?, granted_at_audience = ? WHERE nid = ? AND consent_challenge_id = ?",
+				sqlxx.StringSliceJSONFormat(remainingScope), sqlxx.StringSliceJSONFormat(remainingAudience), nid, g.ConsentRequestID,
+			).Exec(); err != nil {
+				return sqlcon.HandleError(err)
+			}
+
+			// Access tokens can not be downscoped because they may be self-contained, so they are always revoked.
+			if err := c.RawQuery(
+				fmt.Sprintf("DELETE FROM %s WHERE nid = ? AND request_id = ?", OAuth2RequestSQL{Table: sqlTableAccess}.TableName()),
+				nid, g.ConsentRequestID,
+			).Exec(); err != nil {
+				return sqlcon.HandleError(err)
+			}
+
+			// Stateless refresh tokens carry their scopes and can not be downscoped, so they are always revoked.
+			if err := revokeRefreshFamilies(c, nid, g.ConsentRequestID); err != nil {
+				return err
+			}
+
+			if invalidate {
+				if err := c.RawQuery(
+					fmt.Sprintf("DELETE FROM %s WHERE nid = ? AND request_id = ?", OAuth2RefreshTable{}.TableName()),
+					nid, g.ConsentRequestID,
+				).Exec(); err != nil {
+					return sqlcon.HandleError(err)
+				}
+			} else if err := p.downscopeRefreshTokens(ctx, c, g.ConsentRequestID, scope, audience); err != nil {
+				return err
+			}
+
+			if !g.ConsentSkip {
+				if err := p.createConsentHistoryEvent(ctx, c, &consent.HistoryEvent{
+					Subject:          g.Subject,
+					ClientID:         g.ClientID,
+					ConsentRequestID: g.ConsentRequestID,
+					Type:             consent.HistoryEventRevoked,
+					Scope:            revokedScope,
+					Audience:         revokedAudience,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// downscopeRefreshTokens removes the given scopes and audiences from the refresh tokens of a request.
+func (p *ConsentPersister) downscopeRefreshTokens(ctx context.Context, c *pop.Connection, requestID string, scope, audience []string) error {
+	var tokens []struct {
+		Signature       string `db:"signature"`
+		GrantedScope    string `db:"granted_scope"`
+		GrantedAudience string `db:"granted_audience"`
+	}
+	if err := c.RawQuery(
+		fmt.Sprintf("SELECT signature, granted_scope, granted_audience FROM %s WHERE nid = ? AND request_id = ?", OAuth2RefreshTable{}.TableName()),
+		p.NetworkID(ctx), requestID,
+	).All(&tokens); err != nil {
+		return sqlcon.HandleError(err)
+	}
+
+	for _, t := range tokens {
+		remainingScope, revokedScope := partitionGrants(stringsx.Splitx(t.GrantedScope, "|"), scope)
+		remainingAudience, revokedAudience := partitionGrants(stringsx.Splitx(t.GrantedAudience, "|"), audience)
+		if len(revokedScope) == 0 && len(revokedAudience) == 0 {
+			continue
+		}
+		if err := c.RawQuery(
+			fmt.Sprintf("UPDATE %s SET granted_scope = ?, granted_audience = ? WHERE nid = ? AND signature = ?", OAuth2RefreshTable{}.TableName()),
+			strings.Join(remainingScope, "|"), strings.Join(remainingAudience, "|"), p.NetworkID(ctx), t.Signature,
+		).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+	}
+	return nil
+}
+
+// partitionGrants splits granted into the items which remain and the items which are revoked.
+func partitionGrants(granted, revoke []string) (remaining, revoked []string) {
+	remaining, revoked = []string{}, []string{}
+	for _, g := range granted {
+		if g == "" {
+			continue
+		}
+		if slices.Contains(revoke, g) {
+			revoked = append(revoked, g)
+		} else {
+			remaining = append(remaining, g)
+		}
+	}
+	return remaining, revoked
+}
+
+// consentGrant are the columns of a consent flow which are needed to revoke it.
+type consentGrant struct {
+	ConsentRequestID string                      `db:"consent_challenge_id"`
+	Subject          string                      `db:"subject"`
+	ClientID         string                      `db:"client_id"`
+	ConsentSkip      bool                        `db:"consent_skip"`
+	GrantedScope     sqlxx.StringSliceJSONFormat `db:"granted_scope"`
+	GrantedAudience  sqlxx.StringSliceJSONFormat `db:"granted_at_audience"`
+}
+
+func (consentGrant) TableName() string {
+	return "hydra_oauth2_flow"
+}
+
+func (p *ConsentPersister) createConsentHistoryEvent(ctx context.Context, c *pop.Connection, e *consent.HistoryEvent) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	e.ID = id
+	e.NID = p.NetworkID(ctx)
+	e.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	return sqlcon.HandleError(c.Create(e))
+}
+
 func (p *ConsentPersister) revokeConsentSession(whereStmt string, whereArgs ...interface{}) func(context.Context, *pop.Connection) error {
 	return func(ctx context.Context, c *pop.Connection) error {
-		fs := make([]*flow.Flow, 0)
+		var grants []consentGrant
 		if err := p.QueryWithNetwork(ctx).
 			Where(whereStmt, whereArgs...).
-			Select("consent_challenge_id").
-			All(&fs); errors.Is(err, sql.ErrNoRows) {
+			All(&grants); errors.Is(err, sql.ErrNoRows) {
 			return errors.WithStack(x.ErrNotFound)
 		} else if err != nil {
 			return sqlcon.HandleError(err)
 		}
 
-		ids := make([]interface{}, 0, len(fs))
+		ids := make([]interface{}, 0, len(grants))
 		nid := p.NetworkID(ctx)
-		for _, f := range fs {
-			ids = append(ids, f.ConsentRequestID.String())
+		for _, g := range grants {
+			ids = append(ids, g.ConsentRequestID)
 		}
 
 		if len(ids) == 0 {
@@ -99,6 +242,10 @@ func (p *ConsentPersister) revokeConsentSession(whereStmt string, whereArgs ...i
 			return err
 		}
 
+		if err := revokeRefreshFamilies(c, nid, ids...); err != nil {
+			return err
+		}
+
 		if err := p.QueryWithNetwork(ctx).
 			Where("nid = ?", nid).
 			Where("consent_challenge_id IN (?)", ids...).
@@ -108,6 +255,24 @@ func (p *ConsentPersister) revokeConsentSession(whereStmt string, whereArgs ...i
 			return sqlcon.HandleError(err)
 		}
 
+		// Skipped consents only repeat a remembered consent, so only the consents the subject actually granted are
+		// recorded in the history.
+		for _, g := range grants {
+			if g.ConsentSkip {
+				continue
+			}
+			if err := p.createConsentHistoryEvent(ctx, c, &consent.HistoryEvent{
+				Subject:          g.Subject,
+				ClientID:         g.ClientID,
+				ConsentRequestID: g.ConsentRequestID,
+				Type:             consent.HistoryEventRevoked,
+				Scope:            g.GrantedScope,
+				Audience:         g.GrantedAudience,
+			}); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}
 }
@@ -183,7 +348,47 @@ func (p *ConsentPersister) CreateConsentSession(ctx context.Context, f *flow.Flo
 	if f.NID != p.NetworkID(ctx) {
 		return errors.WithStack(sqlcon.ErrNoRows())
 	}
-	return sqlcon.HandleError(p.Connection(ctx).Create(f))
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if err := c.Create(f); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		if f.ConsentSkip {
+			return nil
+		}
+		return p.createConsentHistoryEvent(ctx, c, &consent.HistoryEvent{
+			Subject:          f.Subject,
+			ClientID:         f.ClientID,
+			ConsentRequestID: f.ConsentRequestID.String(),
+			Type:             consent.HistoryEventGranted,
+			Scope:            f.GrantedScope,
+			Audience:         f.GrantedAudience,
+		})
+	})
+}
+
+func (p *ConsentPersister) FindSubjectsConsentHistory(ctx context.Context, subject, clientID string, pageOpts ...keysetpagination.Option) (_ []consent.HistoryEvent, _ *keysetpagination.Paginator, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FindSubjectsConsentHistory")
+	defer otelx.End(span, &err)
+
+	paginator, err := keysetpagination.NewPaginator(append(pageOpts,
+		keysetpagination.WithDefaultToken(keysetpagination.NewPageToken(keysetpagination.Column{Name: "id", Value: uuid.Nil})),
+	)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := p.ReplicaQueryWithNetwork(ctx).Where("subject = ?", subject)
+	if clientID != "" {
+		q = q.Where("client_id = ?", clientID)
+	}
+
+	var es []consent.HistoryEvent
+	if err := q.Scope(keysetpagination.Paginate[consent.HistoryEvent](paginator)).All(&es); err != nil {
+		return nil, nil, sqlcon.HandleError(err)
+	}
+
+	es, nextPage := keysetpagination.Result(es, paginator)
+	return es, nextPage, nil
 }
 
 func (p *Persister) GetRememberedLoginSession(ctx context.Context, id string) (_ *flow.LoginSession, err error) {
@@ -355,7 +560,7 @@ func (p *ConsentPersister) FindSubjectsGrantedConsentRequests(ctx context.Contex
 	}
 
 	var fs []flow.Flow
-	err = p.QueryWithNetwork(ctx).
+	err = p.ReplicaQueryWithNetwork(ctx).
 		Where("(state IN (?, ?) OR state IS NULL)", flow.FlowStateConsentUsed, flow.FlowStateConsentUnused).
 		Where("subject = ?", subject).
 		Where("consent_skip = FALSE").
@@ -385,7 +590,7 @@ func (p *ConsentPersister) FindSubjectsSessionGrantedConsentRequests(ctx context
 	}
 
 	var fs []flow.Flow
-	err = p.QueryWithNetwork(ctx).
+	err = p.ReplicaQueryWithNetwork(ctx).
 		Where("(state IN (?, ?) OR state IS NULL)", flow.FlowStateConsentUsed, flow.FlowStateConsentUnused).
 		Where("subject = ?", subject).
 		Where("login_session_id = ?", sid).
@@ -532,6 +737,11 @@ func (p *Persister) FlushInactiveLoginConsentRequests(ctx context.Context, notAf
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushInactiveLoginConsentRequests")
 	defer otelx.End(span, &err)
 
+	_, err = p.flushInactiveLoginConsentRequests(ctx, notAfter, limit, batchSize)
+	return err
+}
+
+func (p *Persister) flushInactiveLoginConsentRequests(ctx context.Context, notAfter time.Time, limit, batchSize int) (deleted int, err error) {
 	// The value of notAfter should be the minimum between input parameter and request max expire based on its configured age
 	requestMaxExpire := time.Now().Add(-p.r.Config().ConsentRequestMaxAge(ctx))
 	if requestMaxExpire.Before(notAfter) {
@@ -561,7 +771,7 @@ func (p *Persister) FlushInactiveLoginConsentRequests(ctx context.Context, notAf
 		flow.FlowStateConsentUsed, notAfter, p.NetworkID(ctx), limit)
 
 	if err := q.All(&challenges); err != nil {
-		return errors.WithStack(err)
+		return 0, errors.WithStack(err)
 	}
 
 	// Delete in batch consent requests and their references in cascade
@@ -574,12 +784,14 @@ func (p *Persister) FlushInactiveLoginConsentRequests(ctx context.Context, notAf
 			p.NetworkID(ctx),
 		)
 
-		if err := q.Exec(); err != nil {
-			return sqlcon.HandleError(err)
+		n, err := q.ExecWithCount()
+		deleted += n
+		if err != nil {
+			return deleted, sqlcon.HandleError(err)
 		}
 	}
 
-	return nil
+	return deleted, nil
 }
 
 func (p *Persister) mySQLConfirmLoginSession(ctx context.Context, session *flow.LoginSession) error {
@@ -18,12 +18,15 @@ import (
 
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x/events"
 	"github.com/ory/x/otelx"
 	"github.com/ory/x/sqlcon"
 	"github.com/ory/x/sqlxx"
 	"github.com/ory/x/stringsx"
 )
 
+var _ events.PendingDeviceCodeCounter = (*Persister)(nil)
+
 const (
 	sqlTableDeviceAuthCodes tableName = "hydra_oauth2_device_auth_codes"
 )
@@ -288,3 +291,30 @@ func (p *Persister) UpdateDeviceCodeSessionBySignature(ctx context.Context, sign
 		).Exec(),
 	)
 }
+
+// CountPendingDeviceCodes returns the number of device codes of all networks, by client ID, whose user code was not
+// used yet and which did not expire. Implements events.PendingDeviceCodeCounter.
+func (p *Persister) CountPendingDeviceCodes(ctx context.Context) (_ map[string]int, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CountPendingDeviceCodes")
+	defer otelx.End(span, &err)
+
+	var rows []struct {
+		ClientID string `db:"client_id"`
+		Count    int    `db:"pending"`
+	}
+	stmt := fmt.Sprintf(
+		"SELECT client_id, COUNT(*) AS pending FROM %s WHERE user_code_state = ? AND device_code_active = ? AND expires_at > ? GROUP BY client_id",
+		sqlTableDeviceAuthCodes,
+	)
+
+	/* #nosec G201 table is static */
+	if err := p.Connection(ctx).RawQuery(stmt, fosite.UserCodeUnused, true, time.Now().UTC()).All(&rows); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, r := range rows {
+		counts[r.ClientID] = r.Count
+	}
+	return counts, nil
+}
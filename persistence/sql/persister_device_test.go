@@ -0,0 +1,61 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x/events"
+)
+
+func TestPersister_CountPendingDeviceCodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reg := testhelpers.NewRegistryMemory(t)
+	counter, ok := reg.Persister().(events.PendingDeviceCodeCounter)
+	require.True(t, ok)
+
+	cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String()}
+	require.NoError(t, reg.ClientManager().CreateClient(ctx, cl))
+
+	createDeviceCode := func(expiresIn time.Duration) string {
+		r := fosite.NewDeviceRequest()
+		r.ID = uuid.Must(uuid.NewV4()).String()
+		r.Client = cl
+		r.Session = oauth2.NewTestSession(t, "subject")
+		r.Session.SetExpiresAt(fosite.DeviceCode, time.Now().Add(expiresIn))
+
+		deviceCode := uuid.Must(uuid.NewV4()).String()
+		require.NoError(t, reg.OAuth2Storage().CreateDeviceAuthSession(ctx, deviceCode, uuid.Must(uuid.NewV4()).String(), r))
+		return deviceCode
+	}
+
+	createDeviceCode(time.Hour)
+	createDeviceCode(time.Hour)
+	createDeviceCode(-time.Minute)
+
+	accepted := createDeviceCode(time.Hour)
+	r, err := reg.OAuth2Storage().GetDeviceCodeSession(ctx, accepted, oauth2.NewTestSession(t, "subject"))
+	require.NoError(t, err)
+	r.SetUserCodeState(fosite.UserCodeAccepted)
+	require.NoError(t, reg.OAuth2Storage().UpdateDeviceCodeSessionBySignature(ctx, accepted, r))
+
+	invalidated := createDeviceCode(time.Hour)
+	require.NoError(t, reg.OAuth2Storage().InvalidateDeviceCodeSession(ctx, invalidated))
+
+	counts, err := counter.CountPendingDeviceCodes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{cl.ID: 2}, counts)
+}
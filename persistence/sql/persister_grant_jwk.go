@@ -30,8 +30,12 @@ type SQLGrant struct {
 	Subject         string                         `db:"subject"`
 	AllowAnySubject bool                           `db:"allow_any_subject"`
 	Scope           sqlxx.StringSlicePipeDelimiter `db:"scope"`
-	KeySet          string                         `db:"key_set"`
-	KeyID           string                         `db:"key_id"`
+	KeySet          sqlxx.NullString               `db:"key_set"`
+	KeyID           sqlxx.NullString               `db:"key_id"`
+	JWKSURI         string                         `db:"jwks_uri"`
+	OIDCDiscovery   bool                           `db:"oidc_discovery"`
+	ClaimConditions trust.ClaimConditions          `db:"claim_conditions"`
+	ClaimMappings   trust.ClaimMappings            `db:"claim_mappings"`
 	CreatedAt       time.Time                      `db:"created_at"`
 	ExpiresAt       time.Time                      `db:"expires_at"`
 }
@@ -47,8 +51,12 @@ func (SQLGrant) fromGrant(g trust.Grant) SQLGrant {
 		Subject:         g.Subject,
 		AllowAnySubject: g.AllowAnySubject,
 		Scope:           g.Scope,
-		KeySet:          g.PublicKey.Set,
-		KeyID:           g.PublicKey.KeyID,
+		KeySet:          sqlxx.NullString(g.PublicKey.Set),
+		KeyID:           sqlxx.NullString(g.PublicKey.KeyID),
+		JWKSURI:         g.JWKSURI,
+		OIDCDiscovery:   g.OIDCDiscovery,
+		ClaimConditions: g.ClaimConditions,
+		ClaimMappings:   g.ClaimMappings,
 		CreatedAt:       g.CreatedAt,
 		ExpiresAt:       g.ExpiresAt,
 	}
@@ -62,11 +70,15 @@ func (d SQLGrant) toGrant() trust.Grant {
 		AllowAnySubject: d.AllowAnySubject,
 		Scope:           d.Scope,
 		PublicKey: trust.PublicKey{
-			Set:   d.KeySet,
-			KeyID: d.KeyID,
+			Set:   string(d.KeySet),
+			KeyID: string(d.KeyID),
 		},
-		CreatedAt: d.CreatedAt,
-		ExpiresAt: d.ExpiresAt,
+		JWKSURI:         d.JWKSURI,
+		OIDCDiscovery:   d.OIDCDiscovery,
+		ClaimConditions: d.ClaimConditions,
+		ClaimMappings:   d.ClaimMappings,
+		CreatedAt:       d.CreatedAt,
+		ExpiresAt:       d.ExpiresAt,
 	}
 }
 
@@ -75,6 +87,11 @@ func (p *Persister) CreateGrant(ctx context.Context, g trust.Grant, publicKey jo
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateGrant")
 	defer otelx.End(span, &err)
 
+	if g.IsFederated() {
+		data := SQLGrant{}.fromGrant(g)
+		return sqlcon.HandleError(p.CreateWithNetwork(ctx, &data))
+	}
+
 	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
 		// add key, if it doesn't exist
 		if _, err := p.d.KeyManager().GetKey(ctx, g.PublicKey.Set, g.PublicKey.KeyID); err != nil {
@@ -120,6 +137,9 @@ func (p *Persister) DeleteGrant(ctx context.Context, id uuid.UUID) (err error) {
 			return sqlcon.HandleError(err)
 		}
 
+		if grant.IsFederated() {
+			return nil
+		}
 		return p.d.KeyManager().DeleteKey(ctx, grant.PublicKey.Set, grant.PublicKey.KeyID)
 	})
 }
@@ -167,6 +187,29 @@ func (p *Persister) FlushInactiveGrants(ctx context.Context, notAfter time.Time,
 	return sqlcon.HandleError(p.QueryWithNetwork(ctx).Where("expires_at < ?", deleteUntil).Delete(&SQLGrant{}))
 }
 
+// GetFederatedGrants implements GrantManager
+func (p *Persister) GetFederatedGrants(ctx context.Context, issuer string) (_ []trust.Grant, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetFederatedGrants")
+	defer otelx.End(span, &err)
+
+	var grantsData []SQLGrant
+	if err := p.QueryWithNetwork(ctx).
+		Where("issuer = ?", issuer).
+		Where("expires_at > ?", time.Now().UTC()).
+		Where("(jwks_uri <> '' OR oidc_discovery IS TRUE)").
+		Order("created_at DESC").
+		Limit(100).
+		All(&grantsData); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	grants := make([]trust.Grant, len(grantsData))
+	for i := range grantsData {
+		grants[i] = grantsData[i].toGrant()
+	}
+	return grants, nil
+}
+
 // GetPublicKey implements RFC7523KeyStorage
 func (p *Persister) GetPublicKey(ctx context.Context, issuer string, subject string, keyId string) (_ *jose.JSONWebKey, err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetPublicKey")
@@ -213,6 +256,7 @@ func (p *Persister) GetPublicKeys(ctx context.Context, issuer string, subject st
 		Where(expiresAt).
 		Where("issuer = ?", issuer).
 		Where("(subject = ? OR allow_any_subject IS TRUE)", subject).
+		Where("key_id IS NOT NULL").
 		Order("created_at DESC").
 		Limit(100) // Load maximum of 100 keys
 
@@ -0,0 +1,192 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ janitor.Manager = (*Persister)(nil)
+
+// AcquireLease implements janitor.Manager
+func (p *Persister) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (_ bool, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.AcquireLease")
+	defer otelx.End(span, &err)
+
+	now := time.Now().UTC()
+	n, err := p.Connection(ctx).RawQuery(
+		"UPDATE hydra_lease SET holder = ?, expires_at = ? WHERE name = ? AND (holder = ? OR expires_at < ?)",
+		holder, now.Add(ttl), name, holder, now,
+	).ExecWithCount()
+	if err != nil {
+		return false, sqlcon.HandleError(err)
+	} else if n > 0 {
+		return true, nil
+	}
+
+	err = sqlcon.HandleError(p.Connection(ctx).RawQuery(
+		"INSERT INTO hydra_lease (name, holder, expires_at) VALUES (?, ?, ?)",
+		name, holder, now.Add(ttl),
+	).Exec())
+	if err == nil {
+		return true, nil
+	} else if !errors.Is(err, sqlcon.ErrUniqueViolation()) {
+		return false, err
+	}
+
+	// MySQL does not count rows whose values did not change as updated, which happens if the lease is renewed
+	// within the same second. The lease is held by the holder in that case.
+	var lease janitor.Lease
+	if err := p.Connection(ctx).Where("name = ?", name).First(&lease); err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+	return lease.Holder == holder, nil
+}
+
+// ReleaseLease implements janitor.Manager
+func (p *Persister) ReleaseLease(ctx context.Context, name, holder string) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ReleaseLease")
+	defer otelx.End(span, &err)
+
+	return sqlcon.HandleError(p.Connection(ctx).RawQuery("DELETE FROM hydra_lease WHERE name = ? AND holder = ?", name, holder).Exec())
+}
+
+// FlushExpired implements janitor.Manager
+func (p *Persister) FlushExpired(ctx context.Context, table janitor.Table, notAfter time.Time, limit, batchSize int) (_ int, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushExpired")
+	defer otelx.End(span, &err)
+
+	switch table {
+	case janitor.TableAccessTokens:
+		return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableAccess, p.r.Config().GetAccessTokenLifespan(ctx))
+	case janitor.TableRefreshTokens:
+		lifespan := p.r.Config().GetRefreshTokenLifespan(ctx)
+		if lifespan < 0 {
+			// Refresh tokens never expire.
+			return 0, nil
+		}
+		return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableRefresh, lifespan)
+	case janitor.TableAuthorizationCodes:
+		return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableCode, p.r.Config().GetAuthorizeCodeLifespan(ctx))
+	case janitor.TablePKCERequests:
+		return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTablePKCE, p.r.Config().GetAuthorizeCodeLifespan(ctx))
+	case janitor.TablePushedAuthRequests:
+		return p.flushExpiredRows(ctx, notAfter, limit, batchSize, table, "signature")
+	case janitor.TableOpenIDConnectSessions:
+		return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableOpenID, p.r.Config().GetAuthorizeCodeLifespan(ctx))
+	case janitor.TableLoginConsentRequests:
+		return p.flushInactiveLoginConsentRequests(ctx, notAfter, limit, batchSize)
+	case janitor.TableBlacklistedJTIs:
+		return p.flushExpiredRows(ctx, notAfter, limit, batchSize, table, "signature")
+	case janitor.TableDeviceAuthCodes:
+		return p.flushExpiredRows(ctx, notAfter, limit, batchSize, table, "device_code_signature")
+	case janitor.TableTrustedJWTBearerGrants:
+		return p.flushExpiredRows(ctx, notAfter, limit, batchSize, table, "id")
+	case janitor.TableRefreshTokenFamilies:
+		return p.flushExpiredRows(ctx, notAfter, limit, batchSize, table, "request_id")
+	case janitor.TableSecurityEvents:
+		return p.flushExpiredRows(ctx, notAfter, limit, batchSize, table, "id")
+	}
+	return 0, errors.Errorf("unable to flush unknown table %s", table)
+}
+
+// FlushExpiredPartitions implements janitor.Manager
+func (p *Persister) FlushExpiredPartitions(ctx context.Context) (_ map[janitor.Table]int, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushExpiredPartitions")
+	defer otelx.End(span, &err)
+
+	// Only PostgreSQL partitions tables, CockroachDB removes expired rows using a row-level TTL job.
+	if p.Connection(ctx).Dialect.Name() != "postgres" {
+		return nil, nil
+	}
+
+	// Partitions are created ahead for the longest token lifespan, so that tokens are only stored in the default
+	// partition if their lifespan was overridden.
+	lifespan := max(p.r.Config().GetAccessTokenLifespan(ctx), p.r.Config().GetRefreshTokenLifespan(ctx))
+	daysAhead := min(int(lifespan/(24*time.Hour))+1, 366)
+
+	dropped := map[janitor.Table]int{}
+	for _, table := range []tableName{sqlTableAccess, sqlTableRefresh} {
+		native, err := p.nativeTokenExpiry(ctx, table)
+		if err != nil {
+			return nil, err
+		} else if !native {
+			continue
+		}
+
+		var n int
+		name := OAuth2RequestSQL{Table: table}.TableName()
+		if err := p.Connection(ctx).RawQuery("SELECT hydra_maintain_token_partitions(?, ?)", name, daysAhead).First(&n); err != nil {
+			return nil, sqlcon.HandleError(err)
+		}
+		dropped[janitor.Table(name)] = n
+	}
+	return dropped, nil
+}
+
+// nativeTokenExpiry returns whether the database removes expired rows of the token table itself, because the
+// table is partitioned by expiry on PostgreSQL or has a row-level TTL on CockroachDB. See TokenExpiryMigrations.
+//
+// The result is cached, because the migrations are applied before the persister is used and the token tables are
+// written on every token request.
+func (p *Persister) nativeTokenExpiry(ctx context.Context, table tableName) (native bool, err error) {
+	name := OAuth2RequestSQL{Table: table}.TableName()
+	if v, ok := p.nativeExpiry.Load(name); ok {
+		return v.(bool), nil
+	}
+
+	switch c := p.Connection(ctx); c.Dialect.Name() {
+	case "postgres":
+		err = c.RawQuery(`SELECT EXISTS (
+			SELECT 1 FROM pg_partitioned_table pt JOIN pg_class c ON c.oid = pt.partrelid WHERE c.relname = ? AND pg_table_is_visible(c.oid)
+		)`, name).First(&native)
+	case "cockroach":
+		var stmt string
+		/* #nosec G201 table is static */
+		err = c.RawQuery(fmt.Sprintf("SELECT create_statement FROM [SHOW CREATE TABLE %s]", name)).First(&stmt)
+		native = strings.Contains(stmt, "ttl_expiration_expression")
+	}
+	if err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+
+	p.nativeExpiry.Store(name, native)
+	return native, nil
+}
+
+// flushExpiredRows deletes rows of the table whose expires_at is before notAfter, or before now if notAfter is in
+// the future. Rows are identified by the key column.
+func (p *Persister) flushExpiredRows(ctx context.Context, notAfter time.Time, limit, batchSize int, table janitor.Table, key string) (totalDeletedCount int, err error) {
+	if now := time.Now(); now.Before(notAfter) {
+		notAfter = now
+	}
+
+	for deletedRecords := batchSize; totalDeletedCount < limit && deletedRecords == batchSize; {
+		d := min(batchSize, limit-totalDeletedCount)
+		// The outer SELECT is necessary because our version of MySQL doesn't yet support 'LIMIT & IN/ALL/ANY/SOME subquery
+		/* #nosec G201 table and key are static */
+		deletedRecords, err = p.Connection(ctx).RawQuery(
+			fmt.Sprintf(`DELETE FROM %[1]s WHERE %[2]s in (
+				SELECT %[2]s FROM (SELECT %[2]s FROM %[1]s WHERE expires_at < ? AND nid = ? ORDER BY expires_at LIMIT %[3]d) as s
+			) AND nid = ?`, table, key, d),
+			notAfter.UTC(),
+			p.NetworkID(ctx),
+			p.NetworkID(ctx),
+		).ExecWithCount()
+		totalDeletedCount += deletedRecords
+		if err != nil {
+			break
+		}
+	}
+	return totalDeletedCount, sqlcon.HandleError(err)
+}
@@ -0,0 +1,97 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/x/configx"
+)
+
+func TestNativeTokenExpiry(t *testing.T) {
+	if testing.Short() {
+		t.Skip("native token expiry requires PostgreSQL or CockroachDB")
+	}
+	t.Parallel()
+
+	for db, dsn := range map[string]string{
+		"postgres":  newPostgresDatabase(t, testhelpers.ConnectToPG(t)),
+		"cockroach": newPostgresDatabase(t, testhelpers.ConnectToCRDB(t)),
+	} {
+		t.Run("database="+db, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			reg := testhelpers.NewRegistrySQLFromURL(t, dsn, false, false,
+				driver.WithConfigOptions(configx.WithValue(config.KeyDBTokenExpiry, "native")))
+			require.NoError(t, reg.Migrator().MigrateUp(ctx))
+			require.NoError(t, reg.InitNetwork(ctx))
+
+			cl := &client.Client{ID: "native-token-expiry"}
+			require.NoError(t, reg.ClientManager().CreateClient(ctx, cl))
+
+			newRequest := func(requestedAt, expiresAt time.Time) fosite.Requester {
+				sess := &oauth2.Session{DefaultSession: openid.NewDefaultSession()}
+				sess.SetExpiresAt(fosite.AccessToken, expiresAt)
+				sess.SetExpiresAt(fosite.RefreshToken, expiresAt)
+				return &fosite.Request{ID: "request", Client: cl, RequestedAt: requestedAt, Session: sess}
+			}
+			count := func(t *testing.T, table string) (n int) {
+				require.NoError(t, reg.Persister().Connection(ctx).RawQuery(fmt.Sprintf("SELECT count(*) FROM %s", table)).First(&n))
+				return n
+			}
+
+			past := time.Now().UTC().Add(-48 * time.Hour)
+			require.NoError(t, reg.OAuth2Storage().CreateAccessTokenSession(ctx, "expired", newRequest(past, past.Add(time.Hour))))
+			require.NoError(t, reg.OAuth2Storage().CreateAccessTokenSession(ctx, "active", newRequest(time.Now().UTC(), time.Now().UTC().Add(time.Hour))))
+			require.NoError(t, reg.OAuth2Storage().CreateRefreshTokenSession(ctx, "never-expires", "", newRequest(time.Now().UTC(), time.Time{})))
+
+			t.Run("case=tokens which never expire are stored with an expiry", func(t *testing.T) {
+				var n int
+				require.NoError(t, reg.Persister().Connection(ctx).RawQuery("SELECT count(*) FROM hydra_oauth2_refresh WHERE expires_at IS NULL").First(&n))
+				assert.Zero(t, n)
+			})
+
+			t.Run("case=the janitor skips the token tables", func(t *testing.T) {
+				require.NoError(t, reg.OAuth2Storage().FlushInactiveAccessTokens(ctx, time.Now(), 100, 100))
+				require.NoError(t, reg.OAuth2Storage().FlushInactiveRefreshTokens(ctx, time.Now(), 100, 100))
+				assert.Equal(t, 2, count(t, "hydra_oauth2_access"))
+				assert.Equal(t, 1, count(t, "hydra_oauth2_refresh"))
+			})
+
+			t.Run("case=expired tokens are removed by the database", func(t *testing.T) {
+				dropped, err := reg.Persister().FlushExpiredPartitions(ctx)
+				require.NoError(t, err)
+
+				switch db {
+				case "postgres":
+					assert.Equal(t, map[janitor.Table]int{"hydra_oauth2_access": 1, "hydra_oauth2_refresh": 0}, dropped)
+					assert.Equal(t, 1, count(t, "hydra_oauth2_access"))
+					assert.Equal(t, 1, count(t, "hydra_oauth2_refresh"))
+				case "cockroach":
+					// CockroachDB removes expired rows in the background using the row-level TTL.
+					assert.Empty(t, dropped)
+					for _, table := range []string{"hydra_oauth2_access", "hydra_oauth2_refresh"} {
+						var stmt string
+						require.NoError(t, reg.Persister().Connection(ctx).RawQuery(fmt.Sprintf("SELECT create_statement FROM [SHOW CREATE TABLE %s]", table)).First(&stmt))
+						assert.Contains(t, stmt, "ttl_expiration_expression", "table %s", table)
+					}
+				}
+			})
+		})
+	}
+}
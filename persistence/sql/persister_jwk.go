@@ -20,7 +20,10 @@ import (
 	"github.com/ory/x/sqlcon"
 )
 
-var _ jwk.Manager = (*JWKPersister)(nil)
+var (
+	_ jwk.Manager       = (*JWKPersister)(nil)
+	_ jwk.ReplicaReader = (*JWKPersister)(nil)
+)
 
 type JWKPersister struct {
 	D interface {
@@ -193,6 +196,26 @@ func (p *JWKPersister) GetKeySet(ctx context.Context, set string) (keys *jose.JS
 	return js.ToJWK(ctx, aead.NewAESGCM(p.D.Config()))
 }
 
+// GetKeySetFromReplica implements jwk.ReplicaReader. Key sets which have not been replicated yet are read from the
+// primary.
+func (p *JWKPersister) GetKeySetFromReplica(ctx context.Context, set string) (keys *jose.JSONWebKeySet, err error) {
+	ctx, span := p.D.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetKeySetFromReplica", trace.WithAttributes(attribute.String("set", set)))
+	defer otelx.End(span, &err)
+
+	var js jwk.SQLDataRows
+	if err := p.D.BasePersister().ReplicaQueryWithNetwork(ctx).
+		Where("sid = ?", set).
+		Order("created_at DESC").
+		All(&js); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	if len(js) == 0 {
+		return p.GetKeySet(ctx, set)
+	}
+
+	return js.ToJWK(ctx, aead.NewAESGCM(p.D.Config()))
+}
+
 // DeleteKey implements jwk.Manager.
 func (p *JWKPersister) DeleteKey(ctx context.Context, set, kid string) (err error) {
 	ctx, span := p.D.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteKey",
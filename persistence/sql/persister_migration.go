@@ -24,6 +24,12 @@ import (
 //go:embed migrations/*.sql
 var Migrations embed.FS
 
+// TokenExpiryMigrations let PostgreSQL and CockroachDB remove expired access and refresh tokens. They are applied in
+// addition to Migrations if db.token_expiry is set to "native".
+//
+//go:embed migrations/token_expiry/*.sql
+var TokenExpiryMigrations embed.FS
+
 var SilenceMigrations = false
 
 type (
@@ -0,0 +1,414 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/aead"
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/persistence"
+	"github.com/ory/hydra/v2/ssf"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/popx"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+)
+
+var _ persistence.NetworkArchiver = (*Persister)(nil)
+
+type networkArchiveTable struct {
+	name string
+	// model returns a pointer to a new, empty row.
+	model func() any
+	// query selects the rows to export. It is nil if the table is not exported.
+	query func(ctx context.Context, opts persistence.NetworkExportOptions) *pop.Query
+	// seal is applied to every row before it is exported, open to every row before it is imported.
+	seal, open func(ctx context.Context, transport aead.Cipher, row any) error
+}
+
+// networkArchiveTables returns the archived tables in the order they have to be imported in to satisfy foreign keys.
+func (p *Persister) networkArchiveTables() []networkArchiveTable {
+	// auditHead is the hash of the last imported audit event.
+	var auditHead string
+
+	return []networkArchiveTable{
+		{
+			name:  client.Client{}.TableName(),
+			model: func() any { return new(client.Client) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+			seal: func(ctx context.Context, transport aead.Cipher, row any) error {
+				r := row.(*client.Client)
+				if r.EncryptedSecret == "" {
+					return nil
+				}
+				secret, err := reencrypt(ctx, string(r.EncryptedSecret), p.r.KeyCipher(), transport)
+				r.EncryptedSecret = sqlxx.NullString(secret)
+				return err
+			},
+			open: func(ctx context.Context, transport aead.Cipher, row any) error {
+				r := row.(*client.Client)
+				if r.EncryptedSecret == "" {
+					return nil
+				}
+				secret, err := reencrypt(ctx, string(r.EncryptedSecret), transport, p.r.KeyCipher())
+				r.EncryptedSecret = sqlxx.NullString(secret)
+				return err
+			},
+		},
+		{
+			name:  client.InitialAccessToken{}.TableName(),
+			model: func() any { return new(client.InitialAccessToken) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+		},
+		{
+			name:  jwk.SQLData{}.TableName(),
+			model: func() any { return new(jwk.SQLData) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+			seal: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*jwk.SQLData)
+				r.Key, err = reencrypt(ctx, r.Key, p.r.KeyCipher(), transport)
+				return err
+			},
+			open: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*jwk.SQLData)
+				r.Key, err = reencrypt(ctx, r.Key, transport, p.r.KeyCipher())
+				return err
+			},
+		},
+		{
+			name:  SQLGrant{}.TableName(),
+			model: func() any { return new(SQLGrant) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+		},
+		{
+			name:  flow.LoginSession{}.TableName(),
+			model: func() any { return new(flow.LoginSession) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+		},
+		{
+			name:  flow.Flow{}.TableName(),
+			model: func() any { return new(flow.Flow) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx).Where("(state = ? OR state IS NULL)", flow.FlowStateConsentUsed)
+			},
+		},
+		{
+			name:  consent.ForcedObfuscatedLoginSession{}.TableName(),
+			model: func() any { return new(consent.ForcedObfuscatedLoginSession) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+		},
+		{
+			name:  consent.HistoryEvent{}.TableName(),
+			model: func() any { return new(consent.HistoryEvent) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+		},
+		{
+			name:  OAuth2RefreshTable{}.TableName(),
+			model: func() any { return new(OAuth2RefreshTable) },
+			query: func(ctx context.Context, opts persistence.NetworkExportOptions) *pop.Query {
+				if !opts.IncludeRefreshTokens {
+					return nil
+				}
+				return p.QueryWithNetwork(ctx).Where("active = TRUE AND (expires_at IS NULL OR expires_at > ?)", time.Now().UTC())
+			},
+			seal: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*OAuth2RefreshTable)
+				r.Session, err = p.sealSession(ctx, transport, r.Session)
+				return err
+			},
+			open: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*OAuth2RefreshTable)
+				r.Session, err = p.openSession(ctx, transport, r.Session)
+				return err
+			},
+		},
+		{
+			name:  OAuth2RefreshFamily{}.TableName(),
+			model: func() any { return new(OAuth2RefreshFamily) },
+			query: func(ctx context.Context, opts persistence.NetworkExportOptions) *pop.Query {
+				if !opts.IncludeRefreshTokens {
+					return nil
+				}
+				return p.QueryWithNetwork(ctx).Where("revoked = FALSE AND (expires_at IS NULL OR expires_at > ?)", time.Now().UTC())
+			},
+		},
+		{
+			name:  OAuth2PARTable{}.TableName(),
+			model: func() any { return new(OAuth2PARTable) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx).Where("active = TRUE AND expires_at > ?", time.Now().UTC())
+			},
+			seal: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*OAuth2PARTable)
+				r.Session, err = p.sealSession(ctx, transport, r.Session)
+				return err
+			},
+			open: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*OAuth2PARTable)
+				r.Session, err = p.openSession(ctx, transport, r.Session)
+				return err
+			},
+		},
+		{
+			name:  SSFStream{}.TableName(),
+			model: func() any { return new(SSFStream) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx)
+			},
+			seal: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*SSFStream)
+				if r.AuthorizationHeader != "" {
+					r.AuthorizationHeader, err = reencrypt(ctx, r.AuthorizationHeader, p.r.KeyCipher(), transport)
+				}
+				return err
+			},
+			open: func(ctx context.Context, transport aead.Cipher, row any) (err error) {
+				r := row.(*SSFStream)
+				if r.AuthorizationHeader != "" {
+					r.AuthorizationHeader, err = reencrypt(ctx, r.AuthorizationHeader, transport, p.r.KeyCipher())
+				}
+				return err
+			},
+		},
+		{
+			name:  ssf.PendingEvent{}.TableName(),
+			model: func() any { return new(ssf.PendingEvent) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx).Where("expires_at > ?", time.Now().UTC())
+			},
+		},
+		{
+			name:  audit.Event{}.TableName(),
+			model: func() any { return new(audit.Event) },
+			query: func(ctx context.Context, _ persistence.NetworkExportOptions) *pop.Query {
+				return p.QueryWithNetwork(ctx).Order("seq ASC")
+			},
+			// The hash of an event covers the network ID, so the chain is recomputed for the importing network.
+			// Events are exported in order, which makes the previous row the predecessor of every event.
+			open: func(ctx context.Context, _ aead.Cipher, row any) error {
+				key, err := p.r.Config().GetGlobalSecret(ctx)
+				if err != nil {
+					return err
+				}
+				r := row.(*audit.Event)
+				r.PreviousHash = auditHead
+				r.Hash = r.ComputeHash(key)
+				auditHead = r.Hash
+				return nil
+			},
+		},
+	}
+}
+
+// NetworkArchiveColumns returns the columns of every archived table.
+func NetworkArchiveColumns() map[string][]string {
+	result := map[string][]string{}
+	for _, t := range (&Persister{}).networkArchiveTables() {
+		columns, _ := dbFields(t.model())
+		result[t.name] = columns
+	}
+	return result
+}
+
+func (p *Persister) ExportNetwork(ctx context.Context, transport aead.Cipher, opts persistence.NetworkExportOptions) (_ *persistence.NetworkArchive, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ExportNetwork")
+	defer otelx.End(span, &err)
+
+	archive := &persistence.NetworkArchive{
+		Version:    persistence.NetworkArchiveVersion,
+		NID:        p.NetworkID(ctx),
+		ExportedAt: time.Now().UTC(),
+	}
+
+	return archive, p.Transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+		for _, t := range p.networkArchiveTables() {
+			q := t.query(ctx, opts)
+			if q == nil {
+				continue
+			}
+
+			// All() requires a pointer to a slice of the model type.
+			all := reflect.New(reflect.SliceOf(reflect.TypeOf(t.model()).Elem()))
+			if err := q.All(all.Interface()); err != nil {
+				return sqlcon.HandleError(err)
+			}
+
+			table := persistence.NetworkArchiveTable{Name: t.name, Rows: make([]map[string]json.RawMessage, 0, all.Elem().Len())}
+			for i := range all.Elem().Len() {
+				row := all.Elem().Index(i).Addr().Interface()
+				if t.seal != nil {
+					if err := t.seal(ctx, transport, row); err != nil {
+						return errors.Wrapf(err, "unable to re-encrypt row of table %s", t.name)
+					}
+				}
+
+				columns, values := dbFields(row)
+				encoded := make(map[string]json.RawMessage, len(columns))
+				for j, column := range columns {
+					if encoded[column], err = json.Marshal(values[j]); err != nil {
+						return errors.WithStack(err)
+					}
+				}
+				table.Rows = append(table.Rows, encoded)
+			}
+			archive.Tables = append(archive.Tables, table)
+		}
+		return nil
+	})
+}
+
+func (p *Persister) ImportNetwork(ctx context.Context, archive *persistence.NetworkArchive, transport aead.Cipher) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ImportNetwork")
+	defer otelx.End(span, &err)
+
+	if archive.Version != persistence.NetworkArchiveVersion {
+		return errors.Errorf("unsupported archive version %d, expected version %d", archive.Version, persistence.NetworkArchiveVersion)
+	}
+
+	tables := map[string]networkArchiveTable{}
+	for _, t := range p.networkArchiveTables() {
+		tables[t.name] = t
+	}
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		for _, archived := range archive.Tables {
+			t, ok := tables[archived.Name]
+			if !ok {
+				return errors.Errorf("archive contains unknown table %s", archived.Name)
+			}
+
+			for _, encoded := range archived.Rows {
+				row := t.model()
+				columns, values := dbFields(row)
+				for j, column := range columns {
+					raw, ok := encoded[column]
+					if !ok {
+						continue
+					}
+					if err := json.Unmarshal(raw, values[j]); err != nil {
+						return errors.Wrapf(err, "unable to decode column %s of table %s", column, t.name)
+					}
+				}
+
+				p.mustSetNetwork(ctx, row)
+				if t.open != nil {
+					if err := t.open(ctx, transport, row); err != nil {
+						return errors.Wrapf(err, "unable to re-encrypt row of table %s", t.name)
+					}
+				}
+
+				// Values are inserted as-is instead of using pop's Create, which would generate IDs and timestamps.
+				args := make([]any, len(values))
+				quoted := make([]string, len(columns))
+				for j := range columns {
+					args[j] = reflect.ValueOf(values[j]).Elem().Interface()
+					quoted[j] = c.Dialect.Quote(columns[j])
+				}
+				if err := c.RawQuery(fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+					c.Dialect.Quote(t.name),
+					strings.Join(quoted, ", "),
+					popx.Placeholders(len(columns)),
+				), args...).Exec(); err != nil {
+					return sqlcon.HandleError(err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// sealSession encrypts the session data of a request with the transport cipher. The data is decrypted first if
+// it was stored encrypted.
+func (p *Persister) sealSession(ctx context.Context, transport aead.Cipher, session []byte) ([]byte, error) {
+	if !gjson.ValidBytes(session) {
+		var err error
+		if session, err = p.r.KeyCipher().Decrypt(ctx, string(session), nil); err != nil {
+			return nil, err
+		}
+	}
+	sealed, err := transport.Encrypt(ctx, session, nil)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(sealed), nil
+}
+
+// openSession decrypts session data sealed with sealSession and encrypts it again if session data is encrypted.
+func (p *Persister) openSession(ctx context.Context, transport aead.Cipher, sealed []byte) ([]byte, error) {
+	session, err := transport.Decrypt(ctx, string(sealed), nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.r.Config().EncryptSessionData(ctx) {
+		encrypted, err := p.r.KeyCipher().Encrypt(ctx, session, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(encrypted), nil
+	}
+	return session, nil
+}
+
+func reencrypt(ctx context.Context, ciphertext string, from, to aead.Cipher) (string, error) {
+	plaintext, err := from.Decrypt(ctx, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return to.Encrypt(ctx, plaintext, nil)
+}
+
+// dbFields returns the column names and pointers to the respective fields of v, which must be a pointer to a struct.
+// Fields of embedded structs are included.
+func dbFields(v any) (columns []string, values []any) {
+	var walk func(rv reflect.Value)
+	walk = func(rv reflect.Value) {
+		rt := rv.Type()
+		for i := range rt.NumField() {
+			f := rt.Field(i)
+			if f.Anonymous && f.Type.Kind() == reflect.Struct {
+				walk(rv.Field(i))
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			column, _, _ := strings.Cut(f.Tag.Get("db"), ",")
+			if column == "" || column == "-" {
+				continue
+			}
+			columns = append(columns, column)
+			values = append(values, rv.Field(i).Addr().Interface())
+		}
+	}
+	walk(reflect.ValueOf(v).Elem())
+	return columns, values
+}
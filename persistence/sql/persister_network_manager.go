@@ -0,0 +1,149 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/network"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/networkx"
+	"github.com/ory/x/otelx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ network.Manager = (*Persister)(nil)
+
+// CreateNetwork implements network.Manager
+func (p *Persister) CreateNetwork(ctx context.Context, n *network.Network) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateNetwork")
+	defer otelx.End(span, &err)
+
+	if n.ID == uuid.Nil {
+		n.ID = uuid.Must(uuid.NewV4())
+	}
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if err := c.Create(&networkx.Network{ID: n.ID}); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		return sqlcon.HandleError(c.Create(n))
+	})
+}
+
+// GetNetwork implements network.Manager
+func (p *Persister) GetNetwork(ctx context.Context, id uuid.UUID) (_ *network.Network, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetNetwork")
+	defer otelx.End(span, &err)
+
+	var n network.Network
+	if err := p.Connection(ctx).Where("id = ?", id).First(&n); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &n, nil
+}
+
+// FindNetworkByName implements network.Manager
+func (p *Persister) FindNetworkByName(ctx context.Context, name string) (_ *network.Network, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FindNetworkByName")
+	defer otelx.End(span, &err)
+
+	var n network.Network
+	if err := p.Connection(ctx).Where("name = ?", name).First(&n); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &n, nil
+}
+
+// FindNetworkByHost implements network.Manager
+func (p *Persister) FindNetworkByHost(ctx context.Context, host string) (_ *network.Network, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FindNetworkByHost")
+	defer otelx.End(span, &err)
+
+	var n network.Network
+	if err := p.Connection(ctx).Where("host = ?", host).First(&n); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &n, nil
+}
+
+// GetNetworks implements network.Manager
+func (p *Persister) GetNetworks(ctx context.Context, pageOpts ...keysetpagination.Option) (_ []network.Network, _ *keysetpagination.Paginator, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetNetworks")
+	defer otelx.End(span, &err)
+
+	paginator, err := keysetpagination.NewPaginator(append(pageOpts,
+		keysetpagination.WithDefaultToken(keysetpagination.NewPageToken(keysetpagination.Column{Name: "id", Value: uuid.Nil})),
+	)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ns []network.Network
+	if err := p.Connection(ctx).Scope(keysetpagination.Paginate[network.Network](paginator)).All(&ns); err != nil {
+		return nil, nil, sqlcon.HandleError(err)
+	}
+	ns, nextPage := keysetpagination.Result(ns, paginator)
+	return ns, nextPage, nil
+}
+
+// DeleteNetwork implements network.Manager
+func (p *Persister) DeleteNetwork(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteNetwork")
+	defer otelx.End(span, &err)
+
+	if id == p.fallbackNID {
+		return errors.WithStack(x.ErrConflict.WithHint("The default network can not be deleted."))
+	}
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if _, err := p.GetNetwork(ctx, id); err != nil {
+			return err
+		}
+
+		// Not all tables reference the networks table with cascading foreign keys on SQLite, which is why the
+		// data is deleted explicitly, dependents first.
+		for _, table := range networkTables {
+			if err := c.RawQuery(fmt.Sprintf("DELETE FROM %s WHERE nid = ?", c.Dialect.Quote(table)), id).Exec(); err != nil {
+				return sqlcon.HandleError(err)
+			}
+		}
+		if err := c.RawQuery("DELETE FROM hydra_network WHERE id = ?", id).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		return sqlcon.HandleError(c.RawQuery("DELETE FROM networks WHERE id = ?", id).Exec())
+	})
+}
+
+// networkTables are all tables which are partitioned by the network ID, in the order they have to be deleted in.
+var networkTables = []string{
+	"hydra_audit_lock",
+	"hydra_audit_event",
+	"hydra_ssf_event",
+	"hydra_ssf_stream",
+	"hydra_oauth2_trusted_jwt_bearer_issuer",
+	"hydra_oauth2_access",
+	"hydra_oauth2_refresh",
+	"hydra_oauth2_refresh_family",
+	"hydra_oauth2_code",
+	"hydra_oauth2_oidc",
+	"hydra_oauth2_pkce",
+	"hydra_oauth2_par",
+	"hydra_oauth2_device_auth_codes",
+	"hydra_oauth2_jti_blacklist",
+	"hydra_oauth2_logout_request",
+	"hydra_oauth2_obfuscated_authentication_session",
+	"hydra_oauth2_consent_history",
+	"hydra_oauth2_flow",
+	"hydra_oauth2_authentication_session",
+	"hydra_jwk",
+	"hydra_oauth2_initial_access_token",
+	"hydra_client",
+}
@@ -0,0 +1,223 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/audit"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/hydra/v2/persistence"
+	"github.com/ory/hydra/v2/ssf"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/sqlxx"
+)
+
+func TestExportImportNetwork(t *testing.T) {
+	t.Parallel()
+
+	source := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValue(config.KeyGetSystemSecret, []string{"source-system-secret-0123456789"})))
+	target := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValue(config.KeyGetSystemSecret, []string{"target-system-secret-0123456789"})))
+	ctx := t.Context()
+
+	cl := &client.Client{ID: "export-client", Secret: "export-secret", Scope: "openid offline"}
+	require.NoError(t, source.Persister().CreateClient(ctx, cl))
+
+	jwtClient := &client.Client{ID: "export-jwt-client", Secret: "export-jwt-secret-0123456789", TokenEndpointAuthMethod: "client_secret_jwt", TokenEndpointAuthSigningAlgorithm: "HS256"}
+	require.NoError(t, source.Persister().CreateClient(ctx, jwtClient))
+
+	keys := newKeySet("export-set", "sig")
+	require.NoError(t, source.KeyManager().AddKeySet(ctx, "export-set", keys))
+
+	grantKeys := newKeySet("grant-set", "sig")
+	grant := trust.Grant{
+		ID:        uuid.Must(uuid.NewV4()),
+		Issuer:    "https://export.example.com",
+		Subject:   "export-subject",
+		Scope:     []string{"openid"},
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Round(time.Second),
+		PublicKey: trust.PublicKey{Set: "https://export.example.com", KeyID: grantKeys.Keys[0].KeyID},
+	}
+	require.NoError(t, source.Persister().CreateGrant(ctx, grant, grantKeys.Keys[0].Public()))
+
+	session := &flow.LoginSession{ID: uuid.Must(uuid.NewV4()).String(), Subject: "export-subject", Remember: true, AuthenticatedAt: sqlxx.NullTime(time.Now().UTC().Round(time.Second))}
+	persistLoginSession(ctx, t, source.Persister(), session)
+
+	f := newFlow(source.Persister().NetworkID(ctx), cl.ID, "export-subject", sqlxx.NullString(session.ID))
+	f.ConsentRequestID = sqlxx.NullString(uuid.Must(uuid.NewV4()).String())
+	require.NoError(t, f.HandleConsentRequest(&flow.AcceptOAuth2ConsentRequest{GrantedScope: []string{"openid"}, Remember: true}))
+	f.State = flow.FlowStateConsentUsed
+	require.NoError(t, source.ConsentManager().CreateConsentSession(ctx, f))
+
+	request := fosite.NewRequest()
+	request.SetID("export-request")
+	request.Client = cl
+	request.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "export-subject"}}
+	signature := uuid.Must(uuid.NewV4()).String()
+	require.NoError(t, source.Persister().CreateRefreshTokenSession(ctx, signature, "", request))
+
+	iat := &client.InitialAccessToken{ID: uuid.Must(uuid.NewV4()), Signature: "export-iat-signature", Description: "export"}
+	require.NoError(t, source.Persister().CreateInitialAccessToken(ctx, iat))
+
+	par := fosite.NewAuthorizeRequest()
+	par.SetID("export-par")
+	par.Client = cl
+	par.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "export-subject"}}
+	par.Session.SetExpiresAt(fosite.PushedAuthorizeRequestContext, time.Now().Add(time.Hour))
+	requestURI := "urn:ietf:params:oauth:request_uri:" + uuid.Must(uuid.NewV4()).String()
+	require.NoError(t, source.Persister().CreatePARSession(ctx, requestURI, par))
+
+	stream := &ssf.Stream{
+		ID:              uuid.Must(uuid.NewV4()),
+		Audience:        []string{"https://receiver.example.com"},
+		EventsRequested: []string{ssf.EventTypeSessionRevoked},
+		Delivery:        ssf.Delivery{Method: ssf.DeliveryMethodPush, EndpointURL: "https://receiver.example.com/events", AuthorizationHeader: "Bearer export-header"},
+		Status:          ssf.StreamStatusPaused,
+	}
+	require.NoError(t, source.Persister().CreateSSFStream(ctx, stream))
+	pending := ssf.PendingEvent{ID: uuid.Must(uuid.NewV4()), StreamID: stream.ID, Token: "export-set", CreatedAt: time.Now().UTC().Round(time.Second), ExpiresAt: time.Now().Add(time.Hour).UTC().Round(time.Second)}
+	require.NoError(t, source.Persister().AddPendingSSFEvents(ctx, pending))
+
+	for _, action := range []string{audit.ActionClientCreated, audit.ActionClientUpdated} {
+		require.NoError(t, source.Persister().AppendAuditEvent(ctx, &audit.Event{Action: action, ResourceID: cl.ID}))
+	}
+
+	transport, err := persistence.NewTransportCipher("some-transport-key")
+	require.NoError(t, err)
+
+	t.Run("case=rejects short transport keys", func(t *testing.T) {
+		_, err := persistence.NewTransportCipher("short")
+		require.Error(t, err)
+	})
+
+	t.Run("case=refresh tokens are only exported on request", func(t *testing.T) {
+		archive, err := source.Persister().ExportNetwork(ctx, transport, persistence.NetworkExportOptions{})
+		require.NoError(t, err)
+		for _, table := range archive.Tables {
+			assert.NotEqual(t, "hydra_oauth2_refresh", table.Name)
+		}
+	})
+
+	archive, err := source.Persister().ExportNetwork(ctx, transport, persistence.NetworkExportOptions{IncludeRefreshTokens: true})
+	require.NoError(t, err)
+	assert.Equal(t, persistence.NetworkArchiveVersion, archive.Version)
+	assert.Equal(t, source.Persister().NetworkID(ctx), archive.NID)
+
+	// The archive must survive serialization.
+	raw, err := json.Marshal(archive)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "export-secret")
+	assert.NotContains(t, string(raw), "export-header")
+	archive = new(persistence.NetworkArchive)
+	require.NoError(t, json.Unmarshal(raw, archive))
+
+	t.Run("case=rejects the wrong transport key", func(t *testing.T) {
+		wrong, err := persistence.NewTransportCipher("some-other-transport-key")
+		require.NoError(t, err)
+		require.Error(t, testhelpers.NewRegistryMemory(t).Persister().ImportNetwork(ctx, archive, wrong))
+	})
+
+	t.Run("case=rejects unknown archive versions", func(t *testing.T) {
+		unknown := *archive
+		unknown.Version++
+		require.Error(t, testhelpers.NewRegistryMemory(t).Persister().ImportNetwork(ctx, &unknown, transport))
+	})
+
+	require.NoError(t, target.Persister().ImportNetwork(ctx, archive, transport))
+
+	t.Run("case=imports clients", func(t *testing.T) {
+		actual, err := target.ClientManager().GetConcreteClient(ctx, cl.ID)
+		require.NoError(t, err)
+		assert.Equal(t, target.Persister().NetworkID(ctx), actual.NID)
+		assert.Equal(t, cl.Scope, actual.Scope)
+		require.NoError(t, target.ClientHasher().Compare(ctx, actual.GetHashedSecret(), []byte("export-secret")))
+	})
+
+	t.Run("case=imports and re-encrypts client secrets", func(t *testing.T) {
+		actual, err := target.ClientManager().GetConcreteClient(ctx, jwtClient.ID)
+		require.NoError(t, err)
+
+		secret, err := target.ClientManager().GetClientSecret(ctx, actual)
+		require.NoError(t, err)
+		assert.Equal(t, "export-jwt-secret-0123456789", string(secret))
+	})
+
+	t.Run("case=imports and re-encrypts keys", func(t *testing.T) {
+		actual, err := target.KeyManager().GetKeySet(ctx, "export-set")
+		require.NoError(t, err)
+		requireKeySetEqual(t, keys, actual)
+	})
+
+	t.Run("case=imports trust relationships", func(t *testing.T) {
+		actual, err := target.GrantManager().GetConcreteGrant(ctx, grant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, grant.Issuer, actual.Issuer)
+		assert.Equal(t, grant.Scope, actual.Scope)
+
+		key, err := target.Persister().GetPublicKey(ctx, grant.Issuer, grant.Subject, grant.PublicKey.KeyID)
+		require.NoError(t, err)
+		assert.Equal(t, grantKeys.Keys[0].Public().Key, key.Key)
+	})
+
+	t.Run("case=imports remembered sessions", func(t *testing.T) {
+		actual, err := target.Persister().GetRememberedLoginSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, session.Subject, actual.Subject)
+
+		consents, err := target.ConsentManager().FindGrantedAndRememberedConsentRequest(ctx, cl.ID, "export-subject")
+		require.NoError(t, err)
+		assert.Equal(t, f.ConsentRequestID, consents.ConsentRequestID)
+	})
+
+	t.Run("case=imports refresh tokens", func(t *testing.T) {
+		actual, err := target.Persister().GetRefreshTokenSession(ctx, signature, new(oauth2.Session))
+		require.NoError(t, err)
+		assert.Equal(t, "export-request", actual.GetID())
+		assert.Equal(t, "export-subject", actual.GetSession().GetSubject())
+	})
+
+	t.Run("case=imports initial access tokens", func(t *testing.T) {
+		actual, err := target.Persister().GetInitialAccessToken(ctx, iat.ID)
+		require.NoError(t, err)
+		assert.Equal(t, iat.Description, actual.Description)
+	})
+
+	t.Run("case=imports pushed authorization requests", func(t *testing.T) {
+		actual, err := target.Persister().GetPARSession(ctx, requestURI)
+		require.NoError(t, err)
+		assert.Equal(t, "export-par", actual.GetID())
+		assert.Equal(t, "export-subject", actual.GetSession().GetSubject())
+	})
+
+	t.Run("case=imports shared signals streams and pending events", func(t *testing.T) {
+		actual, err := target.Persister().GetSSFStream(ctx, stream.ID)
+		require.NoError(t, err)
+		assert.Equal(t, stream.Delivery.AuthorizationHeader, actual.Delivery.AuthorizationHeader)
+
+		events, err := target.Persister().GetPendingSSFEvents(ctx, stream.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, pending.Token, events[0].Token)
+	})
+
+	t.Run("case=imports the audit log with a valid hash chain", func(t *testing.T) {
+		res, err := audit.Verify(ctx, target)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, res.Events)
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/oauth2/trust"
@@ -739,6 +740,84 @@ func (s *PersisterTestSuite) TestFlushInactiveRefreshTokens() {
 	}
 }
 
+func (s *PersisterTestSuite) TestFlushExpired() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			cl := &client.Client{ID: "client-id"}
+			require.NoError(t, r.Persister().CreateClient(s.t1, cl))
+			for range 3 {
+				fr := fosite.NewRequest()
+				fr.RequestedAt = time.Now().UTC().Add(-24 * time.Hour)
+				fr.Client = &fosite.DefaultClient{ID: cl.ID}
+				fr.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "sub"}}
+				require.NoError(t, r.Persister().CreateAccessTokenSession(s.t1, uuid.Must(uuid.NewV4()).String(), fr))
+			}
+			store, ok := r.OAuth2Storage().(oauth2.AssertionJWTReader)
+			require.True(t, ok)
+			require.NoError(t, store.SetClientAssertionJWTRaw(s.t1, oauth2.NewBlacklistedJTI("expired", time.Now().Add(-time.Hour))))
+			require.NoError(t, store.SetClientAssertionJWTRaw(s.t1, oauth2.NewBlacklistedJTI("valid", time.Now().Add(time.Hour))))
+
+			n, err := r.Persister().FlushExpired(s.t2, janitor.TableAccessTokens, time.Now(), 100, 100)
+			require.NoError(t, err)
+			assert.Zero(t, n)
+
+			n, err = r.Persister().FlushExpired(s.t1, janitor.TableAccessTokens, time.Now(), 2, 1)
+			require.NoError(t, err)
+			assert.Equal(t, 2, n, "the limit is respected")
+			n, err = r.Persister().FlushExpired(s.t1, janitor.TableAccessTokens, time.Now(), 100, 100)
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+
+			n, err = r.Persister().FlushExpired(s.t1, janitor.TableBlacklistedJTIs, time.Now(), 100, 100)
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+			_, err = store.GetClientAssertionJWT(s.t1, "valid")
+			require.NoError(t, err)
+
+			for _, table := range janitor.Tables {
+				_, err := r.Persister().FlushExpired(s.t1, table, time.Now(), 100, 100)
+				require.NoError(t, err, "table %s", table)
+			}
+
+			dropped, err := r.Persister().FlushExpiredPartitions(s.t1)
+			require.NoError(t, err)
+			assert.Empty(t, dropped, "the token tables are not partitioned")
+		})
+	}
+}
+
+func (s *PersisterTestSuite) TestAcquireLease() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			ok, err := r.Persister().AcquireLease(s.t1, "lease", "a", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = r.Persister().AcquireLease(s.t2, "lease", "b", time.Hour)
+			require.NoError(t, err)
+			assert.False(t, ok, "leases are not scoped to networks")
+
+			ok, err = r.Persister().AcquireLease(s.t1, "lease", "a", -time.Hour)
+			require.NoError(t, err)
+			assert.True(t, ok, "the holder can renew the lease")
+
+			ok, err = r.Persister().AcquireLease(s.t1, "lease", "b", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, ok, "an expired lease can be taken over")
+
+			require.NoError(t, r.Persister().ReleaseLease(s.t1, "lease", "a"))
+			ok, err = r.Persister().AcquireLease(s.t1, "lease", "a", time.Hour)
+			require.NoError(t, err)
+			assert.False(t, ok, "only the holder can release the lease")
+
+			require.NoError(t, r.Persister().ReleaseLease(s.t1, "lease", "b"))
+			ok, err = r.Persister().AcquireLease(s.t1, "lease", "a", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestGetAccessTokenSession() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -20,6 +20,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/x"
@@ -58,6 +60,11 @@ type (
 		AccessTokenSignature sql.NullString `db:"access_token_signature"`
 		UsedTimes            sql.NullInt32  `db:"used_times"`
 	}
+	// OAuth2PARTable is a pushed authorization request. Unlike OAuth2RequestSQL, its table name does not depend
+	// on the value, which is required to select multiple rows.
+	OAuth2PARTable struct {
+		OAuth2RequestSQL
+	}
 )
 
 const (
@@ -66,12 +73,17 @@ const (
 	sqlTableRefresh tableName = "refresh"
 	sqlTableCode    tableName = "code"
 	sqlTablePKCE    tableName = "pkce"
+	sqlTablePAR     tableName = "par"
 )
 
 func (r OAuth2RefreshTable) TableName() string {
 	return "hydra_oauth2_refresh"
 }
 
+func (r OAuth2PARTable) TableName() string {
+	return "hydra_oauth2_" + string(sqlTablePAR)
+}
+
 func (r OAuth2RequestSQL) TableName() string {
 	return "hydra_oauth2_" + string(r.Table)
 }
@@ -158,7 +170,7 @@ func (p *Persister) strictRefreshRotation(ctx context.Context, requestID string)
 	return nil
 }
 
-func (p *Persister) gracefulRefreshRotation(ctx context.Context, requestID, refreshSignature string) (err error) {
+func (p *Persister) gracefulRefreshRotation(ctx context.Context, requestID, refreshSignature string, graceful config.GracefulRefreshTokenRotation) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.gracefulRefreshRotation",
 		trace.WithAttributes(
 			attribute.String("request_id", requestID),
@@ -169,7 +181,7 @@ func (p *Persister) gracefulRefreshRotation(ctx context.Context, requestID, refr
 	now := time.Now().UTC().Round(time.Millisecond)
 	// The new expiry of the token starts now and ends at the end of the graceful token period.
 	// After that, we can prune tokens from the store.
-	expiresAt := newUsedExpiry().Add(p.r.Config().GracefulRefreshTokenRotation(ctx).Period)
+	expiresAt := newUsedExpiry().Add(graceful.Period)
 
 	// Signature is the primary key so no limit needed. We only update first_used_at if it is not set yet (otherwise
 	// we would "refresh" the grace period again and again, and the refresh token would never "expire").
@@ -190,7 +202,7 @@ WHERE signature = ? AND nid = ?`
 		p.NetworkID(ctx),
 	)
 
-	if l := p.r.Config().GracefulRefreshTokenRotation(ctx).Count; l > 0 {
+	if l := graceful.Count; l > 0 {
 		query += " AND (used_times IS NULL OR used_times < ?)"
 		args = append(args, l)
 	}
@@ -246,6 +258,15 @@ WHERE signature = ? AND nid = ?`
 }
 
 func (p *Persister) sqlSchemaFromRequest(ctx context.Context, signature string, r fosite.Requester, table tableName, expiresAt time.Time) (*OAuth2RequestSQL, error) {
+	if expiresAt.IsZero() && (table == sqlTableAccess || table == sqlTableRefresh) {
+		// Tables which are partitioned by expiry require an expiry, see TokenExpiryMigrations.
+		if native, err := p.nativeTokenExpiry(ctx, table); err != nil {
+			return nil, err
+		} else if native {
+			expiresAt = neverExpires
+		}
+	}
+
 	subject := ""
 	if r.GetSession() == nil {
 		p.l.Debugf("Got an empty session in sqlSchemaFromRequest")
@@ -368,7 +389,17 @@ func (p *Persister) deleteSessionByRequestID(ctx context.Context, id string, tab
 	return nil
 }
 
-func (p *Persister) flushInactiveTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int, table tableName, lifespan time.Duration) (err error) {
+func (p *Persister) flushInactiveTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int, table tableName, lifespan time.Duration) (totalDeletedCount int, err error) {
+	if table == sqlTableAccess || table == sqlTableRefresh {
+		// Deleting rows is redundant if the database removes expired tokens itself.
+		if native, err := p.nativeTokenExpiry(ctx, table); err != nil {
+			return 0, err
+		} else if native {
+			p.l.Debugf("Skipping flush of %s because the database removes expired tokens", table)
+			return 0, nil
+		}
+	}
+
 	/* #nosec G201 table is static */
 	// The value of notAfter should be the minimum between input parameter and token max expire based on its configured age
 	requestMaxExpire := time.Now().Add(-lifespan)
@@ -376,7 +407,6 @@ func (p *Persister) flushInactiveTokens(ctx context.Context, notAfter time.Time,
 		notAfter = requestMaxExpire
 	}
 
-	totalDeletedCount := 0
 	for deletedRecords := batchSize; totalDeletedCount < limit && deletedRecords == batchSize; {
 		d := batchSize
 		if limit-totalDeletedCount < batchSize {
@@ -399,7 +429,7 @@ func (p *Persister) flushInactiveTokens(ctx context.Context, notAfter time.Time,
 		p.l.Debugf("Flushing tokens...: %d/%d", totalDeletedCount, limit)
 	}
 	p.l.Debugf("Flush Refresh Tokens flushed_records: %d", totalDeletedCount)
-	return sqlcon.HandleError(err)
+	return totalDeletedCount, sqlcon.HandleError(err)
 }
 
 func toEventOptions(requester fosite.Requester) []trace.EventOption {
@@ -430,6 +460,9 @@ func handleRetryError(err error) error {
 	return err
 }
 
+// neverExpires is the expiry of tokens which do not expire if the database removes expired tokens itself.
+var neverExpires = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
 func newUsedExpiry() time.Time {
 	// Reuse detection is racy and would generally happen within seconds. Using 30 minutes here is a paranoid
 	// setting but ensures that we do not prematurely remove rows while they may still be needed (e.g. for reuse detection).
@@ -616,11 +649,15 @@ func (p *Persister) DeleteAccessTokenSession(ctx context.Context, signature stri
 // CreateRefreshTokenSession implements RefreshTokenStorage
 func (p *Persister) CreateRefreshTokenSession(ctx context.Context, signature string, accessTokenSignature string, requester fosite.Requester) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateRefreshTokenSession",
-		trace.WithAttributes(events.RefreshTokenSignature(signature)),
+		trace.WithAttributes(events.RefreshTokenSignature(refreshTokenAttribute(signature))),
 	)
 	defer otelx.End(span, &err)
 	events.Trace(ctx, events.RefreshTokenIssued, toEventOptions(requester)...)
 
+	if oauth2.IsStatelessRefreshToken(signature) {
+		return p.createStatelessRefreshTokenSession(ctx, signature, requester)
+	}
+
 	req, err := p.sqlSchemaFromRequest(ctx, signature, requester, sqlTableRefresh, requester.GetSession().GetExpiresAt(fosite.RefreshToken).UTC())
 	if err != nil {
 		return err
@@ -649,10 +686,14 @@ func (p *Persister) CreateRefreshTokenSession(ctx context.Context, signature str
 // GetRefreshTokenSession implements RefreshTokenStorage
 func (p *Persister) GetRefreshTokenSession(ctx context.Context, signature string, session fosite.Session) (request fosite.Requester, err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetRefreshTokenSession",
-		trace.WithAttributes(events.RefreshTokenSignature(signature)),
+		trace.WithAttributes(events.RefreshTokenSignature(refreshTokenAttribute(signature))),
 	)
 	defer otelx.End(span, &err)
 
+	if oauth2.IsStatelessRefreshToken(signature) {
+		return p.getStatelessRefreshTokenSession(ctx, signature, session)
+	}
+
 	var row OAuth2RefreshTable
 	if err := p.QueryWithNetwork(ctx).Where("signature = ?", signature).First(&row); errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.WithStack(fosite.ErrNotFound)
@@ -660,45 +701,73 @@ func (p *Persister) GetRefreshTokenSession(ctx context.Context, signature string
 		return nil, sqlcon.HandleError(err)
 	}
 
+	fositeRequest, err := row.toRequest(ctx, session, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if row.Active {
 		// Token is active
-		return row.toRequest(ctx, session, p)
+		return fositeRequest, nil
 	}
 
-	if graceful := p.r.Config().GracefulRefreshTokenRotation(ctx); graceful.Period > 0 &&
+	if graceful := p.gracefulRefreshTokenRotation(ctx, fositeRequest.GetClient()); graceful.Period > 0 &&
 		row.FirstUsedAt.Valid &&
 		row.FirstUsedAt.Time.Add(graceful.Period).After(time.Now()) &&
 		(graceful.Count == 0 || // no limit
 			(row.UsedTimes.Int32 < graceful.Count)) {
 		// We return the request as is, which indicates that the token is active (because we are in the grace period still).
-		return row.toRequest(ctx, session, p)
-	}
-
-	fositeRequest, err := row.toRequest(ctx, session, p)
-	if err != nil {
-		return nil, err
+		return fositeRequest, nil
 	}
 
 	return fositeRequest, errors.WithStack(fosite.ErrInactiveToken)
 }
 
+// gracefulRefreshTokenRotation returns the graceful refresh token rotation which applies to the refresh tokens of
+// the client.
+func (p *Persister) gracefulRefreshTokenRotation(ctx context.Context, c fosite.Client) config.GracefulRefreshTokenRotation {
+	graceful := p.r.Config().GracefulRefreshTokenRotation(ctx)
+	if cl, ok := c.(*client.Client); ok {
+		return cl.GetEffectiveGracefulRefreshTokenRotation(graceful)
+	}
+	return graceful
+}
+
 // DeleteRefreshTokenSession implements RefreshTokenStorage
 func (p *Persister) DeleteRefreshTokenSession(ctx context.Context, signature string) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteRefreshTokenSession",
-		trace.WithAttributes(events.RefreshTokenSignature(signature)),
+		trace.WithAttributes(events.RefreshTokenSignature(refreshTokenAttribute(signature))),
 	)
 	defer otelx.End(span, &err)
+
+	if oauth2.IsStatelessRefreshToken(signature) {
+		// Stateless refresh tokens can not be deleted, so their family is revoked instead.
+		return p.revokeStatelessRefreshToken(ctx, signature)
+	}
 	return p.deleteSessionBySignature(ctx, signature, sqlTableRefresh)
 }
 
-// RotateRefreshToken implements RefreshTokenStorage
-func (p *Persister) RotateRefreshToken(ctx context.Context, requestID, refreshTokenSignature string) (err error) {
+// RotateRefreshToken implements RefreshTokenStorage. It applies the rotation policy of the configuration. The
+// refresh token grant uses RotateClientRefreshToken, which applies the policy of the client.
+func (p *Persister) RotateRefreshToken(ctx context.Context, requestID, refreshTokenSignature string) error {
+	return p.RotateClientRefreshToken(ctx, nil, requestID, refreshTokenSignature)
+}
+
+// RotateClientRefreshToken implements ClientRefreshTokenRotator
+func (p *Persister) RotateClientRefreshToken(ctx context.Context, cl fosite.Client, requestID, refreshTokenSignature string) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RotateRefreshToken")
 	defer otelx.End(span, &err)
 
 	// If we end up here, we have a valid refresh token and can proceed with the rotation.
-	if p.r.Config().GracefulRefreshTokenRotation(ctx).Period > 0 {
-		return handleRetryError(p.gracefulRefreshRotation(ctx, requestID, refreshTokenSignature))
+	if oauth2.IsStatelessRefreshToken(refreshTokenSignature) {
+		return handleRetryError(p.statelessRefreshRotation(ctx, requestID, refreshTokenSignature))
+	}
+	graceful := p.r.Config().GracefulRefreshTokenRotation(ctx)
+	if c, ok := cl.(*client.Client); ok {
+		graceful = c.GetEffectiveGracefulRefreshTokenRotation(graceful)
+	}
+	if graceful.Period > 0 {
+		return handleRetryError(p.gracefulRefreshRotation(ctx, requestID, refreshTokenSignature, graceful))
 	}
 
 	return handleRetryError(p.strictRefreshRotation(ctx, requestID))
@@ -755,12 +824,73 @@ func (p *Persister) DeletePKCERequestSession(ctx context.Context, _, signature s
 	return p.deleteSessionBySignature(ctx, signature, sqlTablePKCE)
 }
 
+// CreatePARSession implements fosite.PARStorage
+func (p *Persister) CreatePARSession(ctx context.Context, requestURI string, request fosite.AuthorizeRequester) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreatePARSession")
+	defer otelx.End(span, &err)
+	return p.createSession(ctx, x.SignatureHash(requestURI), oauth2.PushedAuthorizeRequestToStore(request), sqlTablePAR, request.GetSession().GetExpiresAt(fosite.PushedAuthorizeRequestContext).UTC())
+}
+
+// GetPARSession implements fosite.PARStorage.
+//
+// Pushed authorization requests which were already used are returned as well, because the authorization endpoint is
+// requested with the same request_uri again once the user logged in and consented.
+func (p *Persister) GetPARSession(ctx context.Context, requestURI string) (_ fosite.AuthorizeRequester, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetPARSession")
+	defer otelx.End(span, &err)
+
+	r := OAuth2RequestSQL{Table: sqlTablePAR}
+	err = p.QueryWithNetwork(ctx).
+		Where("signature = ? AND expires_at > ?", x.SignatureHash(requestURI), time.Now().UTC()).
+		First(&r)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.WithStack(fosite.ErrNotFound)
+	} else if err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	fr, err := r.toRequest(ctx, oauth2.NewSessionWithCustomClaims(ctx, p.r.Config(), ""), p)
+	if err != nil {
+		return nil, err
+	}
+	return oauth2.RestorePushedAuthorizeRequest(fr)
+}
+
+// DeletePARSession implements fosite.PARStorage.
+//
+// The pushed authorization request is marked as used instead, and expires once the login and consent flow started
+// with it can no longer be completed.
+func (p *Persister) DeletePARSession(ctx context.Context, requestURI string) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeletePARSession")
+	defer otelx.End(span, &err)
+
+	/* #nosec G201 table is static */
+	return sqlcon.HandleError(
+		p.Connection(ctx).
+			RawQuery(
+				fmt.Sprintf(
+					"UPDATE %s SET active = false, expires_at = ? WHERE signature = ? AND nid = ? AND active = ?",
+					OAuth2RequestSQL{Table: sqlTablePAR}.TableName(),
+				),
+				newUsedExpiry(),
+				x.SignatureHash(requestURI),
+				p.NetworkID(ctx),
+				true,
+			).
+			Exec(),
+	)
+}
+
 // RevokeRefreshToken implements TokenRevocationStorage
 func (p *Persister) RevokeRefreshToken(ctx context.Context, id string) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RevokeRefreshToken",
 		trace.WithAttributes(events.ConsentRequestID(id)),
 	)
 	defer otelx.End(span, &err)
+
+	if err := revokeRefreshFamilies(p.Connection(ctx), p.NetworkID(ctx), id); err != nil {
+		return err
+	}
 	return p.deleteSessionByRequestID(ctx, id, sqlTableRefresh)
 }
 
@@ -777,14 +907,16 @@ func (p *Persister) RevokeAccessToken(ctx context.Context, id string) (err error
 func (p *Persister) FlushInactiveAccessTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushInactiveAccessTokens")
 	defer otelx.End(span, &err)
-	return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableAccess, p.r.Config().GetAccessTokenLifespan(ctx))
+	_, err = p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableAccess, p.r.Config().GetAccessTokenLifespan(ctx))
+	return err
 }
 
 // FlushInactiveRefreshTokens implements FositeStorer
 func (p *Persister) FlushInactiveRefreshTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushInactiveRefreshTokens")
 	defer otelx.End(span, &err)
-	return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableRefresh, p.r.Config().GetRefreshTokenLifespan(ctx))
+	_, err = p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableRefresh, p.r.Config().GetRefreshTokenLifespan(ctx))
+	return err
 }
 
 // DeleteAccessTokens implements FositeStorer
@@ -0,0 +1,164 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+)
+
+// OAuth2RefreshFamily is the compact record kept for stateless refresh tokens. All tokens rotated from the same
+// request share a family, and only the token of the current generation is valid.
+type OAuth2RefreshFamily struct {
+	ID         string         `db:"request_id"`
+	NID        uuid.UUID      `db:"nid"`
+	Generation int            `db:"generation"`
+	Revoked    bool           `db:"revoked"`
+	ExpiresAt  sqlxx.NullTime `db:"expires_at"`
+}
+
+func (OAuth2RefreshFamily) TableName() string {
+	return "hydra_oauth2_refresh_family"
+}
+
+// refreshTokenAttribute returns the value used in traces for the refresh token signature. The signature of a
+// stateless refresh token is the token itself and must not be leaked.
+func refreshTokenAttribute(signature string) string {
+	if oauth2.IsStatelessRefreshToken(signature) {
+		return x.SignatureHash(signature)
+	}
+	return signature
+}
+
+// statelessRefreshTokenRequest converts a stateless refresh token into the row an opaque refresh token would be
+// stored in, so that the request can be restored the same way.
+func statelessRefreshTokenRequest(token *oauth2.StatelessRefreshToken) *OAuth2RequestSQL {
+	return &OAuth2RequestSQL{
+		Request:           token.Family,
+		RequestedAt:       token.RequestedAt,
+		Client:            token.ClientID,
+		Scopes:            strings.Join(token.RequestedScope, "|"),
+		GrantedScope:      strings.Join(token.GrantedScope, "|"),
+		RequestedAudience: strings.Join(token.RequestedAudience, "|"),
+		GrantedAudience:   strings.Join(token.GrantedAudience, "|"),
+		Session:           token.Session,
+		Active:            true,
+		Table:             sqlTableRefresh,
+	}
+}
+
+// getStatelessRefreshTokenSession restores the request of a stateless refresh token. Tokens of an earlier
+// generation or of a revoked family are inactive, which lets fosite revoke the family on reuse.
+func (p *Persister) getStatelessRefreshTokenSession(ctx context.Context, token string, session fosite.Session) (fosite.Requester, error) {
+	t, err := oauth2.DecodeStatelessRefreshToken(ctx, p.r.FlowCipher(), token)
+	if err != nil {
+		return nil, err
+	}
+
+	var family OAuth2RefreshFamily
+	if err := p.QueryWithNetwork(ctx).Where("request_id = ?", t.Family).First(&family); errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.WithStack(fosite.ErrNotFound)
+	} else if err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	if family.Generation < t.Generation {
+		// The token was issued in a rotation which was rolled back.
+		return nil, errors.WithStack(fosite.ErrNotFound)
+	}
+
+	r, err := statelessRefreshTokenRequest(t).toRequest(ctx, session, p)
+	if err != nil {
+		return nil, err
+	}
+	if family.Revoked || family.Generation > t.Generation {
+		return r, errors.WithStack(fosite.ErrInactiveToken)
+	}
+	return r, nil
+}
+
+// createStatelessRefreshTokenSession starts a new family for the first generation of a stateless refresh token,
+// and moves the expiry of the family for all later generations.
+func (p *Persister) createStatelessRefreshTokenSession(ctx context.Context, token string, requester fosite.Requester) error {
+	t, err := oauth2.DecodeStatelessRefreshToken(ctx, p.r.FlowCipher(), token)
+	if err != nil {
+		return err
+	}
+	expiresAt := sqlxx.NullTime(requester.GetSession().GetExpiresAt(fosite.RefreshToken).UTC())
+
+	if t.Generation == 0 {
+		err := sqlcon.HandleError(p.CreateWithNetwork(ctx, &OAuth2RefreshFamily{ID: t.Family, ExpiresAt: expiresAt}))
+		if errors.Is(err, sqlcon.ErrConcurrentUpdate()) {
+			return fosite.ErrSerializationFailure.WithWrap(err)
+		}
+		return err
+	}
+
+	count, err := p.Connection(ctx).RawQuery(
+		"UPDATE hydra_oauth2_refresh_family SET expires_at = ? WHERE request_id = ? AND nid = ? AND generation = ?",
+		expiresAt, t.Family, p.NetworkID(ctx), t.Generation,
+	).ExecWithCount()
+	if err != nil {
+		return handleRetryError(sqlcon.HandleError(err))
+	} else if count == 0 {
+		return errors.WithStack(fosite.ErrNotFound)
+	}
+	return nil
+}
+
+// statelessRefreshRotation rotates a stateless refresh token by advancing the generation of its family. Only one
+// concurrent rotation of the same token can succeed. Stateless refresh tokens are always rotated strictly.
+func (p *Persister) statelessRefreshRotation(ctx context.Context, requestID, token string) error {
+	t, err := oauth2.DecodeStatelessRefreshToken(ctx, p.r.FlowCipher(), token)
+	if err != nil {
+		return err
+	}
+
+	if err := p.deleteSessionByRequestID(ctx, requestID, sqlTableAccess); err != nil && !errors.Is(err, fosite.ErrNotFound) {
+		return err
+	}
+
+	count, err := p.Connection(ctx).RawQuery(
+		"UPDATE hydra_oauth2_refresh_family SET generation = ? WHERE request_id = ? AND nid = ? AND generation = ? AND revoked = ?",
+		t.Generation+1, t.Family, p.NetworkID(ctx), t.Generation, false,
+	).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	} else if count == 0 {
+		return errors.WithStack(fosite.ErrNotFound)
+	}
+	return nil
+}
+
+// revokeStatelessRefreshToken revokes the family of a stateless refresh token.
+func (p *Persister) revokeStatelessRefreshToken(ctx context.Context, token string) error {
+	t, err := oauth2.DecodeStatelessRefreshToken(ctx, p.r.FlowCipher(), token)
+	if err != nil {
+		return err
+	}
+	return revokeRefreshFamilies(p.Connection(ctx), p.NetworkID(ctx), t.Family)
+}
+
+// revokeRefreshFamilies revokes the stateless refresh tokens issued for the given request IDs.
+func revokeRefreshFamilies(c *pop.Connection, nid uuid.UUID, requestIDs ...any) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	_, err := c.
+		Where("nid = ?", nid).
+		Where("request_id IN (?)", requestIDs...).
+		UpdateQuery(&OAuth2RefreshFamily{Revoked: true}, "revoked")
+	return handleRetryError(sqlcon.HandleError(err))
+}
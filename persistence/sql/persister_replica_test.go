@@ -0,0 +1,175 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/consent/test"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/dbal"
+	"github.com/ory/x/networkx"
+	"github.com/ory/x/servicelocatorx"
+	"github.com/ory/x/sqlcon"
+)
+
+func newPostgresDatabase(t *testing.T, dsn string) string {
+	c, err := pop.NewConnection(&pop.ConnectionDetails{URL: dsn})
+	require.NoError(t, err)
+	require.NoError(t, c.Open())
+	t.Cleanup(func() { _ = c.Close() })
+
+	name := "testdb" + strings.ReplaceAll(uuid.Must(uuid.NewV4()).String(), "-", "")
+	require.NoError(t, c.RawQuery("CREATE DATABASE "+name).Exec())
+	return regexp.MustCompile(`/[a-z0-9]+\?`).ReplaceAllString(dsn, "/"+name+"?")
+}
+
+func TestReadReplicas(t *testing.T) {
+	t.Parallel()
+
+	// The primary and the replica are separate databases which are not replicated, so that every read shows which
+	// of them it was routed to.
+	dsns := map[string][2]string{
+		"sqlite": {dbal.NewSQLiteTestDatabase(t), dbal.NewSQLiteTestDatabase(t)},
+	}
+	if !testing.Short() {
+		pg := testhelpers.ConnectToPG(t)
+		dsns["postgres"] = [2]string{newPostgresDatabase(t, pg), newPostgresDatabase(t, pg)}
+	}
+
+	for db, dsn := range dsns {
+		t.Run(db, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			nid := uuid.Must(uuid.NewV4())
+			withNetwork := driver.WithServiceLocatorOptions(servicelocatorx.WithContextualizer(&contextx.Static{NID: nid}))
+
+			replica := testhelpers.NewRegistrySQLFromURL(t, dsn[1], true, false, withNetwork)
+			primary := testhelpers.NewRegistrySQLFromURL(t, dsn[0], true, false, withNetwork,
+				driver.WithConfigOptions(configx.WithValue(config.KeyDBReadReplicas, []string{dsn[1]})))
+			for _, r := range []*driver.RegistrySQL{primary, replica} {
+				require.NoError(t, r.Persister().Connection(ctx).Create(&networkx.Network{ID: nid}))
+			}
+
+			onReplica := &client.Client{ID: "replica-client"}
+			require.NoError(t, replica.ClientManager().CreateClient(ctx, onReplica))
+			onPrimary := &client.Client{ID: "primary-client"}
+			require.NoError(t, primary.ClientManager().CreateClient(ctx, onPrimary))
+
+			t.Run("case=client lookups use the replica", func(t *testing.T) {
+				_, err := primary.ClientManager().GetClient(ctx, onReplica.ID)
+				require.NoError(t, err)
+
+				// Clients which have not been replicated yet are read from the primary.
+				_, err = primary.ClientManager().GetClient(ctx, onPrimary.ID)
+				require.NoError(t, err)
+
+				cs, _, err := primary.ClientManager().GetClients(ctx, client.Filter{})
+				require.NoError(t, err)
+				require.Len(t, cs, 1)
+				assert.Equal(t, onReplica.ID, cs[0].ID)
+			})
+
+			t.Run("case=clients which are about to be changed are read from the primary", func(t *testing.T) {
+				_, err := primary.ClientManager().GetConcreteClient(ctx, onPrimary.ID)
+				require.NoError(t, err)
+				_, err = primary.ClientManager().GetConcreteClient(ctx, onReplica.ID)
+				assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+			})
+
+			t.Run("case=transactions use the primary", func(t *testing.T) {
+				require.NoError(t, primary.Persister().Transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+					_, err := primary.ClientManager().GetConcreteClient(ctx, onPrimary.ID)
+					return err
+				}))
+			})
+
+			t.Run("case=JSON Web Key discovery uses the replica", func(t *testing.T) {
+				_, err := replica.KeyManager().GenerateAndPersistKeySet(ctx, "replica-set", "replica-key", "RS256", "sig")
+				require.NoError(t, err)
+				_, err = primary.KeyManager().GenerateAndPersistKeySet(ctx, "primary-set", "primary-key", "RS256", "sig")
+				require.NoError(t, err)
+
+				rr, ok := primary.KeyManager().(jwk.ReplicaReader)
+				require.True(t, ok)
+				keys, err := rr.GetKeySetFromReplica(ctx, "replica-set")
+				require.NoError(t, err)
+				assert.NotEmpty(t, keys.Keys)
+
+				// Key sets which have not been replicated yet are read from the primary.
+				keys, err = rr.GetKeySetFromReplica(ctx, "primary-set")
+				require.NoError(t, err)
+				assert.NotEmpty(t, keys.Keys)
+			})
+
+			t.Run("case=JSON Web Key lookups use the primary", func(t *testing.T) {
+				_, err := primary.KeyManager().GetKeySet(ctx, "primary-set")
+				require.NoError(t, err)
+				_, err = primary.KeyManager().GetKey(ctx, "primary-set", "primary-key")
+				require.NoError(t, err)
+
+				_, err = primary.KeyManager().GetKeySet(ctx, "replica-set")
+				assert.ErrorIs(t, err, x.ErrNotFound)
+				_, err = primary.KeyManager().GetKey(ctx, "replica-set", "replica-key")
+				assert.Error(t, err)
+			})
+
+			t.Run("case=consent session listing uses the replica", func(t *testing.T) {
+				f := test.MockConsentFlow(true, 0, false)
+				f.Client = onReplica
+				f.NID = nid
+				require.NoError(t, replica.ConsentManager().CreateConsentSession(ctx, f))
+
+				sessions, _, err := primary.ConsentManager().FindSubjectsGrantedConsentRequests(ctx, f.Subject)
+				require.NoError(t, err)
+				assert.Len(t, sessions, 1)
+				history, _, err := primary.ConsentManager().FindSubjectsConsentHistory(ctx, f.Subject, "")
+				require.NoError(t, err)
+				assert.Len(t, history, 1)
+
+				// Remembered consent is read right after it was granted, so it is read from the primary.
+				_, err = primary.ConsentManager().FindGrantedAndRememberedConsentRequest(ctx, onReplica.ID, f.Subject)
+				assert.ErrorIs(t, err, consent.ErrNoPreviousConsentFound)
+			})
+
+			t.Run("case=token sessions use the primary", func(t *testing.T) {
+				shared := &client.Client{ID: "shared-client"}
+				require.NoError(t, primary.ClientManager().CreateClient(ctx, shared))
+				require.NoError(t, replica.ClientManager().CreateClient(ctx, shared))
+
+				sess := &oauth2.Session{DefaultSession: openid.NewDefaultSession()}
+				require.NoError(t, primary.OAuth2Storage().CreateAccessTokenSession(ctx, "signature",
+					&fosite.Request{ID: "request", Client: shared, RequestedAt: time.Now(), Session: sess}))
+
+				_, err := primary.OAuth2Storage().GetAccessTokenSession(ctx, "signature", &oauth2.Session{DefaultSession: openid.NewDefaultSession()})
+				require.NoError(t, err)
+			})
+
+			t.Run("case=client deletion uses the primary", func(t *testing.T) {
+				require.NoError(t, primary.ClientManager().DeleteClient(ctx, onPrimary.ID))
+			})
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/ssf"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+)
+
+var _ ssf.Manager = (*Persister)(nil)
+
+// SSFStream is the database representation of ssf.Stream. The authorization header is encrypted.
+type SSFStream struct {
+	ID                  uuid.UUID                   `db:"id"`
+	NID                 uuid.UUID                   `db:"nid"`
+	Audience            sqlxx.StringSliceJSONFormat `db:"audience"`
+	EventsRequested     sqlxx.StringSliceJSONFormat `db:"events_requested"`
+	DeliveryMethod      string                      `db:"delivery_method"`
+	EndpointURL         string                      `db:"endpoint_url"`
+	AuthorizationHeader string                      `db:"authorization_header"`
+	Description         string                      `db:"description"`
+	Status              string                      `db:"status"`
+	StatusReason        string                      `db:"status_reason"`
+	CreatedAt           time.Time                   `db:"created_at"`
+	UpdatedAt           time.Time                   `db:"updated_at"`
+}
+
+func (SSFStream) TableName() string {
+	return "hydra_ssf_stream"
+}
+
+func (p *Persister) sqlSSFStream(ctx context.Context, s *ssf.Stream) (*SSFStream, error) {
+	header := s.Delivery.AuthorizationHeader
+	if header != "" {
+		var err error
+		if header, err = p.r.KeyCipher().Encrypt(ctx, []byte(header), nil); err != nil {
+			return nil, err
+		}
+	}
+	return &SSFStream{
+		ID:                  s.ID,
+		NID:                 p.NetworkID(ctx),
+		Audience:            s.Audience,
+		EventsRequested:     s.EventsRequested,
+		DeliveryMethod:      s.Delivery.Method,
+		EndpointURL:         s.Delivery.EndpointURL,
+		AuthorizationHeader: header,
+		Description:         s.Description,
+		Status:              s.Status,
+		StatusReason:        s.StatusReason,
+		CreatedAt:           s.CreatedAt,
+		UpdatedAt:           s.UpdatedAt,
+	}, nil
+}
+
+func (p *Persister) ssfStream(ctx context.Context, r *SSFStream) (*ssf.Stream, error) {
+	header := r.AuthorizationHeader
+	if header != "" {
+		plain, err := p.r.KeyCipher().Decrypt(ctx, header, nil)
+		if err != nil {
+			return nil, err
+		}
+		header = string(plain)
+	}
+	return &ssf.Stream{
+		ID:              r.ID,
+		NID:             r.NID,
+		Audience:        r.Audience,
+		EventsRequested: r.EventsRequested,
+		Delivery: ssf.Delivery{
+			Method:              r.DeliveryMethod,
+			EndpointURL:         r.EndpointURL,
+			AuthorizationHeader: header,
+		},
+		Description:  r.Description,
+		Status:       r.Status,
+		StatusReason: r.StatusReason,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}, nil
+}
+
+// CreateSSFStream implements ssf.Manager
+func (p *Persister) CreateSSFStream(ctx context.Context, s *ssf.Stream) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateSSFStream")
+	defer otelx.End(span, &err)
+
+	s.NID = p.NetworkID(ctx)
+	s.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	s.UpdatedAt = s.CreatedAt
+	r, err := p.sqlSSFStream(ctx, s)
+	if err != nil {
+		return err
+	}
+	return sqlcon.HandleError(p.Connection(ctx).Create(r))
+}
+
+// GetSSFStream implements ssf.Manager
+func (p *Persister) GetSSFStream(ctx context.Context, id uuid.UUID) (_ *ssf.Stream, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetSSFStream")
+	defer otelx.End(span, &err)
+
+	var r SSFStream
+	if err := p.QueryWithNetwork(ctx).Where("id = ?", id).First(&r); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return p.ssfStream(ctx, &r)
+}
+
+// GetSSFStreams implements ssf.Manager
+func (p *Persister) GetSSFStreams(ctx context.Context) (_ []ssf.Stream, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetSSFStreams")
+	defer otelx.End(span, &err)
+
+	var rs []SSFStream
+	if err := p.QueryWithNetwork(ctx).Order("created_at ASC, id ASC").All(&rs); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	streams := make([]ssf.Stream, 0, len(rs))
+	for i := range rs {
+		s, err := p.ssfStream(ctx, &rs[i])
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, *s)
+	}
+	return streams, nil
+}
+
+// UpdateSSFStream implements ssf.Manager
+func (p *Persister) UpdateSSFStream(ctx context.Context, s *ssf.Stream) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.UpdateSSFStream")
+	defer otelx.End(span, &err)
+
+	s.NID = p.NetworkID(ctx)
+	s.UpdatedAt = time.Now().UTC().Truncate(time.Second)
+	r, err := p.sqlSSFStream(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	n, err := p.Connection(ctx).RawQuery(
+		"UPDATE hydra_ssf_stream SET audience = ?, events_requested = ?, delivery_method = ?, endpoint_url = ?, authorization_header = ?, description = ?, status = ?, status_reason = ?, updated_at = ? WHERE id = ? AND nid = ?",
+		r.Audience, r.EventsRequested, r.DeliveryMethod, r.EndpointURL, r.AuthorizationHeader, r.Description, r.Status, r.StatusReason, r.UpdatedAt, r.ID, r.NID,
+	).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	} else if n == 0 {
+		return errors.WithStack(sqlcon.ErrNoRows())
+	}
+
+	if s.Status == ssf.StreamStatusDisabled {
+		// Events are not held for disabled streams.
+		return sqlcon.HandleError(p.Connection(ctx).RawQuery("DELETE FROM hydra_ssf_event WHERE stream_id = ? AND nid = ?", r.ID, r.NID).Exec())
+	}
+	return nil
+}
+
+// DeleteSSFStream implements ssf.Manager
+func (p *Persister) DeleteSSFStream(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteSSFStream")
+	defer otelx.End(span, &err)
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if err := c.RawQuery("DELETE FROM hydra_ssf_event WHERE stream_id = ? AND nid = ?", id, p.NetworkID(ctx)).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		n, err := c.RawQuery("DELETE FROM hydra_ssf_stream WHERE id = ? AND nid = ?", id, p.NetworkID(ctx)).ExecWithCount()
+		if err != nil {
+			return sqlcon.HandleError(err)
+		} else if n == 0 {
+			return errors.WithStack(sqlcon.ErrNoRows())
+		}
+		return nil
+	})
+}
+
+// AddPendingSSFEvents implements ssf.Manager
+func (p *Persister) AddPendingSSFEvents(ctx context.Context, events ...ssf.PendingEvent) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.AddPendingSSFEvents")
+	defer otelx.End(span, &err)
+
+	for i := range events {
+		events[i].NID = p.NetworkID(ctx)
+	}
+	return sqlcon.HandleError(p.Connection(ctx).Create(events))
+}
+
+// GetPendingSSFEvents implements ssf.Manager
+func (p *Persister) GetPendingSSFEvents(ctx context.Context, streamID uuid.UUID, limit int) (_ []ssf.PendingEvent, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetPendingSSFEvents")
+	defer otelx.End(span, &err)
+
+	var events []ssf.PendingEvent
+	if err := p.QueryWithNetwork(ctx).
+		Where("stream_id = ? AND expires_at > ?", streamID, time.Now().UTC()).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		All(&events); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return events, nil
+}
+
+// DeletePendingSSFEvents implements ssf.Manager
+func (p *Persister) DeletePendingSSFEvents(ctx context.Context, streamID uuid.UUID, ids []uuid.UUID) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeletePendingSSFEvents")
+	defer otelx.End(span, &err)
+
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return sqlcon.HandleError(p.QueryWithNetwork(ctx).
+		Where("stream_id = ?", streamID).
+		Where("id IN (?)", args...).
+		Delete(ssf.PendingEvent{}.TableName()))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ory/hydra/v2/audit"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent/test"
 	"github.com/ory/hydra/v2/driver"
@@ -55,7 +56,13 @@ func testRegistry(t *testing.T, db string, t1, t2 *driver.RegistrySQL) {
 
 		t.Run("case=auth-client", client.TestHelperClientAuthenticate(t1.ClientManager()))
 
+		t.Run("case=client-secret-jwt", client.TestHelperClientSecretJWT(t1.ClientManager()))
+
 		t.Run("case=update-two-clients", client.TestHelperUpdateTwoClients(t1.ClientManager()))
+
+		t.Run("case=update-keeps-initial-access-token", client.TestHelperUpdateClientKeepsInitialAccessToken(t1.ClientManager()))
+
+		t.Run("case=initial-access-tokens", client.TestHelperInitialAccessTokens(t1.InitialAccessTokenManager(), t2.InitialAccessTokenManager()))
 	})
 
 	for _, reg := range []*driver.RegistrySQL{t1, t2} {
@@ -88,6 +95,11 @@ func testRegistry(t *testing.T, db string, t1, t2 *driver.RegistrySQL) {
 		})
 	}
 
+	t.Run("audit", func(t *testing.T) {
+		t.Run("case=concurrent-appends/network=t1", audit.TestHelperConcurrentAppends(t1.AuditManager(), t1))
+		t.Run("case=concurrent-appends/network=t2", audit.TestHelperConcurrentAppends(t2.AuditManager(), t2))
+	})
+
 	t.Run("jwk", func(t *testing.T) {
 		for _, tc := range []struct {
 			alg  string
@@ -142,6 +154,10 @@ func testRegistry(t *testing.T, db string, t1, t2 *driver.RegistrySQL) {
 			t.Run("case=errors", trust.TestHelperGrantManagerErrors(t1.GrantManager(), t1.KeyManager()))
 			t.Run("case=errors", trust.TestHelperGrantManagerErrors(t2.GrantManager(), t2.KeyManager()))
 		})
+		t.Run("parallel boundary", func(t *testing.T) {
+			t.Run("case=federated/network=t1", trust.TestHelperGrantManagerFederatedGrants(t1.GrantManager(), parallel))
+			t.Run("case=federated/network=t2", trust.TestHelperGrantManagerFederatedGrants(t2.GrantManager(), parallel))
+		})
 	})
 }
 
@@ -0,0 +1,191 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssf implements an OpenID Shared Signals Framework (SSF) transmitter.
+//
+// Receivers register streams using the stream configuration API. Whenever Hydra revokes login sessions, consent or
+// tokens, the Transmitter emits a Security Event Token (RFC 8417) to every stream which requested the event type,
+// either by pushing it to the receiver (RFC 8935) or by holding it until the receiver polls it (RFC 8936).
+package ssf
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// EventTypeSessionRevoked is the CAEP event emitted when login sessions are revoked or a client revokes a token.
+	EventTypeSessionRevoked = "https://schemas.openid.net/secevent/caep/event-type/session-revoked"
+
+	// EventTypeTokenClaimsChange is the CAEP event emitted when consent is revoked, which changes the scope of the
+	// tokens issued to the client.
+	EventTypeTokenClaimsChange = "https://schemas.openid.net/secevent/caep/event-type/token-claims-change"
+
+	// EventTypeCredentialCompromise is the RISC event emitted when a refresh token is reused, which indicates that it
+	// was stolen.
+	EventTypeCredentialCompromise = "https://schemas.openid.net/secevent/risc/event-type/credential-compromise"
+
+	// EventTypeVerification is the SSF event sent to a stream when the receiver requests a verification.
+	EventTypeVerification = "https://schemas.openid.net/secevent/ssf/event-type/verification"
+
+	// DeliveryMethodPush delivers events by pushing them to the receiver (RFC 8935).
+	DeliveryMethodPush = "urn:ietf:rfc:8935"
+
+	// DeliveryMethodPoll holds events until the receiver polls them (RFC 8936).
+	DeliveryMethodPoll = "urn:ietf:rfc:8936"
+)
+
+// EventTypesSupported are the event types a stream can request.
+var EventTypesSupported = []string{
+	EventTypeSessionRevoked,
+	EventTypeTokenClaimsChange,
+	EventTypeCredentialCompromise,
+}
+
+// Subject identifies the subject of a security event (RFC 9493).
+type Subject struct {
+	// The format of the subject identifier, for example "iss_sub", "opaque" or "complex".
+	Format string `json:"format"`
+
+	// The issuer of the subject, used by the "iss_sub" format.
+	Issuer string `json:"iss,omitempty"`
+
+	// The subject, used by the "iss_sub" format.
+	Subject string `json:"sub,omitempty"`
+
+	// The identifier, used by the "opaque" format.
+	ID string `json:"id,omitempty"`
+
+	// The user, used by the "complex" format.
+	User *Subject `json:"user,omitempty"`
+
+	// The session, used by the "complex" format.
+	Session *Subject `json:"session,omitempty"`
+
+	// The application, used by the "complex" format.
+	Application *Subject `json:"application,omitempty"`
+}
+
+// UserSubject identifies a user by the issuer and the subject.
+func UserSubject(issuer, subject string) *Subject {
+	return &Subject{Format: "iss_sub", Issuer: issuer, Subject: subject}
+}
+
+// OpaqueSubject identifies a subject by an identifier only the transmitter can resolve.
+func OpaqueSubject(id string) *Subject {
+	return &Subject{Format: "opaque", ID: id}
+}
+
+// Event is a security event which is transmitted to all streams which requested its type.
+type Event struct {
+	// Type is the event type URI.
+	Type string
+
+	// Subject is the subject of the event.
+	Subject *Subject
+
+	// Claims are the event specific claims, which are added to the event timestamp.
+	Claims map[string]any
+}
+
+func (e Event) payload(now time.Time) map[string]any {
+	payload := map[string]any{"event_timestamp": now.Unix()}
+	for k, v := range e.Claims {
+		payload[k] = v
+	}
+	return payload
+}
+
+// SessionRevoked returns the event emitted when the login sessions of a subject, or the login session with the ID
+// sid, are revoked.
+func SessionRevoked(issuer, subject, sid string) Event {
+	s := &Subject{Format: "complex"}
+	if subject != "" {
+		s.User = UserSubject(issuer, subject)
+	}
+	if sid != "" {
+		s.Session = OpaqueSubject(sid)
+	}
+	return Event{
+		Type:    EventTypeSessionRevoked,
+		Subject: s,
+		Claims:  map[string]any{"initiating_entity": "admin"},
+	}
+}
+
+// TokenRevoked returns the event emitted when the client revokes a token it was issued for the subject (RFC 7009),
+// which ends the session of the subject at the client. The credential type is the OAuth 2.0 token type, for example
+// "refresh_token".
+func TokenRevoked(issuer, subject, clientID, tokenType string) Event {
+	s := &Subject{Format: "complex", Application: OpaqueSubject(clientID)}
+	if subject != "" {
+		s.User = UserSubject(issuer, subject)
+	}
+	return Event{
+		Type:    EventTypeSessionRevoked,
+		Subject: s,
+		Claims: map[string]any{
+			"initiating_entity": "user",
+			"reason_admin":      map[string]string{"en": "The client revoked the " + tokenType + "."},
+		},
+	}
+}
+
+// ConsentRevoked returns the event emitted when the consent a subject granted to a client is revoked. The client is
+// empty if the consent was revoked for all clients. If only some scopes or audiences were revoked, they are
+// reported as "revoked_scope" and "revoked_audience" claims, otherwise the scope claim is reported as empty.
+func ConsentRevoked(issuer, subject, clientID string, scope, audience []string) Event {
+	s := &Subject{Format: "complex", User: UserSubject(issuer, subject)}
+	if clientID != "" {
+		s.Application = OpaqueSubject(clientID)
+	}
+
+	claims := map[string]any{"scope": ""}
+	if len(scope) > 0 || len(audience) > 0 {
+		claims = map[string]any{"revoked_scope": scope, "revoked_audience": audience}
+	}
+	return Event{
+		Type:    EventTypeTokenClaimsChange,
+		Subject: s,
+		Claims:  map[string]any{"initiating_entity": "admin", "claims": claims},
+	}
+}
+
+// ConsentRequestRevoked returns the event emitted when the consent session with the given ID is revoked.
+func ConsentRequestRevoked(consentRequestID string) Event {
+	return Event{
+		Type:    EventTypeTokenClaimsChange,
+		Subject: OpaqueSubject(consentRequestID),
+		Claims:  map[string]any{"initiating_entity": "admin", "claims": map[string]any{"scope": ""}},
+	}
+}
+
+// CredentialCompromised returns the event emitted when a token which was issued to the client for the subject is
+// no longer trustworthy. The credential type is the OAuth 2.0 token type, for example "refresh_token".
+func CredentialCompromised(issuer, subject, clientID, tokenType, reason string) Event {
+	s := &Subject{Format: "complex", User: UserSubject(issuer, subject)}
+	if subject == "" {
+		s.User = nil
+	}
+	if clientID != "" {
+		s.Application = OpaqueSubject(clientID)
+	}
+	return Event{
+		Type:    EventTypeCredentialCompromise,
+		Subject: s,
+		Claims: map[string]any{
+			"credential_type": tokenType,
+			"reason_admin":    map[string]string{"en": reason},
+		},
+	}
+}
+
+// Verification returns the verification event for the stream. The state is echoed to the receiver.
+func Verification(streamID uuid.UUID, state string) Event {
+	e := Event{Type: EventTypeVerification, Subject: OpaqueSubject(streamID.String())}
+	if state != "" {
+		e.Claims = map[string]any{"state": state}
+	}
+	return e
+}
@@ -0,0 +1,21 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+func SetMinRetryBackoff(t testing.TB, d time.Duration) {
+	previous := minRetryBackoff
+	minRetryBackoff = d
+	t.Cleanup(func() { minRetryBackoff = previous })
+}
+
+func DeliveryLeaseName(streamID uuid.UUID) string {
+	return deliveryLeaseName(streamID)
+}
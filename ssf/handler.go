@@ -0,0 +1,731 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/httprouterx"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/urlx"
+)
+
+const (
+	ConfigurationPath = "/.well-known/ssf-configuration"
+	StreamPath        = "/ssf/stream"
+	StatusPath        = "/ssf/status"
+	VerificationPath  = "/ssf/verify"
+	PollPath          = "/ssf/poll"
+)
+
+// maxPollEvents is the maximum number of events returned by one poll request.
+const maxPollEvents = 100
+
+type Handler struct {
+	r InternalRegistry
+}
+
+func NewHandler(r InternalRegistry) *Handler {
+	return &Handler{r: r}
+}
+
+func (h *Handler) SetPublicRoutes(public *httprouterx.RouterPublic) {
+	public.GET(ConfigurationPath, h.enabled(h.discoverSSFConfiguration))
+	public.POST(PollPath+"/{id}", h.enabled(h.pollSSFEvents))
+}
+
+func (h *Handler) SetAdminRoutes(admin *httprouterx.RouterAdmin) {
+	admin.GET(StreamPath, h.enabled(h.getSSFStreams))
+	admin.POST(StreamPath, h.enabled(h.createSSFStream))
+	admin.PATCH(StreamPath, h.enabled(h.updateSSFStream))
+	admin.PUT(StreamPath, h.enabled(h.replaceSSFStream))
+	admin.DELETE(StreamPath, h.enabled(h.deleteSSFStream))
+	admin.GET(StatusPath, h.enabled(h.getSSFStreamStatus))
+	admin.POST(StatusPath, h.enabled(h.updateSSFStreamStatus))
+	admin.POST(VerificationPath, h.enabled(h.verifySSFStream))
+}
+
+// enabled responds with 404 Not Found if the transmitter is disabled.
+func (h *Handler) enabled(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !h.r.Config().SSFEnabled(r.Context()) {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrNotFound().WithReason("The shared signals transmitter is disabled.")))
+			return
+		}
+		next(w, r)
+	}
+}
+
+// Shared Signals Transmitter Configuration
+//
+// swagger:model ssfConfiguration
+type Configuration struct {
+	// The version of the Shared Signals Framework specification.
+	SpecVersion string `json:"spec_version"`
+
+	// The issuer of the security event tokens.
+	Issuer string `json:"issuer"`
+
+	// The URL of the JSON Web Key Set used to verify the security event tokens.
+	JWKSURI string `json:"jwks_uri"`
+
+	// The supported delivery methods.
+	DeliveryMethodsSupported []string `json:"delivery_methods_supported"`
+
+	// The URL of the stream configuration endpoint, which is part of the admin API.
+	ConfigurationEndpoint string `json:"configuration_endpoint"`
+
+	// The URL of the stream status endpoint, which is part of the admin API.
+	StatusEndpoint string `json:"status_endpoint"`
+
+	// The URL of the stream verification endpoint, which is part of the admin API.
+	VerificationEndpoint string `json:"verification_endpoint"`
+}
+
+// swagger:route GET /.well-known/ssf-configuration ssf discoverSSFConfiguration
+//
+// # Shared Signals Transmitter Configuration
+//
+// Returns the metadata of the Shared Signals Framework transmitter. The stream management endpoints are part of
+// the admin API.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: ssfConfiguration
+//	  default: errorOAuth2Default
+func (h *Handler) discoverSSFConfiguration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h.r.Writer().Write(w, r, &Configuration{
+		SpecVersion:              "1_0",
+		Issuer:                   h.r.Config().IssuerURL(ctx).String(),
+		JWKSURI:                  h.r.Config().JWKSURL(ctx).String(),
+		DeliveryMethodsSupported: []string{DeliveryMethodPush, DeliveryMethodPoll},
+		ConfigurationEndpoint:    h.adminURL(ctx, StreamPath),
+		StatusEndpoint:           h.adminURL(ctx, StatusPath),
+		VerificationEndpoint:     h.adminURL(ctx, VerificationPath),
+	})
+}
+
+func (h *Handler) adminURL(ctx context.Context, paths ...string) string {
+	return urlx.AppendPaths(h.r.Config().AdminURL(ctx), append([]string{"/admin"}, paths...)...).String()
+}
+
+// Shared Signals Streams
+//
+// swagger:model ssfStreams
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type ssfStreams []Stream
+
+// Shared Signals Streams Request
+//
+// swagger:parameters getSSFStreams
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type ssfStreamsRequest struct {
+	// The ID of the stream. If not set, all streams are returned.
+	//
+	// in: query
+	StreamID string `json:"stream_id"`
+}
+
+// Shared Signals Stream Request
+//
+// swagger:parameters deleteSSFStream getSSFStreamStatus
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type ssfStreamRequest struct {
+	// The ID of the stream.
+	//
+	// in: query
+	// required: true
+	StreamID string `json:"stream_id"`
+}
+
+// swagger:route GET /admin/ssf/stream ssf getSSFStreams
+//
+// # Get Shared Signals Streams
+//
+// Returns the stream with the given ID, or all streams if no ID is given. The authorization header of streams
+// is never returned.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: ssfStreams
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getSSFStreams(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if r.URL.Query().Has("stream_id") {
+		s, err := h.streamFromQuery(r)
+		if err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+		h.r.Writer().Write(w, r, h.present(ctx, s))
+		return
+	}
+
+	streams, err := h.r.SSFManager().GetSSFStreams(ctx)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	out := make([]*Stream, len(streams))
+	for i := range streams {
+		out[i] = h.present(ctx, &streams[i])
+	}
+	h.r.Writer().Write(w, r, out)
+}
+
+// Create Shared Signals Stream Request
+//
+// swagger:parameters createSSFStream updateSSFStream replaceSSFStream
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type createSSFStream struct {
+	// in: body
+	// required: true
+	Body Stream
+}
+
+// swagger:route POST /admin/ssf/stream ssf createSSFStream
+//
+// # Create a Shared Signals Stream
+//
+// Creates a stream for a receiver. The audience identifies the receiver and the requested event types select the
+// events which are delivered. Push streams need the endpoint URL of the receiver; for poll streams, the endpoint
+// URL the receiver polls from is set by the transmitter. New streams are enabled.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  201: ssfStream
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) createSSFStream(w http.ResponseWriter, r *http.Request) {
+	var s Stream
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	s.ID = uuid.Must(uuid.NewV4())
+	s.Status = StreamStatusEnabled
+	if err := h.validate(r.Context(), &s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if err := h.r.SSFManager().CreateSSFStream(r.Context(), &s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().WriteCreated(w, r, StreamPath+"?stream_id="+url.QueryEscape(s.ID.String()), h.present(r.Context(), &s))
+}
+
+// swagger:route PATCH /admin/ssf/stream ssf updateSSFStream
+//
+// # Update a Shared Signals Stream
+//
+// Updates the fields of the stream which are set in the request body. The body must contain the ID of the stream.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: ssfStream
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) updateSSFStream(w http.ResponseWriter, r *http.Request) {
+	h.setSSFStream(w, r, false)
+}
+
+// swagger:route PUT /admin/ssf/stream ssf replaceSSFStream
+//
+// # Replace a Shared Signals Stream
+//
+// Replaces the configuration of the stream. The body must contain the ID of the stream.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: ssfStream
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) replaceSSFStream(w http.ResponseWriter, r *http.Request) {
+	h.setSSFStream(w, r, true)
+}
+
+func (h *Handler) setSSFStream(w http.ResponseWriter, r *http.Request, replace bool) {
+	body, err := readBody(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	var ref struct {
+		ID uuid.UUID `json:"stream_id"`
+	}
+	if err := json.Unmarshal(body, &ref); err != nil || ref.ID == uuid.Nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReason("The request body must contain the ID of the stream.")))
+		return
+	}
+	existing, err := h.r.SSFManager().GetSSFStream(r.Context(), ref.ID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	s := *existing
+	if replace {
+		s = Stream{ID: existing.ID, Status: existing.Status, StatusReason: existing.StatusReason, CreatedAt: existing.CreatedAt}
+	}
+	if err := json.Unmarshal(body, &s); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+	s.ID = existing.ID
+
+	if err := h.validate(r.Context(), &s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if err := h.r.SSFManager().UpdateSSFStream(r.Context(), &s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, h.present(r.Context(), &s))
+}
+
+// swagger:route DELETE /admin/ssf/stream ssf deleteSSFStream
+//
+// # Delete a Shared Signals Stream
+//
+// Deletes the stream and the events which were not delivered yet.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  204: emptyResponse
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) deleteSSFStream(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.URL.Query().Get("stream_id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter stream_id: %v", err)))
+		return
+	}
+
+	if err := h.r.SSFManager().DeleteSSFStream(r.Context(), id); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// swagger:route GET /admin/ssf/status ssf getSSFStreamStatus
+//
+// # Get the Status of a Shared Signals Stream
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: ssfStreamStatus
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getSSFStreamStatus(w http.ResponseWriter, r *http.Request) {
+	s, err := h.streamFromQuery(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, &StreamStatus{StreamID: s.ID, Status: s.Status, Reason: s.StatusReason})
+}
+
+// Update Shared Signals Stream Status Request
+//
+// swagger:parameters updateSSFStreamStatus
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type updateSSFStreamStatus struct {
+	// in: body
+	// required: true
+	Body StreamStatus
+}
+
+// swagger:route POST /admin/ssf/status ssf updateSSFStreamStatus
+//
+// # Update the Status of a Shared Signals Stream
+//
+// Enables, pauses or disables the stream. Events for paused streams are held until the stream is enabled again or
+// they expire; events for disabled streams are dropped. Enabling a paused push stream pushes the held events.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: ssfStreamStatus
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) updateSSFStreamStatus(w http.ResponseWriter, r *http.Request) {
+	var status StreamStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+	if !slices.Contains([]string{StreamStatusEnabled, StreamStatusPaused, StreamStatusDisabled}, status.Status) {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field status must be one of enabled, paused and disabled, but got '%s'.", status.Status)))
+		return
+	}
+
+	s, err := h.r.SSFManager().GetSSFStream(r.Context(), status.StreamID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	s.Status, s.StatusReason = status.Status, status.Reason
+	if err := h.r.SSFManager().UpdateSSFStream(r.Context(), s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	h.r.SSFTransmitter().DeliverPending(r.Context(), *s)
+
+	h.r.Writer().Write(w, r, &StreamStatus{StreamID: s.ID, Status: s.Status, Reason: s.StatusReason})
+}
+
+// Shared Signals Stream Verification
+//
+// swagger:model ssfVerification
+type verification struct {
+	// The ID of the stream.
+	StreamID uuid.UUID `json:"stream_id"`
+
+	// An opaque value which is echoed in the verification event.
+	State string `json:"state,omitempty"`
+}
+
+// Verify Shared Signals Stream Request
+//
+// swagger:parameters verifySSFStream
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type verifySSFStream struct {
+	// in: body
+	// required: true
+	Body verification
+}
+
+// swagger:route POST /admin/ssf/verify ssf verifySSFStream
+//
+// # Verify a Shared Signals Stream
+//
+// Sends a verification event to the stream, which lets the receiver check that events are delivered.
+//
+//	Consumes:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  204: emptyResponse
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) verifySSFStream(w http.ResponseWriter, r *http.Request) {
+	var v verification
+	if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	s, err := h.r.SSFManager().GetSSFStream(r.Context(), v.StreamID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if err := h.r.SSFTransmitter().Verify(r.Context(), *s, v.State); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Shared Signals Poll Request
+//
+// swagger:model ssfPollRequest
+type pollRequest struct {
+	// The maximum number of events to return. Defaults to and is capped at 100.
+	MaxEvents int `json:"maxEvents,omitempty"`
+
+	// Ignored, the endpoint always returns immediately.
+	ReturnImmediately bool `json:"returnImmediately,omitempty"`
+
+	// The IDs of the events the receiver acknowledges. They are not returned again.
+	Ack []uuid.UUID `json:"ack,omitempty"`
+
+	// The events the receiver could not process, keyed by their ID. They are not returned again.
+	SetErrs map[uuid.UUID]pollError `json:"setErrs,omitempty"`
+}
+
+// Shared Signals Poll Error
+//
+// swagger:model ssfPollError
+type pollError struct {
+	// The error code, as defined in RFC 8935.
+	Err string `json:"err"`
+
+	// A human-readable description of the error.
+	Description string `json:"description"`
+}
+
+// Shared Signals Poll Response
+//
+// swagger:model ssfPollResponse
+type pollResponse struct {
+	// The security event tokens, keyed by their ID.
+	Sets map[string]string `json:"sets"`
+
+	// Whether more events are pending.
+	MoreAvailable bool `json:"moreAvailable,omitempty"`
+}
+
+// Poll Shared Signals Events Request
+//
+// swagger:parameters pollSSFEvents
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type pollSSFEvents struct {
+	// The ID of the stream
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+
+	// in: body
+	Body pollRequest
+}
+
+// swagger:route POST /ssf/poll/{id} ssf pollSSFEvents
+//
+// # Poll Shared Signals Events
+//
+// Acknowledges events and returns the pending events of a poll stream as described in RFC 8936. The receiver
+// authenticates with the authorization header of the stream, usually a bearer token. Long polling is not
+// supported; the endpoint always returns immediately. Events are not returned while the stream is paused.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Security:
+//	  bearer:
+//
+//	Responses:
+//	  200: ssfPollResponse
+//	  400: errorOAuth2BadRequest
+//	  default: errorOAuth2Default
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-public-high
+func (h *Handler) pollSSFEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	var req pollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	s, err := h.r.SSFManager().GetSSFStream(ctx, id)
+	if errors.Is(err, sqlcon.ErrNoRows()) || err == nil && !authorized(r, s) {
+		// Unknown streams are not revealed to unauthenticated receivers.
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrUnauthorized().WithReason("The authorization header does not match the poll stream.")))
+		return
+	} else if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	done := req.Ack
+	for jti, e := range req.SetErrs {
+		h.r.Logger().WithField("stream_id", s.ID).WithField("jti", jti).WithField("err", e.Err).
+			Warnf("The receiver was unable to process the security event: %s", e.Description)
+		done = append(done, jti)
+	}
+	if len(done) > 0 {
+		if err := h.r.SSFManager().DeletePendingSSFEvents(ctx, s.ID, done); err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+	}
+
+	res := &pollResponse{Sets: map[string]string{}}
+	if s.Status == StreamStatusEnabled {
+		limit := maxPollEvents
+		if req.MaxEvents > 0 {
+			limit = min(req.MaxEvents, maxPollEvents)
+		}
+		events, err := h.r.SSFManager().GetPendingSSFEvents(ctx, s.ID, limit+1)
+		if err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+		if len(events) > limit {
+			events, res.MoreAvailable = events[:limit], true
+		}
+		for _, e := range events {
+			res.Sets[e.ID.String()] = e.Token
+		}
+	}
+
+	h.r.Writer().Write(w, r, res)
+}
+
+// authorized returns whether the request carries the authorization header of the poll stream.
+func authorized(r *http.Request, s *Stream) bool {
+	header := r.Header.Get("Authorization")
+	return s.Delivery.Method == DeliveryMethodPoll && header != "" &&
+		subtle.ConstantTimeCompare([]byte(header), []byte(s.Delivery.AuthorizationHeader)) == 1
+}
+
+func (h *Handler) streamFromQuery(r *http.Request) (*Stream, error) {
+	id, err := uuid.FromString(r.URL.Query().Get("stream_id"))
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter stream_id: %v", err))
+	}
+	return h.r.SSFManager().GetSSFStream(r.Context(), id)
+}
+
+// validate checks the configuration of the stream and sets the endpoint URL of poll streams.
+func (h *Handler) validate(ctx context.Context, s *Stream) error {
+	if len(s.Audience) == 0 {
+		return errors.WithStack(herodot.ErrBadRequest().WithReason("Field aud must identify the receiver."))
+	}
+	for _, e := range s.EventsRequested {
+		if !slices.Contains(EventTypesSupported, e) {
+			return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Event type '%s' is not supported.", e))
+		}
+	}
+
+	switch s.Delivery.Method {
+	case DeliveryMethodPush:
+		u, err := url.Parse(s.Delivery.EndpointURL)
+		if err != nil || !u.IsAbs() {
+			return errors.WithStack(herodot.ErrBadRequest().WithReason("Field delivery.endpoint_url must be an absolute URL for push delivery."))
+		}
+	case DeliveryMethodPoll:
+		if s.Delivery.AuthorizationHeader == "" {
+			return errors.WithStack(herodot.ErrBadRequest().WithReason("Field delivery.authorization_header must be set for poll delivery, the receiver sends it to authenticate when polling."))
+		}
+		s.Delivery.EndpointURL = urlx.AppendPaths(h.r.Config().PublicURL(ctx), PollPath, s.ID.String()).String()
+	default:
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Field delivery.method must be '%s' or '%s'.", DeliveryMethodPush, DeliveryMethodPoll))
+	}
+	return nil
+}
+
+// present sets the read-only fields of the stream and removes the authorization header.
+func (h *Handler) present(ctx context.Context, s *Stream) *Stream {
+	out := *s
+	out.Issuer = h.r.Config().IssuerURL(ctx).String()
+	out.EventsSupported = EventTypesSupported
+	out.EventsDelivered = []string{}
+	for _, e := range out.EventsRequested {
+		if out.Wants(e) {
+			out.EventsDelivered = append(out.EventsDelivered, e)
+		}
+	}
+	if out.EventsRequested == nil {
+		out.EventsRequested = []string{}
+	}
+	out.Delivery.AuthorizationHeader = ""
+	return &out
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	var body json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err))
+	}
+	return body, nil
+}
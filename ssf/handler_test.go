@@ -0,0 +1,391 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/ssf"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/httprouterx"
+)
+
+func newRegistry(t *testing.T, enabled bool) *driver.RegistrySQL {
+	return testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeySSFEnabled: enabled,
+		config.KeyIssuerURL:  "https://hydra.example.com/",
+	})))
+}
+
+func newServer(t *testing.T, reg *driver.RegistrySQL) *httptest.Server {
+	admin := httprouterx.NewRouterAdminWithPrefix()
+	public := httprouterx.NewRouterPublic()
+	ssf.NewHandler(reg).SetAdminRoutes(admin)
+	ssf.NewHandler(reg).SetPublicRoutes(public)
+
+	mux := http.NewServeMux()
+	mux.Handle("/admin/", admin)
+	mux.Handle("/", public)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func do(t *testing.T, ts *httptest.Server, method, path string, body any) (*http.Response, []byte) {
+	return doWithHeader(t, ts, method, path, "", body)
+}
+
+func doWithHeader(t *testing.T, ts *httptest.Server, method, path, authorization string, body any) (*http.Response, []byte) {
+	var in io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		require.NoError(t, err)
+		in = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(t.Context(), method, ts.URL+path, in)
+	require.NoError(t, err)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	res, err := ts.Client().Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close() //nolint:errcheck
+	out, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res, out
+}
+
+// verify checks the signature of the security event token and returns its claims.
+func verify(t *testing.T, reg *driver.RegistrySQL, token string) jwt.MapClaims {
+	key, err := reg.OpenIDJWTSigner().GetPublicKey(t.Context())
+	require.NoError(t, err)
+	parsed, err := jwt.Parse(token, func(*jwt.Token) (any, error) { return key.Key, nil })
+	require.NoError(t, err)
+	assert.Equal(t, "secevent+jwt", parsed.Header["typ"])
+	assert.Equal(t, key.KeyID, parsed.Header["kid"])
+	return parsed.Claims
+}
+
+func TestHandler(t *testing.T) {
+	ssf.SetMinRetryBackoff(t, 10*time.Millisecond)
+
+	t.Run("case=endpoints are not found if the transmitter is disabled", func(t *testing.T) {
+		ts := newServer(t, newRegistry(t, false))
+
+		res, _ := do(t, ts, http.MethodGet, ssf.ConfigurationPath, nil)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		res, _ = do(t, ts, http.MethodGet, "/admin"+ssf.StreamPath, nil)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	reg := newRegistry(t, true)
+	ts := newServer(t, reg)
+
+	t.Run("case=discovers the transmitter configuration", func(t *testing.T) {
+		res, body := do(t, ts, http.MethodGet, ssf.ConfigurationPath, nil)
+		require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+		assert.Equal(t, "https://hydra.example.com/", gjson.GetBytes(body, "issuer").String())
+		assert.Equal(t, `["urn:ietf:rfc:8935","urn:ietf:rfc:8936"]`, gjson.GetBytes(body, "delivery_methods_supported").Raw)
+		assert.Contains(t, gjson.GetBytes(body, "configuration_endpoint").String(), "/admin/ssf/stream")
+	})
+
+	t.Run("case=rejects invalid streams", func(t *testing.T) {
+		for _, tc := range []struct {
+			name   string
+			stream map[string]any
+		}{
+			{name: "no audience", stream: map[string]any{"delivery": map[string]any{"method": ssf.DeliveryMethodPoll}}},
+			{name: "unknown method", stream: map[string]any{"aud": []string{"rp"}, "delivery": map[string]any{"method": "urn:example"}}},
+			{name: "push without endpoint", stream: map[string]any{"aud": []string{"rp"}, "delivery": map[string]any{"method": ssf.DeliveryMethodPush}}},
+			{name: "poll without authorization header", stream: map[string]any{"aud": []string{"rp"}, "delivery": map[string]any{"method": ssf.DeliveryMethodPoll}}},
+			{name: "unsupported event", stream: map[string]any{"aud": []string{"rp"}, "events_requested": []string{"urn:example"}, "delivery": map[string]any{"method": ssf.DeliveryMethodPoll, "authorization_header": "Bearer secret"}}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				res, body := do(t, ts, http.MethodPost, "/admin"+ssf.StreamPath, tc.stream)
+				assert.Equal(t, http.StatusBadRequest, res.StatusCode, "%s", body)
+			})
+		}
+	})
+
+	t.Run("case=delivers events to poll streams", func(t *testing.T) {
+		res, body := do(t, ts, http.MethodPost, "/admin"+ssf.StreamPath, map[string]any{
+			"aud":              []string{"risk-engine"},
+			"events_requested": []string{ssf.EventTypeSessionRevoked, ssf.EventTypeCredentialCompromise},
+			"delivery":         map[string]any{"method": ssf.DeliveryMethodPoll, "authorization_header": "Bearer poll-secret"},
+		})
+		require.Equal(t, http.StatusCreated, res.StatusCode, "%s", body)
+		assert.False(t, gjson.GetBytes(body, "delivery.authorization_header").Exists(), "the authorization header is write-only")
+		streamID := gjson.GetBytes(body, "stream_id").String()
+		pollPath := ssf.PollPath + "/" + streamID
+		assert.True(t, strings.HasSuffix(gjson.GetBytes(body, "delivery.endpoint_url").String(), pollPath))
+		poll := func(t *testing.T, req map[string]any) (*http.Response, []byte) {
+			return doWithHeader(t, ts, http.MethodPost, pollPath, "Bearer poll-secret", req)
+		}
+
+		t.Run("case=requires the authorization header of the stream", func(t *testing.T) {
+			for _, tc := range []struct {
+				name, path, authorization string
+			}{
+				{name: "no header", path: pollPath},
+				{name: "wrong header", path: pollPath, authorization: "Bearer wrong"},
+				{name: "unknown stream", path: ssf.PollPath + "/" + uuid.Must(uuid.NewV4()).String(), authorization: "Bearer poll-secret"},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					res, body := doWithHeader(t, ts, http.MethodPost, tc.path, tc.authorization, map[string]any{})
+					assert.Equal(t, http.StatusUnauthorized, res.StatusCode, "%s", body)
+				})
+			}
+
+			res, _ := doWithHeader(t, ts, http.MethodPost, "/admin"+pollPath, "Bearer poll-secret", map[string]any{})
+			assert.Equal(t, http.StatusNotFound, res.StatusCode, "the poll endpoint is not part of the admin API")
+		})
+		assert.Equal(t, `["https://schemas.openid.net/secevent/caep/event-type/session-revoked","https://schemas.openid.net/secevent/risc/event-type/credential-compromise"]`, gjson.GetBytes(body, "events_delivered").Raw)
+
+		reg.SSFTransmitter().Transmit(t.Context(), ssf.SessionRevoked("https://hydra.example.com/", "alice", ""))
+		reg.SSFTransmitter().Transmit(t.Context(), ssf.ConsentRevoked("https://hydra.example.com/", "alice", "rp", nil, nil))
+
+		res, body = poll(t, map[string]any{"maxEvents": 10})
+		require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+		sets := gjson.GetBytes(body, "sets").Map()
+		require.Len(t, sets, 1, "the token claims change event was not requested")
+
+		for jti, token := range sets {
+			claims := verify(t, reg, token.String())
+			assert.Equal(t, jti, claims["jti"])
+			assert.Equal(t, "https://hydra.example.com/", claims["iss"])
+			assert.Equal(t, []any{"risk-engine"}, claims["aud"])
+			assert.Equal(t, map[string]any{"format": "complex", "user": map[string]any{"format": "iss_sub", "iss": "https://hydra.example.com/", "sub": "alice"}}, claims["sub_id"])
+			assert.Contains(t, claims["events"], ssf.EventTypeSessionRevoked)
+
+			res, body = poll(t, map[string]any{"ack": []string{jti}})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			assert.Empty(t, gjson.GetBytes(body, "sets").Map(), "acknowledged events are not returned again")
+		}
+
+		t.Run("case=holds events while the stream is paused", func(t *testing.T) {
+			res, body := do(t, ts, http.MethodPost, "/admin"+ssf.StatusPath, map[string]any{"stream_id": streamID, "status": ssf.StreamStatusPaused, "reason": "maintenance"})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+
+			reg.SSFTransmitter().Transmit(t.Context(), ssf.SessionRevoked("https://hydra.example.com/", "", "session-id"))
+			res, body = poll(t, map[string]any{})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			assert.Empty(t, gjson.GetBytes(body, "sets").Map())
+
+			res, body = do(t, ts, http.MethodGet, "/admin"+ssf.StatusPath+"?stream_id="+streamID, nil)
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			assert.Equal(t, "paused", gjson.GetBytes(body, "status").String())
+			assert.Equal(t, "maintenance", gjson.GetBytes(body, "reason").String())
+
+			res, body = do(t, ts, http.MethodPost, "/admin"+ssf.StatusPath, map[string]any{"stream_id": streamID, "status": ssf.StreamStatusEnabled})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			res, body = poll(t, map[string]any{})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			assert.Len(t, gjson.GetBytes(body, "sets").Map(), 1)
+		})
+
+		t.Run("case=reports revoked tokens as revoked sessions", func(t *testing.T) {
+			res, body := poll(t, map[string]any{})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			res, body = poll(t, map[string]any{"ack": gjson.GetBytes(body, "sets|@keys").Value()})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+
+			reg.SSFTransmitter().Transmit(t.Context(), ssf.TokenRevoked("https://hydra.example.com/", "alice", "rp", "refresh_token"))
+			res, body = poll(t, map[string]any{})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			sets := gjson.GetBytes(body, "sets").Map()
+			require.Len(t, sets, 1)
+			for _, token := range sets {
+				claims := verify(t, reg, token.String())
+				assert.Equal(t, map[string]any{
+					"format":      "complex",
+					"user":        map[string]any{"format": "iss_sub", "iss": "https://hydra.example.com/", "sub": "alice"},
+					"application": map[string]any{"format": "opaque", "id": "rp"},
+				}, claims["sub_id"])
+				event := claims["events"].(map[string]any)[ssf.EventTypeSessionRevoked].(map[string]any)
+				assert.Equal(t, "user", event["initiating_entity"])
+			}
+		})
+
+		t.Run("case=deletes the stream", func(t *testing.T) {
+			res, body := do(t, ts, http.MethodDelete, "/admin"+ssf.StreamPath+"?stream_id="+streamID, nil)
+			require.Equal(t, http.StatusNoContent, res.StatusCode, "%s", body)
+			res, _ = do(t, ts, http.MethodGet, "/admin"+ssf.StreamPath+"?stream_id="+streamID, nil)
+			assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		})
+	})
+
+	t.Run("case=pushes events to push streams", func(t *testing.T) {
+		received := make(chan *http.Request, 10)
+		tokens := make(chan string, 10)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received <- r
+			tokens <- string(body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		t.Cleanup(receiver.Close)
+
+		res, body := do(t, ts, http.MethodPost, "/admin"+ssf.StreamPath, map[string]any{
+			"aud":              []string{"relying-party"},
+			"events_requested": []string{ssf.EventTypeTokenClaimsChange},
+			"delivery":         map[string]any{"method": ssf.DeliveryMethodPush, "endpoint_url": receiver.URL, "authorization_header": "Bearer secret"},
+			"description":      "relying party",
+		})
+		require.Equal(t, http.StatusCreated, res.StatusCode, "%s", body)
+		assert.False(t, gjson.GetBytes(body, "delivery.authorization_header").Exists(), "the authorization header is write-only")
+		streamID := gjson.GetBytes(body, "stream_id").String()
+
+		receive := func(t *testing.T) jwt.MapClaims {
+			select {
+			case r := <-received:
+				assert.Equal(t, "application/secevent+jwt", r.Header.Get("Content-Type"))
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				return verify(t, reg, <-tokens)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the security event was not pushed")
+				return nil
+			}
+		}
+
+		reg.SSFTransmitter().Transmit(t.Context(), ssf.ConsentRevoked("https://hydra.example.com/", "alice", "relying-party", []string{"photos"}, nil))
+		claims := receive(t)
+		event := claims["events"].(map[string]any)[ssf.EventTypeTokenClaimsChange].(map[string]any)
+		assert.Equal(t, map[string]any{"revoked_scope": []any{"photos"}, "revoked_audience": nil}, event["claims"])
+		assert.NotZero(t, event["event_timestamp"])
+
+		t.Run("case=sends verification events", func(t *testing.T) {
+			res, body := do(t, ts, http.MethodPost, "/admin"+ssf.VerificationPath, map[string]any{"stream_id": streamID, "state": "abc"})
+			require.Equal(t, http.StatusNoContent, res.StatusCode, "%s", body)
+
+			claims := receive(t)
+			assert.Equal(t, map[string]any{"state": "abc", "event_timestamp": claims["events"].(map[string]any)[ssf.EventTypeVerification].(map[string]any)["event_timestamp"]},
+				claims["events"].(map[string]any)[ssf.EventTypeVerification])
+		})
+
+		t.Run("case=pushes held events when the stream is enabled again", func(t *testing.T) {
+			res, body := do(t, ts, http.MethodPost, "/admin"+ssf.StatusPath, map[string]any{"stream_id": streamID, "status": ssf.StreamStatusPaused})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+
+			reg.SSFTransmitter().Transmit(t.Context(), ssf.ConsentRevoked("https://hydra.example.com/", "bob", "", nil, nil))
+			select {
+			case <-received:
+				t.Fatal("events must not be pushed while the stream is paused")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			res, body = do(t, ts, http.MethodPost, "/admin"+ssf.StatusPath, map[string]any{"stream_id": streamID, "status": ssf.StreamStatusEnabled})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			claims := receive(t)
+			assert.Equal(t, "bob", claims["sub_id"].(map[string]any)["user"].(map[string]any)["sub"])
+		})
+
+		t.Run("case=leaves held events to the instance holding the lease of the stream", func(t *testing.T) {
+			res, body := do(t, ts, http.MethodPost, "/admin"+ssf.StatusPath, map[string]any{"stream_id": streamID, "status": ssf.StreamStatusPaused})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			reg.SSFTransmitter().Transmit(t.Context(), ssf.ConsentRevoked("https://hydra.example.com/", "dave", "", nil, nil))
+
+			lease := ssf.DeliveryLeaseName(uuid.FromStringOrNil(streamID))
+			ok, err := reg.JanitorManager().AcquireLease(t.Context(), lease, "other-instance", time.Minute)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			res, body = do(t, ts, http.MethodPost, "/admin"+ssf.StatusPath, map[string]any{"stream_id": streamID, "status": ssf.StreamStatusEnabled})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			select {
+			case <-received:
+				t.Fatal("events must not be pushed while another instance holds the lease of the stream")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			require.NoError(t, reg.JanitorManager().ReleaseLease(t.Context(), lease, "other-instance"))
+			stream, err := reg.SSFManager().GetSSFStream(t.Context(), uuid.FromStringOrNil(streamID))
+			require.NoError(t, err)
+			reg.SSFTransmitter().DeliverPending(t.Context(), *stream)
+			claims := receive(t)
+			assert.Equal(t, "dave", claims["sub_id"].(map[string]any)["user"].(map[string]any)["sub"])
+		})
+
+		t.Run("case=updates the stream", func(t *testing.T) {
+			res, body := do(t, ts, http.MethodPatch, "/admin"+ssf.StreamPath, map[string]any{"stream_id": streamID, "description": "updated"})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			assert.Equal(t, "updated", gjson.GetBytes(body, "description").String())
+			assert.Equal(t, receiver.URL, gjson.GetBytes(body, "delivery.endpoint_url").String())
+
+			stream, err := reg.SSFManager().GetSSFStream(t.Context(), uuid.FromStringOrNil(streamID))
+			require.NoError(t, err)
+			assert.Equal(t, "Bearer secret", stream.Delivery.AuthorizationHeader, "patching keeps the authorization header")
+
+			res, body = do(t, ts, http.MethodPut, "/admin"+ssf.StreamPath, map[string]any{
+				"stream_id": streamID,
+				"aud":       []string{"relying-party"},
+				"delivery":  map[string]any{"method": ssf.DeliveryMethodPoll, "authorization_header": "Bearer poll-secret"},
+			})
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			assert.Empty(t, gjson.GetBytes(body, "description").String())
+			assert.Equal(t, `[]`, gjson.GetBytes(body, "events_requested").Raw)
+
+			res, body = do(t, ts, http.MethodGet, "/admin"+ssf.StreamPath, nil)
+			require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+			assert.Len(t, gjson.ParseBytes(body).Array(), 1)
+		})
+	})
+
+	t.Run("case=pushes events again which the receiver did not accept", func(t *testing.T) {
+		var attempts atomic.Int32
+		tokens := make(chan string, 10)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if attempts.Add(1) <= 2 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"err":"invalid_request","description":"try again"}`))
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			tokens <- string(body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		t.Cleanup(receiver.Close)
+
+		res, body := do(t, ts, http.MethodPost, "/admin"+ssf.StreamPath, map[string]any{
+			"aud":              []string{"flaky-relying-party"},
+			"events_requested": []string{ssf.EventTypeSessionRevoked},
+			"delivery":         map[string]any{"method": ssf.DeliveryMethodPush, "endpoint_url": receiver.URL},
+		})
+		require.Equal(t, http.StatusCreated, res.StatusCode, "%s", body)
+		streamID := uuid.FromStringOrNil(gjson.GetBytes(body, "stream_id").String())
+
+		reg.SSFTransmitter().Transmit(t.Context(), ssf.SessionRevoked("https://hydra.example.com/", "carol", ""))
+		select {
+		case token := <-tokens:
+			claims := verify(t, reg, token)
+			assert.Equal(t, "carol", claims["sub_id"].(map[string]any)["user"].(map[string]any)["sub"])
+		case <-time.After(5 * time.Second):
+			t.Fatal("the security event was not pushed again")
+		}
+		assert.EqualValues(t, 3, attempts.Load())
+
+		assert.EventuallyWithT(t, func(t *assert.CollectT) {
+			pending, err := reg.SSFManager().GetPendingSSFEvents(context.Background(), streamID, 10)
+			require.NoError(t, err)
+			assert.Empty(t, pending, "delivered events are no longer held")
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+)
+
+type (
+	// Manager stores the streams and the security events which were not delivered yet.
+	Manager interface {
+		CreateSSFStream(ctx context.Context, s *Stream) error
+		GetSSFStream(ctx context.Context, id uuid.UUID) (*Stream, error)
+		GetSSFStreams(ctx context.Context) ([]Stream, error)
+		UpdateSSFStream(ctx context.Context, s *Stream) error
+
+		// DeleteSSFStream deletes the stream and its pending events.
+		DeleteSSFStream(ctx context.Context, id uuid.UUID) error
+
+		AddPendingSSFEvents(ctx context.Context, events ...PendingEvent) error
+
+		// GetPendingSSFEvents returns up to limit events of the stream which did not expire, oldest first.
+		GetPendingSSFEvents(ctx context.Context, streamID uuid.UUID, limit int) ([]PendingEvent, error)
+
+		// DeletePendingSSFEvents deletes the events of the stream with the given IDs.
+		DeletePendingSSFEvents(ctx context.Context, streamID uuid.UUID, ids []uuid.UUID) error
+	}
+
+	ManagerProvider interface {
+		SSFManager() Manager
+	}
+)
@@ -0,0 +1,27 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/x/httpx"
+	"github.com/ory/x/logrusx"
+)
+
+type InternalRegistry interface {
+	httpx.WriterProvider
+	httpx.ClientProvider
+	logrusx.Provider
+	config.Provider
+	jwk.OpenIDSignerProvider
+	janitor.ManagerProvider
+	Registry
+}
+
+type Registry interface {
+	ManagerProvider
+	TransmitterProvider
+}
@@ -0,0 +1,117 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/x/sqlxx"
+)
+
+const (
+	// StreamStatusEnabled streams receive events.
+	StreamStatusEnabled = "enabled"
+
+	// StreamStatusPaused streams do not receive events, but the events are held until the stream is enabled again
+	// or they expire.
+	StreamStatusPaused = "paused"
+
+	// StreamStatusDisabled streams do not receive events and events are not held.
+	StreamStatusDisabled = "disabled"
+)
+
+// Shared Signals Stream
+//
+// swagger:model ssfStream
+type Stream struct {
+	// The ID of the stream.
+	ID uuid.UUID `json:"stream_id"`
+
+	NID uuid.UUID `json:"-"`
+
+	// The issuer of the security event tokens of the stream.
+	Issuer string `json:"iss"`
+
+	// The audience of the security event tokens of the stream, which identifies the receiver.
+	Audience sqlxx.StringSliceJSONFormat `json:"aud"`
+
+	// The event types the transmitter supports.
+	EventsSupported []string `json:"events_supported"`
+
+	// The event types the receiver requested.
+	EventsRequested sqlxx.StringSliceJSONFormat `json:"events_requested"`
+
+	// The event types which are delivered to the receiver, which are the requested event types the transmitter
+	// supports.
+	EventsDelivered []string `json:"events_delivered"`
+
+	// How the events are delivered.
+	Delivery Delivery `json:"delivery"`
+
+	// A description of the stream.
+	Description string `json:"description,omitempty"`
+
+	// The status of the stream, one of "enabled", "paused" and "disabled".
+	Status string `json:"-"`
+
+	// The reason the status was set for.
+	StatusReason string `json:"-"`
+
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Delivery configures how events are delivered to the receiver.
+//
+// swagger:model ssfDelivery
+type Delivery struct {
+	// The delivery method, either "urn:ietf:rfc:8935" (push) or "urn:ietf:rfc:8936" (poll).
+	Method string `json:"method"`
+
+	// The URL of the receiver events are pushed to. For poll delivery, it is the URL of the public endpoint of the
+	// transmitter the receiver polls events from and set by the transmitter.
+	EndpointURL string `json:"endpoint_url,omitempty"`
+
+	// The value of the Authorization header sent when pushing events. For poll delivery, it is the value of the
+	// Authorization header the receiver must send when polling events, for example a bearer token. It is never
+	// returned.
+	AuthorizationHeader string `json:"authorization_header,omitempty"`
+}
+
+// Shared Signals Stream Status
+//
+// swagger:model ssfStreamStatus
+type StreamStatus struct {
+	// The ID of the stream.
+	StreamID uuid.UUID `json:"stream_id"`
+
+	// The status of the stream, one of "enabled", "paused" and "disabled".
+	Status string `json:"status"`
+
+	// The reason the status was set for.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Wants returns whether events of the given type are delivered to the stream.
+func (s *Stream) Wants(eventType string) bool {
+	return slices.Contains(EventTypesSupported, eventType) && slices.Contains(s.EventsRequested, eventType)
+}
+
+// PendingEvent is a security event token which was not delivered yet, because the stream is polled or paused.
+type PendingEvent struct {
+	// ID is the "jti" of the security event token.
+	ID        uuid.UUID `db:"id"`
+	NID       uuid.UUID `db:"nid"`
+	StreamID  uuid.UUID `db:"stream_id"`
+	Token     string    `db:"token"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+func (PendingEvent) TableName() string {
+	return "hydra_ssf_event"
+}
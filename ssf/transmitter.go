@@ -0,0 +1,275 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package ssf
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+)
+
+const (
+	// pendingEventsBatchSize is the number of held events which are pushed at once when a push stream is enabled
+	// again.
+	pendingEventsBatchSize = 100
+
+	// maxRetryBackoff is the longest time the transmitter waits before it pushes the held events of a stream again.
+	maxRetryBackoff = 5 * time.Minute
+
+	// deliveryLeaseTTL is the time an instance may take to push a batch of held events before its lease on the
+	// stream expires. The lease is also held while the instance waits to push them again.
+	deliveryLeaseTTL = time.Minute
+)
+
+// minRetryBackoff is the time the transmitter waits before it pushes the held events of a stream again for the first
+// time. It is a variable so that tests can shorten it.
+var minRetryBackoff = time.Second
+
+type (
+	// Transmitter signs security events and delivers them to the streams of the receivers.
+	Transmitter struct {
+		r InternalRegistry
+
+		// holder identifies the transmitter when it leases a stream to push its held events.
+		holder string
+
+		// delivering contains the IDs of the streams whose held events are being pushed by this transmitter.
+		delivering sync.Map
+	}
+
+	TransmitterProvider interface {
+		SSFTransmitter() *Transmitter
+	}
+)
+
+func NewTransmitter(r InternalRegistry) *Transmitter {
+	host, _ := os.Hostname()
+	return &Transmitter{r: r, holder: host + "/" + uuid.Must(uuid.NewV4()).String()}
+}
+
+// Transmit delivers the event to all streams which requested its type, if the transmitter is enabled.
+//
+// Failing to deliver the event is logged but does not fail the calling request, because the action which caused
+// the event has already been performed at this point.
+func (t *Transmitter) Transmit(ctx context.Context, e Event) {
+	if !t.r.Config().SSFEnabled(ctx) {
+		return
+	}
+
+	streams, err := t.r.SSFManager().GetSSFStreams(ctx)
+	if err != nil {
+		t.r.Logger().WithError(err).WithField("event_type", e.Type).Error("Unable to load the shared signals streams.")
+		return
+	}
+	for _, s := range streams {
+		if s.Status == StreamStatusDisabled || !s.Wants(e.Type) {
+			continue
+		}
+		if err := t.transmit(ctx, s, e); err != nil {
+			t.r.Logger().WithError(err).
+				WithField("stream_id", s.ID).
+				WithField("event_type", e.Type).
+				Error("Unable to transmit the security event.")
+		}
+	}
+}
+
+// Verify sends a verification event to the stream, regardless of the event types it requested.
+func (t *Transmitter) Verify(ctx context.Context, s Stream, state string) error {
+	return t.transmit(ctx, s, Verification(s.ID, state))
+}
+
+// DeliverPending pushes the events which were held while the push stream was paused or the receiver was not
+// reachable. If pushing fails, it is retried with an exponential backoff until the held events expire or the stream
+// is no longer enabled.
+//
+// The held events of a stream are pushed by one instance of Hydra at a time, which holds the lease of the stream.
+// Other instances leave them to it. Events are delivered at least once: an event is pushed again if the instance
+// stopped or its lease expired after pushing the event but before deleting it.
+func (t *Transmitter) DeliverPending(ctx context.Context, s Stream) {
+	t.deliverPendingAfter(ctx, s, 0)
+}
+
+func (t *Transmitter) deliverPendingAfter(ctx context.Context, s Stream, delay time.Duration) {
+	if s.Delivery.Method != DeliveryMethodPush || s.Status != StreamStatusEnabled {
+		return
+	} else if _, running := t.delivering.LoadOrStore(s.ID, struct{}{}); running {
+		return
+	}
+
+	go func() {
+		defer t.delivering.Delete(s.ID)
+		time.Sleep(delay)
+		t.deliverPending(context.WithoutCancel(ctx), s.ID)
+	}()
+}
+
+func (t *Transmitter) deliverPending(ctx context.Context, streamID uuid.UUID) {
+	l := t.r.Logger().WithField("stream_id", streamID)
+	lease := deliveryLeaseName(streamID)
+	defer func() {
+		if err := t.r.JanitorManager().ReleaseLease(ctx, lease, t.holder); err != nil {
+			l.WithError(err).Warn("Unable to release the lease of the stream.")
+		}
+	}()
+
+	backoff := minRetryBackoff
+	for {
+		// The lease is renewed on every attempt and covers the time until the next one.
+		if ok, err := t.r.JanitorManager().AcquireLease(ctx, lease, t.holder, deliveryLeaseTTL+backoff); err != nil {
+			l.WithError(err).Error("Unable to acquire the lease of the stream.")
+			return
+		} else if !ok {
+			l.Debug("The pending security events are pushed by another instance.")
+			return
+		}
+
+		// The stream is loaded again on every attempt, because it may have been paused, changed or deleted.
+		s, err := t.r.SSFManager().GetSSFStream(ctx, streamID)
+		if err != nil {
+			l.WithError(err).Warn("Unable to load the stream of the pending security events.")
+			return
+		} else if s.Delivery.Method != DeliveryMethodPush || s.Status != StreamStatusEnabled {
+			return
+		}
+
+		events, err := t.r.SSFManager().GetPendingSSFEvents(ctx, s.ID, pendingEventsBatchSize)
+		if err != nil {
+			l.WithError(err).Error("Unable to load the pending security events.")
+			return
+		} else if len(events) == 0 {
+			return
+		}
+
+		delivered := make([]uuid.UUID, 0, len(events))
+		for _, e := range events {
+			if err := t.push(ctx, *s, e.Token); err != nil {
+				l.WithError(err).WithField("retry_in", backoff).Warn("Unable to push the pending security event.")
+				break
+			}
+			delivered = append(delivered, e.ID)
+		}
+		if len(delivered) > 0 {
+			if err := t.r.SSFManager().DeletePendingSSFEvents(ctx, s.ID, delivered); err != nil {
+				l.WithError(err).Error("Unable to delete the delivered security events.")
+				return
+			}
+		}
+
+		if len(delivered) < len(events) {
+			time.Sleep(backoff)
+			backoff = min(2*backoff, maxRetryBackoff)
+		} else {
+			backoff = minRetryBackoff
+		}
+	}
+}
+
+// deliveryLeaseName returns the name of the lease which is held while pushing the held events of the stream.
+func deliveryLeaseName(streamID uuid.UUID) string {
+	return "ssf:" + streamID.String()
+}
+
+// transmit pushes the signed event to enabled push streams and holds it for all other streams. Events which can not
+// be pushed are held as well and pushed again later.
+func (t *Transmitter) transmit(ctx context.Context, s Stream, e Event) error {
+	id, token, err := t.sign(ctx, s, e)
+	if err != nil {
+		return err
+	}
+
+	if s.Delivery.Method == DeliveryMethodPush && s.Status == StreamStatusEnabled {
+		go func() {
+			ctx := context.WithoutCancel(ctx)
+			l := t.r.Logger().WithField("stream_id", s.ID).WithField("event_type", e.Type)
+			if err := t.push(ctx, s, token); err != nil {
+				l.WithError(err).Warn("Unable to push the security event, it is held and pushed again later.")
+				if err := t.hold(ctx, s, id, token); err != nil {
+					l.WithError(err).Error("Unable to hold the security event.")
+					return
+				}
+				t.deliverPendingAfter(ctx, s, minRetryBackoff)
+			}
+		}()
+		return nil
+	}
+
+	return t.hold(ctx, s, id, token)
+}
+
+// hold stores the security event token until it is polled or pushed, or it expires.
+func (t *Transmitter) hold(ctx context.Context, s Stream, id uuid.UUID, token string) error {
+	now := time.Now().UTC()
+	return t.r.SSFManager().AddPendingSSFEvents(ctx, PendingEvent{
+		ID:        id,
+		StreamID:  s.ID,
+		Token:     token,
+		CreatedAt: now,
+		ExpiresAt: now.Add(t.r.Config().SSFEventLifespan(ctx)),
+	})
+}
+
+// sign returns the ID and the security event token (RFC 8417) of the event for the stream.
+func (t *Transmitter) sign(ctx context.Context, s Stream, e Event) (uuid.UUID, string, error) {
+	keyID, err := t.r.OpenIDJWTSigner().GetPublicKeyID(ctx)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	id := uuid.Must(uuid.NewV4())
+	now := time.Now().UTC()
+	token, _, err := t.r.OpenIDJWTSigner().Generate(ctx, jwt.MapClaims{
+		"iss":    t.r.Config().IssuerURL(ctx).String(),
+		"aud":    []string(s.Audience),
+		"iat":    now.Unix(),
+		"jti":    id.String(),
+		"sub_id": e.Subject,
+		"events": map[string]any{e.Type: e.payload(now)},
+	}, &jwt.Headers{
+		Extra: map[string]any{"kid": keyID, "typ": "secevent+jwt"},
+	})
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	return id, token, nil
+}
+
+// push delivers the security event token to the receiver as described in RFC 8935.
+func (t *Transmitter) push(ctx context.Context, s Stream, token string) error {
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, s.Delivery.EndpointURL, []byte(token))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/secevent+jwt")
+	req.Header.Set("Accept", "application/json")
+	if s.Delivery.AuthorizationHeader != "" {
+		req.Header.Set("Authorization", s.Delivery.AuthorizationHeader)
+	}
+
+	res, err := t.r.HTTPClient(ctx).Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode != http.StatusAccepted {
+		var body struct {
+			Err         string `json:"err"`
+			Description string `json:"description"`
+		}
+		_ = json.NewDecoder(io.LimitReader(res.Body, 1<<16)).Decode(&body)
+		return errors.Errorf("the receiver responded with status code %d: %s %s", res.StatusCode, body.Err, body.Description)
+	}
+	return nil
+}
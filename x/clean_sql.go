@@ -13,9 +13,11 @@ func DeleteHydraRows(t *testing.T, c *pop.Connection) {
 	for _, tb := range []string{
 		"hydra_oauth2_access",
 		"hydra_oauth2_refresh",
+		"hydra_oauth2_refresh_family",
 		"hydra_oauth2_code",
 		"hydra_oauth2_oidc",
 		"hydra_oauth2_pkce",
+		"hydra_oauth2_par",
 		"hydra_oauth2_device_auth_codes",
 		"hydra_oauth2_flow",
 		"hydra_oauth2_authentication_session",
@@ -25,6 +27,9 @@ func DeleteHydraRows(t *testing.T, c *pop.Connection) {
 		"hydra_oauth2_trusted_jwt_bearer_issuer",
 		"hydra_jwk",
 		"hydra_client",
+		"hydra_lease",
+		"hydra_ssf_event",
+		"hydra_ssf_stream",
 	} {
 		if err := c.RawQuery("DELETE FROM " + tb).Exec(); err != nil {
 			t.Logf(`Unable to delete rows in table "%s": %s`, tb, err)
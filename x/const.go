@@ -6,4 +6,7 @@ package x
 const (
 	OpenIDConnectKeyName = "hydra.openid.id-token"
 	OAuth2JWTKeyName     = "hydra.jwt.access-token"
+	FederationKeyName    = "hydra.openid.federation"
+	MetadataKeyName      = "hydra.oauth2.metadata"
+	IntrospectionKeyName = "hydra.oauth2.introspection"
 )
@@ -22,6 +22,10 @@ const (
 	// LoginRejected will be emitted when the login UI rejects a login request.
 	LoginRejected semconv.Event = "OAuth2LoginRejected"
 
+	// DeviceCodeIssued will be emitted by requests to POST /oauth2/device/auth in case the request was successful.
+	DeviceCodeIssued semconv.Event = "OAuth2DeviceCodeIssued"
+
+	// DeviceUserCodeAccepted will be emitted when a user code is accepted at the device verification endpoint.
 	DeviceUserCodeAccepted semconv.Event = "OAuth2DeviceUserCodeAccepted"
 
 	// ConsentAccepted will be emitted when the consent UI accepts a consent request.
@@ -59,6 +63,10 @@ const (
 
 	// IdentityTokenIssued will be emitted when a refresh token is issued.
 	IdentityTokenIssued semconv.Event = "OIDCIdentityTokenIssued" //nolint:gosec
+
+	// RefreshTokenReused will be emitted by requests to POST /oauth2/token if a refresh token which was already
+	// used is presented again, which indicates that the refresh token was stolen.
+	RefreshTokenReused semconv.Event = "OAuth2RefreshTokenReused" //nolint:gosec
 )
 
 const (
@@ -70,6 +78,7 @@ const (
 	attributeKeyOAuth2TokenFormat           = "OAuth2TokenFormat"           //nolint:gosec
 	attributeKeyOAuth2RefreshTokenSignature = "OAuth2RefreshTokenSignature" //nolint:gosec
 	attributeKeyOAuth2AccessTokenSignature  = "OAuth2AccessTokenSignature"  //nolint:gosec
+	attributeKeyOAuth2TokenActive           = "OAuth2TokenActive"           //nolint:gosec
 	attributeKeyErrorReason                 = "ErrorReason"
 )
 
@@ -99,6 +108,11 @@ func ConsentRequestID(id string) otelattr.KeyValue {
 	return otelattr.String(attributeKeyOAuth2ConsentRequestID, id)
 }
 
+// WithTokenActive emits whether the introspected token is active as part of the event.
+func WithTokenActive(active bool) trace.EventOption {
+	return trace.WithAttributes(otelattr.Bool(attributeKeyOAuth2TokenActive, active))
+}
+
 // WithClientID emits the client ID as part of the event.
 func WithClientID(clientID string) trace.EventOption {
 	return trace.WithAttributes(ClientID(clientID))
@@ -143,11 +157,12 @@ func WithError(err error) trace.EventOption {
 	return trace.WithAttributes(otelattr.String(attributeKeyErrorReason, err.Error()))
 }
 
-// Trace emits an event with the given attributes.
+// Trace emits an event with the given attributes and updates the metric derived from the event.
 func Trace(ctx context.Context, event semconv.Event, opts ...trace.EventOption) {
 	allOpts := append([]trace.EventOption{trace.WithAttributes(semconv.AttributesFromContext(ctx)...)}, opts...)
 	trace.SpanFromContext(ctx).AddEvent(
 		string(event),
 		allOpts...,
 	)
+	observe(ctx, event, opts...)
 }
@@ -0,0 +1,210 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ory/x/otelx/semconv"
+)
+
+// otherClientID is the client_id label value of clients which are not on the allow list.
+const otherClientID = "other"
+
+var (
+	tokensIssued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_tokens_issued_total",
+		Help: "Counts the number of issued access, refresh and ID tokens",
+	}, []string{"token_type", "grant_type", "client_id"})
+	tokenErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_token_errors_total",
+		Help: "Counts the number of failed requests to the token endpoint",
+	}, []string{"client_id"})
+	loginRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_login_requests_total",
+		Help: "Counts the number of login requests accepted or rejected by the login UI",
+	}, []string{"result", "client_id"})
+	consentRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_consent_requests_total",
+		Help: "Counts the number of consent requests accepted or rejected by the consent UI or the consent policy",
+	}, []string{"result", "client_id"})
+	refreshTokenReuses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_refresh_token_reuses_total",
+		Help: "Counts the number of rejected refresh tokens which were used before",
+	}, []string{"client_id"})
+	introspections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_introspections_total",
+		Help: "Counts the number of token introspections by whether the token was active",
+	}, []string{"active", "client_id"})
+	deviceCodesIssued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_device_codes_issued_total",
+		Help: "Counts the number of issued device codes",
+	}, []string{"client_id"})
+	deviceUserCodesAccepted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hydra_oauth2_device_user_codes_accepted_total",
+		Help: "Counts the number of user codes accepted at the device verification endpoint",
+	}, []string{"client_id"})
+
+	// Metrics are the collectors of the metrics derived from the events emitted by Trace.
+	Metrics = []prometheus.Collector{
+		tokensIssued,
+		tokenErrors,
+		loginRequests,
+		consentRequests,
+		refreshTokenReuses,
+		introspections,
+		deviceCodesIssued,
+		deviceUserCodesAccepted,
+	}
+)
+
+// pendingDeviceCodesDesc describes the gauge of the pending device codes, which is read from the storage.
+var pendingDeviceCodesDesc = prometheus.NewDesc(
+	"hydra_oauth2_device_codes_pending",
+	"Number of device codes whose user code was neither accepted nor expired",
+	[]string{"client_id"}, nil,
+)
+
+// pendingDeviceCodesTimeout limits the time a scrape waits for the storage to count the pending device codes.
+const pendingDeviceCodesTimeout = 5 * time.Second
+
+// PendingDeviceCodeCounter counts the device codes which wait for the user to enter their user code.
+type PendingDeviceCodeCounter interface {
+	// CountPendingDeviceCodes returns the number of pending device codes of all networks by client ID.
+	CountPendingDeviceCodes(ctx context.Context) (map[string]int, error)
+}
+
+type pendingDeviceCodesCollector struct {
+	counter PendingDeviceCodeCounter
+	policy  ClientIDLabelPolicy
+	onError func(error)
+}
+
+// NewPendingDeviceCodesCollector returns a collector which counts the pending device codes in the storage on every
+// scrape. Expired device codes are not counted, even if they were not removed from the storage yet. If counting
+// fails, onError is called and the gauge is left out of the scrape.
+func NewPendingDeviceCodesCollector(counter PendingDeviceCodeCounter, policy ClientIDLabelPolicy, onError func(error)) prometheus.Collector {
+	return &pendingDeviceCodesCollector{counter: counter, policy: policy, onError: onError}
+}
+
+func (c *pendingDeviceCodesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pendingDeviceCodesDesc
+}
+
+func (c *pendingDeviceCodesCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), pendingDeviceCodesTimeout)
+	defer cancel()
+
+	counts, err := c.counter.CountPendingDeviceCodes(ctx)
+	if err != nil {
+		c.onError(err)
+		return
+	}
+
+	pending := make(map[string]int, len(counts))
+	for clientID, n := range counts {
+		pending[c.policy.label(clientID)] += n
+	}
+	for clientID, n := range pending {
+		ch <- prometheus.MustNewConstMetric(pendingDeviceCodesDesc, prometheus.GaugeValue, float64(n), clientID)
+	}
+}
+
+// ClientIDLabelPolicy controls the client_id label of the metrics derived from events.
+type ClientIDLabelPolicy struct {
+	// Enabled labels the metrics with the client ID. If false, the label is always empty.
+	Enabled bool
+	// AllowList, if not empty, contains the client IDs used as label values. All other clients are reported as
+	// "other".
+	AllowList []string
+}
+
+func (p ClientIDLabelPolicy) label(clientID string) string {
+	switch {
+	case !p.Enabled || clientID == "":
+		return ""
+	case len(p.AllowList) > 0 && !slices.Contains(p.AllowList, clientID):
+		return otherClientID
+	}
+	return clientID
+}
+
+type clientIDLabelPolicyKey struct{}
+
+// WithClientIDLabelPolicy returns a context which makes Trace apply the policy to the client_id label of the metrics.
+// Without a policy, the client ID is used as it is.
+func WithClientIDLabelPolicy(ctx context.Context, p ClientIDLabelPolicy) context.Context {
+	return context.WithValue(ctx, clientIDLabelPolicyKey{}, p)
+}
+
+func clientIDLabelPolicyFromContext(ctx context.Context) ClientIDLabelPolicy {
+	if p, ok := ctx.Value(clientIDLabelPolicyKey{}).(ClientIDLabelPolicy); ok {
+		return p
+	}
+	return ClientIDLabelPolicy{Enabled: true}
+}
+
+// RegisterMetrics registers the metrics derived from events with the default Prometheus registry.
+func RegisterMetrics() error {
+	for _, c := range Metrics {
+		if err := prometheus.Register(c); err != nil {
+			if !errors.As(err, new(prometheus.AlreadyRegisteredError)) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// observe updates the metric derived from the event, if there is one.
+func observe(ctx context.Context, event semconv.Event, opts ...trace.EventOption) {
+	var clientID, grantType string
+	active := true
+	config := trace.NewEventConfig(opts...)
+	for _, attr := range config.Attributes() {
+		switch attr.Key {
+		case attributeKeyOAuth2ClientID:
+			clientID = attr.Value.AsString()
+		case attributeKeyOAuth2GrantType:
+			grantType = attr.Value.AsString()
+		case attributeKeyOAuth2TokenActive:
+			active = attr.Value.AsBool()
+		}
+	}
+	clientID = clientIDLabelPolicyFromContext(ctx).label(clientID)
+
+	switch event {
+	case AccessTokenIssued:
+		tokensIssued.WithLabelValues("access_token", grantType, clientID).Inc()
+	case RefreshTokenIssued:
+		tokensIssued.WithLabelValues("refresh_token", grantType, clientID).Inc()
+	case IdentityTokenIssued:
+		tokensIssued.WithLabelValues("id_token", grantType, clientID).Inc()
+	case TokenExchangeError:
+		tokenErrors.WithLabelValues(clientID).Inc()
+	case LoginAccepted:
+		loginRequests.WithLabelValues("accepted", clientID).Inc()
+	case LoginRejected:
+		loginRequests.WithLabelValues("rejected", clientID).Inc()
+	case ConsentAccepted:
+		consentRequests.WithLabelValues("accepted", clientID).Inc()
+	case ConsentRejected:
+		consentRequests.WithLabelValues("rejected", clientID).Inc()
+	case RefreshTokenReused:
+		refreshTokenReuses.WithLabelValues(clientID).Inc()
+	case AccessTokenInspected:
+		introspections.WithLabelValues(strconv.FormatBool(active), clientID).Inc()
+	case DeviceCodeIssued:
+		deviceCodesIssued.WithLabelValues(clientID).Inc()
+	case DeviceUserCodeAccepted:
+		deviceUserCodesAccepted.WithLabelValues(clientID).Inc()
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/fosite"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Run("case=derives the metrics from events", func(t *testing.T) {
+		ctx := context.Background()
+
+		before := testutil.ToFloat64(tokensIssued.WithLabelValues("access_token", "authorization_code", "metrics-client"))
+		Trace(ctx, AccessTokenIssued, WithGrantType("authorization_code"), WithClientID("metrics-client"))
+		assert.Equal(t, before+1, testutil.ToFloat64(tokensIssued.WithLabelValues("access_token", "authorization_code", "metrics-client")))
+
+		before = testutil.ToFloat64(consentRequests.WithLabelValues("rejected", "metrics-client"))
+		Trace(ctx, ConsentRejected, WithClientID("metrics-client"), WithSubject("foo"))
+		assert.Equal(t, before+1, testutil.ToFloat64(consentRequests.WithLabelValues("rejected", "metrics-client")))
+
+		before = testutil.ToFloat64(introspections.WithLabelValues("false", ""))
+		Trace(ctx, AccessTokenInspected, WithTokenActive(false))
+		assert.Equal(t, before+1, testutil.ToFloat64(introspections.WithLabelValues("false", "")))
+
+		before = testutil.ToFloat64(tokenErrors.WithLabelValues("metrics-client"))
+		Trace(ctx, TokenExchangeError, WithRequest(&fosite.Request{Client: &fosite.DefaultClient{ID: "metrics-client"}}), WithError(fosite.ErrInvalidGrant))
+		assert.Equal(t, before+1, testutil.ToFloat64(tokenErrors.WithLabelValues("metrics-client")))
+	})
+
+	t.Run("case=applies the client ID label policy", func(t *testing.T) {
+		for _, tc := range []struct {
+			policy   ClientIDLabelPolicy
+			clientID string
+			expected string
+		}{
+			{policy: ClientIDLabelPolicy{Enabled: false}, clientID: "label-client", expected: ""},
+			{policy: ClientIDLabelPolicy{Enabled: true}, clientID: "label-client", expected: "label-client"},
+			{policy: ClientIDLabelPolicy{Enabled: true, AllowList: []string{"label-client"}}, clientID: "label-client", expected: "label-client"},
+			{policy: ClientIDLabelPolicy{Enabled: true, AllowList: []string{"label-client"}}, clientID: "unlisted-client", expected: otherClientID},
+		} {
+			ctx := WithClientIDLabelPolicy(context.Background(), tc.policy)
+
+			before := testutil.ToFloat64(loginRequests.WithLabelValues("accepted", tc.expected))
+			Trace(ctx, LoginAccepted, WithClientID(tc.clientID))
+			assert.Equal(t, before+1, testutil.ToFloat64(loginRequests.WithLabelValues("accepted", tc.expected)), "%+v", tc)
+		}
+	})
+
+	t.Run("case=registers the metrics more than once", func(t *testing.T) {
+		assert.NoError(t, RegisterMetrics())
+		assert.NoError(t, RegisterMetrics())
+	})
+}
+
+type pendingDeviceCodeCounterFunc func(ctx context.Context) (map[string]int, error)
+
+func (f pendingDeviceCodeCounterFunc) CountPendingDeviceCodes(ctx context.Context) (map[string]int, error) {
+	return f(ctx)
+}
+
+func TestPendingDeviceCodesCollector(t *testing.T) {
+	counts := pendingDeviceCodeCounterFunc(func(context.Context) (map[string]int, error) {
+		return map[string]int{"listed-client": 2, "unlisted-client": 3, "another-client": 1}, nil
+	})
+
+	t.Run("case=reads the gauge from the storage", func(t *testing.T) {
+		c := NewPendingDeviceCodesCollector(counts, ClientIDLabelPolicy{Enabled: true, AllowList: []string{"listed-client"}}, func(err error) {
+			t.Errorf("unexpected error: %+v", err)
+		})
+		require.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP hydra_oauth2_device_codes_pending Number of device codes whose user code was neither accepted nor expired
+# TYPE hydra_oauth2_device_codes_pending gauge
+hydra_oauth2_device_codes_pending{client_id="listed-client"} 2
+hydra_oauth2_device_codes_pending{client_id="other"} 4
+`)))
+	})
+
+	t.Run("case=omits the gauge if the storage fails", func(t *testing.T) {
+		var errs []error
+		c := NewPendingDeviceCodesCollector(pendingDeviceCodeCounterFunc(func(context.Context) (map[string]int, error) {
+			return nil, assert.AnError
+		}), ClientIDLabelPolicy{Enabled: true}, func(err error) {
+			errs = append(errs, err)
+		})
+		assert.Equal(t, 0, testutil.CollectAndCount(c))
+		assert.Equal(t, []error{assert.AnError}, errs)
+	})
+}
@@ -18,9 +18,11 @@ import (
 
 type FositeStorer interface {
 	fosite.ClientManager
+	fosite.PARStorage
 	oauth2.AuthorizeCodeStorage
 	oauth2.AccessTokenStorage
 	oauth2.RefreshTokenStorage
+	oauth2.ClientRefreshTokenRotator
 	oauth2.TokenRevocationStorage
 	openid.OpenIDConnectRequestStorage
 	pkce.PKCERequestStorage